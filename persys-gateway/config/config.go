@@ -27,6 +27,7 @@ type Config struct {
 	Forgery     ForgeryConfig   `yaml:"forgery"`
 	Log         LogConfig       `yaml:"log"`
 	Telemetry   TelemetryConfig `yaml:"telemetry"`
+	Authz       AuthzConfig     `yaml:"authorization"`
 }
 
 type AppConfig struct {
@@ -94,6 +95,10 @@ type ClusterConfig struct {
 	Name            string                    `yaml:"name"`
 	RoutingStrategy string                    `yaml:"routing_strategy"`
 	Schedulers      []SchedulerInstanceConfig `yaml:"schedulers"`
+	// DiscoveryDomain is the CoreDNS domain the cluster's elected scheduler
+	// publishes its leader record under. The default cluster falls back to
+	// prow.discovery_domain.
+	DiscoveryDomain string `yaml:"discovery_domain"`
 }
 
 type SchedulerInstanceConfig struct {
@@ -134,6 +139,20 @@ type TelemetryConfig struct {
 	OTLPEndpoint string `yaml:"otlp_endpoint"`
 }
 
+// AuthzConfig limits what mTLS clients may do beyond the plain API.
+type AuthzConfig struct {
+	// WorkloadIONamespaces maps a client certificate common name to the
+	// namespaces it may read logs from and exec into; "*" allows all of
+	// them. The "*" key applies to every client. When empty, no client may
+	// use logs or exec.
+	WorkloadIONamespaces map[string][]string `yaml:"workload_io_namespaces"`
+	// Namespaces maps a client certificate common name to the namespaces it
+	// may use through the scheduler API; "*" allows all of them, which is
+	// also required for cluster-wide routes such as nodes and volumes. The
+	// "*" key applies to every client. When empty, no client may use the API.
+	Namespaces map[string][]string `yaml:"namespaces"`
+}
+
 func LoadConfig() (*Config, error) {
 	path := strings.TrimSpace(os.Getenv("PERSYS_GATEWAY_CONFIG"))
 	if path == "" {
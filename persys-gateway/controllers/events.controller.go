@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	controlv1 "github.com/persys-dev/persys-cloud/persys-gateway/internal/controlv1"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// WatchEventsHandler streams scheduler events as server-sent events. Each
+// event carries its revision as the SSE id, so a reconnecting EventSource
+// resumes after the last event it received via Last-Event-ID.
+func (c *ProwController) WatchEventsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req, ok := watchEventsRequest(ctx)
+		if !ok {
+			return
+		}
+		if last := strings.TrimSpace(ctx.GetHeader("Last-Event-ID")); last != "" && req.FromRevision == 0 {
+			if rev, err := strconv.ParseInt(last, 10, 64); err == nil && rev >= 0 {
+				req.FromRevision = rev + 1
+			}
+		}
+
+		ctx.Header("Content-Type", "text/event-stream")
+		ctx.Header("Cache-Control", "no-cache")
+		ctx.Header("Connection", "keep-alive")
+		ctx.Header("X-Accel-Buffering", "no")
+		ctx.Status(http.StatusOK)
+		ctx.Writer.Flush()
+
+		marshal := protojson.MarshalOptions{UseProtoNames: true}
+		err := c.prowService.WatchEvents(ctx.Request.Context(), c.resolveClusterID(ctx), c.resolveSessionKey(ctx), req, func(event *controlv1.SchedulerEventView) error {
+			data, err := marshal.Marshal(event)
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintf(ctx.Writer, "id: %d\nevent: %s\ndata: %s\n\n", event.GetRevision(), event.GetType(), data); err != nil {
+				return err
+			}
+			ctx.Writer.Flush()
+			return nil
+		})
+		if err != nil {
+			// Headers are already sent, so report the failure in-band.
+			fmt.Fprintf(ctx.Writer, "event: error\ndata: %s\n\n", strconv.Quote(err.Error()))
+			ctx.Writer.Flush()
+		}
+	}
+}
+
+// WatchEventsWebSocketHandler streams scheduler events over a WebSocket, one
+// JSON text frame per event. Clients resume with from_revision.
+func (c *ProwController) WatchEventsWebSocketHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req, ok := watchEventsRequest(ctx)
+		if !ok {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+
+		webSocketServer(func(conn *websocket.Conn) {
+			defer conn.Close()
+			streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+			defer cancel()
+			// The client never sends anything; reading only detects when it
+			// goes away so the scheduler stream can be released.
+			go func() {
+				defer cancel()
+				var discard string
+				for {
+					if err := websocket.Message.Receive(conn, &discard); err != nil {
+						return
+					}
+				}
+			}()
+
+			marshal := protojson.MarshalOptions{UseProtoNames: true}
+			err := c.prowService.WatchEvents(streamCtx, clusterID, sessionKey, req, func(event *controlv1.SchedulerEventView) error {
+				data, err := marshal.Marshal(event)
+				if err != nil {
+					return err
+				}
+				return websocket.Message.Send(conn, string(data))
+			})
+			if err != nil && streamCtx.Err() == nil {
+				_ = websocket.JSON.Send(conn, gin.H{"error": err.Error()})
+			}
+		}).ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
+
+// watchEventsRequest builds the request from the workload_id, node_id, type
+// (repeatable or comma-separated) and from_revision query parameters.
+func watchEventsRequest(ctx *gin.Context) (*controlv1.WatchEventsRequest, bool) {
+	req := &controlv1.WatchEventsRequest{
+		WorkloadId: strings.TrimSpace(ctx.Query("workload_id")),
+		NodeId:     strings.TrimSpace(ctx.Query("node_id")),
+	}
+	for _, raw := range ctx.QueryArray("type") {
+		for _, t := range strings.Split(raw, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				req.Types = append(req.Types, t)
+			}
+		}
+	}
+	if raw := strings.TrimSpace(ctx.Query("from_revision")); raw != "" {
+		rev, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || rev < 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "from_revision must be a non-negative integer"})
+			return nil, false
+		}
+		req.FromRevision = rev
+	}
+	return req, true
+}
+
+// webSocketServer serves handler with a handshake that accepts clients sending
+// no Origin, such as CLIs authenticated by their client certificate, and
+// requires a browser's Origin to name the host it connected to.
+func webSocketServer(handler websocket.Handler) websocket.Server {
+	return websocket.Server{Handler: handler, Handshake: checkWebSocketOrigin}
+}
+
+func checkWebSocketOrigin(config *websocket.Config, req *http.Request) error {
+	if req.Header.Get("Origin") == "" {
+		return nil
+	}
+	origin, err := websocket.Origin(config, req)
+	if err != nil {
+		return err
+	}
+	if origin == nil || !strings.EqualFold(origin.Host, req.Host) {
+		return fmt.Errorf("websocket origin %q does not match host %q", req.Header.Get("Origin"), req.Host)
+	}
+	config.Origin = origin
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/persys-dev/persys-cloud/persys-gateway/services"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProwController struct {
@@ -76,11 +77,832 @@ func (c *ProwController) ScheduleWorkloadHandler() gin.HandlerFunc {
 		if !decodeProtoBody(ctx, req) {
 			return
 		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetSpec().GetNamespace())
+		if !ok {
+			return
+		}
+		if namespace != "" {
+			if req.GetSpec() == nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "spec is required"})
+				return
+			}
+			req.Spec.Namespace = namespace
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListWorkloadsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.ListWorkloadsRequest{Status: ctx.Query("status"), Namespace: namespace}
+
+		resp, err := c.prowService.ListWorkloads(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListPendingWorkloadsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListPendingWorkloadsRequest{}
+
+		resp, err := c.prowService.ListPendingWorkloads(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+// SimulatePlacementHandler runs placement for the spec in the body without
+// scheduling anything and returns every node's filter and score results.
+func (c *ProwController) SimulatePlacementHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.SimulatePlacementRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetSpec().GetNamespace())
+		if !ok {
+			return
+		}
+		if req.GetSpec() != nil {
+			req.Spec.Namespace = namespace
+		}
+		c.simulatePlacement(ctx, req)
+	}
+}
+
+// ExplainPlacementHandler simulates placing a stored workload, which shows
+// why a pending workload does not fit anywhere.
+func (c *ProwController) ExplainPlacementHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !c.authorizeWorkload(ctx, ctx.Param("id")) {
+			return
+		}
+		c.simulatePlacement(ctx, &controlv1.SimulatePlacementRequest{WorkloadId: ctx.Param("id")})
+	}
+}
+
+func (c *ProwController) simulatePlacement(ctx *gin.Context, req *controlv1.SimulatePlacementRequest) {
+	clusterID := c.resolveClusterID(ctx)
+	sessionKey := c.resolveSessionKey(ctx)
+	workloadKey := c.resolveWorkloadKey(ctx)
+
+	resp, err := c.prowService.SimulatePlacement(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+	if err != nil {
+		c.writeProxyError(ctx, err)
+		return
+	}
+	writeProtoJSON(ctx, http.StatusOK, resp)
+}
+
+// WorkloadUsageHistoryHandler returns the usage history of a workload. from
+// and to are RFC 3339 times and step a duration such as 5m; all are optional.
+func (c *ProwController) WorkloadUsageHistoryHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetWorkloadUsageHistoryRequest{WorkloadId: ctx.Param("id"), Namespace: namespace}
+		if req.From, ok = queryTimestamp(ctx, "from"); !ok {
+			return
+		}
+		if req.To, ok = queryTimestamp(ctx, "to"); !ok {
+			return
+		}
+		if raw := strings.TrimSpace(ctx.Query("step")); raw != "" {
+			step, err := time.ParseDuration(raw)
+			if err != nil || step < time.Second {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "step must be a duration of at least 1s"})
+				return
+			}
+			req.StepSeconds = int64(step / time.Second)
+		}
+
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		resp, err := c.prowService.GetWorkloadUsageHistory(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+// queryTimestamp parses an optional RFC 3339 query parameter. It writes a
+// 400 and reports false when the value does not parse.
+func queryTimestamp(ctx *gin.Context, name string) (*timestamppb.Timestamp, bool) {
+	raw := strings.TrimSpace(ctx.Query(name))
+	if raw == "" {
+		return nil, true
+	}
+	at, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": name + " must be an RFC 3339 time"})
+		return nil, false
+	}
+	return timestamppb.New(at), true
+}
+
+func (c *ProwController) GetWorkloadHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetWorkloadRequest{WorkloadId: ctx.Param("id"), Namespace: namespace}
+
+		resp, err := c.prowService.GetWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteWorkloadHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.DeleteWorkloadRequest{WorkloadId: ctx.Param("id"), Namespace: namespace}
+
+		resp, err := c.prowService.DeleteWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) RetryWorkloadHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !c.authorizeWorkload(ctx, ctx.Param("id")) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.RetryWorkloadRequest{WorkloadId: ctx.Param("id")}
+
+		resp, err := c.prowService.RetryWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListWorkloadRevisionsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !c.authorizeWorkload(ctx, ctx.Param("id")) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListWorkloadRevisionsRequest{WorkloadId: ctx.Param("id")}
+
+		resp, err := c.prowService.ListWorkloadRevisions(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) RollbackWorkloadHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.RollbackWorkloadRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		req.WorkloadId = ctx.Param("id")
+		if !c.authorizeWorkload(ctx, req.WorkloadId) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.RollbackWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyReplicaSetHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyReplicaSetRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyReplicaSet(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListReplicaSetsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListReplicaSetsRequest{}
+
+		resp, err := c.prowService.ListReplicaSets(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetReplicaSetHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.GetReplicaSetRequest{ReplicaSetId: ctx.Param("id")}
+
+		resp, err := c.prowService.GetReplicaSet(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ScaleReplicaSetHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ScaleReplicaSetRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		req.ReplicaSetId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ScaleReplicaSet(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteReplicaSetHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.DeleteReplicaSetRequest{ReplicaSetId: ctx.Param("id")}
+
+		resp, err := c.prowService.DeleteReplicaSet(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyJobRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListJobsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListJobsRequest{CronJobId: ctx.Query("cron_job_id")}
+
+		resp, err := c.prowService.ListJobs(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.GetJobRequest{JobId: ctx.Param("id")}
+
+		resp, err := c.prowService.GetJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.DeleteJobRequest{JobId: ctx.Param("id")}
+
+		resp, err := c.prowService.DeleteJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyCronJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyCronJobRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyCronJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListCronJobsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListCronJobsRequest{}
+
+		resp, err := c.prowService.ListCronJobs(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetCronJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.GetCronJobRequest{CronJobId: ctx.Param("id")}
+
+		resp, err := c.prowService.GetCronJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteCronJobHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.DeleteCronJobRequest{CronJobId: ctx.Param("id")}
+
+		resp, err := c.prowService.DeleteCronJob(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyNamespaceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyNamespaceRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyNamespace(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListNamespacesHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.ListNamespacesRequest{}
+
+		resp, err := c.prowService.ListNamespaces(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		if bound, all := c.prowService.NamespaceBinding(clientIdentity(ctx)); !all {
+			visible := resp.Namespaces[:0]
+			for _, ns := range resp.GetNamespaces() {
+				for _, allowed := range bound {
+					if strings.EqualFold(allowed, ns.GetName()) {
+						visible = append(visible, ns)
+						break
+					}
+				}
+			}
+			resp.Namespaces = visible
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetNamespaceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		if _, ok := c.scopeNamespace(ctx, ""); !ok {
+			return
+		}
+		req := &controlv1.GetNamespaceRequest{Name: ctx.Param("namespace")}
+
+		resp, err := c.prowService.GetNamespace(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteNamespaceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.DeleteNamespaceRequest{Name: ctx.Param("namespace")}
+
+		resp, err := c.prowService.DeleteNamespace(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplySecretHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplySecretRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetNamespace())
+		if !ok {
+			return
+		}
+		req.Namespace = namespace
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplySecret(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListSecretsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.ListSecretsRequest{Namespace: namespace}
+
+		resp, err := c.prowService.ListSecrets(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetSecretHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetSecretRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.GetSecret(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteSecretHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.DeleteSecretRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.DeleteSecret(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyConfigHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyConfigRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetNamespace())
+		if !ok {
+			return
+		}
+		req.Namespace = namespace
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyConfig(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListConfigsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.ListConfigsRequest{Namespace: namespace}
+
+		resp, err := c.prowService.ListConfigs(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetConfigHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetConfigRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.GetConfig(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteConfigHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.DeleteConfigRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.DeleteConfig(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyServiceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyServiceRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetNamespace())
+		if !ok {
+			return
+		}
+		req.Namespace = namespace
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ApplyService(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListServicesHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.ListServicesRequest{Namespace: namespace}
+
+		resp, err := c.prowService.ListServices(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetServiceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetServiceRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.GetService(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DeleteServiceHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.DeleteServiceRequest{Namespace: namespace, Name: ctx.Param("name")}
+
+		resp, err := c.prowService.DeleteService(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ApplyStackHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ApplyStackRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		namespace, ok := c.scopeNamespace(ctx, req.GetNamespace())
+		if !ok {
+			return
+		}
+		req.Namespace = namespace
 		clusterID := c.resolveClusterID(ctx)
 		sessionKey := c.resolveSessionKey(ctx)
 		workloadKey := c.resolveWorkloadKey(ctx)
 
-		resp, err := c.prowService.ApplyWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		resp, err := c.prowService.ApplyStack(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
 		if err != nil {
 			c.writeProxyError(ctx, err)
 			return
@@ -89,14 +911,18 @@ func (c *ProwController) ScheduleWorkloadHandler() gin.HandlerFunc {
 	}
 }
 
-func (c *ProwController) ListWorkloadsHandler() gin.HandlerFunc {
+func (c *ProwController) ListStacksHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		clusterID := c.resolveClusterID(ctx)
 		sessionKey := c.resolveSessionKey(ctx)
 		workloadKey := c.resolveWorkloadKey(ctx)
-		req := &controlv1.ListWorkloadsRequest{Status: ctx.Query("status")}
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.ListStacksRequest{Namespace: namespace}
 
-		resp, err := c.prowService.ListWorkloads(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		resp, err := c.prowService.ListStacks(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
 		if err != nil {
 			c.writeProxyError(ctx, err)
 			return
@@ -105,14 +931,18 @@ func (c *ProwController) ListWorkloadsHandler() gin.HandlerFunc {
 	}
 }
 
-func (c *ProwController) GetWorkloadHandler() gin.HandlerFunc {
+func (c *ProwController) GetStackHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		clusterID := c.resolveClusterID(ctx)
 		sessionKey := c.resolveSessionKey(ctx)
 		workloadKey := c.resolveWorkloadKey(ctx)
-		req := &controlv1.GetWorkloadRequest{WorkloadId: ctx.Param("id")}
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.GetStackRequest{Namespace: namespace, Name: ctx.Param("name")}
 
-		resp, err := c.prowService.GetWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		resp, err := c.prowService.GetStack(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
 		if err != nil {
 			c.writeProxyError(ctx, err)
 			return
@@ -121,14 +951,18 @@ func (c *ProwController) GetWorkloadHandler() gin.HandlerFunc {
 	}
 }
 
-func (c *ProwController) DeleteWorkloadHandler() gin.HandlerFunc {
+func (c *ProwController) DeleteStackHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		clusterID := c.resolveClusterID(ctx)
 		sessionKey := c.resolveSessionKey(ctx)
 		workloadKey := c.resolveWorkloadKey(ctx)
-		req := &controlv1.DeleteWorkloadRequest{WorkloadId: ctx.Param("id")}
+		namespace, ok := c.scopeNamespace(ctx, "")
+		if !ok {
+			return
+		}
+		req := &controlv1.DeleteStackRequest{Namespace: namespace, Name: ctx.Param("name")}
 
-		resp, err := c.prowService.DeleteWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		resp, err := c.prowService.DeleteStack(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
 		if err != nil {
 			c.writeProxyError(ctx, err)
 			return
@@ -137,14 +971,23 @@ func (c *ProwController) DeleteWorkloadHandler() gin.HandlerFunc {
 	}
 }
 
-func (c *ProwController) RetryWorkloadHandler() gin.HandlerFunc {
+func (c *ProwController) SetStackStateHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
+		req := &controlv1.SetStackStateRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		req.Name = ctx.Param("name")
+		namespace, ok := c.scopeNamespace(ctx, req.GetNamespace())
+		if !ok {
+			return
+		}
+		req.Namespace = namespace
 		clusterID := c.resolveClusterID(ctx)
 		sessionKey := c.resolveSessionKey(ctx)
 		workloadKey := c.resolveWorkloadKey(ctx)
-		req := &controlv1.RetryWorkloadRequest{WorkloadId: ctx.Param("id")}
 
-		resp, err := c.prowService.RetryWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		resp, err := c.prowService.SetStackState(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
 		if err != nil {
 			c.writeProxyError(ctx, err)
 			return
@@ -185,6 +1028,240 @@ func (c *ProwController) GetNodeHandler() gin.HandlerFunc {
 	}
 }
 
+func (c *ProwController) CordonNodeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.CordonNodeRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		req.NodeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.CordonNode(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) UncordonNodeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+		req := &controlv1.UncordonNodeRequest{NodeId: ctx.Param("id")}
+
+		resp, err := c.prowService.UncordonNode(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) SetNodeTaintsHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.SetNodeTaintsRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		req.NodeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.SetNodeTaints(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) DrainNodeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.DrainNodeRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		req.NodeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.DrainNode(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+// RunDeschedulerHandler runs one descheduler pass. The optional body is a
+// RunDeschedulerRequest; {"dry_run": true} only reports the moves.
+func (c *ProwController) RunDeschedulerHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.RunDeschedulerRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.RunDescheduler(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) CreateVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.CreateVolumeRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.CreateVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ListVolumesHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ListVolumesRequest{
+			Phase:      ctx.Query("phase"),
+			Driver:     ctx.Query("driver"),
+			NodeId:     ctx.Query("node_id"),
+			WorkloadId: ctx.Query("workload_id"),
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ListVolumes(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) GetVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.GetVolumeRequest{VolumeId: ctx.Param("id")}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.GetVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+// DeleteVolumeHandler deletes an unused volume and its data. ?force=true drops
+// the record even when the agent cannot delete the data.
+func (c *ProwController) DeleteVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.DeleteVolumeRequest{
+			VolumeId: ctx.Param("id"),
+			Force:    strings.EqualFold(strings.TrimSpace(ctx.Query("force")), "true"),
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.DeleteVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) ResizeVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.ResizeVolumeRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		req.VolumeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.ResizeVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) SnapshotVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.SnapshotVolumeRequest{}
+		if !decodeOptionalProtoBody(ctx, req) {
+			return
+		}
+		req.VolumeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.SnapshotVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
+func (c *ProwController) CloneVolumeHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		req := &controlv1.CloneVolumeRequest{}
+		if !decodeProtoBody(ctx, req) {
+			return
+		}
+		req.SourceVolumeId = ctx.Param("id")
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+		workloadKey := c.resolveWorkloadKey(ctx)
+
+		resp, err := c.prowService.CloneVolume(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req)
+		if err != nil {
+			c.writeProxyError(ctx, err)
+			return
+		}
+		writeProtoJSON(ctx, http.StatusOK, resp)
+	}
+}
+
 func (c *ProwController) ClusterMetricsHandler() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		clusterID := c.resolveClusterID(ctx)
@@ -418,6 +1495,94 @@ func (c *ProwController) resolveClusterID(ctx *gin.Context) string {
 	return ""
 }
 
+// resolveNamespace returns the namespace a request is scoped to,
+// or "" when the request is not scoped and the scheduler should not filter.
+func (c *ProwController) resolveNamespace(ctx *gin.Context) string {
+	if namespace := strings.TrimSpace(ctx.Param("namespace")); namespace != "" {
+		return namespace
+	}
+	if namespace := strings.TrimSpace(ctx.GetHeader("X-Persys-Namespace")); namespace != "" {
+		return namespace
+	}
+	return strings.TrimSpace(ctx.Query("namespace"))
+}
+
+// scopeNamespace resolves the namespace of a request and checks that the
+// client certificate is bound to it. body is the namespace named in the
+// request body, if any; it must agree with the path, header or query. A
+// client bound to a single namespace defaults to it; "" is only returned to
+// clients bound to every namespace, for whom the request stays unscoped. It
+// writes the error response itself.
+func (c *ProwController) scopeNamespace(ctx *gin.Context, body string) (string, bool) {
+	namespace := c.resolveNamespace(ctx)
+	body = strings.TrimSpace(body)
+	if namespace != "" && body != "" && !strings.EqualFold(namespace, body) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "the request body names namespace " + body + " but the request is scoped to " + namespace})
+		return "", false
+	}
+	if namespace == "" {
+		namespace = body
+	}
+	identity := clientIdentity(ctx)
+	bound, all := c.prowService.NamespaceBinding(identity)
+	if all {
+		return namespace, true
+	}
+	if namespace == "" && len(bound) == 1 {
+		return bound[0], true
+	}
+	if namespace == "" && len(bound) > 1 {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "client " + strconv.Quote(identity) + " must name one of its namespaces: " + strings.Join(bound, ", ")})
+		return "", false
+	}
+	for _, allowed := range bound {
+		if strings.EqualFold(allowed, namespace) {
+			return namespace, true
+		}
+	}
+	if namespace == "" {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "client " + strconv.Quote(identity) + " is not bound to any namespace"})
+	} else {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "client " + strconv.Quote(identity) + " may not use namespace " + namespace})
+	}
+	return "", false
+}
+
+// RequireClusterScope guards routes that reach across namespaces, such as
+// nodes, volumes and events, so only clients bound to every namespace pass.
+func (c *ProwController) RequireClusterScope() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		identity := clientIdentity(ctx)
+		if _, all := c.prowService.NamespaceBinding(identity); !all {
+			ctx.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "client " + strconv.Quote(identity) + " may not use cluster-wide routes"})
+			return
+		}
+		ctx.Next()
+	}
+}
+
+// authorizeWorkload checks, for routes whose scheduler request carries no
+// namespace, that the workload is in a namespace the client may use. It
+// writes the error response itself.
+func (c *ProwController) authorizeWorkload(ctx *gin.Context, workloadID string) bool {
+	namespace, ok := c.scopeNamespace(ctx, "")
+	if !ok {
+		return false
+	}
+	if namespace == "" {
+		return true
+	}
+	clusterID := c.resolveClusterID(ctx)
+	sessionKey := c.resolveSessionKey(ctx)
+	workloadKey := c.resolveWorkloadKey(ctx)
+	req := &controlv1.GetWorkloadRequest{WorkloadId: workloadID, Namespace: namespace}
+	if _, err := c.prowService.GetWorkload(ctx.Request.Context(), clusterID, sessionKey, workloadKey, req); err != nil {
+		c.writeProxyError(ctx, err)
+		return false
+	}
+	return true
+}
+
 func (c *ProwController) resolveSessionKey(ctx *gin.Context) string {
 	if s := strings.TrimSpace(ctx.GetHeader("X-Persys-Session")); s != "" {
 		return s
@@ -476,6 +1641,25 @@ func decodeProtoBody(ctx *gin.Context, msg proto.Message) bool {
 	return true
 }
 
+// decodeOptionalProtoBody is decodeProtoBody for endpoints whose body only
+// carries optional fields; an empty body leaves msg untouched.
+func decodeOptionalProtoBody(ctx *gin.Context, msg proto.Message) bool {
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+		return false
+	}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return true
+	}
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := unmarshal.Unmarshal(body, msg); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return false
+	}
+	return true
+}
+
 func writeProtoJSON(ctx *gin.Context, status int, msg proto.Message) {
 	data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
 	if err != nil {
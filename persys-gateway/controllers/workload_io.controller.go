@@ -0,0 +1,261 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	controlv1 "github.com/persys-dev/persys-cloud/persys-gateway/internal/controlv1"
+	"golang.org/x/net/websocket"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Output frames of the log and exec WebSockets are binary, and their first
+// byte names the stream the rest of the frame belongs to.
+const (
+	stdoutChannel byte = 1
+	stderrChannel byte = 2
+)
+
+const defaultNamespace = "default"
+
+// wsFrame is a WebSocket message together with its frame type, so text
+// control messages can be told apart from binary stdin.
+type wsFrame struct {
+	payloadType byte
+	data        []byte
+}
+
+var frameCodec = websocket.Codec{
+	Marshal: func(v interface{}) ([]byte, byte, error) {
+		f := v.(wsFrame)
+		return f.data, f.payloadType, nil
+	},
+	Unmarshal: func(data []byte, payloadType byte, v interface{}) error {
+		f := v.(*wsFrame)
+		f.payloadType = payloadType
+		f.data = data
+		return nil
+	},
+}
+
+func channelFrame(channel byte, data []byte) wsFrame {
+	return wsFrame{payloadType: websocket.BinaryFrame, data: append([]byte{channel}, data...)}
+}
+
+// execControl is a text frame sent by exec clients.
+type execControl struct {
+	Cols       uint32 `json:"cols"`
+	Rows       uint32 `json:"rows"`
+	CloseStdin bool   `json:"close_stdin"`
+}
+
+// WorkloadLogsWebSocketHandler streams the output of a workload over a
+// WebSocket. Query parameters: follow, tail, since (RFC 3339 or unix
+// seconds) and container.
+func (c *ProwController) WorkloadLogsWebSocketHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		namespace, _, ok := c.authorizeWorkloadIO(ctx)
+		if !ok {
+			return
+		}
+		req := &controlv1.StreamWorkloadLogsRequest{
+			WorkloadId: ctx.Param("id"),
+			Namespace:  namespace,
+			Follow:     queryBool(ctx, "follow"),
+			Container:  strings.TrimSpace(ctx.Query("container")),
+		}
+		if raw := strings.TrimSpace(ctx.Query("tail")); raw != "" {
+			tail, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil || tail < 0 {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "tail must be a non-negative integer"})
+				return
+			}
+			req.TailLines = tail
+		}
+		if raw := strings.TrimSpace(ctx.Query("since")); raw != "" {
+			since, err := parseSince(raw)
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, gin.H{"error": "since must be an RFC 3339 time or unix seconds"})
+				return
+			}
+			req.Since = timestamppb.New(since)
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+
+		webSocketServer(func(conn *websocket.Conn) {
+			defer conn.Close()
+			streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+			defer cancel()
+			// Reading only detects when the client goes away.
+			go func() {
+				defer cancel()
+				var discard wsFrame
+				for {
+					if err := frameCodec.Receive(conn, &discard); err != nil {
+						return
+					}
+				}
+			}()
+
+			err := c.prowService.StreamWorkloadLogs(streamCtx, clusterID, sessionKey, req, func(chunk *controlv1.WorkloadLogChunk) error {
+				channel := stdoutChannel
+				if chunk.GetStream() == controlv1.LogStream_LOG_STREAM_STDERR {
+					channel = stderrChannel
+				}
+				return frameCodec.Send(conn, channelFrame(channel, chunk.GetData()))
+			})
+			if err != nil && streamCtx.Err() == nil {
+				_ = websocket.JSON.Send(conn, gin.H{"error": err.Error()})
+			}
+		}).ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
+
+// WorkloadExecWebSocketHandler runs a command in a workload over a
+// WebSocket. The command is given as repeated command query parameters,
+// with tty, stdin, container, cols and rows. Binary client frames are stdin;
+// text frames are JSON resizes ({"cols":80,"rows":24}) or {"close_stdin":true}.
+// The last frame sent is a JSON text frame with exit_code and error.
+func (c *ProwController) WorkloadExecWebSocketHandler() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		namespace, identity, ok := c.authorizeWorkloadIO(ctx)
+		if !ok {
+			return
+		}
+		start := &controlv1.ExecStart{
+			WorkloadId:  ctx.Param("id"),
+			Namespace:   namespace,
+			Command:     ctx.QueryArray("command"),
+			Tty:         queryBool(ctx, "tty"),
+			Stdin:       queryBool(ctx, "stdin"),
+			Container:   strings.TrimSpace(ctx.Query("container")),
+			RequestedBy: identity,
+		}
+		if len(start.Command) == 0 {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "at least one command query parameter is required"})
+			return
+		}
+		cols, colsErr := strconv.ParseUint(ctx.DefaultQuery("cols", "0"), 10, 32)
+		rows, rowsErr := strconv.ParseUint(ctx.DefaultQuery("rows", "0"), 10, 32)
+		if colsErr != nil || rowsErr != nil {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": "cols and rows must be non-negative integers"})
+			return
+		}
+		if cols > 0 && rows > 0 {
+			start.Size = &controlv1.TerminalSize{Cols: uint32(cols), Rows: uint32(rows)}
+		}
+		clusterID := c.resolveClusterID(ctx)
+		sessionKey := c.resolveSessionKey(ctx)
+
+		webSocketServer(func(conn *websocket.Conn) {
+			defer conn.Close()
+			streamCtx, cancel := context.WithCancel(ctx.Request.Context())
+			defer cancel()
+
+			stream, closeStream, err := c.prowService.ExecWorkload(streamCtx, clusterID, sessionKey)
+			if err != nil {
+				_ = websocket.JSON.Send(conn, gin.H{"error": err.Error()})
+				return
+			}
+			defer closeStream()
+			if err := stream.Send(&controlv1.ExecWorkloadRequest{Payload: &controlv1.ExecWorkloadRequest_Start{Start: start}}); err != nil {
+				_ = websocket.JSON.Send(conn, gin.H{"error": err.Error()})
+				return
+			}
+
+			go func() {
+				defer cancel()
+				for {
+					var frame wsFrame
+					if err := frameCodec.Receive(conn, &frame); err != nil {
+						return
+					}
+					msg := &controlv1.ExecWorkloadRequest{}
+					if frame.payloadType == websocket.BinaryFrame {
+						msg.Payload = &controlv1.ExecWorkloadRequest_Stdin{Stdin: frame.data}
+					} else {
+						var control execControl
+						if err := json.Unmarshal(frame.data, &control); err != nil {
+							continue
+						}
+						switch {
+						case control.CloseStdin:
+							msg.Payload = &controlv1.ExecWorkloadRequest_CloseStdin{CloseStdin: true}
+						case control.Cols > 0 && control.Rows > 0:
+							msg.Payload = &controlv1.ExecWorkloadRequest_Resize{Resize: &controlv1.TerminalSize{Cols: control.Cols, Rows: control.Rows}}
+						default:
+							continue
+						}
+					}
+					if err := stream.Send(msg); err != nil {
+						return
+					}
+				}
+			}()
+
+			for {
+				msg, err := stream.Recv()
+				if err != nil {
+					if streamCtx.Err() == nil {
+						_ = websocket.JSON.Send(conn, gin.H{"error": err.Error()})
+					}
+					return
+				}
+				switch p := msg.GetPayload().(type) {
+				case *controlv1.ExecWorkloadResponse_Stdout:
+					err = frameCodec.Send(conn, channelFrame(stdoutChannel, p.Stdout))
+				case *controlv1.ExecWorkloadResponse_Stderr:
+					err = frameCodec.Send(conn, channelFrame(stderrChannel, p.Stderr))
+				case *controlv1.ExecWorkloadResponse_Exit:
+					_ = websocket.JSON.Send(conn, gin.H{"exit_code": p.Exit.GetExitCode(), "error": p.Exit.GetError()})
+					return
+				}
+				if err != nil {
+					return
+				}
+			}
+		}).ServeHTTP(ctx.Writer, ctx.Request)
+	}
+}
+
+// authorizeWorkloadIO resolves the namespace of a log or exec request and
+// checks that the client certificate may use it. Unscoped requests target
+// the default namespace. It writes the error response itself.
+func (c *ProwController) authorizeWorkloadIO(ctx *gin.Context) (namespace, identity string, ok bool) {
+	namespace = strings.ToLower(c.resolveNamespace(ctx))
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+	identity = clientIdentity(ctx)
+	if !c.prowService.WorkloadIOAllowed(identity, namespace) {
+		ctx.JSON(http.StatusForbidden, gin.H{"error": "client " + strconv.Quote(identity) + " may not access workloads in namespace " + namespace})
+		return "", "", false
+	}
+	return namespace, identity, true
+}
+
+// clientIdentity is the common name of the verified client certificate.
+func clientIdentity(ctx *gin.Context) string {
+	if ctx.Request.TLS == nil || len(ctx.Request.TLS.PeerCertificates) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(ctx.Request.TLS.PeerCertificates[0].Subject.CommonName)
+}
+
+func queryBool(ctx *gin.Context, key string) bool {
+	v, err := strconv.ParseBool(strings.TrimSpace(ctx.Query(key)))
+	return err == nil && v
+}
+
+func parseSince(raw string) (time.Time, error) {
+	if secs, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return time.Unix(secs, 0), nil
+	}
+	return time.Parse(time.RFC3339, raw)
+}
@@ -15,9 +15,10 @@ func NewProwRouteController(prowController *controllers.ProwController) ProwRout
 
 func (rc *ProwRouteController) ProwRoute(rg *gin.RouterGroup) {
 	router := rg.Group("")
+	clusterWide := rc.prowController.RequireClusterScope()
 
 	router.GET("/health", rc.prowController.HealthCheckHandler())
-	router.GET("/list", rc.prowController.ListHandler())
+	router.GET("/list", clusterWide, rc.prowController.ListHandler())
 	router.GET("/clusters", rc.prowController.ListClustersHandler())
 	router.GET("/clusters/:cluster_id", rc.prowController.GetClusterHandler())
 
@@ -25,43 +26,207 @@ func (rc *ProwRouteController) ProwRoute(rg *gin.RouterGroup) {
 	{
 		workloads.POST("/schedule", rc.prowController.ScheduleWorkloadHandler())
 		workloads.GET("", rc.prowController.ListWorkloadsHandler())
+		workloads.GET("/pending", clusterWide, rc.prowController.ListPendingWorkloadsHandler())
+		workloads.POST("/simulate", rc.prowController.SimulatePlacementHandler())
 		workloads.GET("/:id", rc.prowController.GetWorkloadHandler())
 		workloads.DELETE("/:id", rc.prowController.DeleteWorkloadHandler())
 		workloads.POST("/:id/retry", rc.prowController.RetryWorkloadHandler())
+		workloads.GET("/:id/placement", rc.prowController.ExplainPlacementHandler())
+		workloads.GET("/:id/usage", rc.prowController.WorkloadUsageHistoryHandler())
+		workloads.GET("/:id/revisions", rc.prowController.ListWorkloadRevisionsHandler())
+		workloads.POST("/:id/rollback", rc.prowController.RollbackWorkloadHandler())
+		workloads.GET("/:id/logs", rc.prowController.WorkloadLogsWebSocketHandler())
+		workloads.GET("/:id/exec", rc.prowController.WorkloadExecWebSocketHandler())
+	}
+
+	replicaSets := router.Group("/replicasets")
+	{
+		replicaSets.POST("", clusterWide, rc.prowController.ApplyReplicaSetHandler())
+		replicaSets.GET("", clusterWide, rc.prowController.ListReplicaSetsHandler())
+		replicaSets.GET("/:id", clusterWide, rc.prowController.GetReplicaSetHandler())
+		replicaSets.POST("/:id/scale", clusterWide, rc.prowController.ScaleReplicaSetHandler())
+		replicaSets.DELETE("/:id", clusterWide, rc.prowController.DeleteReplicaSetHandler())
+	}
+
+	jobs := router.Group("/jobs")
+	{
+		jobs.POST("", clusterWide, rc.prowController.ApplyJobHandler())
+		jobs.GET("", clusterWide, rc.prowController.ListJobsHandler())
+		jobs.GET("/:id", clusterWide, rc.prowController.GetJobHandler())
+		jobs.DELETE("/:id", clusterWide, rc.prowController.DeleteJobHandler())
+	}
+
+	cronJobs := router.Group("/cronjobs")
+	{
+		cronJobs.POST("", clusterWide, rc.prowController.ApplyCronJobHandler())
+		cronJobs.GET("", clusterWide, rc.prowController.ListCronJobsHandler())
+		cronJobs.GET("/:id", clusterWide, rc.prowController.GetCronJobHandler())
+		cronJobs.DELETE("/:id", clusterWide, rc.prowController.DeleteCronJobHandler())
+	}
+
+	namespaces := router.Group("/namespaces")
+	{
+		namespaces.POST("", clusterWide, rc.prowController.ApplyNamespaceHandler())
+		namespaces.GET("", rc.prowController.ListNamespacesHandler())
+		namespaces.GET("/:namespace", rc.prowController.GetNamespaceHandler())
+		namespaces.DELETE("/:namespace", clusterWide, rc.prowController.DeleteNamespaceHandler())
+		namespaces.POST("/:namespace/workloads", rc.prowController.ScheduleWorkloadHandler())
+		namespaces.GET("/:namespace/workloads", rc.prowController.ListWorkloadsHandler())
+		namespaces.GET("/:namespace/workloads/:id", rc.prowController.GetWorkloadHandler())
+		namespaces.DELETE("/:namespace/workloads/:id", rc.prowController.DeleteWorkloadHandler())
+		namespaces.GET("/:namespace/workloads/:id/logs", rc.prowController.WorkloadLogsWebSocketHandler())
+		namespaces.GET("/:namespace/workloads/:id/exec", rc.prowController.WorkloadExecWebSocketHandler())
+	}
+
+	secrets := router.Group("/secrets")
+	{
+		secrets.POST("", rc.prowController.ApplySecretHandler())
+		secrets.GET("", rc.prowController.ListSecretsHandler())
+		secrets.GET("/:name", rc.prowController.GetSecretHandler())
+		secrets.DELETE("/:name", rc.prowController.DeleteSecretHandler())
+	}
+
+	configs := router.Group("/configs")
+	{
+		configs.POST("", rc.prowController.ApplyConfigHandler())
+		configs.GET("", rc.prowController.ListConfigsHandler())
+		configs.GET("/:name", rc.prowController.GetConfigHandler())
+		configs.DELETE("/:name", rc.prowController.DeleteConfigHandler())
+	}
+
+	services := router.Group("/services")
+	{
+		services.POST("", rc.prowController.ApplyServiceHandler())
+		services.GET("", rc.prowController.ListServicesHandler())
+		services.GET("/:name", rc.prowController.GetServiceHandler())
+		services.DELETE("/:name", rc.prowController.DeleteServiceHandler())
+	}
+
+	stacks := router.Group("/stacks")
+	{
+		stacks.POST("", rc.prowController.ApplyStackHandler())
+		stacks.GET("", rc.prowController.ListStacksHandler())
+		stacks.GET("/:name", rc.prowController.GetStackHandler())
+		stacks.POST("/:name/state", rc.prowController.SetStackStateHandler())
+		stacks.DELETE("/:name", rc.prowController.DeleteStackHandler())
 	}
 
 	forgery := router.Group("/forgery")
 	{
-		forgery.POST("/projects/upsert", rc.prowController.UpsertProjectHandler())
-		forgery.POST("/builds/trigger", rc.prowController.TriggerBuildHandler())
-		forgery.POST("/webhooks/test", rc.prowController.TestWebhookHandler())
-		forgery.GET("/pipeline/status", rc.prowController.ListPipelineStatusHandler())
+		forgery.POST("/projects/upsert", clusterWide, rc.prowController.UpsertProjectHandler())
+		forgery.POST("/builds/trigger", clusterWide, rc.prowController.TriggerBuildHandler())
+		forgery.POST("/webhooks/test", clusterWide, rc.prowController.TestWebhookHandler())
+		forgery.GET("/pipeline/status", clusterWide, rc.prowController.ListPipelineStatusHandler())
 	}
 
 	nodes := router.Group("/nodes")
 	{
-		nodes.GET("", rc.prowController.ListNodesHandler())
-		nodes.GET("/:id", rc.prowController.GetNodeHandler())
+		nodes.GET("", clusterWide, rc.prowController.ListNodesHandler())
+		nodes.GET("/:id", clusterWide, rc.prowController.GetNodeHandler())
+		nodes.POST("/:id/cordon", clusterWide, rc.prowController.CordonNodeHandler())
+		nodes.POST("/:id/uncordon", clusterWide, rc.prowController.UncordonNodeHandler())
+		nodes.POST("/:id/taints", clusterWide, rc.prowController.SetNodeTaintsHandler())
+		nodes.POST("/:id/drain", clusterWide, rc.prowController.DrainNodeHandler())
+	}
+
+	volumes := router.Group("/volumes")
+	{
+		volumes.POST("", clusterWide, rc.prowController.CreateVolumeHandler())
+		volumes.GET("", clusterWide, rc.prowController.ListVolumesHandler())
+		volumes.GET("/:id", clusterWide, rc.prowController.GetVolumeHandler())
+		volumes.DELETE("/:id", clusterWide, rc.prowController.DeleteVolumeHandler())
+		volumes.POST("/:id/resize", clusterWide, rc.prowController.ResizeVolumeHandler())
+		volumes.POST("/:id/snapshots", clusterWide, rc.prowController.SnapshotVolumeHandler())
+		volumes.POST("/:id/clone", clusterWide, rc.prowController.CloneVolumeHandler())
+	}
+
+	events := router.Group("/events")
+	{
+		events.GET("/watch", clusterWide, rc.prowController.WatchEventsHandler())
+		events.GET("/ws", clusterWide, rc.prowController.WatchEventsWebSocketHandler())
 	}
 
 	cluster := router.Group("/cluster")
 	{
-		cluster.GET("/metrics", rc.prowController.ClusterMetricsHandler())
+		cluster.GET("/metrics", clusterWide, rc.prowController.ClusterMetricsHandler())
+		cluster.POST("/deschedule", clusterWide, rc.prowController.RunDeschedulerHandler())
 	}
 
 	clusters := router.Group("/clusters/:cluster_id")
 	{
 		clusters.POST("/workloads/schedule", rc.prowController.ScheduleWorkloadHandler())
 		clusters.GET("/workloads", rc.prowController.ListWorkloadsHandler())
+		clusters.GET("/workloads/pending", clusterWide, rc.prowController.ListPendingWorkloadsHandler())
+		clusters.POST("/workloads/simulate", rc.prowController.SimulatePlacementHandler())
 		clusters.GET("/workloads/:id", rc.prowController.GetWorkloadHandler())
 		clusters.DELETE("/workloads/:id", rc.prowController.DeleteWorkloadHandler())
 		clusters.POST("/workloads/:id/retry", rc.prowController.RetryWorkloadHandler())
-		clusters.GET("/nodes", rc.prowController.ListNodesHandler())
-		clusters.GET("/nodes/:id", rc.prowController.GetNodeHandler())
-		clusters.GET("/cluster/metrics", rc.prowController.ClusterMetricsHandler())
-		clusters.POST("/forgery/projects/upsert", rc.prowController.UpsertProjectHandler())
-		clusters.POST("/forgery/builds/trigger", rc.prowController.TriggerBuildHandler())
-		clusters.POST("/forgery/webhooks/test", rc.prowController.TestWebhookHandler())
-		clusters.GET("/forgery/pipeline/status", rc.prowController.ListPipelineStatusHandler())
+		clusters.GET("/workloads/:id/placement", rc.prowController.ExplainPlacementHandler())
+		clusters.GET("/workloads/:id/usage", rc.prowController.WorkloadUsageHistoryHandler())
+		clusters.GET("/workloads/:id/revisions", rc.prowController.ListWorkloadRevisionsHandler())
+		clusters.POST("/workloads/:id/rollback", rc.prowController.RollbackWorkloadHandler())
+		clusters.GET("/workloads/:id/logs", rc.prowController.WorkloadLogsWebSocketHandler())
+		clusters.GET("/workloads/:id/exec", rc.prowController.WorkloadExecWebSocketHandler())
+		clusters.POST("/replicasets", clusterWide, rc.prowController.ApplyReplicaSetHandler())
+		clusters.GET("/replicasets", clusterWide, rc.prowController.ListReplicaSetsHandler())
+		clusters.GET("/replicasets/:id", clusterWide, rc.prowController.GetReplicaSetHandler())
+		clusters.POST("/replicasets/:id/scale", clusterWide, rc.prowController.ScaleReplicaSetHandler())
+		clusters.DELETE("/replicasets/:id", clusterWide, rc.prowController.DeleteReplicaSetHandler())
+		clusters.POST("/jobs", clusterWide, rc.prowController.ApplyJobHandler())
+		clusters.GET("/jobs", clusterWide, rc.prowController.ListJobsHandler())
+		clusters.GET("/jobs/:id", clusterWide, rc.prowController.GetJobHandler())
+		clusters.DELETE("/jobs/:id", clusterWide, rc.prowController.DeleteJobHandler())
+		clusters.POST("/cronjobs", clusterWide, rc.prowController.ApplyCronJobHandler())
+		clusters.GET("/cronjobs", clusterWide, rc.prowController.ListCronJobsHandler())
+		clusters.GET("/cronjobs/:id", clusterWide, rc.prowController.GetCronJobHandler())
+		clusters.DELETE("/cronjobs/:id", clusterWide, rc.prowController.DeleteCronJobHandler())
+		clusters.POST("/namespaces", clusterWide, rc.prowController.ApplyNamespaceHandler())
+		clusters.GET("/namespaces", rc.prowController.ListNamespacesHandler())
+		clusters.GET("/namespaces/:namespace", rc.prowController.GetNamespaceHandler())
+		clusters.DELETE("/namespaces/:namespace", clusterWide, rc.prowController.DeleteNamespaceHandler())
+		clusters.POST("/namespaces/:namespace/workloads", rc.prowController.ScheduleWorkloadHandler())
+		clusters.GET("/namespaces/:namespace/workloads", rc.prowController.ListWorkloadsHandler())
+		clusters.GET("/namespaces/:namespace/workloads/:id", rc.prowController.GetWorkloadHandler())
+		clusters.DELETE("/namespaces/:namespace/workloads/:id", rc.prowController.DeleteWorkloadHandler())
+		clusters.GET("/namespaces/:namespace/workloads/:id/logs", rc.prowController.WorkloadLogsWebSocketHandler())
+		clusters.GET("/namespaces/:namespace/workloads/:id/exec", rc.prowController.WorkloadExecWebSocketHandler())
+		clusters.POST("/secrets", rc.prowController.ApplySecretHandler())
+		clusters.GET("/secrets", rc.prowController.ListSecretsHandler())
+		clusters.GET("/secrets/:name", rc.prowController.GetSecretHandler())
+		clusters.DELETE("/secrets/:name", rc.prowController.DeleteSecretHandler())
+		clusters.POST("/configs", rc.prowController.ApplyConfigHandler())
+		clusters.GET("/configs", rc.prowController.ListConfigsHandler())
+		clusters.GET("/configs/:name", rc.prowController.GetConfigHandler())
+		clusters.DELETE("/configs/:name", rc.prowController.DeleteConfigHandler())
+		clusters.POST("/services", rc.prowController.ApplyServiceHandler())
+		clusters.GET("/services", rc.prowController.ListServicesHandler())
+		clusters.GET("/services/:name", rc.prowController.GetServiceHandler())
+		clusters.DELETE("/services/:name", rc.prowController.DeleteServiceHandler())
+		clusters.POST("/stacks", rc.prowController.ApplyStackHandler())
+		clusters.GET("/stacks", rc.prowController.ListStacksHandler())
+		clusters.GET("/stacks/:name", rc.prowController.GetStackHandler())
+		clusters.POST("/stacks/:name/state", rc.prowController.SetStackStateHandler())
+		clusters.DELETE("/stacks/:name", rc.prowController.DeleteStackHandler())
+		clusters.GET("/nodes", clusterWide, rc.prowController.ListNodesHandler())
+		clusters.GET("/nodes/:id", clusterWide, rc.prowController.GetNodeHandler())
+		clusters.POST("/nodes/:id/cordon", clusterWide, rc.prowController.CordonNodeHandler())
+		clusters.POST("/nodes/:id/uncordon", clusterWide, rc.prowController.UncordonNodeHandler())
+		clusters.POST("/nodes/:id/taints", clusterWide, rc.prowController.SetNodeTaintsHandler())
+		clusters.POST("/nodes/:id/drain", clusterWide, rc.prowController.DrainNodeHandler())
+		clusters.POST("/volumes", clusterWide, rc.prowController.CreateVolumeHandler())
+		clusters.GET("/volumes", clusterWide, rc.prowController.ListVolumesHandler())
+		clusters.GET("/volumes/:id", clusterWide, rc.prowController.GetVolumeHandler())
+		clusters.DELETE("/volumes/:id", clusterWide, rc.prowController.DeleteVolumeHandler())
+		clusters.POST("/volumes/:id/resize", clusterWide, rc.prowController.ResizeVolumeHandler())
+		clusters.POST("/volumes/:id/snapshots", clusterWide, rc.prowController.SnapshotVolumeHandler())
+		clusters.POST("/volumes/:id/clone", clusterWide, rc.prowController.CloneVolumeHandler())
+		clusters.GET("/cluster/metrics", clusterWide, rc.prowController.ClusterMetricsHandler())
+		clusters.POST("/cluster/deschedule", clusterWide, rc.prowController.RunDeschedulerHandler())
+		clusters.GET("/events/watch", clusterWide, rc.prowController.WatchEventsHandler())
+		clusters.GET("/events/ws", clusterWide, rc.prowController.WatchEventsWebSocketHandler())
+		clusters.POST("/forgery/projects/upsert", clusterWide, rc.prowController.UpsertProjectHandler())
+		clusters.POST("/forgery/builds/trigger", clusterWide, rc.prowController.TriggerBuildHandler())
+		clusters.POST("/forgery/webhooks/test", clusterWide, rc.prowController.TestWebhookHandler())
+		clusters.GET("/forgery/pipeline/status", clusterWide, rc.prowController.ListPipelineStatusHandler())
 	}
 }
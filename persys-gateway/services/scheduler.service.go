@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"github.com/persys-dev/persys-cloud/persys-gateway/config"
 	"os"
+	"strings"
 )
 
 type ProwService struct {
@@ -93,6 +94,47 @@ func (s *ProwService) IsProxyEnabled() bool {
 	return s.config.Prow.EnableProxy
 }
 
+// WorkloadIOAllowed reports whether the client identity may read logs from and
+// exec into workloads of namespace. Access is denied unless a rule grants it;
+// the "*" identity entry applies to every client with a certificate.
+func (s *ProwService) WorkloadIOAllowed(identity, namespace string) bool {
+	if strings.TrimSpace(identity) == "" {
+		return false
+	}
+	rules := s.config.Authz.WorkloadIONamespaces
+	for _, key := range []string{identity, "*"} {
+		for _, allowed := range rules[key] {
+			if allowed == "*" || strings.EqualFold(allowed, namespace) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NamespaceBinding returns the namespaces the client identity may use through
+// the scheduler API, and whether it may use all of them. Rules for identity
+// and for "*", which applies to every client with a certificate, are merged.
+func (s *ProwService) NamespaceBinding(identity string) (namespaces []string, all bool) {
+	if strings.TrimSpace(identity) == "" {
+		return nil, false
+	}
+	seen := map[string]bool{}
+	for _, key := range []string{identity, "*"} {
+		for _, allowed := range s.config.Authz.Namespaces[key] {
+			allowed = strings.ToLower(strings.TrimSpace(allowed))
+			if allowed == "*" {
+				return nil, true
+			}
+			if allowed != "" && !seen[allowed] {
+				seen[allowed] = true
+				namespaces = append(namespaces, allowed)
+			}
+		}
+	}
+	return namespaces, false
+}
+
 func IsSchedulerUnavailable(err error) bool {
 	if err == nil {
 		return false
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
@@ -45,6 +46,36 @@ func (s *ProwService) ListWorkloads(ctx context.Context, clusterID, sessionKey,
 	return resp.(*controlv1.ListWorkloadsResponse), nil
 }
 
+func (s *ProwService) ListPendingWorkloads(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListPendingWorkloadsRequest) (*controlv1.ListPendingWorkloadsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListPendingWorkloads(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListPendingWorkloadsResponse), nil
+}
+
+func (s *ProwService) SimulatePlacement(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.SimulatePlacementRequest) (*controlv1.SimulatePlacementResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.SimulatePlacement(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.SimulatePlacementResponse), nil
+}
+
+func (s *ProwService) GetWorkloadUsageHistory(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetWorkloadUsageHistoryRequest) (*controlv1.GetWorkloadUsageHistoryResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetWorkloadUsageHistory(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetWorkloadUsageHistoryResponse), nil
+}
+
 func (s *ProwService) GetWorkload(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetWorkloadRequest) (*controlv1.GetWorkloadResponse, error) {
 	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
 		return client.GetWorkload(ctx, req)
@@ -75,6 +106,26 @@ func (s *ProwService) RetryWorkload(ctx context.Context, clusterID, sessionKey,
 	return resp.(*controlv1.RetryWorkloadResponse), nil
 }
 
+func (s *ProwService) ListWorkloadRevisions(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListWorkloadRevisionsRequest) (*controlv1.ListWorkloadRevisionsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListWorkloadRevisions(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListWorkloadRevisionsResponse), nil
+}
+
+func (s *ProwService) RollbackWorkload(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.RollbackWorkloadRequest) (*controlv1.RollbackWorkloadResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.RollbackWorkload(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.RollbackWorkloadResponse), nil
+}
+
 func (s *ProwService) GetNode(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetNodeRequest) (*controlv1.GetNodeResponse, error) {
 	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
 		return client.GetNode(ctx, req)
@@ -95,6 +146,466 @@ func (s *ProwService) GetClusterSummary(ctx context.Context, clusterID, sessionK
 	return resp.(*controlv1.GetClusterSummaryResponse), nil
 }
 
+func (s *ProwService) ApplyReplicaSet(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyReplicaSetRequest) (*controlv1.ApplyReplicaSetResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyReplicaSet(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyReplicaSetResponse), nil
+}
+
+func (s *ProwService) ScaleReplicaSet(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ScaleReplicaSetRequest) (*controlv1.ScaleReplicaSetResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ScaleReplicaSet(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ScaleReplicaSetResponse), nil
+}
+
+func (s *ProwService) DeleteReplicaSet(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteReplicaSetRequest) (*controlv1.DeleteReplicaSetResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteReplicaSet(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteReplicaSetResponse), nil
+}
+
+func (s *ProwService) GetReplicaSet(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetReplicaSetRequest) (*controlv1.GetReplicaSetResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetReplicaSet(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetReplicaSetResponse), nil
+}
+
+func (s *ProwService) ListReplicaSets(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListReplicaSetsRequest) (*controlv1.ListReplicaSetsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListReplicaSets(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListReplicaSetsResponse), nil
+}
+
+func (s *ProwService) ApplyJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyJobRequest) (*controlv1.ApplyJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyJobResponse), nil
+}
+
+func (s *ProwService) DeleteJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteJobRequest) (*controlv1.DeleteJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteJobResponse), nil
+}
+
+func (s *ProwService) GetJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetJobRequest) (*controlv1.GetJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetJobResponse), nil
+}
+
+func (s *ProwService) ListJobs(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListJobsRequest) (*controlv1.ListJobsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListJobs(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListJobsResponse), nil
+}
+
+func (s *ProwService) ApplyCronJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyCronJobRequest) (*controlv1.ApplyCronJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyCronJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyCronJobResponse), nil
+}
+
+func (s *ProwService) DeleteCronJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteCronJobRequest) (*controlv1.DeleteCronJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteCronJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteCronJobResponse), nil
+}
+
+func (s *ProwService) GetCronJob(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetCronJobRequest) (*controlv1.GetCronJobResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetCronJob(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetCronJobResponse), nil
+}
+
+func (s *ProwService) ListCronJobs(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListCronJobsRequest) (*controlv1.ListCronJobsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListCronJobs(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListCronJobsResponse), nil
+}
+
+func (s *ProwService) ApplyNamespace(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyNamespaceRequest) (*controlv1.ApplyNamespaceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyNamespace(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyNamespaceResponse), nil
+}
+
+func (s *ProwService) DeleteNamespace(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteNamespaceRequest) (*controlv1.DeleteNamespaceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteNamespace(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteNamespaceResponse), nil
+}
+
+func (s *ProwService) GetNamespace(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetNamespaceRequest) (*controlv1.GetNamespaceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetNamespace(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetNamespaceResponse), nil
+}
+
+func (s *ProwService) ListNamespaces(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListNamespacesRequest) (*controlv1.ListNamespacesResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListNamespaces(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListNamespacesResponse), nil
+}
+
+func (s *ProwService) ApplySecret(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplySecretRequest) (*controlv1.ApplySecretResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplySecret(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplySecretResponse), nil
+}
+
+func (s *ProwService) DeleteSecret(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteSecretRequest) (*controlv1.DeleteSecretResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteSecret(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteSecretResponse), nil
+}
+
+func (s *ProwService) GetSecret(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetSecretRequest) (*controlv1.GetSecretResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetSecret(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetSecretResponse), nil
+}
+
+func (s *ProwService) ListSecrets(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListSecretsRequest) (*controlv1.ListSecretsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListSecrets(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListSecretsResponse), nil
+}
+
+func (s *ProwService) ApplyConfig(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyConfigRequest) (*controlv1.ApplyConfigResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyConfig(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyConfigResponse), nil
+}
+
+func (s *ProwService) DeleteConfig(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteConfigRequest) (*controlv1.DeleteConfigResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteConfig(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteConfigResponse), nil
+}
+
+func (s *ProwService) GetConfig(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetConfigRequest) (*controlv1.GetConfigResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetConfig(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetConfigResponse), nil
+}
+
+func (s *ProwService) ListConfigs(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListConfigsRequest) (*controlv1.ListConfigsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListConfigs(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListConfigsResponse), nil
+}
+
+func (s *ProwService) ApplyService(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyServiceRequest) (*controlv1.ApplyServiceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyService(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyServiceResponse), nil
+}
+
+func (s *ProwService) DeleteService(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteServiceRequest) (*controlv1.DeleteServiceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteService(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteServiceResponse), nil
+}
+
+func (s *ProwService) GetService(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetServiceRequest) (*controlv1.GetServiceResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetService(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetServiceResponse), nil
+}
+
+func (s *ProwService) ListServices(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListServicesRequest) (*controlv1.ListServicesResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListServices(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListServicesResponse), nil
+}
+
+func (s *ProwService) ApplyStack(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ApplyStackRequest) (*controlv1.ApplyStackResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ApplyStack(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ApplyStackResponse), nil
+}
+
+func (s *ProwService) DeleteStack(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteStackRequest) (*controlv1.DeleteStackResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteStack(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteStackResponse), nil
+}
+
+func (s *ProwService) GetStack(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetStackRequest) (*controlv1.GetStackResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetStack(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetStackResponse), nil
+}
+
+func (s *ProwService) ListStacks(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListStacksRequest) (*controlv1.ListStacksResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListStacks(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListStacksResponse), nil
+}
+
+func (s *ProwService) SetStackState(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.SetStackStateRequest) (*controlv1.SetStackStateResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.SetStackState(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.SetStackStateResponse), nil
+}
+
+func (s *ProwService) CordonNode(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.CordonNodeRequest) (*controlv1.CordonNodeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.CordonNode(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.CordonNodeResponse), nil
+}
+
+func (s *ProwService) UncordonNode(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.UncordonNodeRequest) (*controlv1.UncordonNodeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.UncordonNode(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.UncordonNodeResponse), nil
+}
+
+func (s *ProwService) SetNodeTaints(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.SetNodeTaintsRequest) (*controlv1.SetNodeTaintsResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.SetNodeTaints(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.SetNodeTaintsResponse), nil
+}
+
+func (s *ProwService) DrainNode(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DrainNodeRequest) (*controlv1.DrainNodeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DrainNode(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DrainNodeResponse), nil
+}
+
+func (s *ProwService) RunDescheduler(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.RunDeschedulerRequest) (*controlv1.RunDeschedulerResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.RunDescheduler(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.RunDeschedulerResponse), nil
+}
+
+func (s *ProwService) CreateVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.CreateVolumeRequest) (*controlv1.CreateVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.CreateVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.CreateVolumeResponse), nil
+}
+
+func (s *ProwService) GetVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.GetVolumeRequest) (*controlv1.GetVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.GetVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.GetVolumeResponse), nil
+}
+
+func (s *ProwService) ListVolumes(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ListVolumesRequest) (*controlv1.ListVolumesResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ListVolumes(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ListVolumesResponse), nil
+}
+
+func (s *ProwService) DeleteVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.DeleteVolumeRequest) (*controlv1.DeleteVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.DeleteVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.DeleteVolumeResponse), nil
+}
+
+func (s *ProwService) ResizeVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.ResizeVolumeRequest) (*controlv1.ResizeVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.ResizeVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.ResizeVolumeResponse), nil
+}
+
+func (s *ProwService) SnapshotVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.SnapshotVolumeRequest) (*controlv1.SnapshotVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.SnapshotVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.SnapshotVolumeResponse), nil
+}
+
+func (s *ProwService) CloneVolume(ctx context.Context, clusterID, sessionKey, workloadKey string, req *controlv1.CloneVolumeRequest) (*controlv1.CloneVolumeResponse, error) {
+	resp, err := s.invokeControlRPC(ctx, clusterID, sessionKey, workloadKey, func(client controlv1.AgentControlClient) (any, error) {
+		return client.CloneVolume(ctx, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*controlv1.CloneVolumeResponse), nil
+}
+
 func (s *ProwService) invokeControlRPC(ctx context.Context, clusterID, sessionKey, workloadKey string, call func(controlv1.AgentControlClient) (any, error)) (any, error) {
 	if clusterID == "" {
 		clusterID = s.schedulerPool.DefaultClusterID()
@@ -137,6 +648,122 @@ func (s *ProwService) invokeControlRPC(ctx context.Context, clusterID, sessionKe
 	return nil, lastErr
 }
 
+// WatchEvents streams scheduler events to send until ctx ends, the scheduler
+// closes the stream or send fails. Candidates are tried in order until one
+// accepts the stream; once events flow, errors are returned to the caller,
+// which can resume with the last revision it saw.
+func (s *ProwService) WatchEvents(ctx context.Context, clusterID, sessionKey string, req *controlv1.WatchEventsRequest, send func(*controlv1.SchedulerEventView) error) error {
+	var stream controlv1.AgentControl_WatchEventsClient
+	conn, err := s.openControlStream(ctx, clusterID, sessionKey, func(client controlv1.AgentControlClient) (err error) {
+		stream, err = client.WatchEvents(injectTraceContext(ctx), req)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	err = forwardEvents(stream, send)
+	_ = conn.Close()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// StreamWorkloadLogs streams the output of a workload to send until ctx ends,
+// the scheduler closes the stream or send fails.
+func (s *ProwService) StreamWorkloadLogs(ctx context.Context, clusterID, sessionKey string, req *controlv1.StreamWorkloadLogsRequest, send func(*controlv1.WorkloadLogChunk) error) error {
+	var stream controlv1.AgentControl_StreamWorkloadLogsClient
+	conn, err := s.openControlStream(ctx, clusterID, sessionKey, func(client controlv1.AgentControlClient) (err error) {
+		stream, err = client.StreamWorkloadLogs(injectTraceContext(ctx), req)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// ExecWorkload opens an exec stream on a scheduler of the cluster. The caller
+// sends ExecStart first and must call the returned close function when done.
+func (s *ProwService) ExecWorkload(ctx context.Context, clusterID, sessionKey string) (controlv1.AgentControl_ExecWorkloadClient, func() error, error) {
+	var stream controlv1.AgentControl_ExecWorkloadClient
+	conn, err := s.openControlStream(ctx, clusterID, sessionKey, func(client controlv1.AgentControlClient) (err error) {
+		stream, err = client.ExecWorkload(injectTraceContext(ctx))
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return stream, conn.Close, nil
+}
+
+// openControlStream dials the schedulers of a cluster in order until open
+// succeeds on one of them, and returns that connection.
+func (s *ProwService) openControlStream(ctx context.Context, clusterID, sessionKey string, open func(controlv1.AgentControlClient) error) (*grpc.ClientConn, error) {
+	if clusterID == "" {
+		clusterID = s.schedulerPool.DefaultClusterID()
+	}
+
+	candidates, err := s.schedulerPool.OrderedSchedulers(clusterID, sessionKey, "")
+	if err != nil {
+		return nil, fmt.Errorf("select scheduler candidates for cluster %q: %w", clusterID, err)
+	}
+
+	var lastErr error
+	for _, target := range candidates {
+		dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		conn, dialErr := grpc.DialContext(dialCtx, target.Address,
+			grpc.WithTransportCredentials(credentials.NewTLS(s.clientTLS)),
+			grpc.WithBlock(),
+		)
+		cancel()
+		if dialErr != nil {
+			s.schedulerPool.MarkUnhealthy(clusterID, target.Address)
+			lastErr = dialErr
+			continue
+		}
+		if err := open(controlv1.NewAgentControlClient(conn)); err != nil {
+			_ = conn.Close()
+			s.schedulerPool.MarkUnhealthy(clusterID, target.Address)
+			lastErr = err
+			continue
+		}
+		return conn, nil
+	}
+
+	if lastErr == nil {
+		lastErr = ErrNoHealthySchedulers
+	}
+	return nil, lastErr
+}
+
+func forwardEvents(stream controlv1.AgentControl_WatchEventsClient, send func(*controlv1.SchedulerEventView) error) error {
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+}
+
 func (s *ProwService) TriggerBuild(ctx context.Context, req *forgeryv1.TriggerBuildRequest) (*forgeryv1.OperationStatus, error) {
 	if req == nil {
 		return nil, fmt.Errorf("request is required")
@@ -280,6 +907,15 @@ func (c *controlClientWithContext) ListNodes(_ context.Context, req *controlv1.L
 func (c *controlClientWithContext) ListWorkloads(_ context.Context, req *controlv1.ListWorkloadsRequest, opts ...grpc.CallOption) (*controlv1.ListWorkloadsResponse, error) {
 	return c.AgentControlClient.ListWorkloads(c.ctx, req, opts...)
 }
+func (c *controlClientWithContext) ListPendingWorkloads(_ context.Context, req *controlv1.ListPendingWorkloadsRequest, opts ...grpc.CallOption) (*controlv1.ListPendingWorkloadsResponse, error) {
+	return c.AgentControlClient.ListPendingWorkloads(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) SimulatePlacement(_ context.Context, req *controlv1.SimulatePlacementRequest, opts ...grpc.CallOption) (*controlv1.SimulatePlacementResponse, error) {
+	return c.AgentControlClient.SimulatePlacement(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetWorkloadUsageHistory(_ context.Context, req *controlv1.GetWorkloadUsageHistoryRequest, opts ...grpc.CallOption) (*controlv1.GetWorkloadUsageHistoryResponse, error) {
+	return c.AgentControlClient.GetWorkloadUsageHistory(c.ctx, req, opts...)
+}
 func (c *controlClientWithContext) GetWorkload(_ context.Context, req *controlv1.GetWorkloadRequest, opts ...grpc.CallOption) (*controlv1.GetWorkloadResponse, error) {
 	return c.AgentControlClient.GetWorkload(c.ctx, req, opts...)
 }
@@ -289,12 +925,141 @@ func (c *controlClientWithContext) DeleteWorkload(_ context.Context, req *contro
 func (c *controlClientWithContext) RetryWorkload(_ context.Context, req *controlv1.RetryWorkloadRequest, opts ...grpc.CallOption) (*controlv1.RetryWorkloadResponse, error) {
 	return c.AgentControlClient.RetryWorkload(c.ctx, req, opts...)
 }
+func (c *controlClientWithContext) ListWorkloadRevisions(_ context.Context, req *controlv1.ListWorkloadRevisionsRequest, opts ...grpc.CallOption) (*controlv1.ListWorkloadRevisionsResponse, error) {
+	return c.AgentControlClient.ListWorkloadRevisions(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) RollbackWorkload(_ context.Context, req *controlv1.RollbackWorkloadRequest, opts ...grpc.CallOption) (*controlv1.RollbackWorkloadResponse, error) {
+	return c.AgentControlClient.RollbackWorkload(c.ctx, req, opts...)
+}
 func (c *controlClientWithContext) GetNode(_ context.Context, req *controlv1.GetNodeRequest, opts ...grpc.CallOption) (*controlv1.GetNodeResponse, error) {
 	return c.AgentControlClient.GetNode(c.ctx, req, opts...)
 }
 func (c *controlClientWithContext) GetClusterSummary(_ context.Context, req *controlv1.GetClusterSummaryRequest, opts ...grpc.CallOption) (*controlv1.GetClusterSummaryResponse, error) {
 	return c.AgentControlClient.GetClusterSummary(c.ctx, req, opts...)
 }
+func (c *controlClientWithContext) ApplyReplicaSet(_ context.Context, req *controlv1.ApplyReplicaSetRequest, opts ...grpc.CallOption) (*controlv1.ApplyReplicaSetResponse, error) {
+	return c.AgentControlClient.ApplyReplicaSet(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ScaleReplicaSet(_ context.Context, req *controlv1.ScaleReplicaSetRequest, opts ...grpc.CallOption) (*controlv1.ScaleReplicaSetResponse, error) {
+	return c.AgentControlClient.ScaleReplicaSet(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteReplicaSet(_ context.Context, req *controlv1.DeleteReplicaSetRequest, opts ...grpc.CallOption) (*controlv1.DeleteReplicaSetResponse, error) {
+	return c.AgentControlClient.DeleteReplicaSet(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetReplicaSet(_ context.Context, req *controlv1.GetReplicaSetRequest, opts ...grpc.CallOption) (*controlv1.GetReplicaSetResponse, error) {
+	return c.AgentControlClient.GetReplicaSet(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListReplicaSets(_ context.Context, req *controlv1.ListReplicaSetsRequest, opts ...grpc.CallOption) (*controlv1.ListReplicaSetsResponse, error) {
+	return c.AgentControlClient.ListReplicaSets(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplyJob(_ context.Context, req *controlv1.ApplyJobRequest, opts ...grpc.CallOption) (*controlv1.ApplyJobResponse, error) {
+	return c.AgentControlClient.ApplyJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteJob(_ context.Context, req *controlv1.DeleteJobRequest, opts ...grpc.CallOption) (*controlv1.DeleteJobResponse, error) {
+	return c.AgentControlClient.DeleteJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetJob(_ context.Context, req *controlv1.GetJobRequest, opts ...grpc.CallOption) (*controlv1.GetJobResponse, error) {
+	return c.AgentControlClient.GetJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListJobs(_ context.Context, req *controlv1.ListJobsRequest, opts ...grpc.CallOption) (*controlv1.ListJobsResponse, error) {
+	return c.AgentControlClient.ListJobs(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplyCronJob(_ context.Context, req *controlv1.ApplyCronJobRequest, opts ...grpc.CallOption) (*controlv1.ApplyCronJobResponse, error) {
+	return c.AgentControlClient.ApplyCronJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteCronJob(_ context.Context, req *controlv1.DeleteCronJobRequest, opts ...grpc.CallOption) (*controlv1.DeleteCronJobResponse, error) {
+	return c.AgentControlClient.DeleteCronJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetCronJob(_ context.Context, req *controlv1.GetCronJobRequest, opts ...grpc.CallOption) (*controlv1.GetCronJobResponse, error) {
+	return c.AgentControlClient.GetCronJob(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListCronJobs(_ context.Context, req *controlv1.ListCronJobsRequest, opts ...grpc.CallOption) (*controlv1.ListCronJobsResponse, error) {
+	return c.AgentControlClient.ListCronJobs(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplyNamespace(_ context.Context, req *controlv1.ApplyNamespaceRequest, opts ...grpc.CallOption) (*controlv1.ApplyNamespaceResponse, error) {
+	return c.AgentControlClient.ApplyNamespace(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteNamespace(_ context.Context, req *controlv1.DeleteNamespaceRequest, opts ...grpc.CallOption) (*controlv1.DeleteNamespaceResponse, error) {
+	return c.AgentControlClient.DeleteNamespace(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetNamespace(_ context.Context, req *controlv1.GetNamespaceRequest, opts ...grpc.CallOption) (*controlv1.GetNamespaceResponse, error) {
+	return c.AgentControlClient.GetNamespace(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListNamespaces(_ context.Context, req *controlv1.ListNamespacesRequest, opts ...grpc.CallOption) (*controlv1.ListNamespacesResponse, error) {
+	return c.AgentControlClient.ListNamespaces(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplySecret(_ context.Context, req *controlv1.ApplySecretRequest, opts ...grpc.CallOption) (*controlv1.ApplySecretResponse, error) {
+	return c.AgentControlClient.ApplySecret(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteSecret(_ context.Context, req *controlv1.DeleteSecretRequest, opts ...grpc.CallOption) (*controlv1.DeleteSecretResponse, error) {
+	return c.AgentControlClient.DeleteSecret(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetSecret(_ context.Context, req *controlv1.GetSecretRequest, opts ...grpc.CallOption) (*controlv1.GetSecretResponse, error) {
+	return c.AgentControlClient.GetSecret(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListSecrets(_ context.Context, req *controlv1.ListSecretsRequest, opts ...grpc.CallOption) (*controlv1.ListSecretsResponse, error) {
+	return c.AgentControlClient.ListSecrets(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplyConfig(_ context.Context, req *controlv1.ApplyConfigRequest, opts ...grpc.CallOption) (*controlv1.ApplyConfigResponse, error) {
+	return c.AgentControlClient.ApplyConfig(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteConfig(_ context.Context, req *controlv1.DeleteConfigRequest, opts ...grpc.CallOption) (*controlv1.DeleteConfigResponse, error) {
+	return c.AgentControlClient.DeleteConfig(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetConfig(_ context.Context, req *controlv1.GetConfigRequest, opts ...grpc.CallOption) (*controlv1.GetConfigResponse, error) {
+	return c.AgentControlClient.GetConfig(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListConfigs(_ context.Context, req *controlv1.ListConfigsRequest, opts ...grpc.CallOption) (*controlv1.ListConfigsResponse, error) {
+	return c.AgentControlClient.ListConfigs(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ApplyService(_ context.Context, req *controlv1.ApplyServiceRequest, opts ...grpc.CallOption) (*controlv1.ApplyServiceResponse, error) {
+	return c.AgentControlClient.ApplyService(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteService(_ context.Context, req *controlv1.DeleteServiceRequest, opts ...grpc.CallOption) (*controlv1.DeleteServiceResponse, error) {
+	return c.AgentControlClient.DeleteService(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetService(_ context.Context, req *controlv1.GetServiceRequest, opts ...grpc.CallOption) (*controlv1.GetServiceResponse, error) {
+	return c.AgentControlClient.GetService(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListServices(_ context.Context, req *controlv1.ListServicesRequest, opts ...grpc.CallOption) (*controlv1.ListServicesResponse, error) {
+	return c.AgentControlClient.ListServices(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) CordonNode(_ context.Context, req *controlv1.CordonNodeRequest, opts ...grpc.CallOption) (*controlv1.CordonNodeResponse, error) {
+	return c.AgentControlClient.CordonNode(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) UncordonNode(_ context.Context, req *controlv1.UncordonNodeRequest, opts ...grpc.CallOption) (*controlv1.UncordonNodeResponse, error) {
+	return c.AgentControlClient.UncordonNode(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) SetNodeTaints(_ context.Context, req *controlv1.SetNodeTaintsRequest, opts ...grpc.CallOption) (*controlv1.SetNodeTaintsResponse, error) {
+	return c.AgentControlClient.SetNodeTaints(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DrainNode(_ context.Context, req *controlv1.DrainNodeRequest, opts ...grpc.CallOption) (*controlv1.DrainNodeResponse, error) {
+	return c.AgentControlClient.DrainNode(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) RunDescheduler(_ context.Context, req *controlv1.RunDeschedulerRequest, opts ...grpc.CallOption) (*controlv1.RunDeschedulerResponse, error) {
+	return c.AgentControlClient.RunDescheduler(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) CreateVolume(_ context.Context, req *controlv1.CreateVolumeRequest, opts ...grpc.CallOption) (*controlv1.CreateVolumeResponse, error) {
+	return c.AgentControlClient.CreateVolume(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) GetVolume(_ context.Context, req *controlv1.GetVolumeRequest, opts ...grpc.CallOption) (*controlv1.GetVolumeResponse, error) {
+	return c.AgentControlClient.GetVolume(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ListVolumes(_ context.Context, req *controlv1.ListVolumesRequest, opts ...grpc.CallOption) (*controlv1.ListVolumesResponse, error) {
+	return c.AgentControlClient.ListVolumes(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) DeleteVolume(_ context.Context, req *controlv1.DeleteVolumeRequest, opts ...grpc.CallOption) (*controlv1.DeleteVolumeResponse, error) {
+	return c.AgentControlClient.DeleteVolume(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) ResizeVolume(_ context.Context, req *controlv1.ResizeVolumeRequest, opts ...grpc.CallOption) (*controlv1.ResizeVolumeResponse, error) {
+	return c.AgentControlClient.ResizeVolume(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) SnapshotVolume(_ context.Context, req *controlv1.SnapshotVolumeRequest, opts ...grpc.CallOption) (*controlv1.SnapshotVolumeResponse, error) {
+	return c.AgentControlClient.SnapshotVolume(c.ctx, req, opts...)
+}
+func (c *controlClientWithContext) CloneVolume(_ context.Context, req *controlv1.CloneVolumeRequest, opts ...grpc.CallOption) (*controlv1.CloneVolumeResponse, error) {
+	return c.AgentControlClient.CloneVolume(c.ctx, req, opts...)
+}
 func (c *controlClientWithContext) RegisterNode(_ context.Context, req *controlv1.RegisterNodeRequest, opts ...grpc.CallOption) (*controlv1.RegisterNodeResponse, error) {
 	return c.AgentControlClient.RegisterNode(c.ctx, req, opts...)
 }
@@ -47,6 +47,7 @@ type Cluster struct {
 	Name            string
 	Schedulers      []SchedulerInstance
 	RoutingStrategy RoutingStrategy
+	DiscoveryDomain string
 }
 
 type SchedulerPoolManager struct {
@@ -94,7 +95,7 @@ func NewSchedulerPoolManager(cfg *config.Config, tlsClient *tls.Config) (*Schedu
 		for _, sc := range cc.Schedulers {
 			schedulers = append(schedulers, SchedulerInstance{ID: sc.ID, Address: sc.Address, IsLeader: sc.IsLeader})
 		}
-		m.clusters[cc.ID] = Cluster{ID: cc.ID, Name: cc.Name, Schedulers: schedulers, RoutingStrategy: strategy}
+		m.clusters[cc.ID] = Cluster{ID: cc.ID, Name: cc.Name, Schedulers: schedulers, RoutingStrategy: strategy, DiscoveryDomain: strings.TrimSpace(cc.DiscoveryDomain)}
 	}
 
 	return m, nil
@@ -352,9 +353,35 @@ func (m *SchedulerPoolManager) newResolver() *net.Resolver {
 }
 
 func (m *SchedulerPoolManager) refreshHealth(ctx context.Context) {
+	// Each cluster elects its own leader, so resolve every cluster's record
+	// without holding the lock across DNS lookups.
+	m.mu.RLock()
+	domains := make(map[string]string, len(m.clusters))
+	for id := range m.clusters {
+		if domain := m.leaderDomainLocked(id); domain != "" {
+			domains[id] = domain
+		}
+	}
+	m.mu.RUnlock()
+
+	type discoveredLeader struct {
+		keys   []string
+		source string
+	}
+	leaders := make(map[string]discoveredLeader, len(domains))
+	for id, domain := range domains {
+		if keys, source := m.discoverLeader(ctx, domain); len(keys) > 0 {
+			leaders[id] = discoveredLeader{keys: keys, source: source}
+		}
+	}
+
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	for id, leader := range leaders {
+		m.applyLeaderLocked(ctx, id, leader.keys, leader.source)
+	}
+
 	for id, cluster := range m.clusters {
 		for i := range cluster.Schedulers {
 			inst := &cluster.Schedulers[i]
@@ -377,6 +404,106 @@ func (m *SchedulerPoolManager) refreshHealth(ctx context.Context) {
 	}
 }
 
+// leaderDomainLocked returns the CoreDNS domain the cluster's leader record is
+// published under: the cluster's own discovery_domain, or for the default
+// cluster prow.discovery_domain. Other clusters without a domain keep their
+// configured leader flags.
+func (m *SchedulerPoolManager) leaderDomainLocked(clusterID string) string {
+	if domain := m.clusters[clusterID].DiscoveryDomain; domain != "" {
+		return domain
+	}
+	if clusterID == m.defaultClusterIDLocked() {
+		return strings.TrimSpace(m.cfg.Prow.DiscoveryDomain)
+	}
+	return ""
+}
+
+// discoverLeader resolves the leader record the elected scheduler publishes
+// in CoreDNS under domain. It returns canonical address keys for the leader:
+// host:port from the SRV record, or bare IPs from the A record fallback.
+func (m *SchedulerPoolManager) discoverLeader(ctx context.Context, domain string) ([]string, string) {
+	resolver := m.newResolver()
+	lookupCtx, cancel := context.WithTimeout(ctx, 4*time.Second)
+	defer cancel()
+
+	if _, records, err := resolver.LookupSRV(lookupCtx, "", "", "_persys-scheduler-leader."+domain); err == nil {
+		keys := make([]string, 0, len(records))
+		for _, srv := range records {
+			if srv.Port == 0 {
+				continue
+			}
+			ips, ipErr := resolveSRVTarget(lookupCtx, resolver, srv.Target)
+			if ipErr != nil {
+				continue
+			}
+			for _, ip := range ips {
+				keys = append(keys, net.JoinHostPort(ip, strconv.Itoa(int(srv.Port))))
+			}
+		}
+		if len(keys) > 0 {
+			return dedupe(keys), "coredns-srv"
+		}
+	}
+	ips, err := resolver.LookupHost(lookupCtx, "persys-scheduler-leader."+domain)
+	if err != nil {
+		m.logger.WithError(err).WithField("domain", domain).Debug("scheduler leader lookup failed")
+		return nil, ""
+	}
+	return dedupe(ips), "coredns-host"
+}
+
+// applyLeaderLocked marks the scheduler matching the discovered leader in
+// clusterID. Entries without a match keep their configured flag.
+func (m *SchedulerPoolManager) applyLeaderLocked(ctx context.Context, clusterID string, leaderKeys []string, source string) {
+	cluster, ok := m.clusters[clusterID]
+	if !ok {
+		return
+	}
+	resolver := m.newResolver()
+	match := -1
+	for i, s := range cluster.Schedulers {
+		if schedulerMatchesLeader(ctx, resolver, s.Address, leaderKeys) {
+			match = i
+			break
+		}
+	}
+	if match < 0 {
+		m.logger.WithFields(logrus.Fields{
+			"cluster_id": clusterID,
+			"leader":     leaderKeys,
+		}).Debug("discovered scheduler leader is not in the pool")
+		return
+	}
+	for i := range cluster.Schedulers {
+		isLeader := i == match
+		if cluster.Schedulers[i].IsLeader != isLeader && isLeader {
+			m.logger.WithFields(logrus.Fields{
+				"cluster_id": clusterID,
+				"scheduler":  cluster.Schedulers[i].Address,
+				"source":     source,
+			}).Info("scheduler leader changed")
+		}
+		cluster.Schedulers[i].IsLeader = isLeader
+	}
+	m.clusters[clusterID] = cluster
+}
+
+func schedulerMatchesLeader(ctx context.Context, resolver *net.Resolver, address string, leaderKeys []string) bool {
+	for _, key := range canonicalAddressKeys(ctx, resolver, address) {
+		host, _, err := net.SplitHostPort(key)
+		if err != nil {
+			continue
+		}
+		for _, leader := range leaderKeys {
+			// A-record fallbacks carry no port, so match them on host alone.
+			if leader == key || leader == host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (m *SchedulerPoolManager) checkInstanceHealth(ctx context.Context, address string) bool {
 	healthCtx, cancel := context.WithTimeout(ctx, 4*time.Second)
 	defer cancel()
@@ -0,0 +1,156 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/persys-dev/persys-cloud/persys-gateway/config"
+	"github.com/persys-dev/persys-cloud/persys-gateway/controllers"
+	"github.com/persys-dev/persys-cloud/persys-gateway/routes"
+	"github.com/persys-dev/persys-cloud/persys-gateway/services"
+	"github.com/stretchr/testify/assert"
+)
+
+// writeTestCert writes a self-signed certificate, its key and itself as the
+// CA into dir, which is all NewProwService needs to start.
+func writeTestCert(t *testing.T, dir string) config.TLSConfig {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "persys-gateway"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	cfg := config.TLSConfig{
+		CertPath: filepath.Join(dir, "tls.crt"),
+		KeyPath:  filepath.Join(dir, "tls.key"),
+		CAPath:   filepath.Join(dir, "ca.pem"),
+	}
+	for path, data := range map[string][]byte{cfg.CertPath: certPEM, cfg.KeyPath: keyPEM, cfg.CAPath: certPEM} {
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return cfg
+}
+
+func newNamespaceAuthzRouter(t *testing.T, bindings map[string][]string) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	cfg := &config.Config{
+		TLS:       writeTestCert(t, t.TempDir()),
+		Scheduler: config.SchedulerConfig{HealthCheckInterval: "1m", DiscoveryInterval: "1m"},
+		Authz:     config.AuthzConfig{Namespaces: bindings},
+	}
+	prowController := controllers.NewProwController(services.NewProwService(cfg), nil, ctx)
+	prowRouteController := routes.NewProwRouteController(prowController)
+	router := gin.New()
+	prowRouteController.ProwRoute(router.Group(""))
+	return router
+}
+
+func namespaceAuthzRequest(router *gin.Engine, method, target, commonName string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader("{}"))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if commonName != "" {
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: commonName}}}}
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestNamespaceBindingScopesRequests(t *testing.T) {
+	router := newNamespaceAuthzRouter(t, map[string][]string{
+		"team-a-ci": {"team-a"},
+		"multi":     {"team-a", "team-b"},
+		"ops":       {"*"},
+	})
+
+	cases := []struct {
+		name       string
+		method     string
+		target     string
+		commonName string
+		header     http.Header
+		forbidden  bool
+		badRequest bool
+	}{
+		{name: "bound namespace in path", method: http.MethodGet, target: "/namespaces/team-a/workloads", commonName: "team-a-ci"},
+		{name: "other namespace in path", method: http.MethodGet, target: "/namespaces/team-b/workloads", commonName: "team-a-ci", forbidden: true},
+		{name: "other namespace in header", method: http.MethodGet, target: "/workloads", commonName: "team-a-ci", header: http.Header{"X-Persys-Namespace": {"team-b"}}, forbidden: true},
+		{name: "other namespace in query", method: http.MethodGet, target: "/secrets?namespace=team-b", commonName: "team-a-ci", forbidden: true},
+		{name: "bound namespace in header", method: http.MethodPost, target: "/configs", commonName: "team-a-ci", header: http.Header{"X-Persys-Namespace": {"team-a"}}},
+		{name: "get foreign namespace", method: http.MethodGet, target: "/namespaces/team-b", commonName: "team-a-ci", forbidden: true},
+		{name: "unscoped single binding", method: http.MethodGet, target: "/workloads", commonName: "team-a-ci"},
+		{name: "unscoped several bindings", method: http.MethodGet, target: "/workloads", commonName: "multi", badRequest: true},
+		{name: "cluster-wide route", method: http.MethodGet, target: "/nodes", commonName: "team-a-ci", forbidden: true},
+		{name: "namespace change", method: http.MethodPost, target: "/namespaces", commonName: "multi", forbidden: true},
+		{name: "cluster-wide route for ops", method: http.MethodGet, target: "/nodes", commonName: "ops"},
+		{name: "unknown client", method: http.MethodGet, target: "/namespaces/team-a/workloads", commonName: "stranger", forbidden: true},
+		{name: "no certificate", method: http.MethodGet, target: "/namespaces/team-a/workloads", forbidden: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := namespaceAuthzRequest(router, tc.method, tc.target, tc.commonName, tc.header)
+			switch {
+			case tc.forbidden:
+				assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+			case tc.badRequest:
+				assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+				assert.Contains(t, w.Body.String(), "must name one of its namespaces")
+			default:
+				// Authorized requests reach the proxy, which knows no cluster here.
+				assert.Contains(t, w.Body.String(), "unknown cluster")
+			}
+		})
+	}
+}
+
+func TestNamespaceBindingRejectsConflictingBody(t *testing.T) {
+	router := newNamespaceAuthzRouter(t, map[string][]string{"team-a-ci": {"team-a"}})
+	req := httptest.NewRequest(http.MethodPost, "/namespaces/team-a/workloads", strings.NewReader(`{"spec":{"namespace":"team-b"}}`))
+	req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "team-a-ci"}}}}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code, w.Body.String())
+	assert.Contains(t, w.Body.String(), "names namespace team-b")
+}
+
+func TestNamespaceBindingWithoutRulesDeniesEveryone(t *testing.T) {
+	router := newNamespaceAuthzRouter(t, nil)
+	w := namespaceAuthzRequest(router, http.MethodGet, "/workloads", "ops", nil)
+	assert.Equal(t, http.StatusForbidden, w.Code, w.Body.String())
+}
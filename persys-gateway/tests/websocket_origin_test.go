@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebSocketHandshakeChecksOriginOnlyWhenSent(t *testing.T) {
+	router := newNamespaceAuthzRouter(t, map[string][]string{"ops": {"*"}})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "ops"}}}}
+		router.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+	host := server.Listener.Addr().String()
+
+	cases := []struct {
+		name   string
+		origin string
+		status int
+	}{
+		{name: "no origin", status: http.StatusSwitchingProtocols},
+		{name: "same origin", origin: "https://" + host, status: http.StatusSwitchingProtocols},
+		{name: "foreign origin", origin: "https://evil.example", status: http.StatusForbidden},
+		{name: "null origin", origin: "null", status: http.StatusForbidden},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, server.URL+"/events/ws", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set("Connection", "Upgrade")
+			req.Header.Set("Upgrade", "websocket")
+			req.Header.Set("Sec-WebSocket-Version", "13")
+			req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			assert.Equal(t, tc.status, resp.StatusCode)
+		})
+	}
+}
@@ -34,6 +34,13 @@ import (
 
 func main() {
 	logger := logging.C("cmd.scheduler")
+	if handled, err := runStateCommand(os.Args[1:]); handled {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	insecure := flag.Bool("insecure", false, "run scheduler gRPC without mTLS (testing only)")
 	flag.Parse()
 
@@ -112,7 +119,7 @@ func main() {
 	defer cancel()
 
 	sched.StartMonitoring(ctx)
-	sched.StartReconciliation(ctx)
+	sched.StartLeaderElection(ctx, cfg.GRPCPort)
 
 	grpcPort := strconv.Itoa(cfg.GRPCPort)
 	if err := sched.RegisterSchedulerSelfInCoreDNS(cfg.GRPCPort); err != nil {
@@ -144,11 +151,16 @@ func main() {
 	metricsMux.Handle("/health", gootelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		mode, reason, changedAt := sched.ModeSnapshot()
+		leading, leader := sched.LeaderSnapshot()
 		payload, _ := json.Marshal(map[string]string{
 			"status":        "ok",
 			"mode":          string(mode),
 			"reason":        reason,
 			"modeChangedAt": changedAt.Format(time.RFC3339),
+			"instanceId":    cfg.SchedulerInstanceID,
+			"leader":        strconv.FormatBool(leading),
+			"leaderId":      leader.InstanceID,
+			"leaderAddress": leader.Address,
 		})
 		_, _ = w.Write(payload)
 	}), "scheduler.health"))
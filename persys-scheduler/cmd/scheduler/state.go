@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	cfgpkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/config"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+)
+
+// runStateCommand handles the offline state subcommands, which talk to etcd
+// directly instead of going through a running scheduler. It reports whether
+// args named one of them.
+func runStateCommand(args []string) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+	switch args[0] {
+	case "export-state":
+		return true, exportStateCommand(args[1:])
+	case "import-state":
+		return true, importStateCommand(args[1:])
+	default:
+		return false, nil
+	}
+}
+
+func exportStateCommand(args []string) error {
+	fs := flag.NewFlagSet("export-state", flag.ContinueOnError)
+	out := fs.String("o", "", "write the archive to this file instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	sched, err := openStateScheduler()
+	if err != nil {
+		return err
+	}
+	defer sched.Close()
+
+	archive, err := sched.ExportState()
+	if err != nil {
+		return err
+	}
+	payload, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode archive: %w", err)
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(append(payload, '\n'))
+		return err
+	}
+	if err := os.WriteFile(*out, payload, 0o600); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "exported %d nodes and %d workloads to %s\n", len(archive.Nodes), len(archive.Workloads), *out)
+	return nil
+}
+
+func importStateCommand(args []string) error {
+	fs := flag.NewFlagSet("import-state", flag.ContinueOnError)
+	in := fs.String("f", "", "archive file to import (- for stdin)")
+	force := fs.Bool("force", false, "overwrite state already present in etcd")
+	dryRun := fs.Bool("dry-run", false, "validate the archive without writing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" {
+		return fmt.Errorf("import-state: -f is required")
+	}
+
+	var payload []byte
+	var err error
+	if *in == "-" {
+		payload, err = io.ReadAll(os.Stdin)
+	} else {
+		payload, err = os.ReadFile(*in)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read archive: %w", err)
+	}
+	var archive models.StateArchive
+	if err := json.Unmarshal(payload, &archive); err != nil {
+		return fmt.Errorf("archive is not valid JSON: %w", err)
+	}
+	if err := scheduler.ValidateStateArchive(archive); err != nil {
+		return err
+	}
+	if *dryRun {
+		fmt.Fprintf(os.Stderr, "archive is valid: %d nodes, %d workloads, %d assignments\n", len(archive.Nodes), len(archive.Workloads), len(archive.Assignments))
+		return nil
+	}
+
+	sched, err := openStateScheduler()
+	if err != nil {
+		return err
+	}
+	defer sched.Close()
+
+	if err := sched.ImportState(archive, *force); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "imported %d nodes and %d workloads\n", len(archive.Nodes), len(archive.Workloads))
+	return nil
+}
+
+func openStateScheduler() (*scheduler.Scheduler, error) {
+	// The subcommands never serve gRPC, so certificates are not needed.
+	cfg, err := cfgpkg.Load(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scheduler configuration: %w", err)
+	}
+	sched, err := scheduler.NewScheduler(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize scheduler: %w", err)
+	}
+	return sched, nil
+}
@@ -15,7 +15,7 @@ import (
 )
 
 func main() {
-	op := flag.String("op", "", "operation: register-node | heartbeat | apply-container | apply-vm | delete-workload | retry-workload | list-nodes | get-node | list-workloads | get-workload | cluster-summary")
+	op := flag.String("op", "", "operation: register-node | heartbeat | apply-container | apply-vm | delete-workload | retry-workload | list-nodes | get-node | list-workloads | get-workload | cluster-summary | simulate-container | simulate-vm | explain-workload")
 	schedulerAddr := flag.String("scheduler", "127.0.0.1:8085", "scheduler gRPC address")
 	timeout := flag.Duration("timeout", 20*time.Second, "rpc timeout")
 
@@ -74,6 +74,53 @@ func main() {
 
 	client := controlv1.NewAgentControlClient(conn)
 
+	containerSpec := func() *controlv1.WorkloadSpec {
+		return &controlv1.WorkloadSpec{
+			Type: "container",
+			Resources: &controlv1.ResourceRequirements{
+				CpuMillicores: *wCPU,
+				MemoryMb:      *wMem,
+				DiskGb:        *wDisk,
+			},
+			Workload: &controlv1.WorkloadSpec_Container{
+				Container: &controlv1.ContainerSpec{
+					Image:         *containerImage,
+					Command:       splitCSV(*containerCmd),
+					RestartPolicy: *containerRestart,
+				},
+			},
+		}
+	}
+	vmSpec := func() *controlv1.WorkloadSpec {
+		return &controlv1.WorkloadSpec{
+			Type: "vm",
+			Resources: &controlv1.ResourceRequirements{
+				CpuMillicores: *wCPU,
+				MemoryMb:      *wMem,
+				DiskGb:        *wDisk,
+			},
+			Workload: &controlv1.WorkloadSpec_Vm{
+				Vm: &controlv1.VMSpec{
+					Vcpus:    int32(*vmVCPUs),
+					MemoryMb: *vmMemory,
+					Disks: []*controlv1.DiskConfig{
+						{
+							PoolName: *vmDiskPool,
+							SizeGb:   *vmDiskSize,
+						},
+					},
+					Networks: []*controlv1.NetworkConfig{
+						{
+							Bridge: *vmBridge,
+							Dhcp:   *vmDHCP,
+						},
+					},
+					OsImage: *vmImage,
+				},
+			},
+		}
+	}
+
 	switch *op {
 	case "register-node":
 		resp, err := client.RegisterNode(ctx, &controlv1.RegisterNodeRequest{
@@ -114,21 +161,7 @@ func main() {
 			WorkloadId:   *workloadID,
 			RevisionId:   *revisionID,
 			DesiredState: *desiredState,
-			Spec: &controlv1.WorkloadSpec{
-				Type: "container",
-				Resources: &controlv1.ResourceRequirements{
-					CpuMillicores: *wCPU,
-					MemoryMb:      *wMem,
-					DiskGb:        *wDisk,
-				},
-				Workload: &controlv1.WorkloadSpec_Container{
-					Container: &controlv1.ContainerSpec{
-						Image:         *containerImage,
-						Command:       splitCSV(*containerCmd),
-						RestartPolicy: *containerRestart,
-					},
-				},
-			},
+			Spec:         containerSpec(),
 		})
 		if err != nil {
 			log.Fatalf("apply-container failed: %v", err)
@@ -139,33 +172,7 @@ func main() {
 			WorkloadId:   *workloadID,
 			RevisionId:   *revisionID,
 			DesiredState: *desiredState,
-			Spec: &controlv1.WorkloadSpec{
-				Type: "vm",
-				Resources: &controlv1.ResourceRequirements{
-					CpuMillicores: *wCPU,
-					MemoryMb:      *wMem,
-					DiskGb:        *wDisk,
-				},
-				Workload: &controlv1.WorkloadSpec_Vm{
-					Vm: &controlv1.VMSpec{
-						Vcpus:    int32(*vmVCPUs),
-						MemoryMb: *vmMemory,
-						Disks: []*controlv1.DiskConfig{
-							{
-								PoolName: *vmDiskPool,
-								SizeGb:   *vmDiskSize,
-							},
-						},
-						Networks: []*controlv1.NetworkConfig{
-							{
-								Bridge: *vmBridge,
-								Dhcp:   *vmDHCP,
-							},
-						},
-						OsImage: *vmImage,
-					},
-				},
-			},
+			Spec:         vmSpec(),
 		})
 		if err != nil {
 			log.Fatalf("apply-vm failed: %v", err)
@@ -220,6 +227,19 @@ func main() {
 			log.Fatalf("cluster-summary failed: %v", err)
 		}
 		printJSON(resp)
+	case "simulate-container", "simulate-vm", "explain-workload":
+		req := &controlv1.SimulatePlacementRequest{WorkloadId: *workloadID}
+		switch *op {
+		case "simulate-container":
+			req.Spec = containerSpec()
+		case "simulate-vm":
+			req.Spec = vmSpec()
+		}
+		resp, err := client.SimulatePlacement(ctx, req)
+		if err != nil {
+			log.Fatalf("%s failed: %v", *op, err)
+		}
+		printJSON(resp)
 	default:
 		log.Fatalf("unsupported -op %q", *op)
 	}
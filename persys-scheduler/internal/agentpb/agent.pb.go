@@ -180,6 +180,55 @@ func (ActualState) EnumDescriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{2}
 }
 
+type LogStream int32
+
+const (
+	LogStream_LOG_STREAM_UNSPECIFIED LogStream = 0
+	LogStream_LOG_STREAM_STDOUT      LogStream = 1
+	LogStream_LOG_STREAM_STDERR      LogStream = 2
+)
+
+// Enum value maps for LogStream.
+var (
+	LogStream_name = map[int32]string{
+		0: "LOG_STREAM_UNSPECIFIED",
+		1: "LOG_STREAM_STDOUT",
+		2: "LOG_STREAM_STDERR",
+	}
+	LogStream_value = map[string]int32{
+		"LOG_STREAM_UNSPECIFIED": 0,
+		"LOG_STREAM_STDOUT":      1,
+		"LOG_STREAM_STDERR":      2,
+	}
+)
+
+func (x LogStream) Enum() *LogStream {
+	p := new(LogStream)
+	*p = x
+	return p
+}
+
+func (x LogStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_proto_enumTypes[3].Descriptor()
+}
+
+func (LogStream) Type() protoreflect.EnumType {
+	return &file_agent_proto_enumTypes[3]
+}
+
+func (x LogStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogStream.Descriptor instead.
+func (LogStream) EnumDescriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{3}
+}
+
 type ApplyWorkloadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1046,6 +1095,9 @@ type ContainerSpec struct {
 	RestartPolicy  *RestartPolicy         `protobuf:"bytes,8,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
 	Labels         map[string]string      `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ManagedVolumes []*ManagedVolumeSpec   `protobuf:"bytes,10,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
+	LivenessProbe  *Probe                 `protobuf:"bytes,11,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	ReadinessProbe *Probe                 `protobuf:"bytes,12,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
+	Files          []*FileMount           `protobuf:"bytes,13,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1150,11 +1202,38 @@ func (x *ContainerSpec) GetManagedVolumes() []*ManagedVolumeSpec {
 	return nil
 }
 
+func (x *ContainerSpec) GetLivenessProbe() *Probe {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetReadinessProbe() *Probe {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetFiles() []*FileMount {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
 type ComposeSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProjectName   string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
-	ComposeYaml   string                 `protobuf:"bytes,2,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"` // base64 encoded docker-compose.yml
-	Env           map[string]string      `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ProjectName string                 `protobuf:"bytes,1,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	ComposeYaml string                 `protobuf:"bytes,2,opt,name=compose_yaml,json=composeYaml,proto3" json:"compose_yaml,omitempty"` // base64 encoded docker-compose.yml
+	Env         map[string]string      `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Files       []*FileMount           `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"` // paths relative to the project directory
+	// Git source of the project, used when compose_yaml is empty. git_token is
+	// resolved by the scheduler from a secret and must not be persisted.
+	GitRepo       string `protobuf:"bytes,5,opt,name=git_repo,json=gitRepo,proto3" json:"git_repo,omitempty"`
+	GitRef        string `protobuf:"bytes,6,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	GitToken      string `protobuf:"bytes,7,opt,name=git_token,json=gitToken,proto3" json:"git_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1210,6 +1289,97 @@ func (x *ComposeSpec) GetEnv() map[string]string {
 	return nil
 }
 
+func (x *ComposeSpec) GetFiles() []*FileMount {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ComposeSpec) GetGitRepo() string {
+	if x != nil {
+		return x.GitRepo
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitToken() string {
+	if x != nil {
+		return x.GitToken
+	}
+	return ""
+}
+
+// FileMount is a file the agent writes before starting the workload, with
+// content resolved by the scheduler from a secret or config. Agents must
+// keep it off persistent logs and remove it with the workload.
+type FileMount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mode          int32                  `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileMount) Reset() {
+	*x = FileMount{}
+	mi := &file_agent_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMount) ProtoMessage() {}
+
+func (x *FileMount) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMount.ProtoReflect.Descriptor instead.
+func (*FileMount) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FileMount) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileMount) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *FileMount) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
 type VMSpec struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -1221,13 +1391,15 @@ type VMSpec struct {
 	Metadata        map[string]string      `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	CloudInitConfig *CloudInitConfig       `protobuf:"bytes,8,opt,name=cloud_init_config,json=cloudInitConfig,proto3" json:"cloud_init_config,omitempty"` // advanced cloud-init settings
 	ManagedVolumes  []*ManagedVolumeSpec   `protobuf:"bytes,9,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
+	LivenessProbe   *Probe                 `protobuf:"bytes,10,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	ReadinessProbe  *Probe                 `protobuf:"bytes,11,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *VMSpec) Reset() {
 	*x = VMSpec{}
-	mi := &file_agent_proto_msgTypes[16]
+	mi := &file_agent_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1239,7 +1411,7 @@ func (x *VMSpec) String() string {
 func (*VMSpec) ProtoMessage() {}
 
 func (x *VMSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[16]
+	mi := &file_agent_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1424,7 @@ func (x *VMSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VMSpec.ProtoReflect.Descriptor instead.
 func (*VMSpec) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{16}
+	return file_agent_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *VMSpec) GetName() string {
@@ -1318,31 +1490,55 @@ func (x *VMSpec) GetManagedVolumes() []*ManagedVolumeSpec {
 	return nil
 }
 
-type CloudInitConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserData      string                 `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`                // cloud-init user-data script
-	MetaData      string                 `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`                // cloud-init meta-data (JSON)
-	NetworkConfig string                 `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"` // cloud-init network config (YAML)
-	VendorData    string                 `protobuf:"bytes,4,opt,name=vendor_data,json=vendorData,proto3" json:"vendor_data,omitempty"`          // cloud-init vendor-data
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *VMSpec) GetLivenessProbe() *Probe {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return nil
 }
 
-func (x *CloudInitConfig) Reset() {
-	*x = CloudInitConfig{}
-	mi := &file_agent_proto_msgTypes[17]
+func (x *VMSpec) GetReadinessProbe() *Probe {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return nil
+}
+
+// Probe describes a health check the agent runs every period_seconds and
+// reports in WorkloadStatus.probe_results. Thresholds are applied by the
+// scheduler.
+type Probe struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Handler:
+	//
+	//	*Probe_HttpGet
+	//	*Probe_TcpSocket
+	//	*Probe_Exec
+	Handler             isProbe_Handler `protobuf_oneof:"handler"`
+	InitialDelaySeconds int32           `protobuf:"varint,4,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
+	PeriodSeconds       int32           `protobuf:"varint,5,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	TimeoutSeconds      int32           `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	SuccessThreshold    int32           `protobuf:"varint,7,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
+	FailureThreshold    int32           `protobuf:"varint,8,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Probe) Reset() {
+	*x = Probe{}
+	mi := &file_agent_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloudInitConfig) String() string {
+func (x *Probe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInitConfig) ProtoMessage() {}
+func (*Probe) ProtoMessage() {}
 
-func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[17]
+func (x *Probe) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,162 +1549,126 @@ func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInitConfig.ProtoReflect.Descriptor instead.
-func (*CloudInitConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Probe.ProtoReflect.Descriptor instead.
+func (*Probe) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *CloudInitConfig) GetUserData() string {
+func (x *Probe) GetHandler() isProbe_Handler {
 	if x != nil {
-		return x.UserData
+		return x.Handler
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInitConfig) GetMetaData() string {
+func (x *Probe) GetHttpGet() *HTTPGetAction {
 	if x != nil {
-		return x.MetaData
+		if x, ok := x.Handler.(*Probe_HttpGet); ok {
+			return x.HttpGet
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInitConfig) GetNetworkConfig() string {
+func (x *Probe) GetTcpSocket() *TCPSocketAction {
 	if x != nil {
-		return x.NetworkConfig
+		if x, ok := x.Handler.(*Probe_TcpSocket); ok {
+			return x.TcpSocket
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *CloudInitConfig) GetVendorData() string {
+func (x *Probe) GetExec() *ExecAction {
 	if x != nil {
-		return x.VendorData
+		if x, ok := x.Handler.(*Probe_Exec); ok {
+			return x.Exec
+		}
 	}
-	return ""
-}
-
-type ManagedVolumeSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd
-	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	AccessMode    string                 `protobuf:"bytes,4,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
-	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
-	MountPath     string                 `protobuf:"bytes,6,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
-	RetainPolicy  string                 `protobuf:"bytes,8,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ManagedVolumeSpec) Reset() {
-	*x = ManagedVolumeSpec{}
-	mi := &file_agent_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ManagedVolumeSpec) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*ManagedVolumeSpec) ProtoMessage() {}
-
-func (x *ManagedVolumeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[18]
+func (x *Probe) GetInitialDelaySeconds() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.InitialDelaySeconds
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ManagedVolumeSpec.ProtoReflect.Descriptor instead.
-func (*ManagedVolumeSpec) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{18}
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetName() string {
+func (x *Probe) GetPeriodSeconds() int32 {
 	if x != nil {
-		return x.Name
+		return x.PeriodSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetDriver() string {
+func (x *Probe) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.Driver
+		return x.TimeoutSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetSizeGb() int64 {
+func (x *Probe) GetSuccessThreshold() int32 {
 	if x != nil {
-		return x.SizeGb
+		return x.SuccessThreshold
 	}
 	return 0
 }
 
-func (x *ManagedVolumeSpec) GetAccessMode() string {
+func (x *Probe) GetFailureThreshold() int32 {
 	if x != nil {
-		return x.AccessMode
+		return x.FailureThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetFsType() string {
-	if x != nil {
-		return x.FsType
-	}
-	return ""
+type isProbe_Handler interface {
+	isProbe_Handler()
 }
 
-func (x *ManagedVolumeSpec) GetMountPath() string {
-	if x != nil {
-		return x.MountPath
-	}
-	return ""
+type Probe_HttpGet struct {
+	HttpGet *HTTPGetAction `protobuf:"bytes,1,opt,name=http_get,json=httpGet,proto3,oneof"`
 }
 
-func (x *ManagedVolumeSpec) GetReadOnly() bool {
-	if x != nil {
-		return x.ReadOnly
-	}
-	return false
+type Probe_TcpSocket struct {
+	TcpSocket *TCPSocketAction `protobuf:"bytes,2,opt,name=tcp_socket,json=tcpSocket,proto3,oneof"`
 }
 
-func (x *ManagedVolumeSpec) GetRetainPolicy() string {
-	if x != nil {
-		return x.RetainPolicy
-	}
-	return ""
+type Probe_Exec struct {
+	Exec *ExecAction `protobuf:"bytes,3,opt,name=exec,proto3,oneof"`
 }
 
-type VolumeMount struct {
+func (*Probe_HttpGet) isProbe_Handler() {}
+
+func (*Probe_TcpSocket) isProbe_Handler() {}
+
+func (*Probe_Exec) isProbe_Handler() {}
+
+type HTTPGetAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostPath      string                 `protobuf:"bytes,1,opt,name=host_path,json=hostPath,proto3" json:"host_path,omitempty"`
-	ContainerPath string                 `protobuf:"bytes,2,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Scheme        string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeMount) Reset() {
-	*x = VolumeMount{}
+func (x *HTTPGetAction) Reset() {
+	*x = HTTPGetAction{}
 	mi := &file_agent_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeMount) String() string {
+func (x *HTTPGetAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeMount) ProtoMessage() {}
+func (*HTTPGetAction) ProtoMessage() {}
 
-func (x *VolumeMount) ProtoReflect() protoreflect.Message {
+func (x *HTTPGetAction) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1520,55 +1680,60 @@ func (x *VolumeMount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
-func (*VolumeMount) Descriptor() ([]byte, []int) {
+// Deprecated: Use HTTPGetAction.ProtoReflect.Descriptor instead.
+func (*HTTPGetAction) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *VolumeMount) GetHostPath() string {
+func (x *HTTPGetAction) GetPath() string {
 	if x != nil {
-		return x.HostPath
+		return x.Path
 	}
 	return ""
 }
 
-func (x *VolumeMount) GetContainerPath() string {
+func (x *HTTPGetAction) GetPort() int32 {
 	if x != nil {
-		return x.ContainerPath
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HTTPGetAction) GetScheme() string {
+	if x != nil {
+		return x.Scheme
 	}
 	return ""
 }
 
-func (x *VolumeMount) GetReadOnly() bool {
+func (x *HTTPGetAction) GetHeaders() map[string]string {
 	if x != nil {
-		return x.ReadOnly
+		return x.Headers
 	}
-	return false
+	return nil
 }
 
-type PortMapping struct {
+type TCPSocketAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostPort      int32                  `protobuf:"varint,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
-	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
-	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp or udp
+	Port          int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PortMapping) Reset() {
-	*x = PortMapping{}
+func (x *TCPSocketAction) Reset() {
+	*x = TCPSocketAction{}
 	mi := &file_agent_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PortMapping) String() string {
+func (x *TCPSocketAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PortMapping) ProtoMessage() {}
+func (*TCPSocketAction) ProtoMessage() {}
 
-func (x *PortMapping) ProtoReflect() protoreflect.Message {
+func (x *TCPSocketAction) ProtoReflect() protoreflect.Message {
 	mi := &file_agent_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1580,56 +1745,88 @@ func (x *PortMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PortMapping.ProtoReflect.Descriptor instead.
-func (*PortMapping) Descriptor() ([]byte, []int) {
+// Deprecated: Use TCPSocketAction.ProtoReflect.Descriptor instead.
+func (*TCPSocketAction) Descriptor() ([]byte, []int) {
 	return file_agent_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PortMapping) GetHostPort() int32 {
+func (x *TCPSocketAction) GetPort() int32 {
 	if x != nil {
-		return x.HostPort
+		return x.Port
 	}
 	return 0
 }
 
-func (x *PortMapping) GetContainerPort() int32 {
+type ExecAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       []string               `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecAction) Reset() {
+	*x = ExecAction{}
+	mi := &file_agent_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecAction) ProtoMessage() {}
+
+func (x *ExecAction) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[21]
 	if x != nil {
-		return x.ContainerPort
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PortMapping) GetProtocol() string {
+// Deprecated: Use ExecAction.ProtoReflect.Descriptor instead.
+func (*ExecAction) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExecAction) GetCommand() []string {
 	if x != nil {
-		return x.Protocol
+		return x.Command
 	}
-	return ""
+	return nil
 }
 
-type ResourceLimits struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CpuShares       int64                  `protobuf:"varint,1,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`
-	MemoryBytes     int64                  `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	MemorySwapBytes int64                  `protobuf:"varint,3,opt,name=memory_swap_bytes,json=memorySwapBytes,proto3" json:"memory_swap_bytes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// ProbeResult is the latest run of one probe.
+type ProbeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         string                 `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe,omitempty"` // liveness or readiness
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CheckedAt     int64                  `protobuf:"varint,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"` // unix timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResourceLimits) Reset() {
-	*x = ResourceLimits{}
-	mi := &file_agent_proto_msgTypes[21]
+func (x *ProbeResult) Reset() {
+	*x = ProbeResult{}
+	mi := &file_agent_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceLimits) String() string {
+func (x *ProbeResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceLimits) ProtoMessage() {}
+func (*ProbeResult) ProtoMessage() {}
 
-func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[21]
+func (x *ProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,55 +1837,64 @@ func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
-func (*ResourceLimits) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use ProbeResult.ProtoReflect.Descriptor instead.
+func (*ProbeResult) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ResourceLimits) GetCpuShares() int64 {
+func (x *ProbeResult) GetProbe() string {
 	if x != nil {
-		return x.CpuShares
+		return x.Probe
 	}
-	return 0
+	return ""
 }
 
-func (x *ResourceLimits) GetMemoryBytes() int64 {
+func (x *ProbeResult) GetSuccess() bool {
 	if x != nil {
-		return x.MemoryBytes
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ResourceLimits) GetMemorySwapBytes() int64 {
+func (x *ProbeResult) GetMessage() string {
 	if x != nil {
-		return x.MemorySwapBytes
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProbeResult) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
 	}
 	return 0
 }
 
-type RestartPolicy struct {
+type CloudInitConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Policy        string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // no, always, on-failure, unless-stopped
-	MaxRetryCount int32                  `protobuf:"varint,2,opt,name=max_retry_count,json=maxRetryCount,proto3" json:"max_retry_count,omitempty"`
+	UserData      string                 `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`                // cloud-init user-data script
+	MetaData      string                 `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`                // cloud-init meta-data (JSON)
+	NetworkConfig string                 `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"` // cloud-init network config (YAML)
+	VendorData    string                 `protobuf:"bytes,4,opt,name=vendor_data,json=vendorData,proto3" json:"vendor_data,omitempty"`          // cloud-init vendor-data
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RestartPolicy) Reset() {
-	*x = RestartPolicy{}
-	mi := &file_agent_proto_msgTypes[22]
+func (x *CloudInitConfig) Reset() {
+	*x = CloudInitConfig{}
+	mi := &file_agent_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RestartPolicy) String() string {
+func (x *CloudInitConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestartPolicy) ProtoMessage() {}
+func (*CloudInitConfig) ProtoMessage() {}
 
-func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[22]
+func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,52 +1905,1306 @@ func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestartPolicy.ProtoReflect.Descriptor instead.
-func (*RestartPolicy) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CloudInitConfig.ProtoReflect.Descriptor instead.
+func (*CloudInitConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RestartPolicy) GetPolicy() string {
+func (x *CloudInitConfig) GetUserData() string {
 	if x != nil {
-		return x.Policy
+		return x.UserData
 	}
 	return ""
 }
 
-func (x *RestartPolicy) GetMaxRetryCount() int32 {
+func (x *CloudInitConfig) GetMetaData() string {
 	if x != nil {
-		return x.MaxRetryCount
+		return x.MetaData
+	}
+	return ""
+}
+
+func (x *CloudInitConfig) GetNetworkConfig() string {
+	if x != nil {
+		return x.NetworkConfig
+	}
+	return ""
+}
+
+func (x *CloudInitConfig) GetVendorData() string {
+	if x != nil {
+		return x.VendorData
+	}
+	return ""
+}
+
+type ManagedVolumeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd
+	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	AccessMode    string                 `protobuf:"bytes,4,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	MountPath     string                 `protobuf:"bytes,6,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	RetainPolicy  string                 `protobuf:"bytes,8,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManagedVolumeSpec) Reset() {
+	*x = ManagedVolumeSpec{}
+	mi := &file_agent_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagedVolumeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedVolumeSpec) ProtoMessage() {}
+
+func (x *ManagedVolumeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagedVolumeSpec.ProtoReflect.Descriptor instead.
+func (*ManagedVolumeSpec) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ManagedVolumeSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
 	}
 	return 0
 }
 
-type DiskConfig struct {
+func (x *ManagedVolumeSpec) GetAccessMode() string {
+	if x != nil {
+		return x.AccessMode
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ManagedVolumeSpec) GetRetainPolicy() string {
+	if x != nil {
+		return x.RetainPolicy
+	}
+	return ""
+}
+
+type VolumeRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // path to disk image or ISO
-	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"` // vda, vdb, etc.
-	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // qcow2, raw, iso
-	SizeGb        int64                  `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`  // disk or cdrom (for ISO)
-	Boot          bool                   `protobuf:"varint,6,opt,name=boot,proto3" json:"boot,omitempty"` // true if this is the boot disk/ISO
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeRef) Reset() {
+	*x = VolumeRef{}
+	mi := &file_agent_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeRef) ProtoMessage() {}
+
+func (x *VolumeRef) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeRef.ProtoReflect.Descriptor instead.
+func (*VolumeRef) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VolumeRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeRef) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+type VolumeMount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostPath      string                 `protobuf:"bytes,1,opt,name=host_path,json=hostPath,proto3" json:"host_path,omitempty"`
+	ContainerPath string                 `protobuf:"bytes,2,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeMount) Reset() {
+	*x = VolumeMount{}
+	mi := &file_agent_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeMount) ProtoMessage() {}
+
+func (x *VolumeMount) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
+func (*VolumeMount) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VolumeMount) GetHostPath() string {
+	if x != nil {
+		return x.HostPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetContainerPath() string {
+	if x != nil {
+		return x.ContainerPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type PortMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostPort      int32                  `protobuf:"varint,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp or udp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortMapping) Reset() {
+	*x = PortMapping{}
+	mi := &file_agent_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortMapping) ProtoMessage() {}
+
+func (x *PortMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortMapping.ProtoReflect.Descriptor instead.
+func (*PortMapping) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PortMapping) GetHostPort() int32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *PortMapping) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type ResourceLimits struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CpuShares       int64                  `protobuf:"varint,1,opt,name=cpu_shares,json=cpuShares,proto3" json:"cpu_shares,omitempty"`
+	MemoryBytes     int64                  `protobuf:"varint,2,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemorySwapBytes int64                  `protobuf:"varint,3,opt,name=memory_swap_bytes,json=memorySwapBytes,proto3" json:"memory_swap_bytes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
+	mi := &file_agent_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimits) ProtoMessage() {}
+
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ResourceLimits) GetCpuShares() int64 {
+	if x != nil {
+		return x.CpuShares
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMemorySwapBytes() int64 {
+	if x != nil {
+		return x.MemorySwapBytes
+	}
+	return 0
+}
+
+type RestartPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        string                 `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // no, always, on-failure, unless-stopped
+	MaxRetryCount int32                  `protobuf:"varint,2,opt,name=max_retry_count,json=maxRetryCount,proto3" json:"max_retry_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestartPolicy) Reset() {
+	*x = RestartPolicy{}
+	mi := &file_agent_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestartPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartPolicy) ProtoMessage() {}
+
+func (x *RestartPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartPolicy.ProtoReflect.Descriptor instead.
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestartPolicy) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *RestartPolicy) GetMaxRetryCount() int32 {
+	if x != nil {
+		return x.MaxRetryCount
+	}
+	return 0
+}
+
+type DiskConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // path to disk image or ISO
+	Device        string                 `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"` // vda, vdb, etc.
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // qcow2, raw, iso
+	SizeGb        int64                  `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`  // disk or cdrom (for ISO)
+	Boot          bool                   `protobuf:"varint,6,opt,name=boot,proto3" json:"boot,omitempty"` // true if this is the boot disk/ISO
+	Pool          string                 `protobuf:"bytes,7,opt,name=pool,proto3" json:"pool,omitempty"`  // storage pool to provision the disk from (empty = agent default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskConfig) Reset() {
+	*x = DiskConfig{}
+	mi := &file_agent_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskConfig) ProtoMessage() {}
+
+func (x *DiskConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
+func (*DiskConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DiskConfig) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiskConfig) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskConfig) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DiskConfig) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *DiskConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DiskConfig) GetBoot() bool {
+	if x != nil {
+		return x.Boot
+	}
+	return false
+}
+
+func (x *DiskConfig) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+type NetworkConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // network name or bridge
+	MacAddress    string                 `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // optional static IP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
+	mi := &file_agent_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConfig) ProtoMessage() {}
+
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *NetworkConfig) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+type WorkloadStatus struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type         WorkloadType           `protobuf:"varint,2,opt,name=type,proto3,enum=persys.agent.v1.WorkloadType" json:"type,omitempty"`
+	RevisionId   string                 `protobuf:"bytes,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	DesiredState DesiredState           `protobuf:"varint,4,opt,name=desired_state,json=desiredState,proto3,enum=persys.agent.v1.DesiredState" json:"desired_state,omitempty"`
+	ActualState  ActualState            `protobuf:"varint,5,opt,name=actual_state,json=actualState,proto3,enum=persys.agent.v1.ActualState" json:"actual_state,omitempty"`
+	Message      string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt    int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Metadata     map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Usage        *WorkloadUsageSnapshot `protobuf:"bytes,10,opt,name=usage,proto3" json:"usage,omitempty"`
+	ProbeResults []*ProbeResult         `protobuf:"bytes,11,rep,name=probe_results,json=probeResults,proto3" json:"probe_results,omitempty"`
+	// Set once the workload's process has ended on its own; exit_code is the
+	// code it ended with.
+	Exited        bool  `protobuf:"varint,12,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode      int32 `protobuf:"varint,13,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadStatus) Reset() {
+	*x = WorkloadStatus{}
+	mi := &file_agent_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadStatus) ProtoMessage() {}
+
+func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
+func (*WorkloadStatus) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WorkloadStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetType() WorkloadType {
+	if x != nil {
+		return x.Type
+	}
+	return WorkloadType_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *WorkloadStatus) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetDesiredState() DesiredState {
+	if x != nil {
+		return x.DesiredState
+	}
+	return DesiredState_DESIRED_STATE_UNSPECIFIED
+}
+
+func (x *WorkloadStatus) GetActualState() ActualState {
+	if x != nil {
+		return x.ActualState
+	}
+	return ActualState_ACTUAL_STATE_UNSPECIFIED
+}
+
+func (x *WorkloadStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetUsage() *WorkloadUsageSnapshot {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetProbeResults() []*ProbeResult {
+	if x != nil {
+		return x.ProbeResults
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetExited() bool {
+	if x != nil {
+		return x.Exited
+	}
+	return false
+}
+
+func (x *WorkloadStatus) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+type WorkloadUsageSnapshot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Type           WorkloadType           `protobuf:"varint,2,opt,name=type,proto3,enum=persys.agent.v1.WorkloadType" json:"type,omitempty"`
+	CpuPercent     float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryBytes    int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	DiskReadBytes  int64                  `protobuf:"varint,5,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes int64                  `protobuf:"varint,6,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	NetRxBytes     int64                  `protobuf:"varint,7,opt,name=net_rx_bytes,json=netRxBytes,proto3" json:"net_rx_bytes,omitempty"`
+	NetTxBytes     int64                  `protobuf:"varint,8,opt,name=net_tx_bytes,json=netTxBytes,proto3" json:"net_tx_bytes,omitempty"`
+	CollectedAt    int64                  `protobuf:"varint,9,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // unix timestamp
+	Source         string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkloadUsageSnapshot) Reset() {
+	*x = WorkloadUsageSnapshot{}
+	mi := &file_agent_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadUsageSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadUsageSnapshot) ProtoMessage() {}
+
+func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadUsageSnapshot.ProtoReflect.Descriptor instead.
+func (*WorkloadUsageSnapshot) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WorkloadUsageSnapshot) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *WorkloadUsageSnapshot) GetType() WorkloadType {
+	if x != nil {
+		return x.Type
+	}
+	return WorkloadType_WORKLOAD_TYPE_UNSPECIFIED
+}
+
+func (x *WorkloadUsageSnapshot) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetDiskReadBytes() int64 {
+	if x != nil {
+		return x.DiskReadBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetDiskWriteBytes() int64 {
+	if x != nil {
+		return x.DiskWriteBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetNetRxBytes() int64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetNetTxBytes() int64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetCollectedAt() int64 {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type StreamWorkloadLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Follow        bool                   `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	TailLines     int64                  `protobuf:"varint,3,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"` // 0 = whole log
+	Since         int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`                          // unix timestamp, 0 = no lower bound
+	Container     string                 `protobuf:"bytes,5,opt,name=container,proto3" json:"container,omitempty"`                   // compose service; empty = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamWorkloadLogsRequest) Reset() {
+	*x = StreamWorkloadLogsRequest{}
+	mi := &file_agent_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamWorkloadLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamWorkloadLogsRequest) ProtoMessage() {}
+
+func (x *StreamWorkloadLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamWorkloadLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkloadLogsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StreamWorkloadLogsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamWorkloadLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamWorkloadLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamWorkloadLogsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *StreamWorkloadLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type WorkloadLogChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stream        LogStream              `protobuf:"varint,1,opt,name=stream,proto3,enum=persys.agent.v1.LogStream" json:"stream,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unix nanoseconds of the first line
+	Container     string                 `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadLogChunk) Reset() {
+	*x = WorkloadLogChunk{}
+	mi := &file_agent_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadLogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadLogChunk) ProtoMessage() {}
+
+func (x *WorkloadLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadLogChunk.ProtoReflect.Descriptor instead.
+func (*WorkloadLogChunk) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WorkloadLogChunk) GetStream() LogStream {
+	if x != nil {
+		return x.Stream
+	}
+	return LogStream_LOG_STREAM_UNSPECIFIED
+}
+
+func (x *WorkloadLogChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WorkloadLogChunk) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *WorkloadLogChunk) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type TerminalSize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cols          uint32                 `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows          uint32                 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	mi := &file_agent_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminalSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminalSize) ProtoMessage() {}
+
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TerminalSize) GetCols() uint32 {
+	if x != nil {
+		return x.Cols
+	}
+	return 0
+}
+
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type ExecStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Command       []string               `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	Tty           bool                   `protobuf:"varint,3,opt,name=tty,proto3" json:"tty,omitempty"`
+	Stdin         bool                   `protobuf:"varint,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Size          *TerminalSize          `protobuf:"bytes,5,opt,name=size,proto3" json:"size,omitempty"`
+	Container     string                 `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"` // compose service; required for compose workloads
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecStart) Reset() {
+	*x = ExecStart{}
+	mi := &file_agent_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecStart) ProtoMessage() {}
+
+func (x *ExecStart) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecStart.ProtoReflect.Descriptor instead.
+func (*ExecStart) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ExecStart) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExecStart) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *ExecStart) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *ExecStart) GetStdin() bool {
+	if x != nil {
+		return x.Stdin
+	}
+	return false
+}
+
+func (x *ExecStart) GetSize() *TerminalSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *ExecStart) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type ExecWorkloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecWorkloadRequest_Start
+	//	*ExecWorkloadRequest_Stdin
+	//	*ExecWorkloadRequest_Resize
+	//	*ExecWorkloadRequest_CloseStdin
+	Payload       isExecWorkloadRequest_Payload `protobuf_oneof:"payload"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecWorkloadRequest) Reset() {
+	*x = ExecWorkloadRequest{}
+	mi := &file_agent_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecWorkloadRequest) ProtoMessage() {}
+
+func (x *ExecWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*ExecWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ExecWorkloadRequest) GetPayload() isExecWorkloadRequest_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ExecWorkloadRequest) GetStart() *ExecStart {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Start); ok {
+			return x.Start
+		}
+	}
+	return nil
+}
+
+func (x *ExecWorkloadRequest) GetStdin() []byte {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Stdin); ok {
+			return x.Stdin
+		}
+	}
+	return nil
+}
+
+func (x *ExecWorkloadRequest) GetResize() *TerminalSize {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Resize); ok {
+			return x.Resize
+		}
+	}
+	return nil
+}
+
+func (x *ExecWorkloadRequest) GetCloseStdin() bool {
+	if x != nil {
+		if x, ok := x.Payload.(*ExecWorkloadRequest_CloseStdin); ok {
+			return x.CloseStdin
+		}
+	}
+	return false
+}
+
+type isExecWorkloadRequest_Payload interface {
+	isExecWorkloadRequest_Payload()
+}
+
+type ExecWorkloadRequest_Start struct {
+	Start *ExecStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_Stdin struct {
+	Stdin []byte `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_CloseStdin struct {
+	CloseStdin bool `protobuf:"varint,4,opt,name=close_stdin,json=closeStdin,proto3,oneof"`
+}
+
+func (*ExecWorkloadRequest_Start) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_Stdin) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_Resize) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_CloseStdin) isExecWorkloadRequest_Payload() {}
+
+type ExecExit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecExit) Reset() {
+	*x = ExecExit{}
+	mi := &file_agent_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecExit) ProtoMessage() {}
+
+func (x *ExecExit) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
+func (*ExecExit) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExecExit) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ExecExit) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ExecWorkloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecWorkloadResponse_Stdout
+	//	*ExecWorkloadResponse_Stderr
+	//	*ExecWorkloadResponse_Exit
+	Payload       isExecWorkloadResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskConfig) Reset() {
-	*x = DiskConfig{}
-	mi := &file_agent_proto_msgTypes[23]
+func (x *ExecWorkloadResponse) Reset() {
+	*x = ExecWorkloadResponse{}
+	mi := &file_agent_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskConfig) String() string {
+func (x *ExecWorkloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskConfig) ProtoMessage() {}
+func (*ExecWorkloadResponse) ProtoMessage() {}
 
-func (x *DiskConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[23]
+func (x *ExecWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1755,77 +3215,89 @@ func (x *DiskConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
-func (*DiskConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ExecWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*ExecWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DiskConfig) GetPath() string {
+func (x *ExecWorkloadResponse) GetPayload() isExecWorkloadResponse_Payload {
 	if x != nil {
-		return x.Path
+		return x.Payload
 	}
-	return ""
+	return nil
 }
 
-func (x *DiskConfig) GetDevice() string {
+func (x *ExecWorkloadResponse) GetStdout() []byte {
 	if x != nil {
-		return x.Device
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Stdout); ok {
+			return x.Stdout
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *DiskConfig) GetFormat() string {
+func (x *ExecWorkloadResponse) GetStderr() []byte {
 	if x != nil {
-		return x.Format
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Stderr); ok {
+			return x.Stderr
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *DiskConfig) GetSizeGb() int64 {
+func (x *ExecWorkloadResponse) GetExit() *ExecExit {
 	if x != nil {
-		return x.SizeGb
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Exit); ok {
+			return x.Exit
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *DiskConfig) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+type isExecWorkloadResponse_Payload interface {
+	isExecWorkloadResponse_Payload()
 }
 
-func (x *DiskConfig) GetBoot() bool {
-	if x != nil {
-		return x.Boot
-	}
-	return false
+type ExecWorkloadResponse_Stdout struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3,oneof"`
 }
 
-type NetworkConfig struct {
+type ExecWorkloadResponse_Stderr struct {
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3,oneof"`
+}
+
+type ExecWorkloadResponse_Exit struct {
+	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"` // last message of the stream
+}
+
+func (*ExecWorkloadResponse_Stdout) isExecWorkloadResponse_Payload() {}
+
+func (*ExecWorkloadResponse_Stderr) isExecWorkloadResponse_Payload() {}
+
+func (*ExecWorkloadResponse_Exit) isExecWorkloadResponse_Payload() {}
+
+type CreateVolumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"` // network name or bridge
-	MacAddress    string                 `protobuf:"bytes,2,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"` // optional static IP
+	Volume        *ManagedVolumeSpec     `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkConfig) Reset() {
-	*x = NetworkConfig{}
-	mi := &file_agent_proto_msgTypes[24]
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_agent_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkConfig) String() string {
+func (x *CreateVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkConfig) ProtoMessage() {}
+func (*CreateVolumeRequest) ProtoMessage() {}
 
-func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[24]
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,63 +3308,85 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
-func (*NetworkConfig) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *NetworkConfig) GetNetwork() string {
+func (x *CreateVolumeRequest) GetVolume() *ManagedVolumeSpec {
 	if x != nil {
-		return x.Network
+		return x.Volume
 	}
-	return ""
+	return nil
 }
 
-func (x *NetworkConfig) GetMacAddress() string {
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *VolumeRef             `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	mi := &file_agent_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[42]
 	if x != nil {
-		return x.MacAddress
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *NetworkConfig) GetIpAddress() string {
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteVolumeRequest) GetVolume() *VolumeRef {
 	if x != nil {
-		return x.IpAddress
+		return x.Volume
 	}
-	return ""
+	return nil
 }
 
-type WorkloadStatus struct {
+type ResizeVolumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          WorkloadType           `protobuf:"varint,2,opt,name=type,proto3,enum=persys.agent.v1.WorkloadType" json:"type,omitempty"`
-	RevisionId    string                 `protobuf:"bytes,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
-	DesiredState  DesiredState           `protobuf:"varint,4,opt,name=desired_state,json=desiredState,proto3,enum=persys.agent.v1.DesiredState" json:"desired_state,omitempty"`
-	ActualState   ActualState            `protobuf:"varint,5,opt,name=actual_state,json=actualState,proto3,enum=persys.agent.v1.ActualState" json:"actual_state,omitempty"`
-	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Usage         *WorkloadUsageSnapshot `protobuf:"bytes,10,opt,name=usage,proto3" json:"usage,omitempty"`
+	Volume        *VolumeRef             `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SizeGb        int64                  `protobuf:"varint,2,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"` // new size; volumes only grow
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkloadStatus) Reset() {
-	*x = WorkloadStatus{}
-	mi := &file_agent_proto_msgTypes[25]
+func (x *ResizeVolumeRequest) Reset() {
+	*x = ResizeVolumeRequest{}
+	mi := &file_agent_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadStatus) String() string {
+func (x *ResizeVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadStatus) ProtoMessage() {}
+func (*ResizeVolumeRequest) ProtoMessage() {}
 
-func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[25]
+func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,112 +3397,101 @@ func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
-func (*WorkloadStatus) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *WorkloadStatus) GetId() string {
+func (x *ResizeVolumeRequest) GetVolume() *VolumeRef {
 	if x != nil {
-		return x.Id
+		return x.Volume
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkloadStatus) GetType() WorkloadType {
+func (x *ResizeVolumeRequest) GetSizeGb() int64 {
 	if x != nil {
-		return x.Type
+		return x.SizeGb
 	}
-	return WorkloadType_WORKLOAD_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *WorkloadStatus) GetRevisionId() string {
-	if x != nil {
-		return x.RevisionId
-	}
-	return ""
+type SnapshotVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *VolumeRef             `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkloadStatus) GetDesiredState() DesiredState {
-	if x != nil {
-		return x.DesiredState
-	}
-	return DesiredState_DESIRED_STATE_UNSPECIFIED
+func (x *SnapshotVolumeRequest) Reset() {
+	*x = SnapshotVolumeRequest{}
+	mi := &file_agent_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadStatus) GetActualState() ActualState {
-	if x != nil {
-		return x.ActualState
-	}
-	return ActualState_ACTUAL_STATE_UNSPECIFIED
+func (x *SnapshotVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *WorkloadStatus) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
+func (*SnapshotVolumeRequest) ProtoMessage() {}
 
-func (x *WorkloadStatus) GetCreatedAt() int64 {
+func (x *SnapshotVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[44]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WorkloadStatus) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
+// Deprecated: Use SnapshotVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *WorkloadStatus) GetMetadata() map[string]string {
+func (x *SnapshotVolumeRequest) GetVolume() *VolumeRef {
 	if x != nil {
-		return x.Metadata
+		return x.Volume
 	}
 	return nil
 }
 
-func (x *WorkloadStatus) GetUsage() *WorkloadUsageSnapshot {
+func (x *SnapshotVolumeRequest) GetSnapshotName() string {
 	if x != nil {
-		return x.Usage
+		return x.SnapshotName
 	}
-	return nil
+	return ""
 }
 
-type WorkloadUsageSnapshot struct {
+type CloneVolumeRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
-	Type           WorkloadType           `protobuf:"varint,2,opt,name=type,proto3,enum=persys.agent.v1.WorkloadType" json:"type,omitempty"`
-	CpuPercent     float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
-	MemoryBytes    int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	DiskReadBytes  int64                  `protobuf:"varint,5,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
-	DiskWriteBytes int64                  `protobuf:"varint,6,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
-	NetRxBytes     int64                  `protobuf:"varint,7,opt,name=net_rx_bytes,json=netRxBytes,proto3" json:"net_rx_bytes,omitempty"`
-	NetTxBytes     int64                  `protobuf:"varint,8,opt,name=net_tx_bytes,json=netTxBytes,proto3" json:"net_tx_bytes,omitempty"`
-	CollectedAt    int64                  `protobuf:"varint,9,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // unix timestamp
-	Source         string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	Source         *VolumeRef             `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	SourceSnapshot string                 `protobuf:"bytes,2,opt,name=source_snapshot,json=sourceSnapshot,proto3" json:"source_snapshot,omitempty"` // empty clones the live volume
+	Target         *ManagedVolumeSpec     `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WorkloadUsageSnapshot) Reset() {
-	*x = WorkloadUsageSnapshot{}
-	mi := &file_agent_proto_msgTypes[26]
+func (x *CloneVolumeRequest) Reset() {
+	*x = CloneVolumeRequest{}
+	mi := &file_agent_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadUsageSnapshot) String() string {
+func (x *CloneVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadUsageSnapshot) ProtoMessage() {}
+func (*CloneVolumeRequest) ProtoMessage() {}
 
-func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[26]
+func (x *CloneVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2019,77 +3502,96 @@ func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadUsageSnapshot.ProtoReflect.Descriptor instead.
-func (*WorkloadUsageSnapshot) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use CloneVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CloneVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *WorkloadUsageSnapshot) GetWorkloadId() string {
+func (x *CloneVolumeRequest) GetSource() *VolumeRef {
 	if x != nil {
-		return x.WorkloadId
+		return x.Source
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkloadUsageSnapshot) GetType() WorkloadType {
+func (x *CloneVolumeRequest) GetSourceSnapshot() string {
 	if x != nil {
-		return x.Type
+		return x.SourceSnapshot
 	}
-	return WorkloadType_WORKLOAD_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetCpuPercent() float64 {
+func (x *CloneVolumeRequest) GetTarget() *ManagedVolumeSpec {
 	if x != nil {
-		return x.CpuPercent
+		return x.Target
 	}
-	return 0
+	return nil
 }
 
-func (x *WorkloadUsageSnapshot) GetMemoryBytes() int64 {
-	if x != nil {
-		return x.MemoryBytes
-	}
-	return 0
+type VolumeOperationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"` // size after the operation
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`                    // host path of local volumes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WorkloadUsageSnapshot) GetDiskReadBytes() int64 {
-	if x != nil {
-		return x.DiskReadBytes
-	}
-	return 0
+func (x *VolumeOperationResponse) Reset() {
+	*x = VolumeOperationResponse{}
+	mi := &file_agent_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadUsageSnapshot) GetDiskWriteBytes() int64 {
+func (x *VolumeOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeOperationResponse) ProtoMessage() {}
+
+func (x *VolumeOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[46]
 	if x != nil {
-		return x.DiskWriteBytes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WorkloadUsageSnapshot) GetNetRxBytes() int64 {
+// Deprecated: Use VolumeOperationResponse.ProtoReflect.Descriptor instead.
+func (*VolumeOperationResponse) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *VolumeOperationResponse) GetSuccess() bool {
 	if x != nil {
-		return x.NetRxBytes
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *WorkloadUsageSnapshot) GetNetTxBytes() int64 {
+func (x *VolumeOperationResponse) GetMessage() string {
 	if x != nil {
-		return x.NetTxBytes
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetCollectedAt() int64 {
+func (x *VolumeOperationResponse) GetSizeGb() int64 {
 	if x != nil {
-		return x.CollectedAt
+		return x.SizeGb
 	}
 	return 0
 }
 
-func (x *WorkloadUsageSnapshot) GetSource() string {
+func (x *VolumeOperationResponse) GetPath() string {
 	if x != nil {
-		return x.Source
+		return x.Path
 	}
 	return ""
 }
@@ -2162,7 +3664,7 @@ const file_agent_proto_rawDesc = "" +
 	"\tcontainer\x18\x01 \x01(\v2\x1e.persys.agent.v1.ContainerSpecH\x00R\tcontainer\x128\n" +
 	"\acompose\x18\x02 \x01(\v2\x1c.persys.agent.v1.ComposeSpecH\x00R\acompose\x12)\n" +
 	"\x02vm\x18\x03 \x01(\v2\x17.persys.agent.v1.VMSpecH\x00R\x02vmB\x06\n" +
-	"\x04spec\"\x84\x05\n" +
+	"\x04spec\"\xb6\x06\n" +
 	"\rContainerSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x18\n" +
 	"\acommand\x18\x02 \x03(\tR\acommand\x12\x12\n" +
@@ -2174,20 +3676,31 @@ const file_agent_proto_rawDesc = "" +
 	"\x0erestart_policy\x18\b \x01(\v2\x1e.persys.agent.v1.RestartPolicyR\rrestartPolicy\x12B\n" +
 	"\x06labels\x18\t \x03(\v2*.persys.agent.v1.ContainerSpec.LabelsEntryR\x06labels\x12K\n" +
 	"\x0fmanaged_volumes\x18\n" +
-	" \x03(\v2\".persys.agent.v1.ManagedVolumeSpecR\x0emanagedVolumes\x1a6\n" +
+	" \x03(\v2\".persys.agent.v1.ManagedVolumeSpecR\x0emanagedVolumes\x12=\n" +
+	"\x0eliveness_probe\x18\v \x01(\v2\x16.persys.agent.v1.ProbeR\rlivenessProbe\x12?\n" +
+	"\x0freadiness_probe\x18\f \x01(\v2\x16.persys.agent.v1.ProbeR\x0ereadinessProbe\x120\n" +
+	"\x05files\x18\r \x03(\v2\x1a.persys.agent.v1.FileMountR\x05files\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc4\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc7\x02\n" +
 	"\vComposeSpec\x12!\n" +
 	"\fproject_name\x18\x01 \x01(\tR\vprojectName\x12!\n" +
 	"\fcompose_yaml\x18\x02 \x01(\tR\vcomposeYaml\x127\n" +
-	"\x03env\x18\x03 \x03(\v2%.persys.agent.v1.ComposeSpec.EnvEntryR\x03env\x1a6\n" +
+	"\x03env\x18\x03 \x03(\v2%.persys.agent.v1.ComposeSpec.EnvEntryR\x03env\x120\n" +
+	"\x05files\x18\x04 \x03(\v2\x1a.persys.agent.v1.FileMountR\x05files\x12\x19\n" +
+	"\bgit_repo\x18\x05 \x01(\tR\agitRepo\x12\x17\n" +
+	"\agit_ref\x18\x06 \x01(\tR\x06gitRef\x12\x1b\n" +
+	"\tgit_token\x18\a \x01(\tR\bgitToken\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf8\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"M\n" +
+	"\tFileMount\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\x05R\x04mode\"\xf8\x04\n" +
 	"\x06VMSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05vcpus\x18\x02 \x01(\x05R\x05vcpus\x12\x1b\n" +
@@ -2198,10 +3711,43 @@ const file_agent_proto_rawDesc = "" +
 	"cloud_init\x18\x06 \x01(\tR\tcloudInit\x12A\n" +
 	"\bmetadata\x18\a \x03(\v2%.persys.agent.v1.VMSpec.MetadataEntryR\bmetadata\x12L\n" +
 	"\x11cloud_init_config\x18\b \x01(\v2 .persys.agent.v1.CloudInitConfigR\x0fcloudInitConfig\x12K\n" +
-	"\x0fmanaged_volumes\x18\t \x03(\v2\".persys.agent.v1.ManagedVolumeSpecR\x0emanagedVolumes\x1a;\n" +
+	"\x0fmanaged_volumes\x18\t \x03(\v2\".persys.agent.v1.ManagedVolumeSpecR\x0emanagedVolumes\x12=\n" +
+	"\x0eliveness_probe\x18\n" +
+	" \x01(\v2\x16.persys.agent.v1.ProbeR\rlivenessProbe\x12?\n" +
+	"\x0freadiness_probe\x18\v \x01(\v2\x16.persys.agent.v1.ProbeR\x0ereadinessProbe\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x03\n" +
+	"\x05Probe\x12;\n" +
+	"\bhttp_get\x18\x01 \x01(\v2\x1e.persys.agent.v1.HTTPGetActionH\x00R\ahttpGet\x12A\n" +
+	"\n" +
+	"tcp_socket\x18\x02 \x01(\v2 .persys.agent.v1.TCPSocketActionH\x00R\ttcpSocket\x121\n" +
+	"\x04exec\x18\x03 \x01(\v2\x1b.persys.agent.v1.ExecActionH\x00R\x04exec\x122\n" +
+	"\x15initial_delay_seconds\x18\x04 \x01(\x05R\x13initialDelaySeconds\x12%\n" +
+	"\x0eperiod_seconds\x18\x05 \x01(\x05R\rperiodSeconds\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\x12+\n" +
+	"\x11success_threshold\x18\a \x01(\x05R\x10successThreshold\x12+\n" +
+	"\x11failure_threshold\x18\b \x01(\x05R\x10failureThresholdB\t\n" +
+	"\ahandler\"\xd2\x01\n" +
+	"\rHTTPGetAction\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x16\n" +
+	"\x06scheme\x18\x03 \x01(\tR\x06scheme\x12E\n" +
+	"\aheaders\x18\x04 \x03(\v2+.persys.agent.v1.HTTPGetAction.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"%\n" +
+	"\x0fTCPSocketAction\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\"&\n" +
+	"\n" +
+	"ExecAction\x12\x18\n" +
+	"\acommand\x18\x01 \x03(\tR\acommand\"v\n" +
+	"\vProbeResult\x12\x14\n" +
+	"\x05probe\x18\x01 \x01(\tR\x05probe\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\x03R\tcheckedAt\"\x93\x01\n" +
 	"\x0fCloudInitConfig\x12\x1b\n" +
 	"\tuser_data\x18\x01 \x01(\tR\buserData\x12\x1b\n" +
 	"\tmeta_data\x18\x02 \x01(\tR\bmetaData\x12%\n" +
@@ -2218,7 +3764,10 @@ const file_agent_proto_rawDesc = "" +
 	"\n" +
 	"mount_path\x18\x06 \x01(\tR\tmountPath\x12\x1b\n" +
 	"\tread_only\x18\a \x01(\bR\breadOnly\x12#\n" +
-	"\rretain_policy\x18\b \x01(\tR\fretainPolicy\"n\n" +
+	"\rretain_policy\x18\b \x01(\tR\fretainPolicy\"7\n" +
+	"\tVolumeRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\"n\n" +
 	"\vVolumeMount\x12\x1b\n" +
 	"\thost_path\x18\x01 \x01(\tR\bhostPath\x12%\n" +
 	"\x0econtainer_path\x18\x02 \x01(\tR\rcontainerPath\x12\x1b\n" +
@@ -2234,7 +3783,7 @@ const file_agent_proto_rawDesc = "" +
 	"\x11memory_swap_bytes\x18\x03 \x01(\x03R\x0fmemorySwapBytes\"O\n" +
 	"\rRestartPolicy\x12\x16\n" +
 	"\x06policy\x18\x01 \x01(\tR\x06policy\x12&\n" +
-	"\x0fmax_retry_count\x18\x02 \x01(\x05R\rmaxRetryCount\"\x91\x01\n" +
+	"\x0fmax_retry_count\x18\x02 \x01(\x05R\rmaxRetryCount\"\xa5\x01\n" +
 	"\n" +
 	"DiskConfig\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
@@ -2242,13 +3791,14 @@ const file_agent_proto_rawDesc = "" +
 	"\x06format\x18\x03 \x01(\tR\x06format\x12\x17\n" +
 	"\asize_gb\x18\x04 \x01(\x03R\x06sizeGb\x12\x12\n" +
 	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
-	"\x04boot\x18\x06 \x01(\bR\x04boot\"i\n" +
+	"\x04boot\x18\x06 \x01(\bR\x04boot\x12\x12\n" +
+	"\x04pool\x18\a \x01(\tR\x04pool\"i\n" +
 	"\rNetworkConfig\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x1f\n" +
 	"\vmac_address\x18\x02 \x01(\tR\n" +
 	"macAddress\x12\x1d\n" +
 	"\n" +
-	"ip_address\x18\x03 \x01(\tR\tipAddress\"\x97\x04\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\"\x8f\x05\n" +
 	"\x0eWorkloadStatus\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1d.persys.agent.v1.WorkloadTypeR\x04type\x12\x1f\n" +
@@ -2263,7 +3813,10 @@ const file_agent_proto_rawDesc = "" +
 	"updated_at\x18\b \x01(\x03R\tupdatedAt\x12I\n" +
 	"\bmetadata\x18\t \x03(\v2-.persys.agent.v1.WorkloadStatus.MetadataEntryR\bmetadata\x12<\n" +
 	"\x05usage\x18\n" +
-	" \x01(\v2&.persys.agent.v1.WorkloadUsageSnapshotR\x05usage\x1a;\n" +
+	" \x01(\v2&.persys.agent.v1.WorkloadUsageSnapshotR\x05usage\x12A\n" +
+	"\rprobe_results\x18\v \x03(\v2\x1c.persys.agent.v1.ProbeResultR\fprobeResults\x12\x16\n" +
+	"\x06exited\x18\f \x01(\bR\x06exited\x12\x1b\n" +
+	"\texit_code\x18\r \x01(\x05R\bexitCode\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x80\x03\n" +
@@ -2282,7 +3835,63 @@ const file_agent_proto_rawDesc = "" +
 	"netTxBytes\x12!\n" +
 	"\fcollected_at\x18\t \x01(\x03R\vcollectedAt\x12\x16\n" +
 	"\x06source\x18\n" +
-	" \x01(\tR\x06source*{\n" +
+	" \x01(\tR\x06source\"\x96\x01\n" +
+	"\x19StreamWorkloadLogsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06follow\x18\x02 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x03 \x01(\x03R\ttailLines\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x1c\n" +
+	"\tcontainer\x18\x05 \x01(\tR\tcontainer\"\x96\x01\n" +
+	"\x10WorkloadLogChunk\x122\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\x1a.persys.agent.v1.LogStreamR\x06stream\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\x12\x1c\n" +
+	"\tcontainer\x18\x04 \x01(\tR\tcontainer\"6\n" +
+	"\fTerminalSize\x12\x12\n" +
+	"\x04cols\x18\x01 \x01(\rR\x04cols\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\rR\x04rows\"\xae\x01\n" +
+	"\tExecStart\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acommand\x18\x02 \x03(\tR\acommand\x12\x10\n" +
+	"\x03tty\x18\x03 \x01(\bR\x03tty\x12\x14\n" +
+	"\x05stdin\x18\x04 \x01(\bR\x05stdin\x121\n" +
+	"\x04size\x18\x05 \x01(\v2\x1d.persys.agent.v1.TerminalSizeR\x04size\x12\x1c\n" +
+	"\tcontainer\x18\x06 \x01(\tR\tcontainer\"\xc8\x01\n" +
+	"\x13ExecWorkloadRequest\x122\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.persys.agent.v1.ExecStartH\x00R\x05start\x12\x16\n" +
+	"\x05stdin\x18\x02 \x01(\fH\x00R\x05stdin\x127\n" +
+	"\x06resize\x18\x03 \x01(\v2\x1d.persys.agent.v1.TerminalSizeH\x00R\x06resize\x12!\n" +
+	"\vclose_stdin\x18\x04 \x01(\bH\x00R\n" +
+	"closeStdinB\t\n" +
+	"\apayload\"=\n" +
+	"\bExecExit\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x86\x01\n" +
+	"\x14ExecWorkloadResponse\x12\x18\n" +
+	"\x06stdout\x18\x01 \x01(\fH\x00R\x06stdout\x12\x18\n" +
+	"\x06stderr\x18\x02 \x01(\fH\x00R\x06stderr\x12/\n" +
+	"\x04exit\x18\x03 \x01(\v2\x19.persys.agent.v1.ExecExitH\x00R\x04exitB\t\n" +
+	"\apayload\"Q\n" +
+	"\x13CreateVolumeRequest\x12:\n" +
+	"\x06volume\x18\x01 \x01(\v2\".persys.agent.v1.ManagedVolumeSpecR\x06volume\"I\n" +
+	"\x13DeleteVolumeRequest\x122\n" +
+	"\x06volume\x18\x01 \x01(\v2\x1a.persys.agent.v1.VolumeRefR\x06volume\"b\n" +
+	"\x13ResizeVolumeRequest\x122\n" +
+	"\x06volume\x18\x01 \x01(\v2\x1a.persys.agent.v1.VolumeRefR\x06volume\x12\x17\n" +
+	"\asize_gb\x18\x02 \x01(\x03R\x06sizeGb\"p\n" +
+	"\x15SnapshotVolumeRequest\x122\n" +
+	"\x06volume\x18\x01 \x01(\v2\x1a.persys.agent.v1.VolumeRefR\x06volume\x12#\n" +
+	"\rsnapshot_name\x18\x02 \x01(\tR\fsnapshotName\"\xad\x01\n" +
+	"\x12CloneVolumeRequest\x122\n" +
+	"\x06source\x18\x01 \x01(\v2\x1a.persys.agent.v1.VolumeRefR\x06source\x12'\n" +
+	"\x0fsource_snapshot\x18\x02 \x01(\tR\x0esourceSnapshot\x12:\n" +
+	"\x06target\x18\x03 \x01(\v2\".persys.agent.v1.ManagedVolumeSpecR\x06target\"z\n" +
+	"\x17VolumeOperationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x17\n" +
+	"\asize_gb\x18\x03 \x01(\x03R\x06sizeGb\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path*{\n" +
 	"\fWorkloadType\x12\x1d\n" +
 	"\x19WORKLOAD_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17WORKLOAD_TYPE_CONTAINER\x10\x01\x12\x19\n" +
@@ -2298,14 +3907,25 @@ const file_agent_proto_rawDesc = "" +
 	"\x14ACTUAL_STATE_RUNNING\x10\x02\x12\x18\n" +
 	"\x14ACTUAL_STATE_STOPPED\x10\x03\x12\x17\n" +
 	"\x13ACTUAL_STATE_FAILED\x10\x04\x12\x18\n" +
-	"\x14ACTUAL_STATE_UNKNOWN\x10\x052\xd1\x04\n" +
+	"\x14ACTUAL_STATE_UNKNOWN\x10\x05*U\n" +
+	"\tLogStream\x12\x1a\n" +
+	"\x16LOG_STREAM_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LOG_STREAM_STDOUT\x10\x01\x12\x15\n" +
+	"\x11LOG_STREAM_STDERR\x10\x022\xfb\t\n" +
 	"\fAgentService\x12^\n" +
 	"\rApplyWorkload\x12%.persys.agent.v1.ApplyWorkloadRequest\x1a&.persys.agent.v1.ApplyWorkloadResponse\x12a\n" +
 	"\x0eDeleteWorkload\x12&.persys.agent.v1.DeleteWorkloadRequest\x1a'.persys.agent.v1.DeleteWorkloadResponse\x12j\n" +
 	"\x11GetWorkloadStatus\x12).persys.agent.v1.GetWorkloadStatusRequest\x1a*.persys.agent.v1.GetWorkloadStatusResponse\x12^\n" +
 	"\rListWorkloads\x12%.persys.agent.v1.ListWorkloadsRequest\x1a&.persys.agent.v1.ListWorkloadsResponse\x12X\n" +
 	"\vHealthCheck\x12#.persys.agent.v1.HealthCheckRequest\x1a$.persys.agent.v1.HealthCheckResponse\x12X\n" +
-	"\vListActions\x12#.persys.agent.v1.ListActionsRequest\x1a$.persys.agent.v1.ListActionsResponseBNZLgithub.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb;agentpbb\x06proto3"
+	"\vListActions\x12#.persys.agent.v1.ListActionsRequest\x1a$.persys.agent.v1.ListActionsResponse\x12e\n" +
+	"\x12StreamWorkloadLogs\x12*.persys.agent.v1.StreamWorkloadLogsRequest\x1a!.persys.agent.v1.WorkloadLogChunk0\x01\x12_\n" +
+	"\fExecWorkload\x12$.persys.agent.v1.ExecWorkloadRequest\x1a%.persys.agent.v1.ExecWorkloadResponse(\x010\x01\x12^\n" +
+	"\fCreateVolume\x12$.persys.agent.v1.CreateVolumeRequest\x1a(.persys.agent.v1.VolumeOperationResponse\x12^\n" +
+	"\fDeleteVolume\x12$.persys.agent.v1.DeleteVolumeRequest\x1a(.persys.agent.v1.VolumeOperationResponse\x12^\n" +
+	"\fResizeVolume\x12$.persys.agent.v1.ResizeVolumeRequest\x1a(.persys.agent.v1.VolumeOperationResponse\x12b\n" +
+	"\x0eSnapshotVolume\x12&.persys.agent.v1.SnapshotVolumeRequest\x1a(.persys.agent.v1.VolumeOperationResponse\x12\\\n" +
+	"\vCloneVolume\x12#.persys.agent.v1.CloneVolumeRequest\x1a(.persys.agent.v1.VolumeOperationResponseBNZLgithub.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb;agentpbb\x06proto3"
 
 var (
 	file_agent_proto_rawDescOnce sync.Once
@@ -2319,95 +3939,153 @@ func file_agent_proto_rawDescGZIP() []byte {
 	return file_agent_proto_rawDescData
 }
 
-var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 54)
 var file_agent_proto_goTypes = []any{
 	(WorkloadType)(0),                 // 0: persys.agent.v1.WorkloadType
 	(DesiredState)(0),                 // 1: persys.agent.v1.DesiredState
 	(ActualState)(0),                  // 2: persys.agent.v1.ActualState
-	(*ApplyWorkloadRequest)(nil),      // 3: persys.agent.v1.ApplyWorkloadRequest
-	(*ApplyWorkloadResponse)(nil),     // 4: persys.agent.v1.ApplyWorkloadResponse
-	(*DeleteWorkloadRequest)(nil),     // 5: persys.agent.v1.DeleteWorkloadRequest
-	(*DeleteWorkloadResponse)(nil),    // 6: persys.agent.v1.DeleteWorkloadResponse
-	(*GetWorkloadStatusRequest)(nil),  // 7: persys.agent.v1.GetWorkloadStatusRequest
-	(*GetWorkloadStatusResponse)(nil), // 8: persys.agent.v1.GetWorkloadStatusResponse
-	(*ListWorkloadsRequest)(nil),      // 9: persys.agent.v1.ListWorkloadsRequest
-	(*ListWorkloadsResponse)(nil),     // 10: persys.agent.v1.ListWorkloadsResponse
-	(*HealthCheckRequest)(nil),        // 11: persys.agent.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),       // 12: persys.agent.v1.HealthCheckResponse
-	(*ListActionsRequest)(nil),        // 13: persys.agent.v1.ListActionsRequest
-	(*AgentAction)(nil),               // 14: persys.agent.v1.AgentAction
-	(*ListActionsResponse)(nil),       // 15: persys.agent.v1.ListActionsResponse
-	(*WorkloadSpec)(nil),              // 16: persys.agent.v1.WorkloadSpec
-	(*ContainerSpec)(nil),             // 17: persys.agent.v1.ContainerSpec
-	(*ComposeSpec)(nil),               // 18: persys.agent.v1.ComposeSpec
-	(*VMSpec)(nil),                    // 19: persys.agent.v1.VMSpec
-	(*CloudInitConfig)(nil),           // 20: persys.agent.v1.CloudInitConfig
-	(*ManagedVolumeSpec)(nil),         // 21: persys.agent.v1.ManagedVolumeSpec
-	(*VolumeMount)(nil),               // 22: persys.agent.v1.VolumeMount
-	(*PortMapping)(nil),               // 23: persys.agent.v1.PortMapping
-	(*ResourceLimits)(nil),            // 24: persys.agent.v1.ResourceLimits
-	(*RestartPolicy)(nil),             // 25: persys.agent.v1.RestartPolicy
-	(*DiskConfig)(nil),                // 26: persys.agent.v1.DiskConfig
-	(*NetworkConfig)(nil),             // 27: persys.agent.v1.NetworkConfig
-	(*WorkloadStatus)(nil),            // 28: persys.agent.v1.WorkloadStatus
-	(*WorkloadUsageSnapshot)(nil),     // 29: persys.agent.v1.WorkloadUsageSnapshot
-	nil,                               // 30: persys.agent.v1.HealthCheckResponse.RuntimeStatusEntry
-	nil,                               // 31: persys.agent.v1.ContainerSpec.EnvEntry
-	nil,                               // 32: persys.agent.v1.ContainerSpec.LabelsEntry
-	nil,                               // 33: persys.agent.v1.ComposeSpec.EnvEntry
-	nil,                               // 34: persys.agent.v1.VMSpec.MetadataEntry
-	nil,                               // 35: persys.agent.v1.WorkloadStatus.MetadataEntry
+	(LogStream)(0),                    // 3: persys.agent.v1.LogStream
+	(*ApplyWorkloadRequest)(nil),      // 4: persys.agent.v1.ApplyWorkloadRequest
+	(*ApplyWorkloadResponse)(nil),     // 5: persys.agent.v1.ApplyWorkloadResponse
+	(*DeleteWorkloadRequest)(nil),     // 6: persys.agent.v1.DeleteWorkloadRequest
+	(*DeleteWorkloadResponse)(nil),    // 7: persys.agent.v1.DeleteWorkloadResponse
+	(*GetWorkloadStatusRequest)(nil),  // 8: persys.agent.v1.GetWorkloadStatusRequest
+	(*GetWorkloadStatusResponse)(nil), // 9: persys.agent.v1.GetWorkloadStatusResponse
+	(*ListWorkloadsRequest)(nil),      // 10: persys.agent.v1.ListWorkloadsRequest
+	(*ListWorkloadsResponse)(nil),     // 11: persys.agent.v1.ListWorkloadsResponse
+	(*HealthCheckRequest)(nil),        // 12: persys.agent.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),       // 13: persys.agent.v1.HealthCheckResponse
+	(*ListActionsRequest)(nil),        // 14: persys.agent.v1.ListActionsRequest
+	(*AgentAction)(nil),               // 15: persys.agent.v1.AgentAction
+	(*ListActionsResponse)(nil),       // 16: persys.agent.v1.ListActionsResponse
+	(*WorkloadSpec)(nil),              // 17: persys.agent.v1.WorkloadSpec
+	(*ContainerSpec)(nil),             // 18: persys.agent.v1.ContainerSpec
+	(*ComposeSpec)(nil),               // 19: persys.agent.v1.ComposeSpec
+	(*FileMount)(nil),                 // 20: persys.agent.v1.FileMount
+	(*VMSpec)(nil),                    // 21: persys.agent.v1.VMSpec
+	(*Probe)(nil),                     // 22: persys.agent.v1.Probe
+	(*HTTPGetAction)(nil),             // 23: persys.agent.v1.HTTPGetAction
+	(*TCPSocketAction)(nil),           // 24: persys.agent.v1.TCPSocketAction
+	(*ExecAction)(nil),                // 25: persys.agent.v1.ExecAction
+	(*ProbeResult)(nil),               // 26: persys.agent.v1.ProbeResult
+	(*CloudInitConfig)(nil),           // 27: persys.agent.v1.CloudInitConfig
+	(*ManagedVolumeSpec)(nil),         // 28: persys.agent.v1.ManagedVolumeSpec
+	(*VolumeRef)(nil),                 // 29: persys.agent.v1.VolumeRef
+	(*VolumeMount)(nil),               // 30: persys.agent.v1.VolumeMount
+	(*PortMapping)(nil),               // 31: persys.agent.v1.PortMapping
+	(*ResourceLimits)(nil),            // 32: persys.agent.v1.ResourceLimits
+	(*RestartPolicy)(nil),             // 33: persys.agent.v1.RestartPolicy
+	(*DiskConfig)(nil),                // 34: persys.agent.v1.DiskConfig
+	(*NetworkConfig)(nil),             // 35: persys.agent.v1.NetworkConfig
+	(*WorkloadStatus)(nil),            // 36: persys.agent.v1.WorkloadStatus
+	(*WorkloadUsageSnapshot)(nil),     // 37: persys.agent.v1.WorkloadUsageSnapshot
+	(*StreamWorkloadLogsRequest)(nil), // 38: persys.agent.v1.StreamWorkloadLogsRequest
+	(*WorkloadLogChunk)(nil),          // 39: persys.agent.v1.WorkloadLogChunk
+	(*TerminalSize)(nil),              // 40: persys.agent.v1.TerminalSize
+	(*ExecStart)(nil),                 // 41: persys.agent.v1.ExecStart
+	(*ExecWorkloadRequest)(nil),       // 42: persys.agent.v1.ExecWorkloadRequest
+	(*ExecExit)(nil),                  // 43: persys.agent.v1.ExecExit
+	(*ExecWorkloadResponse)(nil),      // 44: persys.agent.v1.ExecWorkloadResponse
+	(*CreateVolumeRequest)(nil),       // 45: persys.agent.v1.CreateVolumeRequest
+	(*DeleteVolumeRequest)(nil),       // 46: persys.agent.v1.DeleteVolumeRequest
+	(*ResizeVolumeRequest)(nil),       // 47: persys.agent.v1.ResizeVolumeRequest
+	(*SnapshotVolumeRequest)(nil),     // 48: persys.agent.v1.SnapshotVolumeRequest
+	(*CloneVolumeRequest)(nil),        // 49: persys.agent.v1.CloneVolumeRequest
+	(*VolumeOperationResponse)(nil),   // 50: persys.agent.v1.VolumeOperationResponse
+	nil,                               // 51: persys.agent.v1.HealthCheckResponse.RuntimeStatusEntry
+	nil,                               // 52: persys.agent.v1.ContainerSpec.EnvEntry
+	nil,                               // 53: persys.agent.v1.ContainerSpec.LabelsEntry
+	nil,                               // 54: persys.agent.v1.ComposeSpec.EnvEntry
+	nil,                               // 55: persys.agent.v1.VMSpec.MetadataEntry
+	nil,                               // 56: persys.agent.v1.HTTPGetAction.HeadersEntry
+	nil,                               // 57: persys.agent.v1.WorkloadStatus.MetadataEntry
 }
 var file_agent_proto_depIdxs = []int32{
 	0,  // 0: persys.agent.v1.ApplyWorkloadRequest.type:type_name -> persys.agent.v1.WorkloadType
 	1,  // 1: persys.agent.v1.ApplyWorkloadRequest.desired_state:type_name -> persys.agent.v1.DesiredState
-	16, // 2: persys.agent.v1.ApplyWorkloadRequest.spec:type_name -> persys.agent.v1.WorkloadSpec
-	28, // 3: persys.agent.v1.ApplyWorkloadResponse.status:type_name -> persys.agent.v1.WorkloadStatus
-	28, // 4: persys.agent.v1.GetWorkloadStatusResponse.status:type_name -> persys.agent.v1.WorkloadStatus
+	17, // 2: persys.agent.v1.ApplyWorkloadRequest.spec:type_name -> persys.agent.v1.WorkloadSpec
+	36, // 3: persys.agent.v1.ApplyWorkloadResponse.status:type_name -> persys.agent.v1.WorkloadStatus
+	36, // 4: persys.agent.v1.GetWorkloadStatusResponse.status:type_name -> persys.agent.v1.WorkloadStatus
 	0,  // 5: persys.agent.v1.ListWorkloadsRequest.type:type_name -> persys.agent.v1.WorkloadType
-	28, // 6: persys.agent.v1.ListWorkloadsResponse.workloads:type_name -> persys.agent.v1.WorkloadStatus
-	30, // 7: persys.agent.v1.HealthCheckResponse.runtime_status:type_name -> persys.agent.v1.HealthCheckResponse.RuntimeStatusEntry
-	14, // 8: persys.agent.v1.ListActionsResponse.actions:type_name -> persys.agent.v1.AgentAction
-	17, // 9: persys.agent.v1.WorkloadSpec.container:type_name -> persys.agent.v1.ContainerSpec
-	18, // 10: persys.agent.v1.WorkloadSpec.compose:type_name -> persys.agent.v1.ComposeSpec
-	19, // 11: persys.agent.v1.WorkloadSpec.vm:type_name -> persys.agent.v1.VMSpec
-	31, // 12: persys.agent.v1.ContainerSpec.env:type_name -> persys.agent.v1.ContainerSpec.EnvEntry
-	22, // 13: persys.agent.v1.ContainerSpec.volumes:type_name -> persys.agent.v1.VolumeMount
-	23, // 14: persys.agent.v1.ContainerSpec.ports:type_name -> persys.agent.v1.PortMapping
-	24, // 15: persys.agent.v1.ContainerSpec.resources:type_name -> persys.agent.v1.ResourceLimits
-	25, // 16: persys.agent.v1.ContainerSpec.restart_policy:type_name -> persys.agent.v1.RestartPolicy
-	32, // 17: persys.agent.v1.ContainerSpec.labels:type_name -> persys.agent.v1.ContainerSpec.LabelsEntry
-	21, // 18: persys.agent.v1.ContainerSpec.managed_volumes:type_name -> persys.agent.v1.ManagedVolumeSpec
-	33, // 19: persys.agent.v1.ComposeSpec.env:type_name -> persys.agent.v1.ComposeSpec.EnvEntry
-	26, // 20: persys.agent.v1.VMSpec.disks:type_name -> persys.agent.v1.DiskConfig
-	27, // 21: persys.agent.v1.VMSpec.networks:type_name -> persys.agent.v1.NetworkConfig
-	34, // 22: persys.agent.v1.VMSpec.metadata:type_name -> persys.agent.v1.VMSpec.MetadataEntry
-	20, // 23: persys.agent.v1.VMSpec.cloud_init_config:type_name -> persys.agent.v1.CloudInitConfig
-	21, // 24: persys.agent.v1.VMSpec.managed_volumes:type_name -> persys.agent.v1.ManagedVolumeSpec
-	0,  // 25: persys.agent.v1.WorkloadStatus.type:type_name -> persys.agent.v1.WorkloadType
-	1,  // 26: persys.agent.v1.WorkloadStatus.desired_state:type_name -> persys.agent.v1.DesiredState
-	2,  // 27: persys.agent.v1.WorkloadStatus.actual_state:type_name -> persys.agent.v1.ActualState
-	35, // 28: persys.agent.v1.WorkloadStatus.metadata:type_name -> persys.agent.v1.WorkloadStatus.MetadataEntry
-	29, // 29: persys.agent.v1.WorkloadStatus.usage:type_name -> persys.agent.v1.WorkloadUsageSnapshot
-	0,  // 30: persys.agent.v1.WorkloadUsageSnapshot.type:type_name -> persys.agent.v1.WorkloadType
-	3,  // 31: persys.agent.v1.AgentService.ApplyWorkload:input_type -> persys.agent.v1.ApplyWorkloadRequest
-	5,  // 32: persys.agent.v1.AgentService.DeleteWorkload:input_type -> persys.agent.v1.DeleteWorkloadRequest
-	7,  // 33: persys.agent.v1.AgentService.GetWorkloadStatus:input_type -> persys.agent.v1.GetWorkloadStatusRequest
-	9,  // 34: persys.agent.v1.AgentService.ListWorkloads:input_type -> persys.agent.v1.ListWorkloadsRequest
-	11, // 35: persys.agent.v1.AgentService.HealthCheck:input_type -> persys.agent.v1.HealthCheckRequest
-	13, // 36: persys.agent.v1.AgentService.ListActions:input_type -> persys.agent.v1.ListActionsRequest
-	4,  // 37: persys.agent.v1.AgentService.ApplyWorkload:output_type -> persys.agent.v1.ApplyWorkloadResponse
-	6,  // 38: persys.agent.v1.AgentService.DeleteWorkload:output_type -> persys.agent.v1.DeleteWorkloadResponse
-	8,  // 39: persys.agent.v1.AgentService.GetWorkloadStatus:output_type -> persys.agent.v1.GetWorkloadStatusResponse
-	10, // 40: persys.agent.v1.AgentService.ListWorkloads:output_type -> persys.agent.v1.ListWorkloadsResponse
-	12, // 41: persys.agent.v1.AgentService.HealthCheck:output_type -> persys.agent.v1.HealthCheckResponse
-	15, // 42: persys.agent.v1.AgentService.ListActions:output_type -> persys.agent.v1.ListActionsResponse
-	37, // [37:43] is the sub-list for method output_type
-	31, // [31:37] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	36, // 6: persys.agent.v1.ListWorkloadsResponse.workloads:type_name -> persys.agent.v1.WorkloadStatus
+	51, // 7: persys.agent.v1.HealthCheckResponse.runtime_status:type_name -> persys.agent.v1.HealthCheckResponse.RuntimeStatusEntry
+	15, // 8: persys.agent.v1.ListActionsResponse.actions:type_name -> persys.agent.v1.AgentAction
+	18, // 9: persys.agent.v1.WorkloadSpec.container:type_name -> persys.agent.v1.ContainerSpec
+	19, // 10: persys.agent.v1.WorkloadSpec.compose:type_name -> persys.agent.v1.ComposeSpec
+	21, // 11: persys.agent.v1.WorkloadSpec.vm:type_name -> persys.agent.v1.VMSpec
+	52, // 12: persys.agent.v1.ContainerSpec.env:type_name -> persys.agent.v1.ContainerSpec.EnvEntry
+	30, // 13: persys.agent.v1.ContainerSpec.volumes:type_name -> persys.agent.v1.VolumeMount
+	31, // 14: persys.agent.v1.ContainerSpec.ports:type_name -> persys.agent.v1.PortMapping
+	32, // 15: persys.agent.v1.ContainerSpec.resources:type_name -> persys.agent.v1.ResourceLimits
+	33, // 16: persys.agent.v1.ContainerSpec.restart_policy:type_name -> persys.agent.v1.RestartPolicy
+	53, // 17: persys.agent.v1.ContainerSpec.labels:type_name -> persys.agent.v1.ContainerSpec.LabelsEntry
+	28, // 18: persys.agent.v1.ContainerSpec.managed_volumes:type_name -> persys.agent.v1.ManagedVolumeSpec
+	22, // 19: persys.agent.v1.ContainerSpec.liveness_probe:type_name -> persys.agent.v1.Probe
+	22, // 20: persys.agent.v1.ContainerSpec.readiness_probe:type_name -> persys.agent.v1.Probe
+	20, // 21: persys.agent.v1.ContainerSpec.files:type_name -> persys.agent.v1.FileMount
+	54, // 22: persys.agent.v1.ComposeSpec.env:type_name -> persys.agent.v1.ComposeSpec.EnvEntry
+	20, // 23: persys.agent.v1.ComposeSpec.files:type_name -> persys.agent.v1.FileMount
+	34, // 24: persys.agent.v1.VMSpec.disks:type_name -> persys.agent.v1.DiskConfig
+	35, // 25: persys.agent.v1.VMSpec.networks:type_name -> persys.agent.v1.NetworkConfig
+	55, // 26: persys.agent.v1.VMSpec.metadata:type_name -> persys.agent.v1.VMSpec.MetadataEntry
+	27, // 27: persys.agent.v1.VMSpec.cloud_init_config:type_name -> persys.agent.v1.CloudInitConfig
+	28, // 28: persys.agent.v1.VMSpec.managed_volumes:type_name -> persys.agent.v1.ManagedVolumeSpec
+	22, // 29: persys.agent.v1.VMSpec.liveness_probe:type_name -> persys.agent.v1.Probe
+	22, // 30: persys.agent.v1.VMSpec.readiness_probe:type_name -> persys.agent.v1.Probe
+	23, // 31: persys.agent.v1.Probe.http_get:type_name -> persys.agent.v1.HTTPGetAction
+	24, // 32: persys.agent.v1.Probe.tcp_socket:type_name -> persys.agent.v1.TCPSocketAction
+	25, // 33: persys.agent.v1.Probe.exec:type_name -> persys.agent.v1.ExecAction
+	56, // 34: persys.agent.v1.HTTPGetAction.headers:type_name -> persys.agent.v1.HTTPGetAction.HeadersEntry
+	0,  // 35: persys.agent.v1.WorkloadStatus.type:type_name -> persys.agent.v1.WorkloadType
+	1,  // 36: persys.agent.v1.WorkloadStatus.desired_state:type_name -> persys.agent.v1.DesiredState
+	2,  // 37: persys.agent.v1.WorkloadStatus.actual_state:type_name -> persys.agent.v1.ActualState
+	57, // 38: persys.agent.v1.WorkloadStatus.metadata:type_name -> persys.agent.v1.WorkloadStatus.MetadataEntry
+	37, // 39: persys.agent.v1.WorkloadStatus.usage:type_name -> persys.agent.v1.WorkloadUsageSnapshot
+	26, // 40: persys.agent.v1.WorkloadStatus.probe_results:type_name -> persys.agent.v1.ProbeResult
+	0,  // 41: persys.agent.v1.WorkloadUsageSnapshot.type:type_name -> persys.agent.v1.WorkloadType
+	3,  // 42: persys.agent.v1.WorkloadLogChunk.stream:type_name -> persys.agent.v1.LogStream
+	40, // 43: persys.agent.v1.ExecStart.size:type_name -> persys.agent.v1.TerminalSize
+	41, // 44: persys.agent.v1.ExecWorkloadRequest.start:type_name -> persys.agent.v1.ExecStart
+	40, // 45: persys.agent.v1.ExecWorkloadRequest.resize:type_name -> persys.agent.v1.TerminalSize
+	43, // 46: persys.agent.v1.ExecWorkloadResponse.exit:type_name -> persys.agent.v1.ExecExit
+	28, // 47: persys.agent.v1.CreateVolumeRequest.volume:type_name -> persys.agent.v1.ManagedVolumeSpec
+	29, // 48: persys.agent.v1.DeleteVolumeRequest.volume:type_name -> persys.agent.v1.VolumeRef
+	29, // 49: persys.agent.v1.ResizeVolumeRequest.volume:type_name -> persys.agent.v1.VolumeRef
+	29, // 50: persys.agent.v1.SnapshotVolumeRequest.volume:type_name -> persys.agent.v1.VolumeRef
+	29, // 51: persys.agent.v1.CloneVolumeRequest.source:type_name -> persys.agent.v1.VolumeRef
+	28, // 52: persys.agent.v1.CloneVolumeRequest.target:type_name -> persys.agent.v1.ManagedVolumeSpec
+	4,  // 53: persys.agent.v1.AgentService.ApplyWorkload:input_type -> persys.agent.v1.ApplyWorkloadRequest
+	6,  // 54: persys.agent.v1.AgentService.DeleteWorkload:input_type -> persys.agent.v1.DeleteWorkloadRequest
+	8,  // 55: persys.agent.v1.AgentService.GetWorkloadStatus:input_type -> persys.agent.v1.GetWorkloadStatusRequest
+	10, // 56: persys.agent.v1.AgentService.ListWorkloads:input_type -> persys.agent.v1.ListWorkloadsRequest
+	12, // 57: persys.agent.v1.AgentService.HealthCheck:input_type -> persys.agent.v1.HealthCheckRequest
+	14, // 58: persys.agent.v1.AgentService.ListActions:input_type -> persys.agent.v1.ListActionsRequest
+	38, // 59: persys.agent.v1.AgentService.StreamWorkloadLogs:input_type -> persys.agent.v1.StreamWorkloadLogsRequest
+	42, // 60: persys.agent.v1.AgentService.ExecWorkload:input_type -> persys.agent.v1.ExecWorkloadRequest
+	45, // 61: persys.agent.v1.AgentService.CreateVolume:input_type -> persys.agent.v1.CreateVolumeRequest
+	46, // 62: persys.agent.v1.AgentService.DeleteVolume:input_type -> persys.agent.v1.DeleteVolumeRequest
+	47, // 63: persys.agent.v1.AgentService.ResizeVolume:input_type -> persys.agent.v1.ResizeVolumeRequest
+	48, // 64: persys.agent.v1.AgentService.SnapshotVolume:input_type -> persys.agent.v1.SnapshotVolumeRequest
+	49, // 65: persys.agent.v1.AgentService.CloneVolume:input_type -> persys.agent.v1.CloneVolumeRequest
+	5,  // 66: persys.agent.v1.AgentService.ApplyWorkload:output_type -> persys.agent.v1.ApplyWorkloadResponse
+	7,  // 67: persys.agent.v1.AgentService.DeleteWorkload:output_type -> persys.agent.v1.DeleteWorkloadResponse
+	9,  // 68: persys.agent.v1.AgentService.GetWorkloadStatus:output_type -> persys.agent.v1.GetWorkloadStatusResponse
+	11, // 69: persys.agent.v1.AgentService.ListWorkloads:output_type -> persys.agent.v1.ListWorkloadsResponse
+	13, // 70: persys.agent.v1.AgentService.HealthCheck:output_type -> persys.agent.v1.HealthCheckResponse
+	16, // 71: persys.agent.v1.AgentService.ListActions:output_type -> persys.agent.v1.ListActionsResponse
+	39, // 72: persys.agent.v1.AgentService.StreamWorkloadLogs:output_type -> persys.agent.v1.WorkloadLogChunk
+	44, // 73: persys.agent.v1.AgentService.ExecWorkload:output_type -> persys.agent.v1.ExecWorkloadResponse
+	50, // 74: persys.agent.v1.AgentService.CreateVolume:output_type -> persys.agent.v1.VolumeOperationResponse
+	50, // 75: persys.agent.v1.AgentService.DeleteVolume:output_type -> persys.agent.v1.VolumeOperationResponse
+	50, // 76: persys.agent.v1.AgentService.ResizeVolume:output_type -> persys.agent.v1.VolumeOperationResponse
+	50, // 77: persys.agent.v1.AgentService.SnapshotVolume:output_type -> persys.agent.v1.VolumeOperationResponse
+	50, // 78: persys.agent.v1.AgentService.CloneVolume:output_type -> persys.agent.v1.VolumeOperationResponse
+	66, // [66:79] is the sub-list for method output_type
+	53, // [53:66] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_agent_proto_init() }
@@ -2420,13 +4098,29 @@ func file_agent_proto_init() {
 		(*WorkloadSpec_Compose)(nil),
 		(*WorkloadSpec_Vm)(nil),
 	}
+	file_agent_proto_msgTypes[18].OneofWrappers = []any{
+		(*Probe_HttpGet)(nil),
+		(*Probe_TcpSocket)(nil),
+		(*Probe_Exec)(nil),
+	}
+	file_agent_proto_msgTypes[38].OneofWrappers = []any{
+		(*ExecWorkloadRequest_Start)(nil),
+		(*ExecWorkloadRequest_Stdin)(nil),
+		(*ExecWorkloadRequest_Resize)(nil),
+		(*ExecWorkloadRequest_CloseStdin)(nil),
+	}
+	file_agent_proto_msgTypes[40].OneofWrappers = []any{
+		(*ExecWorkloadResponse_Stdout)(nil),
+		(*ExecWorkloadResponse_Stderr)(nil),
+		(*ExecWorkloadResponse_Exit)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_proto_rawDesc), len(file_agent_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   33,
+			NumEnums:      4,
+			NumMessages:   54,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
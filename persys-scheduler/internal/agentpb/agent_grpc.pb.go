@@ -19,12 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AgentService_ApplyWorkload_FullMethodName     = "/persys.agent.v1.AgentService/ApplyWorkload"
-	AgentService_DeleteWorkload_FullMethodName    = "/persys.agent.v1.AgentService/DeleteWorkload"
-	AgentService_GetWorkloadStatus_FullMethodName = "/persys.agent.v1.AgentService/GetWorkloadStatus"
-	AgentService_ListWorkloads_FullMethodName     = "/persys.agent.v1.AgentService/ListWorkloads"
-	AgentService_HealthCheck_FullMethodName       = "/persys.agent.v1.AgentService/HealthCheck"
-	AgentService_ListActions_FullMethodName       = "/persys.agent.v1.AgentService/ListActions"
+	AgentService_ApplyWorkload_FullMethodName      = "/persys.agent.v1.AgentService/ApplyWorkload"
+	AgentService_DeleteWorkload_FullMethodName     = "/persys.agent.v1.AgentService/DeleteWorkload"
+	AgentService_GetWorkloadStatus_FullMethodName  = "/persys.agent.v1.AgentService/GetWorkloadStatus"
+	AgentService_ListWorkloads_FullMethodName      = "/persys.agent.v1.AgentService/ListWorkloads"
+	AgentService_HealthCheck_FullMethodName        = "/persys.agent.v1.AgentService/HealthCheck"
+	AgentService_ListActions_FullMethodName        = "/persys.agent.v1.AgentService/ListActions"
+	AgentService_StreamWorkloadLogs_FullMethodName = "/persys.agent.v1.AgentService/StreamWorkloadLogs"
+	AgentService_ExecWorkload_FullMethodName       = "/persys.agent.v1.AgentService/ExecWorkload"
+	AgentService_CreateVolume_FullMethodName       = "/persys.agent.v1.AgentService/CreateVolume"
+	AgentService_DeleteVolume_FullMethodName       = "/persys.agent.v1.AgentService/DeleteVolume"
+	AgentService_ResizeVolume_FullMethodName       = "/persys.agent.v1.AgentService/ResizeVolume"
+	AgentService_SnapshotVolume_FullMethodName     = "/persys.agent.v1.AgentService/SnapshotVolume"
+	AgentService_CloneVolume_FullMethodName        = "/persys.agent.v1.AgentService/CloneVolume"
 )
 
 // AgentServiceClient is the client API for AgentService service.
@@ -45,6 +52,19 @@ type AgentServiceClient interface {
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 	// ListActions returns action/task history tracked by the agent since startup
 	ListActions(ctx context.Context, in *ListActionsRequest, opts ...grpc.CallOption) (*ListActionsResponse, error)
+	// StreamWorkloadLogs streams the stdout/stderr of a workload. With follow
+	// set it stays open until the caller cancels.
+	StreamWorkloadLogs(ctx context.Context, in *StreamWorkloadLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkloadLogChunk], error)
+	// ExecWorkload runs a command inside a workload. The first request carries
+	// ExecStart; later ones carry stdin and terminal resizes.
+	ExecWorkload(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse], error)
+	// Managed volumes that exist outside any workload. A volume is addressed
+	// by its name and driver, as in ManagedVolumeSpec.
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error)
+	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error)
+	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error)
+	SnapshotVolume(ctx context.Context, in *SnapshotVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error)
+	CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error)
 }
 
 type agentServiceClient struct {
@@ -115,6 +135,88 @@ func (c *agentServiceClient) ListActions(ctx context.Context, in *ListActionsReq
 	return out, nil
 }
 
+func (c *agentServiceClient) StreamWorkloadLogs(ctx context.Context, in *StreamWorkloadLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkloadLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentService_ServiceDesc.Streams[0], AgentService_StreamWorkloadLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWorkloadLogsRequest, WorkloadLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentService_StreamWorkloadLogsClient = grpc.ServerStreamingClient[WorkloadLogChunk]
+
+func (c *agentServiceClient) ExecWorkload(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentService_ServiceDesc.Streams[1], AgentService_ExecWorkload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecWorkloadRequest, ExecWorkloadResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentService_ExecWorkloadClient = grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse]
+
+func (c *agentServiceClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VolumeOperationResponse)
+	err := c.cc.Invoke(ctx, AgentService_CreateVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VolumeOperationResponse)
+	err := c.cc.Invoke(ctx, AgentService_DeleteVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VolumeOperationResponse)
+	err := c.cc.Invoke(ctx, AgentService_ResizeVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) SnapshotVolume(ctx context.Context, in *SnapshotVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VolumeOperationResponse)
+	err := c.cc.Invoke(ctx, AgentService_SnapshotVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*VolumeOperationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VolumeOperationResponse)
+	err := c.cc.Invoke(ctx, AgentService_CloneVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServiceServer is the server API for AgentService service.
 // All implementations must embed UnimplementedAgentServiceServer
 // for forward compatibility.
@@ -133,6 +235,19 @@ type AgentServiceServer interface {
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	// ListActions returns action/task history tracked by the agent since startup
 	ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error)
+	// StreamWorkloadLogs streams the stdout/stderr of a workload. With follow
+	// set it stays open until the caller cancels.
+	StreamWorkloadLogs(*StreamWorkloadLogsRequest, grpc.ServerStreamingServer[WorkloadLogChunk]) error
+	// ExecWorkload runs a command inside a workload. The first request carries
+	// ExecStart; later ones carry stdin and terminal resizes.
+	ExecWorkload(grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]) error
+	// Managed volumes that exist outside any workload. A volume is addressed
+	// by its name and driver, as in ManagedVolumeSpec.
+	CreateVolume(context.Context, *CreateVolumeRequest) (*VolumeOperationResponse, error)
+	DeleteVolume(context.Context, *DeleteVolumeRequest) (*VolumeOperationResponse, error)
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*VolumeOperationResponse, error)
+	SnapshotVolume(context.Context, *SnapshotVolumeRequest) (*VolumeOperationResponse, error)
+	CloneVolume(context.Context, *CloneVolumeRequest) (*VolumeOperationResponse, error)
 	mustEmbedUnimplementedAgentServiceServer()
 }
 
@@ -161,6 +276,27 @@ func (UnimplementedAgentServiceServer) HealthCheck(context.Context, *HealthCheck
 func (UnimplementedAgentServiceServer) ListActions(context.Context, *ListActionsRequest) (*ListActionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListActions not implemented")
 }
+func (UnimplementedAgentServiceServer) StreamWorkloadLogs(*StreamWorkloadLogsRequest, grpc.ServerStreamingServer[WorkloadLogChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamWorkloadLogs not implemented")
+}
+func (UnimplementedAgentServiceServer) ExecWorkload(grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExecWorkload not implemented")
+}
+func (UnimplementedAgentServiceServer) CreateVolume(context.Context, *CreateVolumeRequest) (*VolumeOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (UnimplementedAgentServiceServer) DeleteVolume(context.Context, *DeleteVolumeRequest) (*VolumeOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (UnimplementedAgentServiceServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*VolumeOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeVolume not implemented")
+}
+func (UnimplementedAgentServiceServer) SnapshotVolume(context.Context, *SnapshotVolumeRequest) (*VolumeOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnapshotVolume not implemented")
+}
+func (UnimplementedAgentServiceServer) CloneVolume(context.Context, *CloneVolumeRequest) (*VolumeOperationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneVolume not implemented")
+}
 func (UnimplementedAgentServiceServer) mustEmbedUnimplementedAgentServiceServer() {}
 func (UnimplementedAgentServiceServer) testEmbeddedByValue()                      {}
 
@@ -290,6 +426,114 @@ func _AgentService_ListActions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentService_StreamWorkloadLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkloadLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentServiceServer).StreamWorkloadLogs(m, &grpc.GenericServerStream[StreamWorkloadLogsRequest, WorkloadLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentService_StreamWorkloadLogsServer = grpc.ServerStreamingServer[WorkloadLogChunk]
+
+func _AgentService_ExecWorkload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServiceServer).ExecWorkload(&grpc.GenericServerStream[ExecWorkloadRequest, ExecWorkloadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentService_ExecWorkloadServer = grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]
+
+func _AgentService_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_CreateVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_DeleteVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).ResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_ResizeVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_SnapshotVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).SnapshotVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_SnapshotVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).SnapshotVolume(ctx, req.(*SnapshotVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_CloneVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).CloneVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentService_CloneVolume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).CloneVolume(ctx, req.(*CloneVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentService_ServiceDesc is the grpc.ServiceDesc for AgentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,7 +565,39 @@ var AgentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListActions",
 			Handler:    _AgentService_ListActions_Handler,
 		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _AgentService_CreateVolume_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _AgentService_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ResizeVolume",
+			Handler:    _AgentService_ResizeVolume_Handler,
+		},
+		{
+			MethodName: "SnapshotVolume",
+			Handler:    _AgentService_SnapshotVolume_Handler,
+		},
+		{
+			MethodName: "CloneVolume",
+			Handler:    _AgentService_CloneVolume_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamWorkloadLogs",
+			Handler:       _AgentService_StreamWorkloadLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecWorkload",
+			Handler:       _AgentService_ExecWorkload_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "agent.proto",
 }
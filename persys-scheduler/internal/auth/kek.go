@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+// KeyEncrypter wraps data keys with a key-encryption key (KEK). Name
+// identifies the KEK and is stored next to every key it wrapped.
+type KeyEncrypter interface {
+	Name() string
+	WrapKey(ctx context.Context, key []byte) (string, error)
+	UnwrapKey(ctx context.Context, wrapped string) ([]byte, error)
+}
+
+// LocalKEK wraps data keys with an AES-256-GCM key held in memory. It is
+// meant for tests and setups without Vault.
+type LocalKEK struct {
+	aead cipher.AEAD
+	name string
+}
+
+func NewLocalKEK(key []byte) (*LocalKEK, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("local KEK must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(key)
+	return &LocalKEK{aead: aead, name: "local:" + hex.EncodeToString(sum[:4])}, nil
+}
+
+func (k *LocalKEK) Name() string { return k.name }
+
+func (k *LocalKEK) WrapKey(_ context.Context, key []byte) (string, error) {
+	nonce := make([]byte, k.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(k.aead.Seal(nonce, nonce, key, nil)), nil
+}
+
+func (k *LocalKEK) UnwrapKey(_ context.Context, wrapped string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("decode wrapped key: %w", err)
+	}
+	if len(raw) < k.aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	nonce, sealed := raw[:k.aead.NonceSize()], raw[k.aead.NonceSize():]
+	key, err := k.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap key: %w", err)
+	}
+	return key, nil
+}
+
+// TransitKEK wraps data keys with a key of Vault's transit engine, so the KEK
+// never leaves Vault. It logs in on first use and again after a failed call,
+// so an expired token does not need a restart.
+type TransitKEK struct {
+	cfg   Config
+	mount string
+	key   string
+
+	mu     sync.Mutex
+	client *vault.Client
+}
+
+func NewTransitKEK(cfg Config, mount, key string) *TransitKEK {
+	return &TransitKEK{cfg: cfg, mount: strings.Trim(mount, "/"), key: key}
+}
+
+func (k *TransitKEK) Name() string { return "vault-transit:" + k.mount + "/" + k.key }
+
+func (k *TransitKEK) WrapKey(ctx context.Context, key []byte) (string, error) {
+	secret, err := k.write(ctx, "encrypt", map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(key),
+	})
+	if err != nil {
+		return "", err
+	}
+	ciphertext := asString(secret.Data["ciphertext"])
+	if ciphertext == "" {
+		return "", errors.New("vault transit encrypt returned no ciphertext")
+	}
+	return ciphertext, nil
+}
+
+func (k *TransitKEK) UnwrapKey(ctx context.Context, wrapped string) ([]byte, error) {
+	secret, err := k.write(ctx, "decrypt", map[string]interface{}{"ciphertext": wrapped})
+	if err != nil {
+		return nil, err
+	}
+	key, err := base64.StdEncoding.DecodeString(asString(secret.Data["plaintext"]))
+	if err != nil {
+		return nil, fmt.Errorf("decode vault transit plaintext: %w", err)
+	}
+	return key, nil
+}
+
+func (k *TransitKEK) write(ctx context.Context, op string, payload map[string]interface{}) (*vault.Secret, error) {
+	path := fmt.Sprintf("%s/%s/%s", k.mount, op, k.key)
+	var lastErr error
+	for attempt := 0; attempt < 2; attempt++ {
+		client, err := k.vaultClient(attempt > 0)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		secret, err := client.Logical().WriteWithContext(ctx, path, payload)
+		if err == nil && (secret == nil || secret.Data == nil) {
+			err = errors.New("empty response")
+		}
+		if err == nil {
+			return secret, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("vault transit %s with key %s: %w", op, k.key, lastErr)
+}
+
+func (k *TransitKEK) vaultClient(relogin bool) (*vault.Client, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.client != nil && !relogin {
+		return k.client, nil
+	}
+	client, err := NewVaultClient(k.cfg)
+	if err != nil {
+		return nil, err
+	}
+	k.client = client
+	return client, nil
+}
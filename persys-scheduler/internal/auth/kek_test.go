@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"testing"
+)
+
+func testKey(fill byte) []byte {
+	return bytes.Repeat([]byte{fill}, 32)
+}
+
+func TestNewLocalKEKRejectsBadKeyLength(t *testing.T) {
+	for _, n := range []int{0, 16, 31, 33} {
+		if _, err := NewLocalKEK(make([]byte, n)); err == nil {
+			t.Fatalf("expected a %d-byte key to be rejected", n)
+		}
+	}
+}
+
+func TestLocalKEKWrapUnwrap(t *testing.T) {
+	ctx := context.Background()
+	kek, err := NewLocalKEK(testKey(1))
+	if err != nil {
+		t.Fatalf("NewLocalKEK: %v", err)
+	}
+	other, err := NewLocalKEK(testKey(2))
+	if err != nil {
+		t.Fatalf("NewLocalKEK: %v", err)
+	}
+	dataKey := testKey(7)
+	wrapped, err := kek.WrapKey(ctx, dataKey)
+	if err != nil {
+		t.Fatalf("WrapKey: %v", err)
+	}
+	raw, err := base64.StdEncoding.DecodeString(wrapped)
+	if err != nil {
+		t.Fatalf("wrapped key is not base64: %v", err)
+	}
+	tampered := append([]byte(nil), raw...)
+	tampered[len(tampered)-1] ^= 0x01
+
+	tests := []struct {
+		name    string
+		kek     *LocalKEK
+		wrapped string
+		wantErr bool
+	}{
+		{name: "round trip", kek: kek, wrapped: wrapped},
+		{name: "wrong key", kek: other, wrapped: wrapped, wantErr: true},
+		{name: "tampered", kek: kek, wrapped: base64.StdEncoding.EncodeToString(tampered), wantErr: true},
+		{name: "truncated", kek: kek, wrapped: base64.StdEncoding.EncodeToString(raw[:4]), wantErr: true},
+		{name: "not base64", kek: kek, wrapped: "%%%", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.kek.UnwrapKey(ctx, tt.wrapped)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got key %x", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UnwrapKey: %v", err)
+			}
+			if !bytes.Equal(got, dataKey) {
+				t.Fatalf("unwrapped key differs: %x", got)
+			}
+		})
+	}
+}
+
+func TestLocalKEKWrapIsRandomized(t *testing.T) {
+	kek, err := NewLocalKEK(testKey(1))
+	if err != nil {
+		t.Fatalf("NewLocalKEK: %v", err)
+	}
+	a, _ := kek.WrapKey(context.Background(), testKey(7))
+	b, _ := kek.WrapKey(context.Background(), testKey(7))
+	if a == b {
+		t.Fatalf("expected a fresh nonce per wrap")
+	}
+}
+
+func TestLocalKEKNameIdentifiesKey(t *testing.T) {
+	a, _ := NewLocalKEK(testKey(1))
+	b, _ := NewLocalKEK(testKey(1))
+	c, _ := NewLocalKEK(testKey(2))
+	if a.Name() != b.Name() {
+		t.Fatalf("same key, different names: %s %s", a.Name(), b.Name())
+	}
+	if a.Name() == c.Name() {
+		t.Fatalf("different keys share the name %s", a.Name())
+	}
+}
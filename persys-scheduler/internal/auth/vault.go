@@ -203,24 +203,30 @@ func (m *Manager) recoveryLoop(ctx context.Context) {
 }
 
 func (m *Manager) newVaultClient() (*vault.Client, error) {
+	return NewVaultClient(m.cfg)
+}
+
+// NewVaultClient returns a Vault client logged in with the token or AppRole
+// credentials from cfg.
+func NewVaultClient(cfg Config) (*vault.Client, error) {
 	conf := vault.DefaultConfig()
-	conf.Address = m.cfg.VaultAddr
+	conf.Address = cfg.VaultAddr
 
 	client, err := vault.NewClient(conf)
 	if err != nil {
 		return nil, err
 	}
 
-	switch strings.ToLower(strings.TrimSpace(m.cfg.VaultAuthMethod)) {
+	switch strings.ToLower(strings.TrimSpace(cfg.VaultAuthMethod)) {
 	case "token":
-		client.SetToken(m.cfg.VaultToken)
+		client.SetToken(cfg.VaultToken)
 		if _, err := client.Auth().Token().LookupSelf(); err != nil {
 			return nil, fmt.Errorf("token auth validation failed: %w", err)
 		}
 	case "approle":
 		secret, err := client.Logical().Write("auth/approle/login", map[string]interface{}{
-			"role_id":   m.cfg.VaultAppRoleID,
-			"secret_id": m.cfg.VaultAppSecretID,
+			"role_id":   cfg.VaultAppRoleID,
+			"secret_id": cfg.VaultAppSecretID,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("approle login failed: %w", err)
@@ -230,7 +236,7 @@ func (m *Manager) newVaultClient() (*vault.Client, error) {
 		}
 		client.SetToken(secret.Auth.ClientToken)
 	default:
-		return nil, fmt.Errorf("unsupported vault auth method: %s", m.cfg.VaultAuthMethod)
+		return nil, fmt.Errorf("unsupported vault auth method: %s", cfg.VaultAuthMethod)
 	}
 
 	return client, nil
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"encoding/base64"
 	"fmt"
 	"os"
 	"strconv"
@@ -54,6 +55,11 @@ type Config struct {
 	VaultServiceDomain string
 	VaultRetryInterval time.Duration
 
+	// Secret encryption at rest
+	SchedulerSecretsKEKProvider  string // vault-transit | local
+	SchedulerSecretsTransitMount string
+	SchedulerSecretsTransitKey   string
+	SchedulerSecretsLocalKey     string // base64, 32 bytes
 	// Scheduler -> agent gRPC
 	SchedulerAgentTLSEnabled         bool
 	SchedulerAgentStatusPollInterval time.Duration
@@ -61,6 +67,8 @@ type Config struct {
 	SchedulerAgentVMApplyTimeout     time.Duration
 	SchedulerAgentDeleteTimeout      time.Duration
 	SchedulerAgentRPCTimeout         time.Duration
+	SchedulerAgentStreamEnabled      bool
+	SchedulerAgentStreamResumeWindow time.Duration
 
 	// Reconciliation / drift
 	SchedulerReconcileInterval    time.Duration
@@ -68,6 +76,43 @@ type Config struct {
 	SchedulerNodeUnavailableGrace time.Duration
 	SchedulerReapplyGuard         time.Duration
 	SchedulerMissingGracePeriod   time.Duration
+	SchedulerMaxReplicas          int
+	SchedulerDrainMaxUnavailable  int
+	SchedulerRevisionHistoryLimit int
+
+	// Placement
+	SchedulerPlacementStrategy string
+	SchedulerScoreWeights      map[string]float64
+	SchedulerPriorityClasses   map[string]int
+	SchedulerPreemptionEnabled bool
+	// Host ports allocated for port mappings that ask for host port 0
+	SchedulerHostPortRangeStart int
+	SchedulerHostPortRangeEnd   int
+
+	// Descheduler: moves running workloads off hot, crowded or mismatched nodes
+	SchedulerDeschedulerEnabled         bool
+	SchedulerDeschedulerDryRun          bool
+	SchedulerDeschedulerInterval        time.Duration
+	SchedulerDeschedulerStrategies      []string
+	SchedulerDeschedulerMaxMoves        int // per pass, across all strategies
+	SchedulerDeschedulerLowUtilization  int // percent; nodes below it on CPU and memory receive workloads
+	SchedulerDeschedulerHighUtilization int // percent; nodes above it on CPU or memory give workloads away
+
+	// Managed volumes: Released volumes nobody uses are deleted after the grace; 0 keeps them
+	SchedulerVolumeReleaseGrace time.Duration
+
+	// Leader election
+	SchedulerLeaderElectionEnabled bool
+	SchedulerLeaderLeaseTTL        time.Duration
+	SchedulerInstanceID            string
+
+	// Disaster recovery
+	SchedulerStateSnapshotPath string
+
+	// Workload usage history: how long each rollup keeps its points
+	SchedulerUsageRetention1m time.Duration
+	SchedulerUsageRetention5m time.Duration
+	SchedulerUsageRetention1h time.Duration
 
 	// Logging / telemetry
 	LogLevel       string
@@ -81,6 +126,7 @@ func Load(insecureFlag bool) (*Config, error) {
 	grpcPort := envIntOr("GRPC_PORT", 8085)
 	metricsPort := envIntOr("METRICS_PORT", 8084)
 	advertisePort := envIntOr("SCHEDULER_ADVERTISE_PORT", grpcPort)
+	hostname, _ := os.Hostname()
 
 	cfg := &Config{
 		Insecure: insecureFlag,
@@ -121,18 +167,52 @@ func Load(insecureFlag bool) (*Config, error) {
 		VaultServiceDomain: strings.TrimSpace(os.Getenv("PERSYS_VAULT_SERVICE_DOMAIN")),
 		VaultRetryInterval: envDurationOr("PERSYS_VAULT_RETRY_INTERVAL", time.Minute),
 
+		SchedulerSecretsTransitMount: envOr("SCHEDULER_SECRETS_TRANSIT_MOUNT", "transit"),
+		SchedulerSecretsTransitKey:   envOr("SCHEDULER_SECRETS_TRANSIT_KEY", "persys-scheduler"),
+		SchedulerSecretsLocalKey:     strings.TrimSpace(os.Getenv("SCHEDULER_SECRETS_LOCAL_KEY")),
+
 		SchedulerAgentTLSEnabled:         envBoolOr("SCHEDULER_AGENT_TLS_ENABLED", !insecureFlag),
 		SchedulerAgentStatusPollInterval: envDurationOrFlexibleSeconds("SCHEDULER_AGENT_STATUS_POLL_INTERVAL", time.Second),
 		SchedulerAgentApplyTimeout:       envDurationOrFlexibleSeconds("SCHEDULER_AGENT_APPLY_TIMEOUT", 45*time.Second),
 		SchedulerAgentVMApplyTimeout:     envDurationOrFlexibleSeconds("SCHEDULER_AGENT_VM_APPLY_TIMEOUT", 240*time.Second),
 		SchedulerAgentDeleteTimeout:      envDurationOrFlexibleSeconds("SCHEDULER_AGENT_DELETE_TIMEOUT", 60*time.Second),
 		SchedulerAgentRPCTimeout:         envDurationOrFlexibleSeconds("SCHEDULER_AGENT_RPC_TIMEOUT", 10*time.Second),
+		SchedulerAgentStreamEnabled:      envBoolOr("SCHEDULER_AGENT_STREAM_ENABLED", true),
+		SchedulerAgentStreamResumeWindow: envDurationOrFlexibleSeconds("SCHEDULER_AGENT_STREAM_RESUME_WINDOW", 2*time.Minute),
 
 		SchedulerReconcileInterval:    envDurationOrFlexibleSeconds("SCHEDULER_RECONCILE_INTERVAL", 5*time.Second),
 		SchedulerDriftDetectInterval:  envDurationOrFlexibleSeconds("SCHEDULER_DRIFT_DETECT_INTERVAL", 300*time.Second),
 		SchedulerNodeUnavailableGrace: envDurationOrFlexibleSeconds("SCHEDULER_NODE_UNAVAILABLE_GRACE", 3*time.Minute),
 		SchedulerReapplyGuard:         envDurationOrFlexibleSeconds("SCHEDULER_REAPPLY_GUARD", 45*time.Second),
 		SchedulerMissingGracePeriod:   envDurationOrFlexibleSeconds("SCHEDULER_MISSING_GRACE_PERIOD", 10*time.Second),
+		SchedulerMaxReplicas:          envIntOr("SCHEDULER_MAX_REPLICAS", 100),
+		SchedulerDrainMaxUnavailable:  envIntOr("SCHEDULER_DRAIN_MAX_UNAVAILABLE", 1),
+		SchedulerRevisionHistoryLimit: envIntOr("SCHEDULER_REVISION_HISTORY_LIMIT", 10),
+
+		SchedulerPlacementStrategy: strings.ToLower(envOr("SCHEDULER_PLACEMENT_STRATEGY", "spread")),
+		SchedulerScoreWeights:      envWeightsOr("SCHEDULER_SCORE_WEIGHTS", defaultScoreWeights()),
+		SchedulerPriorityClasses:   envPriorityClassesOr("SCHEDULER_PRIORITY_CLASSES", defaultPriorityClasses()),
+		SchedulerPreemptionEnabled: envBoolOr("SCHEDULER_PREEMPTION_ENABLED", true),
+
+		SchedulerDeschedulerEnabled:         envBoolOr("SCHEDULER_DESCHEDULER_ENABLED", false),
+		SchedulerDeschedulerDryRun:          envBoolOr("SCHEDULER_DESCHEDULER_DRY_RUN", false),
+		SchedulerDeschedulerInterval:        envDurationOrFlexibleSeconds("SCHEDULER_DESCHEDULER_INTERVAL", 5*time.Minute),
+		SchedulerDeschedulerStrategies:      splitCSV(envOr("SCHEDULER_DESCHEDULER_STRATEGIES", "lowNodeUtilization,removeDuplicates,violatedAffinity")),
+		SchedulerDeschedulerMaxMoves:        envIntOr("SCHEDULER_DESCHEDULER_MAX_MOVES", 5),
+		SchedulerDeschedulerLowUtilization:  envIntOr("SCHEDULER_DESCHEDULER_LOW_UTILIZATION", 20),
+		SchedulerDeschedulerHighUtilization: envIntOr("SCHEDULER_DESCHEDULER_HIGH_UTILIZATION", 80),
+
+		SchedulerVolumeReleaseGrace: envDurationOrFlexibleSeconds("SCHEDULER_VOLUME_RELEASE_GRACE", 24*time.Hour),
+
+		SchedulerLeaderElectionEnabled: envBoolOr("SCHEDULER_LEADER_ELECTION_ENABLED", true),
+		SchedulerLeaderLeaseTTL:        envDurationOrFlexibleSeconds("SCHEDULER_LEADER_LEASE_TTL", 10*time.Second),
+		SchedulerInstanceID:            envOr("SCHEDULER_INSTANCE_ID", hostname),
+
+		SchedulerStateSnapshotPath: strings.TrimSpace(os.Getenv("SCHEDULER_STATE_SNAPSHOT_PATH")),
+
+		SchedulerUsageRetention1m: envDurationOrFlexibleSeconds("SCHEDULER_USAGE_RETENTION_1M", 6*time.Hour),
+		SchedulerUsageRetention5m: envDurationOrFlexibleSeconds("SCHEDULER_USAGE_RETENTION_5M", 48*time.Hour),
+		SchedulerUsageRetention1h: envDurationOrFlexibleSeconds("SCHEDULER_USAGE_RETENTION_1H", 30*24*time.Hour),
 
 		LogLevel:       envOr("LOG_LEVEL", "info"),
 		LogFormat:      envOr("LOG_FORMAT", "json"),
@@ -141,6 +221,14 @@ func Load(insecureFlag bool) (*Config, error) {
 		OTLPInsecure:   envBoolOr("OTEL_EXPORTER_OTLP_INSECURE", true),
 	}
 
+	cfg.SchedulerHostPortRangeStart, cfg.SchedulerHostPortRangeEnd = envPortRangeOr("SCHEDULER_HOST_PORT_RANGE", 30000, 32767)
+
+	defaultKEKProvider := "local"
+	if cfg.VaultEnabled {
+		defaultKEKProvider = "vault-transit"
+	}
+	cfg.SchedulerSecretsKEKProvider = strings.ToLower(envOr("SCHEDULER_SECRETS_KEK_PROVIDER", defaultKEKProvider))
+
 	if err := cfg.Validate(); err != nil {
 		return nil, err
 	}
@@ -157,6 +245,68 @@ func (c *Config) Validate() error {
 	if c.SchedulerAdvertisePort < 1 || c.SchedulerAdvertisePort > 65535 {
 		return fmt.Errorf("invalid SCHEDULER_ADVERTISE_PORT: %d", c.SchedulerAdvertisePort)
 	}
+	if c.SchedulerMaxReplicas < 1 {
+		return fmt.Errorf("invalid SCHEDULER_MAX_REPLICAS: %d", c.SchedulerMaxReplicas)
+	}
+	if c.SchedulerDrainMaxUnavailable < 1 {
+		return fmt.Errorf("invalid SCHEDULER_DRAIN_MAX_UNAVAILABLE: %d", c.SchedulerDrainMaxUnavailable)
+	}
+	if c.SchedulerRevisionHistoryLimit < 1 {
+		return fmt.Errorf("invalid SCHEDULER_REVISION_HISTORY_LIMIT: %d", c.SchedulerRevisionHistoryLimit)
+	}
+	if c.SchedulerHostPortRangeStart < 1 || c.SchedulerHostPortRangeEnd > 65535 || c.SchedulerHostPortRangeStart > c.SchedulerHostPortRangeEnd {
+		return fmt.Errorf("invalid SCHEDULER_HOST_PORT_RANGE: %d-%d", c.SchedulerHostPortRangeStart, c.SchedulerHostPortRangeEnd)
+	}
+	for _, r := range []struct {
+		env       string
+		retention time.Duration
+		step      time.Duration
+	}{
+		{"SCHEDULER_USAGE_RETENTION_1M", c.SchedulerUsageRetention1m, time.Minute},
+		{"SCHEDULER_USAGE_RETENTION_5M", c.SchedulerUsageRetention5m, 5 * time.Minute},
+		{"SCHEDULER_USAGE_RETENTION_1H", c.SchedulerUsageRetention1h, time.Hour},
+	} {
+		if r.retention < r.step {
+			return fmt.Errorf("invalid %s: %s must be at least %s", r.env, r.retention, r.step)
+		}
+	}
+	if c.SchedulerDeschedulerInterval <= 0 {
+		return fmt.Errorf("invalid SCHEDULER_DESCHEDULER_INTERVAL: %s", c.SchedulerDeschedulerInterval)
+	}
+	if c.SchedulerDeschedulerMaxMoves < 1 {
+		return fmt.Errorf("invalid SCHEDULER_DESCHEDULER_MAX_MOVES: %d", c.SchedulerDeschedulerMaxMoves)
+	}
+	if c.SchedulerDeschedulerLowUtilization < 0 || c.SchedulerDeschedulerHighUtilization > 100 || c.SchedulerDeschedulerLowUtilization >= c.SchedulerDeschedulerHighUtilization {
+		return fmt.Errorf("invalid descheduler utilization thresholds: low=%d high=%d (want 0 <= low < high <= 100)", c.SchedulerDeschedulerLowUtilization, c.SchedulerDeschedulerHighUtilization)
+	}
+	for _, strategy := range c.SchedulerDeschedulerStrategies {
+		switch strategy {
+		case "lowNodeUtilization", "removeDuplicates", "violatedAffinity":
+		default:
+			return fmt.Errorf("unsupported SCHEDULER_DESCHEDULER_STRATEGIES entry %q", strategy)
+		}
+	}
+	if c.SchedulerVolumeReleaseGrace < 0 {
+		return fmt.Errorf("invalid SCHEDULER_VOLUME_RELEASE_GRACE: %s", c.SchedulerVolumeReleaseGrace)
+	}
+	if c.SchedulerLeaderElectionEnabled {
+		if c.SchedulerLeaderLeaseTTL < 2*time.Second {
+			return fmt.Errorf("invalid SCHEDULER_LEADER_LEASE_TTL: %s must be at least 2s", c.SchedulerLeaderLeaseTTL)
+		}
+		if strings.TrimSpace(c.SchedulerInstanceID) == "" {
+			return fmt.Errorf("SCHEDULER_INSTANCE_ID is required when leader election is enabled")
+		}
+	}
+	switch c.SchedulerPlacementStrategy {
+	case "spread", "binpack":
+	default:
+		return fmt.Errorf("unsupported SCHEDULER_PLACEMENT_STRATEGY=%q", c.SchedulerPlacementStrategy)
+	}
+	for name, weight := range c.SchedulerScoreWeights {
+		if weight < 0 {
+			return fmt.Errorf("invalid SCHEDULER_SCORE_WEIGHTS: %s=%v must not be negative", name, weight)
+		}
+	}
 	if len(c.EtcdEndpoints) == 0 {
 		return fmt.Errorf("at least one ETCD endpoint is required")
 	}
@@ -170,6 +320,21 @@ func (c *Config) Validate() error {
 			return fmt.Errorf("TLS enabled but cert/key/ca paths are missing")
 		}
 	}
+	switch c.SchedulerSecretsKEKProvider {
+	case "vault-transit":
+		if !c.VaultEnabled {
+			return fmt.Errorf("SCHEDULER_SECRETS_KEK_PROVIDER=vault-transit requires PERSYS_VAULT_ENABLED")
+		}
+	case "local":
+		if c.SchedulerSecretsLocalKey != "" {
+			key, err := base64.StdEncoding.DecodeString(c.SchedulerSecretsLocalKey)
+			if err != nil || len(key) != 32 {
+				return fmt.Errorf("invalid SCHEDULER_SECRETS_LOCAL_KEY: must be 32 bytes, base64 encoded")
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported SCHEDULER_SECRETS_KEK_PROVIDER=%q", c.SchedulerSecretsKEKProvider)
+	}
 	if c.VaultEnabled && c.TLSEnabled {
 		switch c.VaultAuthMethod {
 		case "token":
@@ -241,6 +406,86 @@ func envDurationOrFlexibleSeconds(key string, fallback time.Duration) time.Durat
 	return fallback
 }
 
+func defaultScoreWeights() map[string]float64 {
+	return map[string]float64{
+		"utilization":     1,
+		"topology_spread": 2,
+		"anti_affinity":   2,
+		"node_affinity":   1,
+		"taint":           1,
+	}
+}
+
+// envWeightsOr parses "name=weight,name=weight" and overlays it on fallback.
+// Malformed entries are ignored.
+func envWeightsOr(key string, fallback map[string]float64) map[string]float64 {
+	out := make(map[string]float64, len(fallback))
+	for k, v := range fallback {
+		out[k] = v
+	}
+	for _, entry := range splitCSV(os.Getenv(key)) {
+		name, raw, ok := strings.Cut(entry, "=")
+		if !ok {
+			continue
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			continue
+		}
+		out[strings.ToLower(strings.TrimSpace(name))] = weight
+	}
+	return out
+}
+
+func defaultPriorityClasses() map[string]int {
+	return map[string]int{
+		"system-critical": 1000000,
+		"production":      1000,
+		"default":         0,
+		"batch":           -1000,
+	}
+}
+
+// envPriorityClassesOr parses "class=priority,class=priority" and overlays it
+// on fallback. Malformed entries are ignored.
+func envPriorityClassesOr(key string, fallback map[string]int) map[string]int {
+	out := make(map[string]int, len(fallback))
+	for k, v := range fallback {
+		out[k] = v
+	}
+	for _, entry := range splitCSV(os.Getenv(key)) {
+		name, raw, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			continue
+		}
+		priority, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil {
+			continue
+		}
+		out[strings.ToLower(strings.TrimSpace(name))] = priority
+	}
+	return out
+}
+
+// envPortRangeOr parses "start-end". A malformed value yields 0-0, which
+// Validate rejects.
+func envPortRangeOr(key string, start, end int) (int, int) {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return start, end
+	}
+	rawStart, rawEnd, ok := strings.Cut(v, "-")
+	if !ok {
+		return 0, 0
+	}
+	first, err1 := strconv.Atoi(strings.TrimSpace(rawStart))
+	last, err2 := strconv.Atoi(strings.TrimSpace(rawEnd))
+	if err1 != nil || err2 != nil {
+		return 0, 0
+	}
+	return first, last
+}
+
 func splitCSV(v string) []string {
 	parts := strings.Split(v, ",")
 	out := make([]string, 0, len(parts))
@@ -19,7 +19,16 @@ func TestLoadDefaults(t *testing.T) {
 		"AGENTS_DISCOVERY_DOMAIN", "SCHEDULER_SHARD_KEY",
 		"PERSYS_VAULT_ENABLED", "PERSYS_VAULT_AUTH_METHOD", "PERSYS_VAULT_TOKEN",
 		"SCHEDULER_AGENT_STATUS_POLL_INTERVAL", "SCHEDULER_AGENT_APPLY_TIMEOUT",
-		"SCHEDULER_RECONCILE_INTERVAL",
+		"SCHEDULER_RECONCILE_INTERVAL", "SCHEDULER_MAX_REPLICAS",
+		"SCHEDULER_DRAIN_MAX_UNAVAILABLE", "SCHEDULER_PRIORITY_CLASSES",
+		"SCHEDULER_PREEMPTION_ENABLED", "SCHEDULER_LEADER_ELECTION_ENABLED",
+		"SCHEDULER_LEADER_LEASE_TTL", "SCHEDULER_STATE_SNAPSHOT_PATH",
+		"SCHEDULER_SECRETS_KEK_PROVIDER", "SCHEDULER_SECRETS_LOCAL_KEY",
+		"SCHEDULER_HOST_PORT_RANGE", "SCHEDULER_USAGE_RETENTION_1M",
+		"SCHEDULER_USAGE_RETENTION_5M", "SCHEDULER_USAGE_RETENTION_1H",
+		"SCHEDULER_DESCHEDULER_ENABLED", "SCHEDULER_DESCHEDULER_STRATEGIES",
+		"SCHEDULER_DESCHEDULER_MAX_MOVES", "SCHEDULER_DESCHEDULER_LOW_UTILIZATION",
+		"SCHEDULER_DESCHEDULER_HIGH_UTILIZATION", "SCHEDULER_VOLUME_RELEASE_GRACE",
 	)
 	t.Setenv("PERSYS_VAULT_ENABLED", "false")
 
@@ -40,6 +49,45 @@ func TestLoadDefaults(t *testing.T) {
 	if cfg.SchedulerReconcileInterval != 5*time.Second {
 		t.Fatalf("unexpected reconcile interval: %s", cfg.SchedulerReconcileInterval)
 	}
+	if cfg.SchedulerMaxReplicas != 100 {
+		t.Fatalf("unexpected max replicas: %d", cfg.SchedulerMaxReplicas)
+	}
+	if cfg.SchedulerDrainMaxUnavailable != 1 {
+		t.Fatalf("unexpected drain max unavailable: %d", cfg.SchedulerDrainMaxUnavailable)
+	}
+	if cfg.SchedulerRevisionHistoryLimit != 10 {
+		t.Fatalf("unexpected revision history limit: %d", cfg.SchedulerRevisionHistoryLimit)
+	}
+	if !cfg.SchedulerPreemptionEnabled {
+		t.Fatalf("expected preemption enabled by default")
+	}
+	if !cfg.SchedulerLeaderElectionEnabled || cfg.SchedulerLeaderLeaseTTL != 10*time.Second {
+		t.Fatalf("unexpected leader election defaults: enabled=%v ttl=%s", cfg.SchedulerLeaderElectionEnabled, cfg.SchedulerLeaderLeaseTTL)
+	}
+	if cfg.SchedulerStateSnapshotPath != "" {
+		t.Fatalf("expected state snapshot disabled by default, got %q", cfg.SchedulerStateSnapshotPath)
+	}
+	if cfg.SchedulerSecretsKEKProvider != "local" || cfg.SchedulerSecretsLocalKey != "" {
+		t.Fatalf("unexpected secrets KEK defaults without vault: provider=%q", cfg.SchedulerSecretsKEKProvider)
+	}
+	if cfg.SchedulerPriorityClasses["production"] <= cfg.SchedulerPriorityClasses["batch"] {
+		t.Fatalf("expected production to outrank batch: %#v", cfg.SchedulerPriorityClasses)
+	}
+	if cfg.SchedulerHostPortRangeStart != 30000 || cfg.SchedulerHostPortRangeEnd != 32767 {
+		t.Fatalf("unexpected host port range: %d-%d", cfg.SchedulerHostPortRangeStart, cfg.SchedulerHostPortRangeEnd)
+	}
+	if cfg.SchedulerUsageRetention1m != 6*time.Hour || cfg.SchedulerUsageRetention5m != 48*time.Hour || cfg.SchedulerUsageRetention1h != 720*time.Hour {
+		t.Fatalf("unexpected usage retention: 1m=%s 5m=%s 1h=%s", cfg.SchedulerUsageRetention1m, cfg.SchedulerUsageRetention5m, cfg.SchedulerUsageRetention1h)
+	}
+	if cfg.SchedulerDeschedulerEnabled || cfg.SchedulerDeschedulerMaxMoves != 5 || len(cfg.SchedulerDeschedulerStrategies) != 3 {
+		t.Fatalf("unexpected descheduler defaults: enabled=%v max_moves=%d strategies=%v", cfg.SchedulerDeschedulerEnabled, cfg.SchedulerDeschedulerMaxMoves, cfg.SchedulerDeschedulerStrategies)
+	}
+	if cfg.SchedulerDeschedulerLowUtilization != 20 || cfg.SchedulerDeschedulerHighUtilization != 80 {
+		t.Fatalf("unexpected descheduler thresholds: low=%d high=%d", cfg.SchedulerDeschedulerLowUtilization, cfg.SchedulerDeschedulerHighUtilization)
+	}
+	if cfg.SchedulerVolumeReleaseGrace != 24*time.Hour {
+		t.Fatalf("unexpected volume release grace: %s", cfg.SchedulerVolumeReleaseGrace)
+	}
 }
 
 func TestLoadDurationSupportsSecondsInt(t *testing.T) {
@@ -54,6 +102,40 @@ func TestLoadDurationSupportsSecondsInt(t *testing.T) {
 	}
 }
 
+func TestLoadScoreWeightsOverlayDefaults(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_SCORE_WEIGHTS", "utilization=3, topology_spread=0,bogus")
+	cfg, err := Load(false)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.SchedulerScoreWeights["utilization"] != 3 {
+		t.Fatalf("expected utilization weight 3, got %v", cfg.SchedulerScoreWeights["utilization"])
+	}
+	if cfg.SchedulerScoreWeights["topology_spread"] != 0 {
+		t.Fatalf("expected topology_spread weight 0, got %v", cfg.SchedulerScoreWeights["topology_spread"])
+	}
+	if cfg.SchedulerScoreWeights["anti_affinity"] != 2 {
+		t.Fatalf("expected default anti_affinity weight 2, got %v", cfg.SchedulerScoreWeights["anti_affinity"])
+	}
+}
+
+func TestValidateRejectsUnknownPlacementStrategy(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_PLACEMENT_STRATEGY", "random")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected error for unknown placement strategy")
+	}
+}
+
+func TestValidateRejectsShortSecretsLocalKey(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_SECRETS_LOCAL_KEY", "c2hvcnQ=")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected error for a local secrets key that is not 32 bytes")
+	}
+}
+
 func TestValidateVaultTokenModeRequiresToken(t *testing.T) {
 	t.Setenv("PERSYS_VAULT_ENABLED", "true")
 	t.Setenv("PERSYS_VAULT_AUTH_METHOD", "token")
@@ -76,3 +158,75 @@ func TestValidateVaultAppRoleModeRequiresCredentials(t *testing.T) {
 		t.Fatalf("expected validation error for missing approle credentials")
 	}
 }
+
+func TestLoadPriorityClassesOverlayDefaults(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_PRIORITY_CLASSES", "Critical-Web=5000, batch=-50,broken=x")
+	cfg, err := Load(false)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.SchedulerPriorityClasses["critical-web"] != 5000 {
+		t.Fatalf("expected critical-web priority 5000, got %v", cfg.SchedulerPriorityClasses["critical-web"])
+	}
+	if cfg.SchedulerPriorityClasses["batch"] != -50 {
+		t.Fatalf("expected batch priority -50, got %v", cfg.SchedulerPriorityClasses["batch"])
+	}
+	if _, ok := cfg.SchedulerPriorityClasses["broken"]; ok {
+		t.Fatalf("expected malformed entry to be ignored")
+	}
+	if cfg.SchedulerPriorityClasses["production"] != 1000 {
+		t.Fatalf("expected default production priority 1000, got %v", cfg.SchedulerPriorityClasses["production"])
+	}
+}
+
+func TestLoadRejectsInvalidHostPortRange(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	for _, v := range []string{"40000-30000", "0-100", "30000-70000", "30000"} {
+		t.Setenv("SCHEDULER_HOST_PORT_RANGE", v)
+		if _, err := Load(false); err == nil {
+			t.Fatalf("expected SCHEDULER_HOST_PORT_RANGE=%q to be rejected", v)
+		}
+	}
+	t.Setenv("SCHEDULER_HOST_PORT_RANGE", "20000-20010")
+	cfg, err := Load(false)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+	if cfg.SchedulerHostPortRangeStart != 20000 || cfg.SchedulerHostPortRangeEnd != 20010 {
+		t.Fatalf("unexpected host port range: %d-%d", cfg.SchedulerHostPortRangeStart, cfg.SchedulerHostPortRangeEnd)
+	}
+}
+
+func TestValidateRejectsUsageRetentionShorterThanStep(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_USAGE_RETENTION_1H", "30m")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected 1h retention shorter than one hour to be rejected")
+	}
+}
+
+func TestValidateRejectsUnknownDeschedulerStrategy(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_DESCHEDULER_STRATEGIES", "lowNodeUtilization,shuffle")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected error for unknown descheduler strategy")
+	}
+}
+
+func TestValidateRejectsInvertedDeschedulerThresholds(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_DESCHEDULER_LOW_UTILIZATION", "70")
+	t.Setenv("SCHEDULER_DESCHEDULER_HIGH_UTILIZATION", "60")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected low utilization above high utilization to be rejected")
+	}
+}
+
+func TestValidateRejectsNegativeVolumeReleaseGrace(t *testing.T) {
+	t.Setenv("PERSYS_VAULT_ENABLED", "false")
+	t.Setenv("SCHEDULER_VOLUME_RELEASE_GRACE", "-1h")
+	if _, err := Load(false); err == nil {
+		t.Fatalf("expected negative volume release grace to be rejected")
+	}
+}
@@ -0,0 +1,243 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const defaultStreamAgentPort = "50051"
+
+// ControlStream holds one long-lived channel per agent. The first message must
+// be a register (or a heartbeat carrying a session_id to resume). Afterwards
+// the agent sends heartbeats, workload statuses and push results, and the
+// scheduler pushes applies/deletes through the node's stream session. Only the
+// leader accepts streams.
+func (s *Service) ControlStream(stream controlv1.AgentControl_ControlStreamServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	nodeID := controlMessageNodeID(first)
+	annotateRPC(ctx, attribute.String("scheduler.node_id", nodeID))
+	if nodeID == "" {
+		err := status.Error(codes.InvalidArgument, "first control message must carry node_id")
+		recordRPCError(ctx, err)
+		return err
+	}
+	if first.GetRegister() == nil && first.GetHeartbeat() == nil {
+		err := status.Error(codes.InvalidArgument, "first control message must be register or heartbeat")
+		recordRPCError(ctx, err)
+		return err
+	}
+	if err := authorizeStreamNode(ctx, nodeID); err != nil {
+		recordRPCError(ctx, err)
+		return err
+	}
+	// Pushes only come from the leader, so a session held by a follower
+	// could never be used; the agent retries against the leader.
+	if err := s.sched.RequireLeader(); err != nil {
+		rpcErr := status.Error(codes.Unavailable, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return rpcErr
+	}
+
+	conn, resumed := s.sched.AttachAgentStream(nodeID, first.GetSessionId(), first.GetAckSequence())
+	defer conn.Close()
+	annotateRPC(ctx,
+		attribute.String("scheduler.stream_session_id", conn.SessionID()),
+		attribute.Bool("scheduler.stream_resumed", resumed),
+	)
+
+	sendErr := make(chan error, 1)
+	go func() {
+		for {
+			select {
+			case msg := <-conn.Outbound():
+				if err := stream.Send(msg); err != nil {
+					sendErr <- err
+					return
+				}
+			case <-conn.Done():
+				sendErr <- nil
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	recvCh := make(chan *controlv1.ControlMessage)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case recvCh <- msg:
+			case <-conn.Done():
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	msg := first
+	for {
+		if err := s.handleControlMessage(ctx, conn, msg); err != nil {
+			recordRPCError(ctx, err)
+			return err
+		}
+		select {
+		case msg = <-recvCh:
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case err := <-sendErr:
+			if err != nil {
+				recordRPCError(ctx, err)
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// authorizeStreamNode checks that the agent's client certificate names the
+// node it opens a stream for, in its common name or a DNS SAN. Without TLS
+// (insecure mode) there is no identity to check.
+func authorizeStreamNode(ctx context.Context, nodeID string) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	if len(info.State.PeerCertificates) == 0 {
+		return status.Errorf(codes.PermissionDenied, "no client certificate to authorize node %s", nodeID)
+	}
+	leaf := info.State.PeerCertificates[0]
+	want := strings.ToLower(strings.TrimSpace(nodeID))
+	if strings.ToLower(strings.TrimSpace(leaf.Subject.CommonName)) == want {
+		return nil
+	}
+	for _, name := range leaf.DNSNames {
+		if strings.ToLower(strings.TrimSpace(name)) == want {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "client certificate %q does not identify node %s", leaf.Subject.CommonName, nodeID)
+}
+
+func (s *Service) handleControlMessage(ctx context.Context, conn *scheduler.AgentStreamConn, msg *controlv1.ControlMessage) error {
+	if msg == nil || !conn.Receive(msg) {
+		return nil
+	}
+	if nodeID := controlMessageNodeID(msg); nodeID != "" && nodeID != conn.NodeID() {
+		return status.Errorf(codes.InvalidArgument, "control message for node %q on stream of node %q", nodeID, conn.NodeID())
+	}
+
+	switch m := msg.GetMessage().(type) {
+	case *controlv1.ControlMessage_Register:
+		req := proto.Clone(m.Register).(*controlv1.RegisterNodeRequest)
+		if strings.TrimSpace(req.GetGrpcEndpoint()) == "" {
+			// Agents behind NAT may not expose an inbound endpoint; record the
+			// peer address so the dial fallback has something to try.
+			if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+				if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+					req.GrpcEndpoint = net.JoinHostPort(host, defaultStreamAgentPort)
+				}
+			}
+		}
+		resp, err := s.RegisterNode(ctx, req)
+		if err != nil {
+			resp = &controlv1.RegisterNodeResponse{Accepted: false, Reason: status.Convert(err).Message()}
+		}
+		return conn.Send(ctx, &controlv1.ControlMessage{
+			CorrelationId: msg.GetCorrelationId(),
+			Message:       &controlv1.ControlMessage_RegisterAck{RegisterAck: resp},
+		})
+	case *controlv1.ControlMessage_Heartbeat:
+		resp, err := s.Heartbeat(ctx, m.Heartbeat)
+		if err != nil {
+			// NotFound tells the agent to re-register; surface it by ending the stream.
+			return err
+		}
+		return conn.Send(ctx, &controlv1.ControlMessage{
+			CorrelationId: msg.GetCorrelationId(),
+			Message:       &controlv1.ControlMessage_HeartbeatAck{HeartbeatAck: resp},
+		})
+	case *controlv1.ControlMessage_Apply:
+		resp, err := s.ApplyWorkload(ctx, m.Apply)
+		if err != nil {
+			resp = &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: streamFailureReason(err), ErrorMessage: status.Convert(err).Message()}
+		}
+		return conn.Send(ctx, &controlv1.ControlMessage{
+			CorrelationId: msg.GetCorrelationId(),
+			Message:       &controlv1.ControlMessage_ApplyResult{ApplyResult: resp},
+		})
+	case *controlv1.ControlMessage_Delete:
+		resp, err := s.DeleteWorkload(ctx, m.Delete)
+		if err != nil {
+			resp = &controlv1.DeleteWorkloadResponse{Success: false, ErrorMessage: status.Convert(err).Message()}
+		}
+		return conn.Send(ctx, &controlv1.ControlMessage{
+			CorrelationId: msg.GetCorrelationId(),
+			Message:       &controlv1.ControlMessage_DeleteResult{DeleteResult: resp},
+		})
+	case *controlv1.ControlMessage_WorkloadStatus:
+		if s.sched.IsWritable() {
+			s.recordWorkloadStatus(m.WorkloadStatus)
+		}
+	}
+	return nil
+}
+
+// streamFailureReason maps an ApplyWorkload error onto the failure reason
+// returned to the agent, so only malformed requests read as INVALID_SPEC.
+func streamFailureReason(err error) controlv1.FailureReason {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return controlv1.FailureReason_INVALID_SPEC
+	case codes.ResourceExhausted:
+		return controlv1.FailureReason_QUOTA_EXCEEDED
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return controlv1.FailureReason_NETWORK_ERROR
+	}
+	return applyFailureReason(err)
+}
+
+func controlMessageNodeID(msg *controlv1.ControlMessage) string {
+	if id := strings.TrimSpace(msg.GetNodeId()); id != "" {
+		return id
+	}
+	if id := strings.TrimSpace(msg.GetRegister().GetNodeId()); id != "" {
+		return id
+	}
+	return strings.TrimSpace(msg.GetHeartbeat().GetNodeId())
+}
@@ -0,0 +1,91 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchEvents streams scheduler events until the client goes away. It only
+// reads, so it keeps working while the control plane is frozen.
+func (s *Service) WatchEvents(in *controlv1.WatchEventsRequest, stream controlv1.AgentControl_WatchEventsServer) error {
+	ctx := stream.Context()
+	if in == nil {
+		in = &controlv1.WatchEventsRequest{}
+	}
+	if in.GetFromRevision() < 0 {
+		err := status.Error(codes.InvalidArgument, "from_revision must not be negative")
+		recordRPCError(ctx, err)
+		return err
+	}
+	filter := scheduler.EventFilter{
+		WorkloadID: strings.TrimSpace(in.GetWorkloadId()),
+		NodeID:     strings.TrimSpace(in.GetNodeId()),
+		Types:      in.GetTypes(),
+	}
+	annotateRPC(ctx,
+		attribute.String("scheduler.workload_id", filter.WorkloadID),
+		attribute.String("scheduler.node_id", filter.NodeID),
+		attribute.Int64("scheduler.from_revision", in.GetFromRevision()),
+	)
+
+	err := s.sched.WatchEvents(ctx, filter, in.GetFromRevision(), func(event models.SchedulerEvent) error {
+		return stream.Send(eventToView(event))
+	})
+	switch {
+	case err == nil, ctx.Err() != nil:
+		return nil
+	case errors.Is(err, scheduler.ErrEventsCompacted):
+		rpcErr := status.Error(codes.OutOfRange, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return rpcErr
+	default:
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		rpcErr := status.Error(codes.Unavailable, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return rpcErr
+	}
+}
+
+func eventToView(event models.SchedulerEvent) *controlv1.SchedulerEventView {
+	view := &controlv1.SchedulerEventView{
+		Id:         event.ID,
+		Type:       event.Type,
+		WorkloadId: event.WorkloadID,
+		NodeId:     event.NodeID,
+		Reason:     event.Reason,
+		Timestamp:  timestampPtr(event.Timestamp),
+		Revision:   event.Revision,
+	}
+	if len(event.Details) > 0 {
+		view.Details = make(map[string]string, len(event.Details))
+		for k, v := range event.Details {
+			view.Details[k] = detailString(v)
+		}
+	}
+	return view
+}
+
+func detailString(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case nil:
+		return ""
+	case map[string]interface{}, []interface{}:
+		if payload, err := json.Marshal(t); err == nil {
+			return string(payload)
+		}
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,271 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultJobBackoffLimit            = 6
+	defaultSuccessfulJobsHistoryLimit = 3
+	defaultFailedJobsHistoryLimit     = 1
+)
+
+func (s *Service) ApplyJob(ctx context.Context, in *controlv1.ApplyJobRequest) (*controlv1.ApplyJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.job_id", strings.TrimSpace(in.GetJobId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyJobResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || in.GetSpec().GetTemplate() == nil {
+		err := status.Error(codes.InvalidArgument, "spec.template is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	job, err := jobFromSpec(in.GetJobId(), in.GetSpec())
+	if err != nil {
+		return &controlv1.ApplyJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	annotateRPC(ctx, attribute.String("scheduler.workload_type", strings.TrimSpace(job.Template.Type)))
+
+	job, err = s.sched.ApplyJob(job)
+	if err != nil {
+		return &controlv1.ApplyJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyJobResponse{Success: true, Job: jobToView(s.convergeJob(job))}, nil
+}
+
+func (s *Service) DeleteJob(ctx context.Context, in *controlv1.DeleteJobRequest) (*controlv1.DeleteJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.job_id", strings.TrimSpace(in.GetJobId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteJobResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetJobId()) == "" {
+		err := status.Error(codes.InvalidArgument, "job_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteJob(strings.TrimSpace(in.GetJobId())); err != nil {
+		return &controlv1.DeleteJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteJobResponse{Success: true}, nil
+}
+
+func (s *Service) GetJob(ctx context.Context, in *controlv1.GetJobRequest) (*controlv1.GetJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.job_id", strings.TrimSpace(in.GetJobId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetJobId()) == "" {
+		err := status.Error(codes.InvalidArgument, "job_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	job, err := s.sched.GetJob(strings.TrimSpace(in.GetJobId()))
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "job %q not found", in.GetJobId())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetJobResponse{Job: jobToView(job)}, nil
+}
+
+func (s *Service) ListJobs(ctx context.Context, in *controlv1.ListJobsRequest) (*controlv1.ListJobsResponse, error) {
+	cronJobID := strings.TrimSpace(in.GetCronJobId())
+	jobs, err := s.sched.ListJobs()
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.JobView, 0, len(jobs))
+	for _, job := range jobs {
+		if cronJobID != "" && job.CronJobID != cronJobID {
+			continue
+		}
+		out = append(out, jobToView(job))
+	}
+	return &controlv1.ListJobsResponse{Jobs: out}, nil
+}
+
+func (s *Service) ApplyCronJob(ctx context.Context, in *controlv1.ApplyCronJobRequest) (*controlv1.ApplyCronJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.cron_job_id", strings.TrimSpace(in.GetCronJobId())),
+			attribute.String("scheduler.cron_schedule", strings.TrimSpace(in.GetSchedule())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyCronJobResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || in.GetJobTemplate().GetTemplate() == nil {
+		err := status.Error(codes.InvalidArgument, "job_template.template is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if strings.TrimSpace(in.GetSchedule()) == "" {
+		err := status.Error(codes.InvalidArgument, "schedule is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	template, err := jobFromSpec(in.GetCronJobId(), in.GetJobTemplate())
+	if err != nil {
+		return &controlv1.ApplyCronJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	cj := models.CronJob{
+		ID:                         strings.TrimSpace(in.GetCronJobId()),
+		Schedule:                   in.GetSchedule(),
+		ConcurrencyPolicy:          in.GetConcurrencyPolicy(),
+		Suspend:                    in.GetSuspend(),
+		StartingDeadlineSeconds:    int(in.GetStartingDeadlineSeconds()),
+		SuccessfulJobsHistoryLimit: defaultSuccessfulJobsHistoryLimit,
+		FailedJobsHistoryLimit:     defaultFailedJobsHistoryLimit,
+		JobTemplate:                template,
+	}
+	if in.SuccessfulJobsHistoryLimit != nil {
+		cj.SuccessfulJobsHistoryLimit = int(in.GetSuccessfulJobsHistoryLimit())
+	}
+	if in.FailedJobsHistoryLimit != nil {
+		cj.FailedJobsHistoryLimit = int(in.GetFailedJobsHistoryLimit())
+	}
+	cj, err = s.sched.ApplyCronJob(cj)
+	if err != nil {
+		return &controlv1.ApplyCronJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyCronJobResponse{Success: true, CronJob: cronJobToView(cj)}, nil
+}
+
+func (s *Service) DeleteCronJob(ctx context.Context, in *controlv1.DeleteCronJobRequest) (*controlv1.DeleteCronJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.cron_job_id", strings.TrimSpace(in.GetCronJobId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteCronJobResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetCronJobId()) == "" {
+		err := status.Error(codes.InvalidArgument, "cron_job_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteCronJob(strings.TrimSpace(in.GetCronJobId())); err != nil {
+		return &controlv1.DeleteCronJobResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteCronJobResponse{Success: true}, nil
+}
+
+func (s *Service) GetCronJob(ctx context.Context, in *controlv1.GetCronJobRequest) (*controlv1.GetCronJobResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.cron_job_id", strings.TrimSpace(in.GetCronJobId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetCronJobId()) == "" {
+		err := status.Error(codes.InvalidArgument, "cron_job_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	cj, err := s.sched.GetCronJob(strings.TrimSpace(in.GetCronJobId()))
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "cron job %q not found", in.GetCronJobId())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetCronJobResponse{CronJob: cronJobToView(cj)}, nil
+}
+
+func (s *Service) ListCronJobs(ctx context.Context, _ *controlv1.ListCronJobsRequest) (*controlv1.ListCronJobsResponse, error) {
+	cronJobs, err := s.sched.ListCronJobs()
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.CronJobView, 0, len(cronJobs))
+	for _, cj := range cronJobs {
+		out = append(out, cronJobToView(cj))
+	}
+	return &controlv1.ListCronJobsResponse{CronJobs: out}, nil
+}
+
+// convergeJob runs a job pass right away so callers see the first runs
+// that were started, instead of waiting for the next tick.
+func (s *Service) convergeJob(job models.Job) models.Job {
+	if !s.sched.IsLeader() {
+		return job
+	}
+	if err := s.sched.ReconcileJobs(); err != nil {
+		return job
+	}
+	if refreshed, err := s.sched.GetJob(job.ID); err == nil {
+		return refreshed
+	}
+	return job
+}
+
+func jobFromSpec(id string, spec *controlv1.JobSpec) (models.Job, error) {
+	template, err := controlApplyToModel(&controlv1.ApplyWorkloadRequest{
+		WorkloadId: id,
+		Spec:       spec.GetTemplate(),
+	})
+	if err != nil {
+		return models.Job{}, err
+	}
+	job := models.Job{
+		ID:                      strings.TrimSpace(id),
+		Template:                template,
+		Completions:             int(spec.GetCompletions()),
+		Parallelism:             int(spec.GetParallelism()),
+		BackoffLimit:            defaultJobBackoffLimit,
+		ActiveDeadlineSeconds:   int(spec.GetActiveDeadlineSeconds()),
+		TTLSecondsAfterFinished: int(spec.GetTtlSecondsAfterFinished()),
+	}
+	if spec.BackoffLimit != nil {
+		job.BackoffLimit = int(spec.GetBackoffLimit())
+	}
+	return job, nil
+}
+
+func jobToView(job models.Job) *controlv1.JobView {
+	return &controlv1.JobView{
+		JobId:        job.ID,
+		Type:         job.Template.Type,
+		Phase:        job.Status.Phase,
+		Reason:       job.Status.Reason,
+		Completions:  int32(job.Completions),
+		Parallelism:  int32(job.Parallelism),
+		BackoffLimit: int32(job.BackoffLimit),
+		Active:       int32(job.Status.Active),
+		Succeeded:    int32(job.Status.Succeeded),
+		Failed:       int32(job.Status.Failed),
+		WorkloadIds:  append([]string(nil), job.Status.WorkloadIDs...),
+		CronJobId:    job.CronJobID,
+		DesiredState: job.DesiredState,
+		CreatedAt:    timestampPtr(job.CreatedAt),
+		StartedAt:    timestampPtr(job.Status.StartedAt),
+		CompletedAt:  timestampPtr(job.Status.CompletedAt),
+	}
+}
+
+func cronJobToView(cj models.CronJob) *controlv1.CronJobView {
+	return &controlv1.CronJobView{
+		CronJobId:                  cj.ID,
+		Schedule:                   cj.Schedule,
+		ConcurrencyPolicy:          cj.ConcurrencyPolicy,
+		Suspend:                    cj.Suspend,
+		StartingDeadlineSeconds:    int32(cj.StartingDeadlineSeconds),
+		SuccessfulJobsHistoryLimit: int32(cj.SuccessfulJobsHistoryLimit),
+		FailedJobsHistoryLimit:     int32(cj.FailedJobsHistoryLimit),
+		DesiredState:               cj.DesiredState,
+		ActiveJobIds:               append([]string(nil), cj.Status.ActiveJobIDs...),
+		LastScheduleAt:             timestampPtr(cj.Status.LastScheduleAt),
+		LastSuccessfulAt:           timestampPtr(cj.Status.LastSuccessfulAt),
+		NextScheduleAt:             timestampPtr(cj.Status.NextScheduleAt),
+		CreatedAt:                  timestampPtr(cj.CreatedAt),
+	}
+}
@@ -0,0 +1,119 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ApplyNamespace(ctx context.Context, in *controlv1.ApplyNamespaceRequest) (*controlv1.ApplyNamespaceResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.namespace", strings.TrimSpace(in.GetName())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyNamespaceResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	ns, err := s.sched.ApplyNamespace(models.Namespace{
+		Name:   in.GetName(),
+		Labels: in.GetLabels(),
+		Quota:  quotaFromControl(in.GetQuota()),
+	})
+	if err != nil {
+		return &controlv1.ApplyNamespaceResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyNamespaceResponse{Success: true, Namespace: s.namespaceToView(ns)}, nil
+}
+
+func (s *Service) DeleteNamespace(ctx context.Context, in *controlv1.DeleteNamespaceRequest) (*controlv1.DeleteNamespaceResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.namespace", strings.TrimSpace(in.GetName())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteNamespaceResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteNamespace(in.GetName()); err != nil {
+		return &controlv1.DeleteNamespaceResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteNamespaceResponse{Success: true}, nil
+}
+
+func (s *Service) GetNamespace(ctx context.Context, in *controlv1.GetNamespaceRequest) (*controlv1.GetNamespaceResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.namespace", strings.TrimSpace(in.GetName())))
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	ns, err := s.sched.GetNamespace(in.GetName())
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "namespace %q not found", in.GetName())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetNamespaceResponse{Namespace: s.namespaceToView(ns)}, nil
+}
+
+func (s *Service) ListNamespaces(ctx context.Context, _ *controlv1.ListNamespacesRequest) (*controlv1.ListNamespacesResponse, error) {
+	namespaces, err := s.sched.ListNamespaces()
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.NamespaceView, 0, len(namespaces))
+	for _, ns := range namespaces {
+		out = append(out, s.namespaceToView(ns))
+	}
+	return &controlv1.ListNamespacesResponse{Namespaces: out}, nil
+}
+
+func (s *Service) namespaceToView(ns models.Namespace) *controlv1.NamespaceView {
+	view := &controlv1.NamespaceView{
+		Name:      ns.Name,
+		Labels:    copyStringMap(ns.Labels),
+		Quota:     quotaToControl(ns.Quota),
+		CreatedAt: timestampPtr(ns.CreatedAt),
+		UpdatedAt: timestampPtr(ns.UpdatedAt),
+	}
+	if used, err := s.sched.NamespaceUsage(ns.Name); err == nil {
+		view.Used = quotaToControl(used)
+	}
+	return view
+}
+
+func quotaFromControl(q *controlv1.ResourceQuota) models.ResourceQuota {
+	return models.ResourceQuota{
+		CPU:       q.GetCpuCores(),
+		MemoryMB:  q.GetMemoryMb(),
+		DiskGB:    q.GetDiskGb(),
+		Workloads: int(q.GetWorkloads()),
+		VMs:       int(q.GetVms()),
+	}
+}
+
+func quotaToControl(q models.ResourceQuota) *controlv1.ResourceQuota {
+	return &controlv1.ResourceQuota{
+		CpuCores:  q.CPU,
+		MemoryMb:  q.MemoryMB,
+		DiskGb:    q.DiskGB,
+		Workloads: int32(q.Workloads),
+		Vms:       int32(q.VMs),
+	}
+}
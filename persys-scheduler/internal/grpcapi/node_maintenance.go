@@ -0,0 +1,171 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CordonNode(ctx context.Context, in *controlv1.CordonNodeRequest) (*controlv1.CordonNodeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.node_id", strings.TrimSpace(in.GetNodeId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.CordonNodeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetNodeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "node_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	node, err := s.sched.CordonNode(strings.TrimSpace(in.GetNodeId()), in.GetReason())
+	if err != nil {
+		return &controlv1.CordonNodeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.CordonNodeResponse{Success: true, Node: s.nodeView(node)}, nil
+}
+
+func (s *Service) UncordonNode(ctx context.Context, in *controlv1.UncordonNodeRequest) (*controlv1.UncordonNodeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.node_id", strings.TrimSpace(in.GetNodeId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.UncordonNodeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetNodeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "node_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	node, err := s.sched.UncordonNode(strings.TrimSpace(in.GetNodeId()))
+	if err != nil {
+		return &controlv1.UncordonNodeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.UncordonNodeResponse{Success: true, Node: s.nodeView(node)}, nil
+}
+
+// SetNodeTaints replaces the taints an operator set on a node. The agent's
+// own taints stay and are merged into node.taints.
+func (s *Service) SetNodeTaints(ctx context.Context, in *controlv1.SetNodeTaintsRequest) (*controlv1.SetNodeTaintsResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.node_id", strings.TrimSpace(in.GetNodeId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.SetNodeTaintsResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetNodeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "node_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	taints, err := toModelTaints(in.GetTaints())
+	if err != nil {
+		rpcErr := status.Error(codes.InvalidArgument, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	node, err := s.sched.SetNodeTaints(strings.TrimSpace(in.GetNodeId()), taints)
+	if err != nil {
+		return &controlv1.SetNodeTaintsResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.SetNodeTaintsResponse{Success: true, Node: s.nodeView(node)}, nil
+}
+
+// DrainNode starts (or reports on) a drain. The response carries progress in
+// node.drain; poll GetNode or call DrainNode again to follow it.
+func (s *Service) DrainNode(ctx context.Context, in *controlv1.DrainNodeRequest) (*controlv1.DrainNodeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.node_id", strings.TrimSpace(in.GetNodeId())),
+			attribute.Int("scheduler.max_unavailable", int(in.GetMaxUnavailable())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DrainNodeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetNodeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "node_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if in.GetMaxUnavailable() < 0 {
+		err := status.Error(codes.InvalidArgument, "max_unavailable must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	node, err := s.sched.DrainNode(strings.TrimSpace(in.GetNodeId()), in.GetReason(), int(in.GetMaxUnavailable()))
+	if err != nil {
+		return &controlv1.DrainNodeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DrainNodeResponse{Success: true, Node: s.nodeView(s.convergeNodeDrain(node))}, nil
+}
+
+// convergeNodeDrain runs a drain pass right away so the first batch moves
+// without waiting for the next reconcile tick. Followers leave the pass to the
+// leader.
+func (s *Service) convergeNodeDrain(node models.Node) models.Node {
+	if !s.sched.IsLeader() {
+		return node
+	}
+	if err := s.sched.ReconcileNodeDrains(); err != nil {
+		return node
+	}
+	if refreshed, err := s.sched.GetNodeByID(node.NodeID); err == nil {
+		return refreshed
+	}
+	return node
+}
+
+// RunDescheduler runs one descheduler pass on demand, whether or not the
+// background descheduler is enabled. A dry run only plans, so it also works
+// while the control plane is frozen.
+func (s *Service) RunDescheduler(ctx context.Context, in *controlv1.RunDeschedulerRequest) (*controlv1.RunDeschedulerResponse, error) {
+	if in == nil {
+		in = &controlv1.RunDeschedulerRequest{}
+	}
+	annotateRPC(ctx,
+		attribute.Bool("scheduler.dry_run", in.GetDryRun()),
+		attribute.Int("scheduler.max_moves", int(in.GetMaxMoves())),
+	)
+	if !in.GetDryRun() && !s.sched.IsWritable() {
+		return &controlv1.RunDeschedulerResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if !in.GetDryRun() {
+		// Moving workloads is the leader's job, like every reconcile pass.
+		if err := s.sched.RequireLeader(); err != nil {
+			return &controlv1.RunDeschedulerResponse{Success: false, ErrorMessage: err.Error()}, nil
+		}
+	}
+	if in.GetMaxMoves() < 0 {
+		err := status.Error(codes.InvalidArgument, "max_moves must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	moves, err := s.sched.RunDescheduler(scheduler.DeschedulerOptions{
+		DryRun:     in.GetDryRun(),
+		Strategies: in.GetStrategies(),
+		MaxMoves:   int(in.GetMaxMoves()),
+	})
+	if err != nil {
+		return &controlv1.RunDeschedulerResponse{Success: false, ErrorMessage: err.Error(), DryRun: in.GetDryRun()}, nil
+	}
+	resp := &controlv1.RunDeschedulerResponse{Success: true, DryRun: in.GetDryRun()}
+	for _, move := range moves {
+		resp.Moves = append(resp.Moves, &controlv1.DeschedulerMove{
+			WorkloadId: move.WorkloadID,
+			Strategy:   move.Strategy,
+			FromNodeId: move.FromNode,
+			ToNodeId:   move.ToNode,
+			Reason:     move.Reason,
+			Executed:   move.Executed,
+			Error:      move.Error,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,166 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const frozenControlPlaneMessage = "scheduler degraded/recovery mode; control plane frozen"
+
+func (s *Service) ApplyReplicaSet(ctx context.Context, in *controlv1.ApplyReplicaSetRequest) (*controlv1.ApplyReplicaSetResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.replica_set_id", strings.TrimSpace(in.GetReplicaSetId())),
+			attribute.Int("scheduler.replicas", int(in.GetReplicas())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyReplicaSetResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || in.GetTemplate() == nil {
+		err := status.Error(codes.InvalidArgument, "template is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if in.GetReplicas() < 0 {
+		err := status.Error(codes.InvalidArgument, "replicas must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	template, err := controlApplyToModel(&controlv1.ApplyWorkloadRequest{
+		WorkloadId:   in.GetReplicaSetId(),
+		Spec:         in.GetTemplate(),
+		DesiredState: in.GetDesiredState(),
+	})
+	if err != nil {
+		return &controlv1.ApplyReplicaSetResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	annotateRPC(ctx, attribute.String("scheduler.workload_type", strings.TrimSpace(template.Type)))
+
+	rs, err := s.sched.ApplyReplicaSet(models.ReplicaSet{
+		ID:           strings.TrimSpace(in.GetReplicaSetId()),
+		Replicas:     int(in.GetReplicas()),
+		DesiredState: template.DesiredState,
+		Template:     template,
+	})
+	if err != nil {
+		return &controlv1.ApplyReplicaSetResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyReplicaSetResponse{Success: true, ReplicaSet: replicaSetToView(s.convergeReplicaSet(rs))}, nil
+}
+
+func (s *Service) ScaleReplicaSet(ctx context.Context, in *controlv1.ScaleReplicaSetRequest) (*controlv1.ScaleReplicaSetResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.replica_set_id", strings.TrimSpace(in.GetReplicaSetId())),
+			attribute.Int("scheduler.replicas", int(in.GetReplicas())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ScaleReplicaSetResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetReplicaSetId()) == "" {
+		err := status.Error(codes.InvalidArgument, "replica_set_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if in.GetReplicas() < 0 {
+		err := status.Error(codes.InvalidArgument, "replicas must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	rs, err := s.sched.ScaleReplicaSet(strings.TrimSpace(in.GetReplicaSetId()), int(in.GetReplicas()))
+	if err != nil {
+		return &controlv1.ScaleReplicaSetResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ScaleReplicaSetResponse{Success: true, ReplicaSet: replicaSetToView(s.convergeReplicaSet(rs))}, nil
+}
+
+func (s *Service) DeleteReplicaSet(ctx context.Context, in *controlv1.DeleteReplicaSetRequest) (*controlv1.DeleteReplicaSetResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.replica_set_id", strings.TrimSpace(in.GetReplicaSetId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteReplicaSetResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetReplicaSetId()) == "" {
+		err := status.Error(codes.InvalidArgument, "replica_set_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteReplicaSet(strings.TrimSpace(in.GetReplicaSetId())); err != nil {
+		return &controlv1.DeleteReplicaSetResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteReplicaSetResponse{Success: true}, nil
+}
+
+func (s *Service) GetReplicaSet(ctx context.Context, in *controlv1.GetReplicaSetRequest) (*controlv1.GetReplicaSetResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.replica_set_id", strings.TrimSpace(in.GetReplicaSetId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetReplicaSetId()) == "" {
+		err := status.Error(codes.InvalidArgument, "replica_set_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	rs, err := s.sched.GetReplicaSet(strings.TrimSpace(in.GetReplicaSetId()))
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "replica set %q not found", in.GetReplicaSetId())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetReplicaSetResponse{ReplicaSet: replicaSetToView(rs)}, nil
+}
+
+func (s *Service) ListReplicaSets(ctx context.Context, _ *controlv1.ListReplicaSetsRequest) (*controlv1.ListReplicaSetsResponse, error) {
+	sets, err := s.sched.ListReplicaSets()
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.ReplicaSetView, 0, len(sets))
+	for _, rs := range sets {
+		out = append(out, replicaSetToView(rs))
+	}
+	return &controlv1.ListReplicaSetsResponse{ReplicaSets: out}, nil
+}
+
+// convergeReplicaSet runs a replica set pass right away so callers see the
+// children that were created or removed, instead of waiting for the next tick.
+// Followers leave the pass to the leader.
+func (s *Service) convergeReplicaSet(rs models.ReplicaSet) models.ReplicaSet {
+	if !s.sched.IsLeader() {
+		return rs
+	}
+	if err := s.sched.ReconcileReplicaSets(); err != nil {
+		return rs
+	}
+	if refreshed, err := s.sched.GetReplicaSet(rs.ID); err == nil {
+		return refreshed
+	}
+	return rs
+}
+
+func replicaSetToView(rs models.ReplicaSet) *controlv1.ReplicaSetView {
+	return &controlv1.ReplicaSetView{
+		ReplicaSetId:     rs.ID,
+		Type:             rs.Template.Type,
+		DesiredState:     rs.DesiredState,
+		Replicas:         int32(rs.Replicas),
+		CurrentReplicas:  int32(rs.Status.CurrentReplicas),
+		ReadyReplicas:    int32(rs.Status.ReadyReplicas),
+		UpdatedReplicas:  int32(rs.Status.UpdatedReplicas),
+		TemplateRevision: rs.TemplateRevision,
+		WorkloadIds:      append([]string(nil), rs.Status.WorkloadIDs...),
+		CreatedAt:        timestampPtr(rs.CreatedAt),
+		UpdatedAt:        timestampPtr(rs.UpdatedAt),
+		LastScaledAt:     timestampPtr(rs.Status.LastScaledAt),
+	}
+}
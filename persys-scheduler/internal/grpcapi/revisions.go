@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ListWorkloadRevisions(ctx context.Context, in *controlv1.ListWorkloadRevisionsRequest) (*controlv1.ListWorkloadRevisionsResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetWorkloadId()) == "" {
+		err := status.Error(codes.InvalidArgument, "workload_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	workloadID := strings.TrimSpace(in.GetWorkloadId())
+	workload, err := s.sched.GetWorkloadByID(workloadID)
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "workload %q not found", workloadID)
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	revisions, err := s.sched.ListWorkloadRevisions(workloadID)
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.WorkloadRevisionView, 0, len(revisions))
+	for _, rev := range revisions {
+		out = append(out, revisionToView(rev, workload.RevisionID))
+	}
+	return &controlv1.ListWorkloadRevisionsResponse{Revisions: out}, nil
+}
+
+// RollbackWorkload reapplies a stored revision and runs a reconcile pass so
+// the agent picks it up right away.
+func (s *Service) RollbackWorkload(ctx context.Context, in *controlv1.RollbackWorkloadRequest) (*controlv1.RollbackWorkloadResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())),
+			attribute.String("scheduler.revision_id", strings.TrimSpace(in.GetRevisionId())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.RollbackWorkloadResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetWorkloadId()) == "" {
+		err := status.Error(codes.InvalidArgument, "workload_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	workload, err := s.sched.RollbackWorkload(strings.TrimSpace(in.GetWorkloadId()), in.GetRevisionId())
+	if err != nil {
+		return &controlv1.RollbackWorkloadResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	if err := s.reconcileWorkloadNow(ctx, workload); err != nil {
+		return &controlv1.RollbackWorkloadResponse{Success: false, ErrorMessage: err.Error(), Workload: workloadToView(workload)}, nil
+	}
+	if refreshed, err := s.sched.GetWorkloadByID(workload.ID); err == nil {
+		workload = refreshed
+	}
+	return &controlv1.RollbackWorkloadResponse{Success: true, Workload: workloadToView(workload)}, nil
+}
+
+func revisionToView(rev models.WorkloadRevision, currentRevisionID string) *controlv1.WorkloadRevisionView {
+	return &controlv1.WorkloadRevisionView{
+		RevisionId:  rev.RevisionID,
+		Revision:    int32(rev.Revision),
+		ChangeCause: rev.ChangeCause,
+		CreatedAt:   timestampPtr(rev.CreatedAt),
+		Current:     rev.RevisionID == currentRevisionID,
+		Type:        rev.Spec.Type,
+		Image:       rev.Spec.Image,
+	}
+}
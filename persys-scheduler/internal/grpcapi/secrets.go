@@ -0,0 +1,230 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func annotateObject(ctx context.Context, kind, namespace, name string) {
+	annotateRPC(ctx,
+		attribute.String("scheduler.namespace", strings.TrimSpace(namespace)),
+		attribute.String("scheduler."+kind, strings.TrimSpace(name)),
+	)
+}
+
+func (s *Service) ApplySecret(ctx context.Context, in *controlv1.ApplySecretRequest) (*controlv1.ApplySecretResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "secret", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplySecretResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	secret, err := s.sched.ApplySecret(models.Secret{
+		Namespace: in.GetNamespace(),
+		Name:      in.GetName(),
+		Labels:    in.GetLabels(),
+		Data:      in.GetData(),
+	})
+	if err != nil {
+		return &controlv1.ApplySecretResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplySecretResponse{Success: true, Secret: secretToView(secret)}, nil
+}
+
+func (s *Service) DeleteSecret(ctx context.Context, in *controlv1.DeleteSecretRequest) (*controlv1.DeleteSecretResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "secret", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteSecretResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteSecret(in.GetNamespace(), in.GetName()); err != nil {
+		return &controlv1.DeleteSecretResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteSecretResponse{Success: true}, nil
+}
+
+func (s *Service) GetSecret(ctx context.Context, in *controlv1.GetSecretRequest) (*controlv1.GetSecretResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "secret", in.GetNamespace(), in.GetName())
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	secret, err := s.sched.GetSecret(in.GetNamespace(), in.GetName())
+	if err != nil {
+		rpcErr := status.Error(codes.NotFound, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetSecretResponse{Secret: secretToView(secret)}, nil
+}
+
+func (s *Service) ListSecrets(ctx context.Context, in *controlv1.ListSecretsRequest) (*controlv1.ListSecretsResponse, error) {
+	secrets, err := s.sched.ListSecrets(in.GetNamespace())
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.SecretView, 0, len(secrets))
+	for _, secret := range secrets {
+		out = append(out, secretToView(secret))
+	}
+	return &controlv1.ListSecretsResponse{Secrets: out}, nil
+}
+
+func (s *Service) ApplyConfig(ctx context.Context, in *controlv1.ApplyConfigRequest) (*controlv1.ApplyConfigResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "config", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyConfigResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	config, err := s.sched.ApplyConfig(models.Config{
+		Namespace: in.GetNamespace(),
+		Name:      in.GetName(),
+		Labels:    in.GetLabels(),
+		Data:      in.GetData(),
+	})
+	if err != nil {
+		return &controlv1.ApplyConfigResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyConfigResponse{Success: true, Config: configToView(config)}, nil
+}
+
+func (s *Service) DeleteConfig(ctx context.Context, in *controlv1.DeleteConfigRequest) (*controlv1.DeleteConfigResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "config", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteConfigResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteConfig(in.GetNamespace(), in.GetName()); err != nil {
+		return &controlv1.DeleteConfigResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteConfigResponse{Success: true}, nil
+}
+
+func (s *Service) GetConfig(ctx context.Context, in *controlv1.GetConfigRequest) (*controlv1.GetConfigResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "config", in.GetNamespace(), in.GetName())
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	config, err := s.sched.GetConfig(in.GetNamespace(), in.GetName())
+	if err != nil {
+		rpcErr := status.Error(codes.NotFound, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetConfigResponse{Config: configToView(config)}, nil
+}
+
+func (s *Service) ListConfigs(ctx context.Context, in *controlv1.ListConfigsRequest) (*controlv1.ListConfigsResponse, error) {
+	configs, err := s.sched.ListConfigs(in.GetNamespace())
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.ConfigView, 0, len(configs))
+	for _, config := range configs {
+		out = append(out, configToView(config))
+	}
+	return &controlv1.ListConfigsResponse{Configs: out}, nil
+}
+
+func secretToView(secret models.Secret) *controlv1.SecretView {
+	return &controlv1.SecretView{
+		Namespace: secret.Namespace,
+		Name:      secret.Name,
+		Labels:    copyStringMap(secret.Labels),
+		Keys:      append([]string(nil), secret.Keys...),
+		Version:   int32(secret.Version),
+		CreatedAt: timestampPtr(secret.CreatedAt),
+		UpdatedAt: timestampPtr(secret.UpdatedAt),
+	}
+}
+
+func configToView(config models.Config) *controlv1.ConfigView {
+	return &controlv1.ConfigView{
+		Namespace: config.Namespace,
+		Name:      config.Name,
+		Labels:    copyStringMap(config.Labels),
+		Data:      copyStringMap(config.Data),
+		Version:   int32(config.Version),
+		CreatedAt: timestampPtr(config.CreatedAt),
+		UpdatedAt: timestampPtr(config.UpdatedAt),
+	}
+}
+
+func toModelEnvFrom(in []*controlv1.EnvFromSource) []models.EnvFromSource {
+	var out []models.EnvFromSource
+	for _, src := range in {
+		out = append(out, models.EnvFromSource{
+			Secret: strings.TrimSpace(src.GetSecret()),
+			Config: strings.TrimSpace(src.GetConfig()),
+			Prefix: src.GetPrefix(),
+		})
+	}
+	return out
+}
+
+func toModelFiles(in []*controlv1.FileMount) []models.FileMount {
+	var out []models.FileMount
+	for _, f := range in {
+		out = append(out, models.FileMount{
+			Secret: strings.TrimSpace(f.GetSecret()),
+			Config: strings.TrimSpace(f.GetConfig()),
+			Key:    strings.TrimSpace(f.GetKey()),
+			Path:   strings.TrimSpace(f.GetPath()),
+			Mode:   int(f.GetMode()),
+		})
+	}
+	return out
+}
+
+func toModelTemplateVars(in []*controlv1.TemplateVar) []models.TemplateVar {
+	var out []models.TemplateVar
+	for _, v := range in {
+		out = append(out, models.TemplateVar{
+			Name:   strings.TrimSpace(v.GetName()),
+			Secret: strings.TrimSpace(v.GetSecret()),
+			Config: strings.TrimSpace(v.GetConfig()),
+			Key:    strings.TrimSpace(v.GetKey()),
+		})
+	}
+	return out
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -74,7 +75,15 @@ func (s *Service) RegisterNode(ctx context.Context, in *controlv1.RegisterNodeRe
 		TotalMemory:             in.GetCapabilities().GetMemoryTotalMb(),
 		SupportedWorkloadTypes:  normalizeSupportedWorkloadTypes(in.GetCapabilities().GetSupportedWorkloadTypes()),
 		SupportedStorageDrivers: normalizeSupportedStorageDrivers(in.GetCapabilities().GetSupportedStorageDrivers()),
+		StoragePools:            toModelStoragePools(in.GetCapabilities().GetStoragePools()),
 	}
+	taints, err := toModelTaints(in.GetTaints())
+	if err != nil {
+		rpcErr := status.Error(codes.InvalidArgument, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	node.Taints = taints
 
 	if endpoint := strings.TrimSpace(in.GetGrpcEndpoint()); endpoint != "" {
 		node.AgentEndpoint = endpoint
@@ -153,7 +162,9 @@ func (s *Service) Heartbeat(ctx context.Context, in *controlv1.HeartbeatRequest)
 
 	availableCPU := currentNode.AvailableCPU
 	availableMemory := currentNode.AvailableMemory
+	var diskUsage *models.NodeDiskUsage
 	if in.GetUsage() != nil {
+		diskUsage = toModelNodeDiskUsage(in.GetUsage())
 		totalMillicores := int64(currentNode.TotalCPU * 1000.0)
 		if totalMillicores > 0 {
 			availableCPU = float64(maxInt64(0, totalMillicores-in.GetUsage().GetCpuUsedMillicores())) / 1000.0
@@ -163,27 +174,25 @@ func (s *Service) Heartbeat(ctx context.Context, in *controlv1.HeartbeatRequest)
 		}
 	}
 
-	if err := s.sched.UpdateNodeHeartbeat(in.GetNodeId(), "Ready", availableCPU, availableMemory); err != nil {
+	if err := s.sched.UpdateNodeHeartbeat(in.GetNodeId(), "Ready", availableCPU, availableMemory, diskUsage); err != nil {
 		rpcErr := status.Error(codes.Internal, err.Error())
 		recordRPCError(ctx, rpcErr)
 		return nil, rpcErr
 	}
 
 	for _, ws := range in.GetWorkloadStatuses() {
-		if strings.TrimSpace(ws.GetWorkloadId()) == "" {
-			continue
-		}
-		_ = s.sched.UpdateWorkloadStatus(ws.GetWorkloadId(), ws.GetState())
-		if msg := strings.TrimSpace(ws.GetMessage()); msg != "" {
-			_ = s.sched.UpdateWorkloadLogs(ws.GetWorkloadId(), msg)
-		}
-		reason := reasonFromProto(ws.GetReason(), ws.GetLastTransition())
-		usage := usageFromProto(ws.GetUsage(), ws.GetWorkloadId(), "")
-		if reason != nil || usage != nil {
-			_ = s.sched.UpdateWorkloadRuntimeDetails(ws.GetWorkloadId(), reason, usage)
-		}
+		s.recordWorkloadStatus(ws)
 	}
 
+	reportedCPU, reportedMemory := -1.0, int64(-1)
+	if in.GetUsage() != nil {
+		reportedCPU = float64(in.GetUsage().GetCpuAllocatedMillicores()) / 1000.0
+		reportedMemory = in.GetUsage().GetMemoryAllocatedMb()
+	}
+	// Best effort: a failed resync is logged by the scheduler and retried on
+	// the next heartbeat.
+	_ = s.sched.ReconcileNodeAllocations(in.GetNodeId(), reportedCPU, reportedMemory)
+
 	for _, usage := range in.GetWorkloadUsage() {
 		workloadID := strings.TrimSpace(usage.GetWorkloadId())
 		if workloadID == "" {
@@ -196,7 +205,28 @@ func (s *Service) Heartbeat(ctx context.Context, in *controlv1.HeartbeatRequest)
 	}
 
 	lease := time.Now().UTC().Add(3 * time.Minute)
-	return &controlv1.HeartbeatResponse{Acknowledged: true, DrainNode: false, LeaseExpiresAt: timestamppb.New(lease)}, nil
+	return &controlv1.HeartbeatResponse{Acknowledged: true, DrainNode: currentNode.Drain != nil, LeaseExpiresAt: timestamppb.New(lease)}, nil
+}
+
+func (s *Service) recordWorkloadStatus(ws *controlv1.WorkloadStatus) {
+	if strings.TrimSpace(ws.GetWorkloadId()) == "" {
+		return
+	}
+	if ws.GetExited() {
+		_ = s.sched.RecordWorkloadExit(ws.GetWorkloadId(), int(ws.GetExitCode()))
+	}
+	_ = s.sched.UpdateWorkloadStatus(ws.GetWorkloadId(), ws.GetState())
+	if msg := strings.TrimSpace(ws.GetMessage()); msg != "" {
+		_ = s.sched.UpdateWorkloadLogs(ws.GetWorkloadId(), msg)
+	}
+	reason := reasonFromProto(ws.GetReason(), ws.GetLastTransition())
+	usage := usageFromProto(ws.GetUsage(), ws.GetWorkloadId(), "")
+	if reason != nil || usage != nil {
+		_ = s.sched.UpdateWorkloadRuntimeDetails(ws.GetWorkloadId(), reason, usage)
+	}
+	if len(ws.GetProbeResults()) > 0 {
+		_ = s.sched.RecordProbeResults(ws.GetWorkloadId(), probeResultsFromProto(ws.GetProbeResults()))
+	}
 }
 
 func (s *Service) ApplyWorkload(ctx context.Context, in *controlv1.ApplyWorkloadRequest) (*controlv1.ApplyWorkloadResponse, error) {
@@ -227,7 +257,7 @@ func (s *Service) ApplyWorkload(ctx context.Context, in *controlv1.ApplyWorkload
 		if err != nil {
 			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: err.Error()}, nil
 		}
-		if _, err := s.sched.ReconcileWorkloadWithContext(ctx, updated); err != nil {
+		if err := s.reconcileWorkloadNow(ctx, updated); err != nil {
 			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: err.Error()}, nil
 		}
 		return &controlv1.ApplyWorkloadResponse{Success: true}, nil
@@ -243,25 +273,36 @@ func (s *Service) ApplyWorkload(ctx context.Context, in *controlv1.ApplyWorkload
 	if _, err := s.sched.GetWorkloadByID(workload.ID); err == nil {
 		updated, err := s.sched.UpdateWorkloadSpec(workload.ID, workload)
 		if err != nil {
-			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: err.Error()}, nil
+			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: applyFailureReason(err), ErrorMessage: err.Error()}, nil
 		}
 		persisted = updated
 	} else {
 		created, err := s.sched.CreateWorkload(workload)
 		if err != nil {
-			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: err.Error()}, nil
+			return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: applyFailureReason(err), ErrorMessage: err.Error()}, nil
 		}
 		persisted = created
 	}
 
 	// Trigger reconciliation immediately so ApplyWorkload performs scheduling now,
 	// instead of waiting for the periodic reconciliation tick.
-	if _, err := s.sched.ReconcileWorkloadWithContext(ctx, persisted); err != nil {
+	if err := s.reconcileWorkloadNow(ctx, persisted); err != nil {
 		return &controlv1.ApplyWorkloadResponse{Success: false, FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: err.Error()}, nil
 	}
 	return &controlv1.ApplyWorkloadResponse{Success: true}, nil
 }
 
+// reconcileWorkloadNow reconciles w right away so the caller sees it scheduled
+// instead of waiting for the next tick. Reconciling is the leader's job; on a
+// follower the stored change is picked up by the leader's next pass.
+func (s *Service) reconcileWorkloadNow(ctx context.Context, w models.Workload) error {
+	if !s.sched.IsLeader() {
+		return nil
+	}
+	_, err := s.sched.ReconcileWorkloadWithContext(ctx, w)
+	return err
+}
+
 func (s *Service) DeleteWorkload(ctx context.Context, in *controlv1.DeleteWorkloadRequest) (*controlv1.DeleteWorkloadResponse, error) {
 	if in != nil {
 		annotateRPC(ctx, attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())))
@@ -274,6 +315,12 @@ func (s *Service) DeleteWorkload(ctx context.Context, in *controlv1.DeleteWorklo
 		recordRPCError(ctx, err)
 		return nil, err
 	}
+	if ns := strings.TrimSpace(in.GetNamespace()); ns != "" {
+		workload, err := s.sched.GetWorkloadByID(in.GetWorkloadId())
+		if err != nil || !inNamespace(workload, ns) {
+			return &controlv1.DeleteWorkloadResponse{Success: false, ErrorMessage: fmt.Sprintf("workload %s not found in namespace %s", in.GetWorkloadId(), ns)}, nil
+		}
+	}
 	if err := s.sched.MarkWorkloadDeleted(in.GetWorkloadId()); err != nil {
 		return &controlv1.DeleteWorkloadResponse{Success: false, ErrorMessage: err.Error()}, nil
 	}
@@ -352,7 +399,7 @@ func (s *Service) SubmitAutomationSuggestion(ctx context.Context, in *controlv1.
 				DecidedAt:     timestamppb.Now(),
 			}, nil
 		}
-		if _, err := s.sched.ReconcileWorkloadWithContext(ctx, updated); err != nil {
+		if err := s.reconcileWorkloadNow(ctx, updated); err != nil {
 			return &controlv1.SubmitAutomationSuggestionResponse{
 				Accepted:      false,
 				Decision:      "rejected",
@@ -407,12 +454,57 @@ func (s *Service) SubmitAutomationSuggestion(ctx context.Context, in *controlv1.
 		}, nil
 	case controlv1.AutomationActionType_AUTOMATION_ACTION_SCALE_REPLICAS:
 		// Scheduler remains authoritative and can reject unsupported/unsafe requests.
-		_ = s.sched.UpdateWorkloadLogs(target, logPrefix+" decision=rejected reason=replica updates not supported yet")
+		replicaSetID, err := s.sched.ReplicaSetIDForTarget(target)
+		if err != nil {
+			_ = s.sched.UpdateWorkloadLogs(target, logPrefix+" decision=rejected reason="+err.Error())
+			return &controlv1.SubmitAutomationSuggestionResponse{
+				Accepted:      false,
+				Decision:      "rejected",
+				Reason:        err.Error(),
+				AppliedAction: "",
+				DecidedAt:     timestamppb.Now(),
+			}, nil
+		}
+		rs, err := s.sched.GetReplicaSet(replicaSetID)
+		if err != nil {
+			return &controlv1.SubmitAutomationSuggestionResponse{
+				Accepted:      false,
+				Decision:      "rejected",
+				Reason:        err.Error(),
+				AppliedAction: "",
+				DecidedAt:     timestamppb.Now(),
+			}, nil
+		}
+		desired := int(suggestion.GetDesiredReplicas())
+		if desired <= 0 {
+			if suggestion.GetReplicaDelta() == 0 {
+				return &controlv1.SubmitAutomationSuggestionResponse{
+					Accepted:      false,
+					Decision:      "rejected",
+					Reason:        "desired_replicas or replica_delta is required for scale_replicas action",
+					AppliedAction: "",
+					DecidedAt:     timestamppb.Now(),
+				}, nil
+			}
+			desired = rs.Replicas + int(suggestion.GetReplicaDelta())
+		}
+		scaled, err := s.sched.ScaleReplicaSet(replicaSetID, desired)
+		if err != nil {
+			return &controlv1.SubmitAutomationSuggestionResponse{
+				Accepted:      false,
+				Decision:      "rejected",
+				Reason:        err.Error(),
+				AppliedAction: "",
+				DecidedAt:     timestamppb.Now(),
+			}, nil
+		}
+		s.convergeReplicaSet(scaled)
+		_ = s.sched.UpdateWorkloadLogs(target, logPrefix+" decision=accepted")
 		return &controlv1.SubmitAutomationSuggestionResponse{
-			Accepted:      false,
-			Decision:      "rejected",
-			Reason:        "replica updates are not supported by scheduler contract yet",
-			AppliedAction: "",
+			Accepted:      true,
+			Decision:      "accepted",
+			Reason:        fmt.Sprintf("replica set %s scaled from %d to %d replicas", replicaSetID, rs.Replicas, scaled.Replicas),
+			AppliedAction: "scale_replicas",
 			DecidedAt:     timestamppb.Now(),
 		}, nil
 	default:
@@ -443,7 +535,7 @@ func (s *Service) ListNodes(ctx context.Context, in *controlv1.ListNodesRequest)
 		if filterStatus != "" && strings.ToLower(strings.TrimSpace(node.Status)) != filterStatus {
 			continue
 		}
-		out = append(out, nodeToView(node))
+		out = append(out, s.nodeView(node))
 	}
 	return &controlv1.ListNodesResponse{Nodes: out}, nil
 }
@@ -463,7 +555,7 @@ func (s *Service) GetNode(ctx context.Context, in *controlv1.GetNodeRequest) (*c
 		recordRPCError(ctx, rpcErr)
 		return nil, rpcErr
 	}
-	return &controlv1.GetNodeResponse{Node: nodeToView(node)}, nil
+	return &controlv1.GetNodeResponse{Node: s.nodeView(node)}, nil
 }
 
 func (s *Service) ListWorkloads(ctx context.Context, in *controlv1.ListWorkloadsRequest) (*controlv1.ListWorkloadsResponse, error) {
@@ -471,22 +563,33 @@ func (s *Service) ListWorkloads(ctx context.Context, in *controlv1.ListWorkloads
 		annotateRPC(ctx,
 			attribute.String("scheduler.filter_node_id", strings.TrimSpace(in.GetNodeId())),
 			attribute.String("scheduler.filter_status", strings.TrimSpace(in.GetStatus())),
+			attribute.String("scheduler.filter_namespace", strings.TrimSpace(in.GetNamespace())),
 		)
 	}
-	workloads, err := s.sched.GetWorkloads()
+	filterNodeID := strings.TrimSpace(in.GetNodeId())
+	filterStatus := strings.ToLower(strings.TrimSpace(in.GetStatus()))
+	filterNamespace := strings.TrimSpace(in.GetNamespace())
+	var workloads []models.Workload
+	var err error
+	if filterStatus != "" {
+		workloads, err = s.sched.GetWorkloadsByStatus(filterStatus)
+	} else {
+		workloads, err = s.sched.GetWorkloads()
+	}
 	if err != nil {
 		rpcErr := status.Error(codes.Internal, err.Error())
 		recordRPCError(ctx, rpcErr)
 		return nil, rpcErr
 	}
 
-	filterNodeID := strings.TrimSpace(in.GetNodeId())
-	filterStatus := strings.ToLower(strings.TrimSpace(in.GetStatus()))
 	out := make([]*controlv1.WorkloadView, 0, len(workloads))
 	for _, workload := range workloads {
 		if filterNodeID != "" && assignedNodeID(workload) != filterNodeID {
 			continue
 		}
+		if filterNamespace != "" && !inNamespace(workload, filterNamespace) {
+			continue
+		}
 		if filterStatus != "" && strings.ToLower(strings.TrimSpace(workload.Status)) != filterStatus {
 			continue
 		}
@@ -505,6 +608,9 @@ func (s *Service) GetWorkload(ctx context.Context, in *controlv1.GetWorkloadRequ
 		return nil, err
 	}
 	workload, err := s.sched.GetWorkloadByID(in.GetWorkloadId())
+	if err == nil && strings.TrimSpace(in.GetNamespace()) != "" && !inNamespace(workload, in.GetNamespace()) {
+		err = fmt.Errorf("workload %s is not in namespace %s", in.GetWorkloadId(), in.GetNamespace())
+	}
 	if err != nil {
 		rpcErr := status.Errorf(codes.NotFound, "workload %q not found", in.GetWorkloadId())
 		recordRPCError(ctx, rpcErr)
@@ -548,6 +654,11 @@ func (s *Service) GetClusterSummary(ctx context.Context, _ *controlv1.GetCluster
 		switch statusValue {
 		case "running":
 			resp.RunningWorkloads++
+			if scheduler.WorkloadReady(workload) {
+				resp.ReadyWorkloads++
+			} else {
+				resp.UnhealthyWorkloads++
+			}
 		case "failed":
 			resp.FailedWorkloads++
 		case "pending", "unknown", "updating":
@@ -561,10 +672,48 @@ func (s *Service) GetClusterSummary(ctx context.Context, _ *controlv1.GetCluster
 	return resp, nil
 }
 
-func (s *Service) ControlStream(stream controlv1.AgentControl_ControlStreamServer) error {
-	err := status.Error(codes.Unimplemented, "ControlStream is not implemented yet")
-	recordRPCError(stream.Context(), err)
-	return err
+func (s *Service) ListPendingWorkloads(ctx context.Context, _ *controlv1.ListPendingWorkloadsRequest) (*controlv1.ListPendingWorkloadsResponse, error) {
+	pending, err := s.sched.ListPendingWorkloads()
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.PendingWorkloadView, 0, len(pending))
+	for _, entry := range pending {
+		out = append(out, pendingWorkloadToView(entry))
+	}
+	return &controlv1.ListPendingWorkloadsResponse{Workloads: out}, nil
+}
+
+func pendingWorkloadToView(entry models.PendingWorkload) *controlv1.PendingWorkloadView {
+	rejections := make([]*controlv1.NodeRejection, 0, len(entry.Rejections))
+	for _, r := range entry.Rejections {
+		rejections = append(rejections, &controlv1.NodeRejection{NodeId: r.NodeID, Filter: r.Filter, Reason: r.Reason})
+	}
+	return &controlv1.PendingWorkloadView{
+		WorkloadId:    entry.WorkloadID,
+		Priority:      int32(entry.Priority),
+		PriorityClass: entry.PriorityClass,
+		QueuedAt:      timestampPtr(entry.QueuedAt),
+		LastAttemptAt: timestampPtr(entry.LastAttemptAt),
+		NextAttemptAt: timestampPtr(entry.NextAttemptAt),
+		Attempts:      int32(entry.Attempts),
+		Message:       entry.Message,
+		Rejections:    rejections,
+	}
+}
+
+// nodeView is nodeToView plus the node's allocation ledger totals.
+func (s *Service) nodeView(node models.Node) *controlv1.NodeView {
+	view := nodeToView(node)
+	if allocated, err := s.sched.GetNodeAllocation(node.NodeID); err == nil {
+		view.AllocatedCpuCores = allocated.CPU
+		view.AllocatedMemoryMb = allocated.MemoryMB
+		view.AllocatedDiskGb = allocated.DiskGB
+		view.AllocatedWorkloads = int32(allocated.Workloads)
+	}
+	return view
 }
 
 func nodeToView(node models.Node) *controlv1.NodeView {
@@ -582,12 +731,38 @@ func nodeToView(node models.Node) *controlv1.NodeView {
 		AvailableMemoryMb:      node.AvailableMemory,
 		SupportedWorkloadTypes: append([]string(nil), node.SupportedWorkloadTypes...),
 		Labels:                 copyStringMap(node.Labels),
+		StoragePools:           storagePoolsToView(node.StoragePools),
+		Unschedulable:          node.Unschedulable,
+		Taints:                 taintsToView(node.Taints),
+		OperatorTaints:         taintsToView(node.OperatorTaints),
+		Drain:                  nodeDrainToView(node.Drain),
+		UsedCpuCores:           nonNegativeFloat(node.TotalCPU - node.AvailableCPU),
+		UsedMemoryMb:           maxInt64(0, node.TotalMemory-node.AvailableMemory),
+		UsedDiskGb:             node.DiskUsedGB,
+	}
+}
+
+func storagePoolsToView(pools []models.StoragePool) []*controlv1.StoragePoolStatus {
+	if len(pools) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.StoragePoolStatus, 0, len(pools))
+	for _, pool := range pools {
+		out = append(out, &controlv1.StoragePoolStatus{
+			Name:        pool.Name,
+			Type:        pool.Type,
+			TotalGb:     pool.TotalGB,
+			AllocatedGb: pool.AllocatedGB,
+			UsedGb:      pool.UsedGB,
+		})
 	}
+	return out
 }
 
 func workloadToView(workload models.Workload) *controlv1.WorkloadView {
 	return &controlv1.WorkloadView{
 		WorkloadId:       workload.ID,
+		Namespace:        scheduler.WorkloadNamespace(workload),
 		Type:             workload.Type,
 		DesiredState:     workload.DesiredState,
 		Status:           workloadStatusForView(workload),
@@ -600,6 +775,60 @@ func workloadToView(workload models.Workload) *controlv1.WorkloadView {
 		LastUpdated:      workloadLastUpdated(workload),
 		Reason:           reasonToProto(workload.StatusInfo.Reason, workload.StatusInfo.LastUpdated),
 		Usage:            usageToProto(workload.Usage, workload.ID, workload.Type),
+		Priority:         int32(workload.Priority),
+		PriorityClass:    workload.PriorityClass,
+		Rollout:          rolloutToView(workload.StatusInfo.Rollout),
+		Ready:            scheduler.WorkloadReady(workload),
+		Health:           healthToView(workload.StatusInfo.Health),
+		Ports:            placedPortsToView(workload),
+	}
+}
+
+func placedPortsToView(workload models.Workload) []*controlv1.Port {
+	mappings := scheduler.PlacedPorts(workload)
+	if len(mappings) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.Port, 0, len(mappings))
+	for _, pm := range mappings {
+		out = append(out, &controlv1.Port{HostPort: pm.GetHostPort(), ContainerPort: pm.GetContainerPort(), Protocol: pm.GetProtocol()})
+	}
+	return out
+}
+
+// inNamespace reports whether workload belongs to namespace; an empty
+// namespace name means the default one.
+func inNamespace(workload models.Workload, namespace string) bool {
+	want := strings.ToLower(strings.TrimSpace(namespace))
+	if want == "" {
+		want = scheduler.DefaultNamespace
+	}
+	return scheduler.WorkloadNamespace(workload) == want
+}
+
+func applyFailureReason(err error) controlv1.FailureReason {
+	switch {
+	case errors.Is(err, scheduler.ErrQuotaExceeded):
+		return controlv1.FailureReason_QUOTA_EXCEEDED
+	case errors.Is(err, scheduler.ErrInvalidObjectID):
+		return controlv1.FailureReason_INVALID_SPEC
+	}
+	return controlv1.FailureReason_RUNTIME_ERROR
+}
+
+func rolloutToView(ro *models.RolloutStatus) *controlv1.RolloutStatusView {
+	if ro == nil {
+		return nil
+	}
+	return &controlv1.RolloutStatusView{
+		Strategy:           ro.Strategy,
+		Revision:           int32(ro.Revision),
+		RevisionId:         ro.RevisionID,
+		PreviousRevisionId: ro.PreviousRevisionID,
+		Phase:              ro.Phase,
+		Message:            ro.Message,
+		StartedAt:          timestampPtr(ro.StartedAt),
+		CompletedAt:        timestampPtr(ro.CompletedAt),
 	}
 }
 
@@ -842,6 +1071,51 @@ func splitHostPort(endpoint string) (string, int, error) {
 	return host, port, nil
 }
 
+func toModelStoragePools(in []*controlv1.StoragePool) []models.StoragePool {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]models.StoragePool, 0, len(in))
+	for _, pool := range in {
+		name := strings.TrimSpace(pool.GetName())
+		if name == "" {
+			continue
+		}
+		out = append(out, models.StoragePool{
+			Name:    name,
+			Type:    strings.ToLower(strings.TrimSpace(pool.GetType())),
+			TotalGB: maxInt64(0, pool.GetTotalGb()),
+		})
+	}
+	return out
+}
+
+func toModelNodeDiskUsage(in *controlv1.NodeUsage) *models.NodeDiskUsage {
+	usage := &models.NodeDiskUsage{
+		AllocatedGB: maxInt64(0, in.GetDiskAllocatedGb()),
+		UsedGB:      maxInt64(0, in.GetDiskUsedGb()),
+	}
+	for _, pool := range in.GetStoragePools() {
+		name := strings.TrimSpace(pool.GetName())
+		if name == "" {
+			continue
+		}
+		usage.Pools = append(usage.Pools, models.StoragePool{
+			Name:        name,
+			AllocatedGB: maxInt64(0, pool.GetAllocatedGb()),
+			UsedGB:      maxInt64(0, pool.GetUsedGb()),
+		})
+	}
+	return usage
+}
+
+func nonNegativeFloat(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	return v
+}
+
 func maxInt64(a, b int64) int64 {
 	if a > b {
 		return a
@@ -856,6 +1130,7 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 
 	w := models.Workload{
 		ID:           in.GetWorkloadId(),
+		Namespace:    strings.TrimSpace(in.GetSpec().GetNamespace()),
 		Type:         strings.ToLower(strings.TrimSpace(in.GetSpec().GetType())),
 		RevisionID:   in.GetRevisionId(),
 		DesiredState: normalizeDesiredStateString(in.GetDesiredState()),
@@ -878,6 +1153,22 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 	for k, v := range in.GetSpec().GetMetadata() {
 		w.Metadata[k] = v
 	}
+	w.Placement = toModelPlacement(in.GetSpec().GetPlacement())
+	if err := validatePlacement(w.Placement); err != nil {
+		return models.Workload{}, err
+	}
+	tolerations, err := toModelTolerations(in.GetSpec().GetTolerations())
+	if err != nil {
+		return models.Workload{}, err
+	}
+	w.Tolerations = tolerations
+	w.Priority = int(in.GetSpec().GetPriority())
+	w.PriorityClass = strings.TrimSpace(in.GetSpec().GetPriorityClass())
+	rollout, err := toModelRollout(in.GetSpec().GetRollout())
+	if err != nil {
+		return models.Workload{}, err
+	}
+	w.Rollout = rollout
 
 	switch strings.ToLower(in.GetSpec().GetType()) {
 	case "container":
@@ -886,7 +1177,7 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 			return models.Workload{}, fmt.Errorf("container spec required")
 		}
 		w.Image = cs.GetImage()
-		w.CommandList = append([]string{}, cs.GetCommand()...)
+		w.CommandList = append(append([]string{}, cs.GetCommand()...), cs.GetArgs()...)
 		w.Command = strings.Join(w.CommandList, " ")
 		w.EnvVars = cs.GetEnv()
 		w.RestartPolicy = cs.GetRestartPolicy()
@@ -905,6 +1196,8 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 			w.Volumes = append(w.Volumes, vol)
 		}
 		w.ManagedVolumes = toModelManagedVolumes(cs.GetManagedVolumes())
+		w.EnvFrom = toModelEnvFrom(cs.GetEnvFrom())
+		w.Files = toModelFiles(cs.GetFiles())
 	case "compose":
 		cp := in.GetSpec().GetCompose()
 		if cp == nil {
@@ -912,9 +1205,17 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 		}
 		w.Type = "compose"
 		w.EnvVars = cp.GetEnv()
+		w.EnvFrom = toModelEnvFrom(cp.GetEnvFrom())
+		w.Files = toModelFiles(cp.GetFiles())
+		if cp.GetGitToken() != "" {
+			return models.Workload{}, fmt.Errorf("git_token is not accepted; reference a secret with git_token_secret and git_token_key")
+		}
 		if strings.EqualFold(cp.GetSourceType(), "git") {
 			w.GitRepo = cp.GetGitRepo()
 			w.GitBranch = cp.GetGitRef()
+			if secret := strings.TrimSpace(cp.GetGitTokenSecret()); secret != "" {
+				w.GitTokenRef = &models.SecretKeyRef{Secret: secret, Key: strings.TrimSpace(cp.GetGitTokenKey())}
+			}
 		} else {
 			w.ComposeYAML = cp.GetInlineYaml()
 		}
@@ -942,12 +1243,14 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 			}
 		}
 		w.VM.ManagedVolumes = toModelManagedVolumes(vm.GetManagedVolumes())
+		w.VM.TemplateVars = toModelTemplateVars(vm.GetTemplateVars())
 		for _, d := range vm.GetDisks() {
 			device := strings.TrimSpace(d.GetMountPoint())
 			if device == "" {
 				device = "vda"
 			}
 			w.VM.Disks = append(w.VM.Disks, models.VMDiskConfig{
+				Pool:   strings.TrimSpace(d.GetPoolName()),
 				Type:   "disk",
 				SizeGB: d.GetSizeGb(),
 				Device: device,
@@ -961,6 +1264,17 @@ func controlApplyToModel(in *controlv1.ApplyWorkloadRequest) (models.Workload, e
 		return models.Workload{}, fmt.Errorf("unsupported workload type %q", in.GetSpec().GetType())
 	}
 
+	liveness, readiness := in.GetSpec().GetContainer().GetLivenessProbe(), in.GetSpec().GetContainer().GetReadinessProbe()
+	if w.Type == "vm" {
+		liveness, readiness = in.GetSpec().GetVm().GetLivenessProbe(), in.GetSpec().GetVm().GetReadinessProbe()
+	}
+	if w.LivenessProbe, err = toModelProbe(liveness, models.ProbeLiveness); err != nil {
+		return models.Workload{}, err
+	}
+	if w.ReadinessProbe, err = toModelProbe(readiness, models.ProbeReadiness); err != nil {
+		return models.Workload{}, err
+	}
+
 	return w, nil
 }
 
@@ -983,6 +1297,7 @@ func parseEmbeddedVMSpec(metadata map[string]interface{}) (*models.VMSpec, bool)
 		VCPUs    int32  `json:"vcpus"`
 		MemoryMB int64  `json:"memory_mb"`
 		Disks    []struct {
+			Pool   string `json:"pool"`
 			Path   string `json:"path"`
 			Device string `json:"device"`
 			Format string `json:"format"`
@@ -1012,6 +1327,7 @@ func parseEmbeddedVMSpec(metadata map[string]interface{}) (*models.VMSpec, bool)
 			MountPath    string `json:"mount_path"`
 			ReadOnly     bool   `json:"read_only"`
 			RetainPolicy string `json:"retain_policy"`
+			VolumeID     string `json:"volume_id"`
 		} `json:"managed_volumes"`
 	}
 	if err := json.Unmarshal(payload, &spec); err != nil {
@@ -1035,6 +1351,7 @@ func parseEmbeddedVMSpec(metadata map[string]interface{}) (*models.VMSpec, bool)
 	}
 	for _, d := range spec.Disks {
 		out.Disks = append(out.Disks, models.VMDiskConfig{
+			Pool:   d.Pool,
 			Path:   d.Path,
 			Device: d.Device,
 			Format: d.Format,
@@ -1060,11 +1377,279 @@ func parseEmbeddedVMSpec(metadata map[string]interface{}) (*models.VMSpec, bool)
 			MountPath:    mv.MountPath,
 			ReadOnly:     mv.ReadOnly,
 			RetainPolicy: mv.RetainPolicy,
+			VolumeID:     mv.VolumeID,
 		})
 	}
 	return out, true
 }
 
+func toModelPlacement(in *controlv1.PlacementPolicy) *models.PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := &models.PlacementPolicy{
+		Strategy:             strings.ToLower(strings.TrimSpace(in.GetStrategy())),
+		AntiAffinityGroup:    strings.TrimSpace(in.GetAntiAffinityGroup()),
+		AntiAffinityRequired: in.GetAntiAffinityRequired(),
+		SpreadTopologyKey:    strings.TrimSpace(in.GetSpreadTopologyKey()),
+		AffinityGroup:        strings.TrimSpace(in.GetAffinityGroup()),
+	}
+	for _, req := range in.GetRequiredNodeAffinity() {
+		out.RequiredNodeAffinity = append(out.RequiredNodeAffinity, toModelNodeSelectorRequirement(req))
+	}
+	for _, pref := range in.GetPreferredNodeAffinity() {
+		out.PreferredNodeAffinity = append(out.PreferredNodeAffinity, models.PreferredNodeAffinity{
+			Weight:      int(pref.GetWeight()),
+			Requirement: toModelNodeSelectorRequirement(pref.GetRequirement()),
+		})
+	}
+	return out
+}
+
+func validatePlacement(p *models.PlacementPolicy) error {
+	if p == nil {
+		return nil
+	}
+	switch p.Strategy {
+	case "", "spread", "binpack":
+	default:
+		return fmt.Errorf("unsupported placement strategy %q", p.Strategy)
+	}
+	reqs := append([]models.NodeSelectorRequirement{}, p.RequiredNodeAffinity...)
+	for _, pref := range p.PreferredNodeAffinity {
+		reqs = append(reqs, pref.Requirement)
+	}
+	for _, req := range reqs {
+		if req.Key == "" {
+			return fmt.Errorf("node affinity key is required")
+		}
+		switch strings.ToLower(req.Operator) {
+		case "", "in", "notin", "exists", "doesnotexist":
+		default:
+			return fmt.Errorf("unsupported node affinity operator %q", req.Operator)
+		}
+	}
+	return nil
+}
+
+func toModelRollout(in *controlv1.RolloutStrategy) (*models.RolloutStrategy, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &models.RolloutStrategy{
+		MaxUnavailable: int(in.GetMaxUnavailable()),
+		MaxSurge:       int(in.GetMaxSurge()),
+	}
+	switch strings.ToLower(strings.TrimSpace(in.GetType())) {
+	case "", "recreate":
+		out.Type = models.RolloutRecreate
+	case "rollingupdate", "rolling":
+		out.Type = models.RolloutRollingUpdate
+	default:
+		return nil, fmt.Errorf("unsupported rollout strategy %q", in.GetType())
+	}
+	if out.MaxUnavailable < 0 || out.MaxSurge < 0 {
+		return nil, fmt.Errorf("rollout max_unavailable and max_surge must not be negative")
+	}
+	return out, nil
+}
+
+func toModelProbe(in *controlv1.Probe, kind string) (*models.Probe, error) {
+	if in == nil {
+		return nil, nil
+	}
+	out := &models.Probe{
+		InitialDelaySeconds: int(in.GetInitialDelaySeconds()),
+		PeriodSeconds:       int(in.GetPeriodSeconds()),
+		TimeoutSeconds:      int(in.GetTimeoutSeconds()),
+		SuccessThreshold:    int(in.GetSuccessThreshold()),
+		FailureThreshold:    int(in.GetFailureThreshold()),
+	}
+	if out.InitialDelaySeconds < 0 || out.PeriodSeconds < 0 || out.TimeoutSeconds < 0 || out.SuccessThreshold < 0 || out.FailureThreshold < 0 {
+		return nil, fmt.Errorf("%s probe timings and thresholds must not be negative", kind)
+	}
+	switch h := in.GetHandler().(type) {
+	case *controlv1.Probe_HttpGet:
+		scheme := strings.ToLower(strings.TrimSpace(h.HttpGet.GetScheme()))
+		if scheme != "" && scheme != "http" && scheme != "https" {
+			return nil, fmt.Errorf("unsupported %s probe scheme %q", kind, h.HttpGet.GetScheme())
+		}
+		if h.HttpGet.GetPort() <= 0 {
+			return nil, fmt.Errorf("%s probe http_get.port is required", kind)
+		}
+		out.HTTPGet = &models.HTTPGetProbe{
+			Path:    strings.TrimSpace(h.HttpGet.GetPath()),
+			Port:    int(h.HttpGet.GetPort()),
+			Scheme:  scheme,
+			Headers: copyStringMap(h.HttpGet.GetHeaders()),
+		}
+	case *controlv1.Probe_TcpSocket:
+		if h.TcpSocket.GetPort() <= 0 {
+			return nil, fmt.Errorf("%s probe tcp_socket.port is required", kind)
+		}
+		out.TCPSocket = &models.TCPSocketProbe{Port: int(h.TcpSocket.GetPort())}
+	case *controlv1.Probe_Exec:
+		if len(h.Exec.GetCommand()) == 0 {
+			return nil, fmt.Errorf("%s probe exec.command is required", kind)
+		}
+		out.Exec = &models.ExecProbe{Command: append([]string{}, h.Exec.GetCommand()...)}
+	default:
+		return nil, fmt.Errorf("%s probe requires one of http_get, tcp_socket or exec", kind)
+	}
+	return out, nil
+}
+
+func probeStateToView(state *models.ProbeState) *controlv1.ProbeStateView {
+	if state == nil {
+		return nil
+	}
+	return &controlv1.ProbeStateView{
+		Status:               state.Status,
+		ConsecutiveSuccesses: int32(state.ConsecutiveSuccesses),
+		ConsecutiveFailures:  int32(state.ConsecutiveFailures),
+		LastMessage:          state.LastMessage,
+		LastCheckedAt:        timestampPtr(state.LastCheckedAt),
+		LastTransitionAt:     timestampPtr(state.LastTransitionAt),
+	}
+}
+
+func healthToView(health *models.WorkloadHealth) *controlv1.WorkloadHealthView {
+	if health == nil {
+		return nil
+	}
+	return &controlv1.WorkloadHealthView{
+		Liveness:          probeStateToView(health.Liveness),
+		Readiness:         probeStateToView(health.Readiness),
+		Restarts:          int32(health.Restarts),
+		LastRestartAt:     timestampPtr(health.LastRestartAt),
+		LastRestartReason: health.LastRestartReason,
+	}
+}
+
+func probeResultsFromProto(in []*controlv1.ProbeResult) []models.ProbeResult {
+	out := make([]models.ProbeResult, 0, len(in))
+	for _, r := range in {
+		result := models.ProbeResult{
+			Probe:   strings.ToLower(strings.TrimSpace(r.GetProbe())),
+			Success: r.GetSuccess(),
+			Message: r.GetMessage(),
+		}
+		if r.GetCheckedAt() != nil {
+			result.CheckedAt = r.GetCheckedAt().AsTime()
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+func toModelTaints(in []*controlv1.Taint) ([]models.Taint, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make([]models.Taint, 0, len(in))
+	for _, t := range in {
+		key := strings.TrimSpace(t.GetKey())
+		if key == "" {
+			return nil, fmt.Errorf("taint key is required")
+		}
+		effect, ok := canonicalTaintEffect(t.GetEffect())
+		if !ok || effect == "" {
+			return nil, fmt.Errorf("unsupported taint effect %q", t.GetEffect())
+		}
+		out = append(out, models.Taint{Key: key, Value: strings.TrimSpace(t.GetValue()), Effect: effect})
+	}
+	return out, nil
+}
+
+func toModelTolerations(in []*controlv1.Toleration) ([]models.Toleration, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make([]models.Toleration, 0, len(in))
+	for _, t := range in {
+		tol := models.Toleration{
+			Key:   strings.TrimSpace(t.GetKey()),
+			Value: strings.TrimSpace(t.GetValue()),
+		}
+		switch strings.ToLower(strings.TrimSpace(t.GetOperator())) {
+		case "", "equal":
+			tol.Operator = "Equal"
+			if tol.Key == "" {
+				return nil, fmt.Errorf("toleration key is required with operator Equal")
+			}
+		case "exists":
+			tol.Operator = "Exists"
+			if tol.Value != "" {
+				return nil, fmt.Errorf("toleration value must be empty with operator Exists")
+			}
+		default:
+			return nil, fmt.Errorf("unsupported toleration operator %q", t.GetOperator())
+		}
+		effect, ok := canonicalTaintEffect(t.GetEffect())
+		if !ok {
+			return nil, fmt.Errorf("unsupported toleration effect %q", t.GetEffect())
+		}
+		tol.Effect = effect
+		out = append(out, tol)
+	}
+	return out, nil
+}
+
+// canonicalTaintEffect accepts effects case-insensitively; empty is allowed
+// and returned as empty.
+func canonicalTaintEffect(effect string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(effect)) {
+	case "":
+		return "", true
+	case "noschedule":
+		return models.TaintEffectNoSchedule, true
+	case "prefernoschedule":
+		return models.TaintEffectPreferNoSchedule, true
+	case "noexecute":
+		return models.TaintEffectNoExecute, true
+	default:
+		return "", false
+	}
+}
+
+func taintsToView(in []models.Taint) []*controlv1.Taint {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.Taint, 0, len(in))
+	for _, t := range in {
+		out = append(out, &controlv1.Taint{Key: t.Key, Value: t.Value, Effect: t.Effect})
+	}
+	return out
+}
+
+func nodeDrainToView(d *models.NodeDrainStatus) *controlv1.NodeDrainStatus {
+	if d == nil {
+		return nil
+	}
+	return &controlv1.NodeDrainStatus{
+		State:               d.State,
+		Reason:              d.Reason,
+		MaxUnavailable:      int32(d.MaxUnavailable),
+		StartedAt:           timestampPtr(d.StartedAt),
+		CompletedAt:         timestampPtr(d.CompletedAt),
+		Total:               int32(d.Total),
+		Migrated:            int32(d.Migrated),
+		Failed:              int32(d.Failed),
+		Remaining:           int32(d.Remaining),
+		InFlightWorkloadIds: append([]string(nil), d.InFlight...),
+		LastError:           d.LastError,
+	}
+}
+
+func toModelNodeSelectorRequirement(in *controlv1.NodeSelectorRequirement) models.NodeSelectorRequirement {
+	return models.NodeSelectorRequirement{
+		Key:      strings.TrimSpace(in.GetKey()),
+		Operator: strings.TrimSpace(in.GetOperator()),
+		Values:   append([]string(nil), in.GetValues()...),
+	}
+}
+
 func toModelManagedVolumes(in []*controlv1.ManagedVolumeSpec) []models.ManagedVolumeSpec {
 	if len(in) == 0 {
 		return nil
@@ -1083,6 +1668,7 @@ func toModelManagedVolumes(in []*controlv1.ManagedVolumeSpec) []models.ManagedVo
 			MountPath:    strings.TrimSpace(mv.GetMountPath()),
 			ReadOnly:     mv.GetReadOnly(),
 			RetainPolicy: strings.TrimSpace(mv.GetRetainPolicy()),
+			VolumeID:     strings.TrimSpace(mv.GetVolumeId()),
 		})
 	}
 	return out
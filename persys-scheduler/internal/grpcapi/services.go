@@ -0,0 +1,130 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ApplyService(ctx context.Context, in *controlv1.ApplyServiceRequest) (*controlv1.ApplyServiceResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "service", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyServiceResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	ports := make([]models.ServicePort, 0, len(in.GetPorts()))
+	for _, port := range in.GetPorts() {
+		ports = append(ports, models.ServicePort{
+			Name:     port.GetName(),
+			Protocol: port.GetProtocol(),
+			Port:     int(port.GetPort()),
+			HostPort: int(port.GetHostPort()),
+		})
+	}
+	svc, err := s.sched.ApplyService(models.Service{
+		Namespace: in.GetNamespace(),
+		Name:      in.GetName(),
+		Selector:  in.GetSelector(),
+		Ports:     ports,
+	})
+	if err != nil {
+		return &controlv1.ApplyServiceResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyServiceResponse{Success: true, Service: s.serviceToView(svc)}, nil
+}
+
+func (s *Service) DeleteService(ctx context.Context, in *controlv1.DeleteServiceRequest) (*controlv1.DeleteServiceResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "service", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteServiceResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteService(in.GetNamespace(), in.GetName()); err != nil {
+		return &controlv1.DeleteServiceResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteServiceResponse{Success: true}, nil
+}
+
+func (s *Service) GetService(ctx context.Context, in *controlv1.GetServiceRequest) (*controlv1.GetServiceResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "service", in.GetNamespace(), in.GetName())
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	svc, err := s.sched.GetService(in.GetNamespace(), in.GetName())
+	if err != nil {
+		rpcErr := status.Error(codes.NotFound, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetServiceResponse{Service: s.serviceToView(svc)}, nil
+}
+
+func (s *Service) ListServices(ctx context.Context, in *controlv1.ListServicesRequest) (*controlv1.ListServicesResponse, error) {
+	services, err := s.sched.ListServices(in.GetNamespace())
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.ServiceView, 0, len(services))
+	for _, svc := range services {
+		out = append(out, s.serviceToView(svc))
+	}
+	return &controlv1.ListServicesResponse{Services: out}, nil
+}
+
+// serviceToView includes the endpoints the service publishes right now. An
+// endpoint lookup failure leaves them out rather than failing the call.
+func (s *Service) serviceToView(svc models.Service) *controlv1.ServiceView {
+	view := &controlv1.ServiceView{
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+		Fqdn:      s.sched.ServiceFQDN(svc),
+		Selector:  copyStringMap(svc.Selector),
+		CreatedAt: timestampPtr(svc.CreatedAt),
+		UpdatedAt: timestampPtr(svc.UpdatedAt),
+	}
+	for _, port := range svc.Ports {
+		view.Ports = append(view.Ports, &controlv1.ServicePort{
+			Name:     port.Name,
+			Protocol: port.Protocol,
+			Port:     int32(port.Port),
+			HostPort: int32(port.HostPort),
+		})
+	}
+	endpoints, err := s.sched.ServiceEndpoints(svc)
+	if err != nil {
+		return view
+	}
+	for _, ep := range endpoints {
+		view.Endpoints = append(view.Endpoints, &controlv1.ServiceEndpoint{
+			WorkloadId: ep.WorkloadID,
+			NodeId:     ep.NodeID,
+			Host:       ep.Host,
+			Port:       int32(ep.Port),
+			PortName:   ep.PortName,
+			Protocol:   ep.Protocol,
+		})
+	}
+	return view
+}
@@ -0,0 +1,120 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulatePlacement only reads, so it keeps working while the control plane
+// is frozen.
+func (s *Service) SimulatePlacement(ctx context.Context, in *controlv1.SimulatePlacementRequest) (*controlv1.SimulatePlacementResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())))
+	}
+	if in == nil || (in.GetSpec() == nil && strings.TrimSpace(in.GetWorkloadId()) == "") {
+		err := status.Error(codes.InvalidArgument, "spec or workload_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+
+	var workload models.Workload
+	if in.GetSpec() != nil {
+		var err error
+		workload, err = controlApplyToModel(&controlv1.ApplyWorkloadRequest{
+			WorkloadId: strings.TrimSpace(in.GetWorkloadId()),
+			Spec:       in.GetSpec(),
+		})
+		if err != nil {
+			rpcErr := status.Error(codes.InvalidArgument, err.Error())
+			recordRPCError(ctx, rpcErr)
+			return nil, rpcErr
+		}
+	} else {
+		var err error
+		workload, err = s.sched.GetWorkloadByID(strings.TrimSpace(in.GetWorkloadId()))
+		if err != nil {
+			rpcErr := status.Error(codes.Unavailable, err.Error())
+			if errors.Is(err, scheduler.ErrWorkloadNotFound) {
+				rpcErr = status.Errorf(codes.NotFound, "workload %q not found", in.GetWorkloadId())
+			}
+			recordRPCError(ctx, rpcErr)
+			return nil, rpcErr
+		}
+	}
+	annotateRPC(ctx, attribute.String("scheduler.workload_type", strings.TrimSpace(workload.Type)))
+
+	sim, err := s.sched.SimulatePlacement(workload)
+	if err != nil {
+		// Anything but a bad workload is a failed cluster-state read.
+		rpcErr := status.Error(codes.Unavailable, err.Error())
+		if errors.Is(err, scheduler.ErrInvalidSimulation) {
+			rpcErr = status.Error(codes.InvalidArgument, err.Error())
+		}
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return simulationToResponse(sim), nil
+}
+
+func simulationToResponse(sim scheduler.PlacementSimulation) *controlv1.SimulatePlacementResponse {
+	resp := &controlv1.SimulatePlacementResponse{
+		Strategy:          sim.Decision.Strategy,
+		PreemptionNodeId:  sim.PreemptionNode,
+		PreemptionVictims: append([]string(nil), sim.PreemptionVictims...),
+	}
+	if sim.Decision.Node != nil {
+		resp.Schedulable = true
+		resp.SelectedNodeId = sim.Decision.Node.NodeID
+		resp.Reason = sim.Decision.Reason
+	}
+
+	evals := append([]scheduler.NodeEvaluation(nil), sim.Decision.Evaluations...)
+	sort.SliceStable(evals, func(i, j int) bool {
+		a, b := evals[i], evals[j]
+		if a.Feasible != b.Feasible {
+			return a.Feasible
+		}
+		if a.Feasible {
+			return a.Rank < b.Rank
+		}
+		return a.NodeID < b.NodeID
+	})
+	for _, eval := range evals {
+		candidate := &controlv1.PlacementCandidate{
+			NodeId:     eval.NodeID,
+			Feasible:   eval.Feasible,
+			Rank:       int32(eval.Rank),
+			TotalScore: eval.Total,
+		}
+		for _, f := range eval.Filters {
+			candidate.Filters = append(candidate.Filters, pluginResultToView(f))
+			if !f.Passed {
+				candidate.RejectedBy = f.Plugin
+			}
+		}
+		for _, sc := range eval.Scores {
+			candidate.Scores = append(candidate.Scores, pluginResultToView(sc))
+		}
+		resp.Candidates = append(resp.Candidates, candidate)
+	}
+	return resp
+}
+
+func pluginResultToView(r scheduler.PluginResult) *controlv1.PlacementPluginResult {
+	return &controlv1.PlacementPluginResult{
+		Plugin: r.Plugin,
+		Passed: r.Passed,
+		Reason: r.Reason,
+		Score:  r.Score,
+		Weight: r.Weight,
+	}
+}
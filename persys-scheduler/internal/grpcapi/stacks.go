@@ -0,0 +1,175 @@
+package grpcapi
+
+import (
+	"context"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) ApplyStack(ctx context.Context, in *controlv1.ApplyStackRequest) (*controlv1.ApplyStackResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "stack", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ApplyStackResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	members := make([]models.StackMember, 0, len(in.GetMembers()))
+	for _, member := range in.GetMembers() {
+		if member.GetTemplate() == nil {
+			err := status.Errorf(codes.InvalidArgument, "member %q needs a template", member.GetName())
+			recordRPCError(ctx, err)
+			return nil, err
+		}
+		template, err := controlApplyToModel(&controlv1.ApplyWorkloadRequest{Spec: member.GetTemplate()})
+		if err != nil {
+			return &controlv1.ApplyStackResponse{Success: false, ErrorMessage: "member " + member.GetName() + ": " + err.Error()}, nil
+		}
+		members = append(members, models.StackMember{
+			Name:      member.GetName(),
+			DependsOn: append([]string(nil), member.GetDependsOn()...),
+			Template:  template,
+		})
+	}
+	st, err := s.sched.ApplyStack(models.Stack{
+		Namespace:    in.GetNamespace(),
+		Name:         in.GetName(),
+		DesiredState: in.GetDesiredState(),
+		Colocate:     in.GetColocate(),
+		Members:      members,
+	})
+	if err != nil {
+		return &controlv1.ApplyStackResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ApplyStackResponse{Success: true, Stack: stackToView(s.convergeStack(st))}, nil
+}
+
+func (s *Service) SetStackState(ctx context.Context, in *controlv1.SetStackStateRequest) (*controlv1.SetStackStateResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "stack", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.SetStackStateResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	switch strings.ToLower(strings.TrimSpace(in.GetDesiredState())) {
+	case "running", "stopped":
+	default:
+		err := status.Error(codes.InvalidArgument, "desired_state must be Running or Stopped")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	st, err := s.sched.SetStackState(in.GetNamespace(), in.GetName(), in.GetDesiredState())
+	if err != nil {
+		return &controlv1.SetStackStateResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.SetStackStateResponse{Success: true, Stack: stackToView(s.convergeStack(st))}, nil
+}
+
+func (s *Service) DeleteStack(ctx context.Context, in *controlv1.DeleteStackRequest) (*controlv1.DeleteStackResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "stack", in.GetNamespace(), in.GetName())
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteStackResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteStack(in.GetNamespace(), in.GetName()); err != nil {
+		return &controlv1.DeleteStackResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteStackResponse{Success: true}, nil
+}
+
+func (s *Service) GetStack(ctx context.Context, in *controlv1.GetStackRequest) (*controlv1.GetStackResponse, error) {
+	if in != nil {
+		annotateObject(ctx, "stack", in.GetNamespace(), in.GetName())
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "name is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	st, err := s.sched.GetStack(in.GetNamespace(), in.GetName())
+	if err != nil {
+		rpcErr := status.Error(codes.NotFound, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetStackResponse{Stack: stackToView(st)}, nil
+}
+
+func (s *Service) ListStacks(ctx context.Context, in *controlv1.ListStacksRequest) (*controlv1.ListStacksResponse, error) {
+	stacks, err := s.sched.ListStacks(in.GetNamespace())
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	out := make([]*controlv1.StackView, 0, len(stacks))
+	for _, st := range stacks {
+		out = append(out, stackToView(st))
+	}
+	return &controlv1.ListStacksResponse{Stacks: out}, nil
+}
+
+// convergeStack runs a stack pass right away so callers see the members that
+// were started, instead of waiting for the next tick.
+func (s *Service) convergeStack(st models.Stack) models.Stack {
+	if !s.sched.IsLeader() {
+		return st
+	}
+	if err := s.sched.ReconcileStacks(); err != nil {
+		return st
+	}
+	if refreshed, err := s.sched.GetStack(st.Namespace, st.Name); err == nil {
+		return refreshed
+	}
+	return st
+}
+
+func stackToView(st models.Stack) *controlv1.StackView {
+	view := &controlv1.StackView{
+		Namespace:        st.Namespace,
+		Name:             st.Name,
+		DesiredState:     st.DesiredState,
+		Phase:            st.Status.Phase,
+		Colocate:         st.Colocate,
+		ReadyMembers:     int32(st.Status.ReadyMembers),
+		CreatedAt:        timestampPtr(st.CreatedAt),
+		UpdatedAt:        timestampPtr(st.UpdatedAt),
+		LastReconciledAt: timestampPtr(st.Status.LastReconciledAt),
+	}
+	statuses := make(map[string]models.StackMemberStatus, len(st.Status.Members))
+	for _, ms := range st.Status.Members {
+		statuses[ms.Name] = ms
+	}
+	for _, m := range st.Members {
+		ms := statuses[m.Name]
+		view.Members = append(view.Members, &controlv1.StackMemberView{
+			Name:       m.Name,
+			DependsOn:  append([]string(nil), m.DependsOn...),
+			WorkloadId: ms.WorkloadID,
+			NodeId:     ms.NodeID,
+			Status:     ms.Status,
+			Ready:      ms.Ready,
+			WaitingFor: append([]string(nil), ms.WaitingFor...),
+		})
+	}
+	return view
+}
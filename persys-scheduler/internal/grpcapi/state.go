@@ -0,0 +1,85 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportState works in every mode; while frozen it returns the snapshot taken
+// when the scheduler left normal mode.
+func (s *Service) ExportState(ctx context.Context, _ *controlv1.ExportStateRequest) (*controlv1.ExportStateResponse, error) {
+	archive, err := s.sched.ExportState()
+	if err != nil {
+		rpcErr := status.Error(codes.FailedPrecondition, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	payload, err := json.Marshal(archive)
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	annotateRPC(ctx, attribute.String("scheduler.state_source", archive.Source))
+	return &controlv1.ExportStateResponse{
+		Archive:   payload,
+		Version:   int32(archive.Version),
+		Source:    archive.Source,
+		CreatedAt: timestampPtr(archive.CreatedAt),
+		Nodes:     int32(len(archive.Nodes)),
+		Workloads: int32(len(archive.Workloads)),
+	}, nil
+}
+
+// ImportState is allowed while the control plane is frozen: restoring state
+// is how a scheduler leaves recovery mode.
+func (s *Service) ImportState(ctx context.Context, in *controlv1.ImportStateRequest) (*controlv1.ImportStateResponse, error) {
+	if in == nil || len(in.GetArchive()) == 0 {
+		err := status.Error(codes.InvalidArgument, "archive is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	annotateRPC(ctx, attribute.Bool("scheduler.import_force", in.GetForce()), attribute.Bool("scheduler.import_dry_run", in.GetDryRun()))
+	var archive models.StateArchive
+	if err := json.Unmarshal(in.GetArchive(), &archive); err != nil {
+		rpcErr := status.Errorf(codes.InvalidArgument, "archive is not valid JSON: %v", err)
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	resp := &controlv1.ImportStateResponse{
+		Nodes:       int32(len(archive.Nodes)),
+		Workloads:   int32(len(archive.Workloads)),
+		Assignments: int32(len(archive.Assignments)),
+		ReplicaSets: int32(len(archive.ReplicaSets)),
+		Namespaces:  int32(len(archive.Namespaces)),
+		Secrets:     int32(len(archive.Secrets)),
+		Configs:     int32(len(archive.Configs)),
+		Services:    int32(len(archive.Services)),
+		Stacks:      int32(len(archive.Stacks)),
+		Jobs:        int32(len(archive.Jobs)),
+		CronJobs:    int32(len(archive.CronJobs)),
+		Revisions:   int32(len(archive.Revisions)),
+		Volumes:     int32(len(archive.Volumes)),
+		Attachments: int32(len(archive.Attachments)),
+	}
+	var err error
+	if in.GetDryRun() {
+		err = scheduler.ValidateStateArchive(archive)
+	} else {
+		err = s.sched.ImportState(archive, in.GetForce())
+	}
+	resp.Mode = string(s.sched.CurrentMode())
+	if err != nil {
+		resp.ErrorMessage = err.Error()
+		return resp, nil
+	}
+	resp.Success = true
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package grpcapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Service) GetWorkloadUsageHistory(ctx context.Context, in *controlv1.GetWorkloadUsageHistoryRequest) (*controlv1.GetWorkloadUsageHistoryResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetWorkloadId()) == "" {
+		err := status.Error(codes.InvalidArgument, "workload_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	var from, to time.Time
+	if in.GetFrom() != nil {
+		from = in.GetFrom().AsTime()
+	}
+	if in.GetTo() != nil {
+		to = in.GetTo().AsTime()
+	}
+	if in.GetStepSeconds() < 0 || (!from.IsZero() && !to.IsZero() && !from.Before(to)) {
+		err := status.Error(codes.InvalidArgument, "from must be before to and step_seconds must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+
+	workloadID := strings.TrimSpace(in.GetWorkloadId())
+	workload, err := s.sched.GetWorkloadByID(workloadID)
+	if err == nil && strings.TrimSpace(in.GetNamespace()) != "" && !inNamespace(workload, in.GetNamespace()) {
+		err = fmt.Errorf("workload %s is not in namespace %s", workloadID, in.GetNamespace())
+	}
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "workload %q not found", workloadID)
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	history, err := s.sched.GetWorkloadUsageHistory(workloadID, from, to, time.Duration(in.GetStepSeconds())*time.Second)
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return usageHistoryToResponse(history), nil
+}
+
+func usageHistoryToResponse(history models.WorkloadUsageHistory) *controlv1.GetWorkloadUsageHistoryResponse {
+	resp := &controlv1.GetWorkloadUsageHistoryResponse{
+		WorkloadId:  history.WorkloadID,
+		Resolution:  history.Resolution,
+		StepSeconds: int64(history.Step / time.Second),
+		From:        timestamppb.New(history.From),
+		To:          timestamppb.New(history.To),
+		CpuTrend:    history.CPUTrend,
+		Points:      make([]*controlv1.WorkloadUsagePoint, 0, len(history.Points)),
+	}
+	for _, p := range history.Points {
+		resp.Points = append(resp.Points, &controlv1.WorkloadUsagePoint{
+			Timestamp:      timestamppb.New(p.Timestamp),
+			Samples:        int32(p.Samples),
+			CpuAvgPercent:  p.CPUAvgPercent,
+			CpuMaxPercent:  p.CPUMaxPercent,
+			MemoryAvgBytes: p.MemoryAvgBytes,
+			MemoryMaxBytes: p.MemoryMaxBytes,
+			DiskReadBytes:  p.DiskReadBytes,
+			DiskWriteBytes: p.DiskWriteBytes,
+			NetRxBytes:     p.NetRXBytes,
+			NetTxBytes:     p.NetTXBytes,
+		})
+	}
+	return resp
+}
@@ -0,0 +1,212 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) CreateVolume(ctx context.Context, in *controlv1.CreateVolumeRequest) (*controlv1.CreateVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.volume_name", strings.TrimSpace(in.GetName())),
+			attribute.String("scheduler.node_id", strings.TrimSpace(in.GetNodeId())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.CreateVolumeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetName()) == "" || in.GetSizeGb() <= 0 {
+		err := status.Error(codes.InvalidArgument, "name and a positive size_gb are required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	record, err := s.sched.CreateVolume(models.ManagedVolumeSpec{
+		Name:         strings.TrimSpace(in.GetName()),
+		Driver:       strings.TrimSpace(in.GetDriver()),
+		SizeGB:       in.GetSizeGb(),
+		AccessMode:   strings.TrimSpace(in.GetAccessMode()),
+		FSType:       strings.TrimSpace(in.GetFsType()),
+		RetainPolicy: strings.TrimSpace(in.GetRetainPolicy()),
+	}, in.GetNodeId())
+	if err != nil {
+		return &controlv1.CreateVolumeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.CreateVolumeResponse{Success: true, Volume: volumeToView(record)}, nil
+}
+
+func (s *Service) GetVolume(ctx context.Context, in *controlv1.GetVolumeRequest) (*controlv1.GetVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.volume_id", strings.TrimSpace(in.GetVolumeId())))
+	}
+	if in == nil || strings.TrimSpace(in.GetVolumeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "volume_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	record, err := s.sched.GetVolume(in.GetVolumeId())
+	if err != nil {
+		code := codes.Internal
+		if errors.Is(err, scheduler.ErrVolumeNotFound) {
+			code = codes.NotFound
+		}
+		rpcErr := status.Error(code, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	return &controlv1.GetVolumeResponse{Volume: volumeToView(record)}, nil
+}
+
+func (s *Service) ListVolumes(ctx context.Context, in *controlv1.ListVolumesRequest) (*controlv1.ListVolumesResponse, error) {
+	filter := scheduler.VolumeFilter{}
+	if in != nil {
+		filter = scheduler.VolumeFilter{
+			Phase:      in.GetPhase(),
+			Driver:     in.GetDriver(),
+			NodeID:     in.GetNodeId(),
+			WorkloadID: in.GetWorkloadId(),
+		}
+	}
+	records, err := s.sched.ListVolumes(filter)
+	if err != nil {
+		rpcErr := status.Error(codes.Internal, err.Error())
+		recordRPCError(ctx, rpcErr)
+		return nil, rpcErr
+	}
+	resp := &controlv1.ListVolumesResponse{Volumes: make([]*controlv1.VolumeView, 0, len(records))}
+	for _, record := range records {
+		resp.Volumes = append(resp.Volumes, volumeToView(record))
+	}
+	return resp, nil
+}
+
+func (s *Service) DeleteVolume(ctx context.Context, in *controlv1.DeleteVolumeRequest) (*controlv1.DeleteVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.volume_id", strings.TrimSpace(in.GetVolumeId())),
+			attribute.Bool("scheduler.force", in.GetForce()),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.DeleteVolumeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetVolumeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "volume_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if err := s.sched.DeleteVolume(in.GetVolumeId(), in.GetForce()); err != nil {
+		return &controlv1.DeleteVolumeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.DeleteVolumeResponse{Success: true}, nil
+}
+
+func (s *Service) ResizeVolume(ctx context.Context, in *controlv1.ResizeVolumeRequest) (*controlv1.ResizeVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.volume_id", strings.TrimSpace(in.GetVolumeId())),
+			attribute.Int64("scheduler.size_gb", in.GetSizeGb()),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.ResizeVolumeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetVolumeId()) == "" || in.GetSizeGb() <= 0 {
+		err := status.Error(codes.InvalidArgument, "volume_id and a positive size_gb are required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	record, err := s.sched.ResizeVolume(in.GetVolumeId(), in.GetSizeGb())
+	if err != nil {
+		return &controlv1.ResizeVolumeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.ResizeVolumeResponse{Success: true, Volume: volumeToView(record)}, nil
+}
+
+func (s *Service) SnapshotVolume(ctx context.Context, in *controlv1.SnapshotVolumeRequest) (*controlv1.SnapshotVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx, attribute.String("scheduler.volume_id", strings.TrimSpace(in.GetVolumeId())))
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.SnapshotVolumeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetVolumeId()) == "" {
+		err := status.Error(codes.InvalidArgument, "volume_id is required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	record, snapshot, err := s.sched.SnapshotVolume(in.GetVolumeId(), in.GetSnapshotName())
+	if err != nil {
+		return &controlv1.SnapshotVolumeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.SnapshotVolumeResponse{Success: true, SnapshotName: snapshot, Volume: volumeToView(record)}, nil
+}
+
+func (s *Service) CloneVolume(ctx context.Context, in *controlv1.CloneVolumeRequest) (*controlv1.CloneVolumeResponse, error) {
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.volume_id", strings.TrimSpace(in.GetSourceVolumeId())),
+			attribute.String("scheduler.volume_name", strings.TrimSpace(in.GetName())),
+		)
+	}
+	if !s.sched.IsWritable() {
+		return &controlv1.CloneVolumeResponse{Success: false, ErrorMessage: frozenControlPlaneMessage}, nil
+	}
+	if in == nil || strings.TrimSpace(in.GetSourceVolumeId()) == "" || strings.TrimSpace(in.GetName()) == "" {
+		err := status.Error(codes.InvalidArgument, "source_volume_id and name are required")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	if in.GetSizeGb() < 0 {
+		err := status.Error(codes.InvalidArgument, "size_gb must not be negative")
+		recordRPCError(ctx, err)
+		return nil, err
+	}
+	record, err := s.sched.CloneVolume(in.GetSourceVolumeId(), scheduler.CloneVolumeOptions{
+		SourceSnapshot: in.GetSourceSnapshot(),
+		Name:           in.GetName(),
+		SizeGB:         in.GetSizeGb(),
+		RetainPolicy:   in.GetRetainPolicy(),
+	})
+	if err != nil {
+		return &controlv1.CloneVolumeResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
+	return &controlv1.CloneVolumeResponse{Success: true, Volume: volumeToView(record)}, nil
+}
+
+func volumeToView(record models.ManagedVolumeRecord) *controlv1.VolumeView {
+	view := &controlv1.VolumeView{
+		Id:              record.ID,
+		Name:            record.Name,
+		Driver:          record.Driver,
+		SizeGb:          record.SizeGB,
+		AccessMode:      record.AccessMode,
+		FsType:          record.FSType,
+		RetainPolicy:    record.RetainPolicy,
+		Phase:           record.Phase,
+		LastError:       record.LastError,
+		WorkloadIds:     append([]string(nil), record.WorkloadRefs...),
+		AttachedNodeIds: append([]string(nil), record.AttachedNodes...),
+		NodeId:          record.NodeID,
+		SourceVolumeId:  record.SourceVolumeID,
+		SourceSnapshot:  record.SourceSnapshot,
+		CreatedAt:       timestampPtr(record.CreatedAt),
+		UpdatedAt:       timestampPtr(record.UpdatedAt),
+		ReleasedAt:      timestampPtr(record.ReleasedAt),
+	}
+	for _, snap := range record.Snapshots {
+		view.Snapshots = append(view.Snapshots, &controlv1.VolumeSnapshotView{
+			Name:      snap.Name,
+			SizeGb:    snap.SizeGB,
+			CreatedAt: timestampPtr(snap.CreatedAt),
+		})
+	}
+	return view
+}
@@ -0,0 +1,227 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/scheduler"
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StreamWorkloadLogs relays the output of a workload from its agent. It only
+// reads, so it keeps working while the control plane is frozen.
+func (s *Service) StreamWorkloadLogs(in *controlv1.StreamWorkloadLogsRequest, stream controlv1.AgentControl_StreamWorkloadLogsServer) error {
+	ctx := stream.Context()
+	if in != nil {
+		annotateRPC(ctx,
+			attribute.String("scheduler.workload_id", strings.TrimSpace(in.GetWorkloadId())),
+			attribute.Bool("scheduler.follow", in.GetFollow()),
+		)
+	}
+	if in == nil || strings.TrimSpace(in.GetWorkloadId()) == "" {
+		err := status.Error(codes.InvalidArgument, "workload_id is required")
+		recordRPCError(ctx, err)
+		return err
+	}
+	if in.GetTailLines() < 0 {
+		err := status.Error(codes.InvalidArgument, "tail_lines must not be negative")
+		recordRPCError(ctx, err)
+		return err
+	}
+	if err := s.checkWorkloadNamespace(ctx, in.GetWorkloadId(), in.GetNamespace()); err != nil {
+		return err
+	}
+
+	req := &agentpb.StreamWorkloadLogsRequest{
+		Follow:    in.GetFollow(),
+		TailLines: in.GetTailLines(),
+		Container: strings.TrimSpace(in.GetContainer()),
+	}
+	if in.GetSince() != nil {
+		req.Since = in.GetSince().AsTime().Unix()
+	}
+	err := s.sched.StreamWorkloadLogs(ctx, in.GetWorkloadId(), req, func(chunk *agentpb.WorkloadLogChunk) error {
+		out := &controlv1.WorkloadLogChunk{
+			Stream:    controlv1.LogStream(chunk.GetStream()),
+			Data:      chunk.GetData(),
+			Container: chunk.GetContainer(),
+		}
+		if chunk.GetTimestamp() > 0 {
+			out.Timestamp = timestamppb.New(time.Unix(0, chunk.GetTimestamp()))
+		}
+		return stream.Send(out)
+	})
+	return workloadIOError(ctx, err)
+}
+
+// ExecWorkload relays an exec session between the caller and the agent
+// running the workload. The first message must carry ExecStart; the stream
+// ends after the agent reports the exit status.
+func (s *Service) ExecWorkload(stream controlv1.AgentControl_ExecWorkloadServer) error {
+	ctx := stream.Context()
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		err := status.Error(codes.InvalidArgument, "the first exec message must carry start")
+		recordRPCError(ctx, err)
+		return err
+	}
+	annotateRPC(ctx, attribute.String("scheduler.workload_id", strings.TrimSpace(start.GetWorkloadId())))
+	if strings.TrimSpace(start.GetWorkloadId()) == "" {
+		err := status.Error(codes.InvalidArgument, "workload_id is required")
+		recordRPCError(ctx, err)
+		return err
+	}
+	if err := s.checkWorkloadNamespace(ctx, start.GetWorkloadId(), start.GetNamespace()); err != nil {
+		return err
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	agentStart := &agentpb.ExecStart{
+		Command:   start.GetCommand(),
+		Tty:       start.GetTty(),
+		Stdin:     start.GetStdin(),
+		Container: strings.TrimSpace(start.GetContainer()),
+	}
+	if size := start.GetSize(); size != nil {
+		agentStart.Size = &agentpb.TerminalSize{Cols: size.GetCols(), Rows: size.GetRows()}
+	}
+	session, err := s.sched.ExecWorkload(sessionCtx, start.GetWorkloadId(), agentStart, execRequester(ctx, start.GetRequestedBy()))
+	if err != nil {
+		return workloadIOError(ctx, err)
+	}
+	defer session.Close()
+
+	// Caller to agent. The agent sees a half-close once the caller has
+	// nothing more to send.
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				_ = session.CloseSend()
+				return
+			}
+			if err != nil {
+				cancel()
+				return
+			}
+			var out *agentpb.ExecWorkloadRequest
+			switch p := msg.GetPayload().(type) {
+			case *controlv1.ExecWorkloadRequest_Stdin:
+				out = &agentpb.ExecWorkloadRequest{Payload: &agentpb.ExecWorkloadRequest_Stdin{Stdin: p.Stdin}}
+			case *controlv1.ExecWorkloadRequest_Resize:
+				out = &agentpb.ExecWorkloadRequest{Payload: &agentpb.ExecWorkloadRequest_Resize{
+					Resize: &agentpb.TerminalSize{Cols: p.Resize.GetCols(), Rows: p.Resize.GetRows()},
+				}}
+			case *controlv1.ExecWorkloadRequest_CloseStdin:
+				out = &agentpb.ExecWorkloadRequest{Payload: &agentpb.ExecWorkloadRequest_CloseStdin{CloseStdin: p.CloseStdin}}
+			default:
+				continue
+			}
+			if err := session.Send(out); err != nil {
+				cancel()
+				return
+			}
+		}
+	}()
+
+	// Agent to caller.
+	for {
+		msg, err := session.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return workloadIOError(ctx, err)
+		}
+		var out *controlv1.ExecWorkloadResponse
+		switch p := msg.GetPayload().(type) {
+		case *agentpb.ExecWorkloadResponse_Stdout:
+			out = &controlv1.ExecWorkloadResponse{Payload: &controlv1.ExecWorkloadResponse_Stdout{Stdout: p.Stdout}}
+		case *agentpb.ExecWorkloadResponse_Stderr:
+			out = &controlv1.ExecWorkloadResponse{Payload: &controlv1.ExecWorkloadResponse_Stderr{Stderr: p.Stderr}}
+		case *agentpb.ExecWorkloadResponse_Exit:
+			out = &controlv1.ExecWorkloadResponse{Payload: &controlv1.ExecWorkloadResponse_Exit{
+				Exit: &controlv1.ExecExit{ExitCode: p.Exit.GetExitCode(), Error: p.Exit.GetError()},
+			}}
+		default:
+			continue
+		}
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+}
+
+// checkWorkloadNamespace fails with NotFound when the workload does not exist
+// or, if namespace is set, lives in another namespace.
+func (s *Service) checkWorkloadNamespace(ctx context.Context, workloadID, namespace string) error {
+	workload, err := s.sched.GetWorkloadByID(workloadID)
+	if err == nil && strings.TrimSpace(namespace) != "" && !inNamespace(workload, namespace) {
+		err = fmt.Errorf("workload %s is not in namespace %s", workloadID, namespace)
+	}
+	if err != nil {
+		rpcErr := status.Errorf(codes.NotFound, "workload %q not found", workloadID)
+		recordRPCError(ctx, rpcErr)
+		return rpcErr
+	}
+	return nil
+}
+
+func workloadIOError(ctx context.Context, err error) error {
+	if err == nil || ctx.Err() != nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var rpcErr error
+	switch {
+	case errors.Is(err, scheduler.ErrInvalidExec):
+		rpcErr = status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, scheduler.ErrWorkloadNotPlaced):
+		rpcErr = status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		rpcErr = status.Error(codes.Unavailable, err.Error())
+	}
+	recordRPCError(ctx, rpcErr)
+	return rpcErr
+}
+
+// execRequester names who opened an exec session: the identity the caller
+// vouches for, followed by the caller's own certificate name.
+func execRequester(ctx context.Context, requestedBy string) string {
+	caller := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.PeerCertificates) > 0 {
+			caller = info.State.PeerCertificates[0].Subject.CommonName
+		}
+	}
+	requestedBy = strings.TrimSpace(requestedBy)
+	switch {
+	case requestedBy == "":
+		return caller
+	case caller == "" || caller == requestedBy:
+		return requestedBy
+	default:
+		return requestedBy + " via " + caller
+	}
+}
@@ -109,6 +109,14 @@ var (
 		},
 		[]string{"desired_state"},
 	)
+	leaderGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "persys",
+			Subsystem: "scheduler",
+			Name:      "leader",
+			Help:      "1 when this scheduler instance holds the leader lease.",
+		},
+	)
 	stateStoreWritesTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "persys",
@@ -118,6 +126,24 @@ var (
 		},
 		[]string{"category"},
 	)
+	stateCacheResyncsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "persys",
+			Subsystem: "scheduler",
+			Name:      "state_cache_resyncs_total",
+			Help:      "Number of full relists of the node and workload state cache by reason.",
+		},
+		[]string{"reason"},
+	)
+	deschedulerMovesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "persys",
+			Subsystem: "scheduler",
+			Name:      "descheduler_moves_total",
+			Help:      "Number of descheduler moves by strategy and result (moved, planned, failed).",
+		},
+		[]string{"strategy", "result"},
+	)
 )
 
 var defaultNodeStatuses = []string{"ready", "active", "notready", "unknown"}
@@ -141,7 +167,10 @@ func Register() {
 			nodeStatusGauge,
 			workloadStatusGauge,
 			workloadDesiredGauge,
+			leaderGauge,
 			stateStoreWritesTotal,
+			stateCacheResyncsTotal,
+			deschedulerMovesTotal,
 		)
 
 		for _, s := range defaultNodeStatuses {
@@ -169,6 +198,14 @@ func GRPCUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 func IncStateStoreWrite(category string) {
 	stateStoreWritesTotal.WithLabelValues(category).Inc()
 }
+
+func IncStateCacheResync(reason string) {
+	stateCacheResyncsTotal.WithLabelValues(reason).Inc()
+}
+
+func IncDeschedulerMove(strategy, result string) {
+	deschedulerMovesTotal.WithLabelValues(strategy, result).Inc()
+}
 func GRPCStreamServerInterceptor() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		start := time.Now()
@@ -230,3 +267,11 @@ func SetWorkloadStatusCounts(statusCounts map[string]int, desiredCounts map[stri
 		workloadDesiredGauge.WithLabelValues(desired).Set(float64(count))
 	}
 }
+
+func SetLeader(leading bool) {
+	if leading {
+		leaderGauge.Set(1)
+		return
+	}
+	leaderGauge.Set(0)
+}
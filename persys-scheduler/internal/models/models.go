@@ -66,26 +66,156 @@ type Node struct {
 	AgentEndpoint           string            `json:"agentEndpoint,omitempty"`
 	SupportedWorkloadTypes  []string          `json:"supportedWorkloadTypes,omitempty"`
 	SupportedStorageDrivers []string          `json:"supportedStorageDrivers,omitempty"`
+	StoragePools            []StoragePool     `json:"storagePools,omitempty"`
+	DiskAllocatedGB         int64             `json:"diskAllocatedGb,omitempty"`
+	DiskUsedGB              int64             `json:"diskUsedGb,omitempty"`
+	Taints                  []Taint           `json:"taints,omitempty"`         // AgentTaints merged with OperatorTaints
+	AgentTaints             []Taint           `json:"agentTaints,omitempty"`    // Sent by the agent on registration
+	OperatorTaints          []Taint           `json:"operatorTaints,omitempty"` // Set by SetNodeTaints
+	Unschedulable           bool              `json:"unschedulable,omitempty"`  // Set by CordonNode/DrainNode
+	Drain                   *NodeDrainStatus  `json:"drain,omitempty"`
 	DomainName              string            `json:"domainName,omitempty"` // Added field
 }
 
+// WorkloadAllocation is the capacity the scheduler reserved for one workload
+// on a node. CPU is in cores; DiskGB includes the per-pool amounts in Pools.
+type WorkloadAllocation struct {
+	WorkloadID  string           `json:"workloadId"`
+	CPU         float64          `json:"cpu"`
+	MemoryMB    int64            `json:"memoryMb"`
+	DiskGB      int64            `json:"diskGb"`
+	Pools       map[string]int64 `json:"pools,omitempty"`
+	HostPorts   []string         `json:"hostPorts,omitempty"` // "port/protocol"
+	Victims     []string         `json:"victims,omitempty"`   // workloads preempted to make room for this one
+	AllocatedAt time.Time        `json:"allocatedAt"`
+}
+
+// NodeAllocationLedger holds every reservation on a node. It is one etcd key
+// per node and is only written with compare-and-swap.
+type NodeAllocationLedger struct {
+	NodeID      string                        `json:"nodeId"`
+	Allocations map[string]WorkloadAllocation `json:"allocations"`
+	UpdatedAt   time.Time                     `json:"updatedAt"`
+}
+
+// NodeAllocationSummary totals a node's ledger.
+type NodeAllocationSummary struct {
+	CPU       float64
+	MemoryMB  int64
+	DiskGB    int64
+	Pools     map[string]int64
+	HostPorts map[string]string // "port/protocol" -> workload ID
+	Workloads int
+}
+
+// PendingWorkload is an entry in the scheduling queue: a workload no node
+// could take on its last placement attempt.
+type PendingWorkload struct {
+	WorkloadID    string          `json:"workloadId"`
+	Priority      int             `json:"priority"`
+	PriorityClass string          `json:"priorityClass,omitempty"`
+	QueuedAt      time.Time       `json:"queuedAt"`
+	LastAttemptAt time.Time       `json:"lastAttemptAt"`
+	NextAttemptAt time.Time       `json:"nextAttemptAt"`
+	Attempts      int             `json:"attempts"`
+	Message       string          `json:"message,omitempty"`
+	Rejections    []NodeRejection `json:"rejections,omitempty"`
+}
+
+// NodeRejection is why placement turned a node down.
+type NodeRejection struct {
+	NodeID string `json:"nodeId"`
+	Filter string `json:"filter"`
+	Reason string `json:"reason"`
+}
+
+// Taint effects.
+const (
+	TaintEffectNoSchedule       = "NoSchedule"
+	TaintEffectPreferNoSchedule = "PreferNoSchedule"
+	TaintEffectNoExecute        = "NoExecute"
+)
+
+// Taint repels workloads that do not tolerate it. NoSchedule keeps new
+// workloads off the node, PreferNoSchedule only lowers its score, and
+// NoExecute also moves untolerating workloads that already run there.
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// Toleration lets a workload ignore matching taints. Operator is Equal
+// (default) or Exists; an empty Key with Exists matches every taint, and an
+// empty Effect matches every effect.
+type Toleration struct {
+	Key      string `json:"key,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	Value    string `json:"value,omitempty"`
+	Effect   string `json:"effect,omitempty"`
+}
+
+// Drain states.
+const (
+	NodeDrainStateDraining = "Draining"
+	NodeDrainStateDrained  = "Drained"
+)
+
+// NodeDrainStatus tracks a DrainNode request. InFlight holds workloads that
+// were moved off the node but are not Running elsewhere yet; its length is
+// bounded by MaxUnavailable.
+type NodeDrainStatus struct {
+	State          string    `json:"state"`
+	Reason         string    `json:"reason,omitempty"`
+	MaxUnavailable int       `json:"maxUnavailable"`
+	StartedAt      time.Time `json:"startedAt"`
+	CompletedAt    time.Time `json:"completedAt,omitempty"`
+	Total          int       `json:"total"`
+	Migrated       int       `json:"migrated"`
+	Failed         int       `json:"failed"`
+	Remaining      int       `json:"remaining"`
+	InFlight       []string  `json:"inFlight,omitempty"`
+	LastError      string    `json:"lastError,omitempty"`
+}
+
+// StoragePool is a disk pool advertised by a node agent. TotalGB comes from
+// registration; AllocatedGB (provisioned sizes) and UsedGB (bytes actually
+// written) are refreshed by heartbeats.
+type StoragePool struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	TotalGB     int64  `json:"totalGb"`
+	AllocatedGB int64  `json:"allocatedGb"`
+	UsedGB      int64  `json:"usedGb"`
+}
+
+// NodeDiskUsage is the disk part of a heartbeat. Pools carries per-pool
+// figures when the agent reports them; only Name, AllocatedGB and UsedGB are read.
+type NodeDiskUsage struct {
+	AllocatedGB int64
+	UsedGB      int64
+	Pools       []StoragePool
+}
+
 // Workload represents a scheduled task
 type Workload struct {
 	ID             string                 `json:"id,omitempty"`
+	Namespace      string                 `json:"namespace,omitempty"` // empty means "default"
 	Name           string                 `json:"name" binding:"required"`
 	Type           string                 `json:"type" binding:"required"` // "docker-container", "docker-compose", "compose", "vm"
 	RevisionID     string                 `json:"revisionId,omitempty"`    // stable revision for idempotent apply
 	AssignedNode   string                 `json:"assignedNode,omitempty"`
-	Image          string                 `json:"image,omitempty"`       // For docker-container
-	Command        string                 `json:"command,omitempty"`     // For docker-container
-	CommandList    []string               `json:"commandList,omitempty"` // Preserves tokenized command/args for container workloads
-	Compose        string                 `json:"compose,omitempty"`     // Base64-encoded Compose content (optional)
-	ComposeYAML    string                 `json:"composeYaml,omitempty"` // Base64-encoded Compose YAML for compute-agent compose spec
-	ProjectName    string                 `json:"projectName,omitempty"` // Deterministic compose project name
-	GitRepo        string                 `json:"gitRepo,omitempty"`     // Git URL for git-compose
-	GitBranch      string                 `json:"gitBranch,omitempty"`   // Git branch for git-compose
-	GitToken       string                 `json:"gitToken,omitempty"`    // Optional Git auth token
-	EnvVars        map[string]string      `json:"envVars,omitempty"`     // Environment variables
+	NominatedNode  string                 `json:"nominatedNode,omitempty"` // node a preemption reserved capacity on
+	Image          string                 `json:"image,omitempty"`         // For docker-container
+	Command        string                 `json:"command,omitempty"`       // For docker-container
+	CommandList    []string               `json:"commandList,omitempty"`   // Preserves tokenized command/args for container workloads
+	Compose        string                 `json:"compose,omitempty"`       // Base64-encoded Compose content (optional)
+	ComposeYAML    string                 `json:"composeYaml,omitempty"`   // Base64-encoded Compose YAML for compute-agent compose spec
+	ProjectName    string                 `json:"projectName,omitempty"`   // Deterministic compose project name
+	GitRepo        string                 `json:"gitRepo,omitempty"`       // Git URL for git-compose
+	GitBranch      string                 `json:"gitBranch,omitempty"`     // Git branch for git-compose
+	GitTokenRef    *SecretKeyRef          `json:"gitTokenRef,omitempty"`   // Optional Git auth token, kept in a secret
+	EnvVars        map[string]string      `json:"envVars,omitempty"`       // Environment variables
 	Resources      Resources              `json:"resources"`
 	NodeID         string                 `json:"nodeId,omitempty"`
 	Status         string                 `json:"status"`
@@ -93,7 +223,8 @@ type Workload struct {
 	Labels         map[string]string      `json:"labels,omitempty"`
 	CreatedAt      time.Time              `json:"createdAt"`
 	LocalPath      string                 `json:"localPath,omitempty"` // Local path for docker-compose
-	Ports          []string               `json:"ports,omitempty"`     // e.g., ["8080:80"]
+	Ports          []string               `json:"ports,omitempty"`     // e.g., ["8080:80"]; host port 0 is allocated by the scheduler
+	HostPorts      []string               `json:"hostPorts,omitempty"` // Ports with allocated host ports filled in, set on placement
 	Volumes        []string               `json:"volumes,omitempty"`   // e.g., ["/host:/container"]
 	ManagedVolumes []ManagedVolumeSpec    `json:"managedVolumes,omitempty"`
 	Network        string                 `json:"network,omitempty"`       // e.g., "bridge"
@@ -104,6 +235,427 @@ type Workload struct {
 	StatusInfo     WorkloadStatusInfo     `json:"statusInfo"`
 	Usage          *WorkloadUsage         `json:"usage,omitempty"`
 	VM             *VMSpec                `json:"vm,omitempty"` // VM workload spec
+	Placement      *PlacementPolicy       `json:"placement,omitempty"`
+	Tolerations    []Toleration           `json:"tolerations,omitempty"`
+	Priority       int                    `json:"priority,omitempty"`      // higher wins; resolved from PriorityClass when set
+	PriorityClass  string                 `json:"priorityClass,omitempty"` // named class from SCHEDULER_PRIORITY_CLASSES
+	Rollout        *RolloutStrategy       `json:"rollout,omitempty"`
+	LivenessProbe  *Probe                 `json:"livenessProbe,omitempty"`  // restarted when failing
+	ReadinessProbe *Probe                 `json:"readinessProbe,omitempty"` // ready only when passing
+	EnvFrom        []EnvFromSource        `json:"envFrom,omitempty"`        // secrets and configs injected as env vars
+	Files          []FileMount            `json:"files,omitempty"`          // secret and config keys written to files
+	RefsRevision   string                 `json:"refsRevision,omitempty"`   // versions of the referenced secrets and configs
+}
+
+// EnvFromSource injects every key of a secret or config as an environment
+// variable. Exactly one of Secret and Config is set. Explicit EnvVars win
+// over injected keys.
+type EnvFromSource struct {
+	Secret string `json:"secret,omitempty"`
+	Config string `json:"config,omitempty"`
+	Prefix string `json:"prefix,omitempty"` // prepended to every key
+}
+
+// FileMount writes a key of a secret or config to Path, or every key as a
+// file under the directory Path when Key is empty.
+type FileMount struct {
+	Secret string `json:"secret,omitempty"`
+	Config string `json:"config,omitempty"`
+	Key    string `json:"key,omitempty"`
+	Path   string `json:"path"`
+	Mode   int    `json:"mode,omitempty"` // default 0400 for secrets, 0444 for configs
+}
+
+// TemplateVar makes a secret or config key available to the cloud-init
+// templates of a VM as {{ .Name }}.
+type TemplateVar struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret,omitempty"`
+	Config string `json:"config,omitempty"`
+	Key    string `json:"key"`
+}
+
+// SecretKeyRef names one key of a secret in the workload's namespace.
+type SecretKeyRef struct {
+	Secret string `json:"secret"`
+	Key    string `json:"key"`
+}
+
+// Probe is a health check the agent runs against a workload. Exactly one of
+// HTTPGet, TCPSocket and Exec is set. The agent reports each run; the
+// scheduler applies the thresholds.
+type Probe struct {
+	HTTPGet             *HTTPGetProbe   `json:"httpGet,omitempty"`
+	TCPSocket           *TCPSocketProbe `json:"tcpSocket,omitempty"`
+	Exec                *ExecProbe      `json:"exec,omitempty"`
+	InitialDelaySeconds int             `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int             `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      int             `json:"timeoutSeconds,omitempty"`
+	SuccessThreshold    int             `json:"successThreshold,omitempty"` // default 1
+	FailureThreshold    int             `json:"failureThreshold,omitempty"` // default 3
+}
+
+type HTTPGetProbe struct {
+	Path    string            `json:"path,omitempty"`
+	Port    int               `json:"port"`
+	Scheme  string            `json:"scheme,omitempty"` // http|https
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type TCPSocketProbe struct {
+	Port int `json:"port"`
+}
+
+type ExecProbe struct {
+	Command []string `json:"command"`
+}
+
+// Probe kinds.
+const (
+	ProbeLiveness  = "liveness"
+	ProbeReadiness = "readiness"
+)
+
+// ProbeResult is one probe run reported by an agent.
+type ProbeResult struct {
+	Probe     string    `json:"probe"` // liveness|readiness
+	Success   bool      `json:"success"`
+	Message   string    `json:"message,omitempty"`
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+// Probe states.
+const (
+	ProbeUnknown = "Unknown"
+	ProbePassing = "Passing"
+	ProbeFailing = "Failing"
+)
+
+// ProbeState is the scheduler's verdict on one probe.
+type ProbeState struct {
+	Status               string    `json:"status"`
+	ConsecutiveSuccesses int       `json:"consecutiveSuccesses,omitempty"`
+	ConsecutiveFailures  int       `json:"consecutiveFailures,omitempty"`
+	LastMessage          string    `json:"lastMessage,omitempty"`
+	LastCheckedAt        time.Time `json:"lastCheckedAt,omitempty"`
+	LastTransitionAt     time.Time `json:"lastTransitionAt,omitempty"`
+}
+
+// WorkloadHealth carries probe verdicts and liveness restarts.
+type WorkloadHealth struct {
+	Liveness          *ProbeState `json:"liveness,omitempty"`
+	Readiness         *ProbeState `json:"readiness,omitempty"`
+	Restarts          int         `json:"restarts,omitempty"`
+	LastRestartAt     time.Time   `json:"lastRestartAt,omitempty"`
+	LastRestartReason string      `json:"lastRestartReason,omitempty"`
+}
+
+// Rollout strategy types.
+const (
+	RolloutRecreate      = "Recreate"
+	RolloutRollingUpdate = "RollingUpdate"
+)
+
+// RolloutStrategy controls how a spec change reaches running replicas.
+// Recreate updates every replica at once; RollingUpdate keeps at most
+// MaxUnavailable replicas down and runs up to MaxSurge extra replicas while
+// the rollout is in progress. A standalone workload always recreates.
+type RolloutStrategy struct {
+	Type           string `json:"type,omitempty"`
+	MaxUnavailable int    `json:"maxUnavailable,omitempty"`
+	MaxSurge       int    `json:"maxSurge,omitempty"`
+}
+
+// Rollout phases.
+const (
+	RolloutProgressing = "Progressing"
+	RolloutComplete    = "Complete"
+	RolloutFailed      = "Failed"
+)
+
+// RolloutStatus tracks the latest spec revision on its way to the agent.
+type RolloutStatus struct {
+	Strategy           string    `json:"strategy,omitempty"`
+	Revision           int       `json:"revision,omitempty"` // sequence number in the revision history
+	RevisionID         string    `json:"revisionId,omitempty"`
+	PreviousRevisionID string    `json:"previousRevisionId,omitempty"`
+	Phase              string    `json:"phase,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	StartedAt          time.Time `json:"startedAt,omitempty"`
+	CompletedAt        time.Time `json:"completedAt,omitempty"`
+}
+
+// WorkloadRevision is an immutable snapshot of a workload spec. Revision is
+// the sequence number and is re-stamped when an old revision is rolled back to.
+type WorkloadRevision struct {
+	WorkloadID  string    `json:"workloadId"`
+	RevisionID  string    `json:"revisionId"`
+	Revision    int       `json:"revision"`
+	Spec        Workload  `json:"spec"`
+	ChangeCause string    `json:"changeCause,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// PlacementPolicy carries per-workload placement preferences for the scoring pipeline.
+type PlacementPolicy struct {
+	Strategy              string                    `json:"strategy,omitempty"` // spread|binpack; empty uses scheduler default
+	RequiredNodeAffinity  []NodeSelectorRequirement `json:"requiredNodeAffinity,omitempty"`
+	PreferredNodeAffinity []PreferredNodeAffinity   `json:"preferredNodeAffinity,omitempty"`
+	AntiAffinityGroup     string                    `json:"antiAffinityGroup,omitempty"` // defaults to the replica set for replica children
+	AntiAffinityRequired  bool                      `json:"antiAffinityRequired,omitempty"`
+	SpreadTopologyKey     string                    `json:"spreadTopologyKey,omitempty"` // node label, e.g. zone or rack
+	AffinityGroup         string                    `json:"affinityGroup,omitempty"`     // placed on the node of the group's other members
+}
+
+type NodeSelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"` // In|NotIn|Exists|DoesNotExist
+	Values   []string `json:"values,omitempty"`
+}
+
+type PreferredNodeAffinity struct {
+	Weight      int                     `json:"weight"`
+	Requirement NodeSelectorRequirement `json:"requirement"`
+}
+
+// ReplicaSet keeps Replicas copies of Template converged as child workloads.
+type ReplicaSet struct {
+	ID               string           `json:"id"`
+	Replicas         int              `json:"replicas"`
+	DesiredState     string           `json:"desiredState,omitempty"` // Running|Stopped|Deleted
+	Template         Workload         `json:"template"`
+	TemplateRevision string           `json:"templateRevision,omitempty"`
+	CreatedAt        time.Time        `json:"createdAt"`
+	UpdatedAt        time.Time        `json:"updatedAt"`
+	Status           ReplicaSetStatus `json:"status"`
+}
+
+type ReplicaSetStatus struct {
+	CurrentReplicas  int       `json:"currentReplicas"`
+	ReadyReplicas    int       `json:"readyReplicas"`
+	UpdatedReplicas  int       `json:"updatedReplicas"` // children on the current template revision
+	WorkloadIDs      []string  `json:"workloadIds,omitempty"`
+	LastScaledAt     time.Time `json:"lastScaledAt,omitempty"`
+	LastReconciledAt time.Time `json:"lastReconciledAt,omitempty"`
+}
+
+// ResourceQuota caps the combined requests of a namespace's workloads. Zero
+// fields are unlimited.
+type ResourceQuota struct {
+	CPU       float64 `json:"cpu,omitempty"` // cores
+	MemoryMB  int64   `json:"memoryMb,omitempty"`
+	DiskGB    int64   `json:"diskGb,omitempty"`
+	Workloads int     `json:"workloads,omitempty"`
+	VMs       int     `json:"vms,omitempty"`
+}
+
+// QuotaCharge is what one workload counts against its namespace quota.
+type QuotaCharge struct {
+	ResourceQuota
+	ChargedAt time.Time `json:"chargedAt"`
+}
+
+// NamespaceUsageLedger holds the quota charges of a namespace's live
+// workloads. It is one etcd key per namespace with a quota and is only
+// written with compare-and-swap.
+type NamespaceUsageLedger struct {
+	Namespace string                 `json:"namespace"`
+	Charges   map[string]QuotaCharge `json:"charges"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+}
+
+// Namespace groups workloads of one tenant and holds its quota.
+type Namespace struct {
+	Name      string            `json:"name"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Quota     ResourceQuota     `json:"quota"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// Secret is the plaintext view of a secret. Data is never persisted or
+// returned by read APIs; etcd holds a SealedSecret instead.
+type Secret struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Data      map[string]string `json:"-"`
+	Keys      []string          `json:"keys,omitempty"`
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// SealedSecret is a secret as stored in etcd. Ciphertext is the JSON-encoded
+// data sealed with AES-256-GCM under a per-secret data key; WrappedKey is
+// that data key encrypted by the key-encryption key named by KEK.
+type SealedSecret struct {
+	Name       string            `json:"name"`
+	Namespace  string            `json:"namespace"`
+	Labels     map[string]string `json:"labels,omitempty"`
+	Keys       []string          `json:"keys,omitempty"`
+	Version    int               `json:"version"`
+	KEK        string            `json:"kek"`
+	WrappedKey string            `json:"wrappedKey"`
+	Ciphertext string            `json:"ciphertext"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+}
+
+// Config holds non-sensitive settings injected into workloads the same way
+// as secrets. It is stored in plaintext.
+type Config struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Data      map[string]string `json:"data,omitempty"`
+	Version   int               `json:"version"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// Service publishes DNS records for the ready workloads of its namespace
+// whose labels match every entry of Selector.
+type Service struct {
+	Name      string            `json:"name"`
+	Namespace string            `json:"namespace"`
+	Selector  map[string]string `json:"selector"`
+	Ports     []ServicePort     `json:"ports,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	UpdatedAt time.Time         `json:"updatedAt"`
+}
+
+// ServicePort names a port of the selected workloads. The record points at
+// the host port the workload publishes for Port; HostPort is used instead
+// when the workload publishes no mapping for it, as with compose stacks.
+type ServicePort struct {
+	Name     string `json:"name,omitempty"`
+	Protocol string `json:"protocol,omitempty"` // tcp (default) or udp
+	Port     int    `json:"port"`
+	HostPort int    `json:"hostPort,omitempty"`
+}
+
+// ServiceEndpoint is one published address of a service.
+type ServiceEndpoint struct {
+	WorkloadID string `json:"workloadId"`
+	NodeID     string `json:"nodeId"`
+	Host       string `json:"host"`
+	Port       int    `json:"port,omitempty"`
+	PortName   string `json:"portName,omitempty"`
+	Protocol   string `json:"protocol,omitempty"`
+}
+
+// Stack phases.
+const (
+	StackProgressing = "Progressing"
+	StackRunning     = "Running"
+	StackDegraded    = "Degraded"
+	StackStopped     = "Stopped"
+	StackDeleting    = "Deleting"
+)
+
+// Stack groups the workloads of one application. Members are started once
+// the members they depend on are ready, and stopped and deleted in reverse
+// order.
+type Stack struct {
+	Name         string        `json:"name"`
+	Namespace    string        `json:"namespace"`
+	DesiredState string        `json:"desiredState,omitempty"` // Running|Stopped|Deleted
+	Colocate     bool          `json:"colocate,omitempty"`     // place every member on one node
+	Members      []StackMember `json:"members"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	UpdatedAt    time.Time     `json:"updatedAt"`
+	Status       StackStatus   `json:"status"`
+}
+
+type StackMember struct {
+	Name             string   `json:"name"`
+	DependsOn        []string `json:"dependsOn,omitempty"`
+	Template         Workload `json:"template"`
+	TemplateRevision string   `json:"templateRevision,omitempty"`
+}
+
+type StackStatus struct {
+	Phase            string              `json:"phase,omitempty"`
+	ReadyMembers     int                 `json:"readyMembers"`
+	Members          []StackMemberStatus `json:"members,omitempty"`
+	LastReconciledAt time.Time           `json:"lastReconciledAt,omitempty"`
+}
+
+type StackMemberStatus struct {
+	Name       string   `json:"name"`
+	WorkloadID string   `json:"workloadId,omitempty"`
+	NodeID     string   `json:"nodeId,omitempty"`
+	Status     string   `json:"status,omitempty"`
+	Ready      bool     `json:"ready"`
+	WaitingFor []string `json:"waitingFor,omitempty"` // members this one waits on
+}
+
+// Job phases.
+const (
+	JobPending   = "Pending"
+	JobRunning   = "Running"
+	JobSucceeded = "Succeeded"
+	JobFailed    = "Failed"
+)
+
+// Job runs Template to completion. Each run is a child workload; a run that
+// exits with code 0 succeeds and is not restarted.
+type Job struct {
+	ID                      string    `json:"id"`
+	Template                Workload  `json:"template"`
+	Completions             int       `json:"completions"`                       // successful runs needed; default 1
+	Parallelism             int       `json:"parallelism"`                       // runs at once; default 1
+	BackoffLimit            int       `json:"backoffLimit"`                      // failed runs tolerated before the job fails
+	ActiveDeadlineSeconds   int       `json:"activeDeadlineSeconds,omitempty"`   // 0 means no deadline
+	TTLSecondsAfterFinished int       `json:"ttlSecondsAfterFinished,omitempty"` // 0 keeps finished jobs
+	CronJobID               string    `json:"cronJobId,omitempty"`               // owning cron job, if any
+	DesiredState            string    `json:"desiredState,omitempty"`            // empty or Deleted
+	CreatedAt               time.Time `json:"createdAt"`
+	UpdatedAt               time.Time `json:"updatedAt"`
+	Status                  JobStatus `json:"status"`
+}
+
+type JobStatus struct {
+	Phase            string    `json:"phase"`
+	Active           int       `json:"active"`
+	Succeeded        int       `json:"succeeded"`
+	Failed           int       `json:"failed"`
+	Reason           string    `json:"reason,omitempty"`
+	WorkloadIDs      []string  `json:"workloadIds,omitempty"`
+	StartedAt        time.Time `json:"startedAt,omitempty"`
+	CompletedAt      time.Time `json:"completedAt,omitempty"`
+	LastReconciledAt time.Time `json:"lastReconciledAt,omitempty"`
+}
+
+// Cron job concurrency policies.
+const (
+	ConcurrencyAllow   = "Allow"
+	ConcurrencyForbid  = "Forbid"
+	ConcurrencyReplace = "Replace"
+)
+
+// CronJob creates a Job from JobTemplate at every time matching Schedule.
+type CronJob struct {
+	ID                         string        `json:"id"`
+	Schedule                   string        `json:"schedule"`          // standard five-field cron; CRON_TZ= prefix allowed
+	ConcurrencyPolicy          string        `json:"concurrencyPolicy"` // Allow|Forbid|Replace
+	Suspend                    bool          `json:"suspend,omitempty"`
+	StartingDeadlineSeconds    int           `json:"startingDeadlineSeconds,omitempty"` // skip runs missed by more than this
+	SuccessfulJobsHistoryLimit int           `json:"successfulJobsHistoryLimit"`
+	FailedJobsHistoryLimit     int           `json:"failedJobsHistoryLimit"`
+	JobTemplate                Job           `json:"jobTemplate"`
+	DesiredState               string        `json:"desiredState,omitempty"` // empty or Deleted
+	CreatedAt                  time.Time     `json:"createdAt"`
+	UpdatedAt                  time.Time     `json:"updatedAt"`
+	Status                     CronJobStatus `json:"status"`
+}
+
+type CronJobStatus struct {
+	ActiveJobIDs     []string  `json:"activeJobIds,omitempty"`
+	LastScheduleAt   time.Time `json:"lastScheduleAt,omitempty"`
+	LastSuccessfulAt time.Time `json:"lastSuccessfulAt,omitempty"`
+	NextScheduleAt   time.Time `json:"nextScheduleAt,omitempty"`
 }
 
 type RetryState struct {
@@ -117,6 +669,8 @@ type WorkloadStatusInfo struct {
 	LastUpdated   time.Time       `json:"lastUpdated,omitempty"`
 	FailureReason string          `json:"failureReason,omitempty"`
 	Reason        *WorkloadReason `json:"reason,omitempty"`
+	Rollout       *RolloutStatus  `json:"rollout,omitempty"`
+	Health        *WorkloadHealth `json:"health,omitempty"`
 }
 
 type AssignmentRecord struct {
@@ -142,6 +696,9 @@ type SchedulerEvent struct {
 	Reason     string                 `json:"reason,omitempty"`
 	Timestamp  time.Time              `json:"timestamp"`
 	Details    map[string]interface{} `json:"details,omitempty"`
+	// Revision orders events for WatchEvents: the etcd mod revision, or a
+	// Redis sequence number when events are kept in Redis.
+	Revision int64 `json:"revision,omitempty"`
 }
 
 type DriftRecord struct {
@@ -167,9 +724,11 @@ type VMSpec struct {
 	Metadata        map[string]string   `json:"metadata,omitempty"`
 	CloudInitConfig *CloudInitConfig    `json:"cloudInitConfig,omitempty"`
 	ManagedVolumes  []ManagedVolumeSpec `json:"managedVolumes,omitempty"`
+	TemplateVars    []TemplateVar       `json:"templateVars,omitempty"` // renders CloudInit and CloudInitConfig as templates when set
 }
 
 type VMDiskConfig struct {
+	Pool   string `json:"pool,omitempty"`
 	Path   string `json:"path,omitempty"`
 	Device string `json:"device,omitempty"`
 	Format string `json:"format,omitempty"`
@@ -200,6 +759,7 @@ type ManagedVolumeSpec struct {
 	MountPath    string `json:"mountPath,omitempty"`
 	ReadOnly     bool   `json:"readOnly,omitempty"`
 	RetainPolicy string `json:"retainPolicy,omitempty"`
+	VolumeID     string `json:"volumeId,omitempty"` // existing volume to attach; fills the fields above
 }
 
 type WorkloadUsage struct {
@@ -215,6 +775,34 @@ type WorkloadUsage struct {
 	Source         string    `json:"source,omitempty"`
 }
 
+// WorkloadUsagePoint aggregates the usage samples of a workload over one
+// step starting at Timestamp. The disk and network counters are those of the
+// last sample in the step.
+type WorkloadUsagePoint struct {
+	Timestamp      time.Time `json:"timestamp"`
+	Samples        int       `json:"samples"`
+	CPUAvgPercent  float64   `json:"cpuAvgPercent"`
+	CPUMaxPercent  float64   `json:"cpuMaxPercent"`
+	MemoryAvgBytes int64     `json:"memoryAvgBytes"`
+	MemoryMaxBytes int64     `json:"memoryMaxBytes"`
+	DiskReadBytes  int64     `json:"diskReadBytes,omitempty"`
+	DiskWriteBytes int64     `json:"diskWriteBytes,omitempty"`
+	NetRXBytes     int64     `json:"netRxBytes,omitempty"`
+	NetTXBytes     int64     `json:"netTxBytes,omitempty"`
+}
+
+// WorkloadUsageHistory is the usage of a workload between From and To, one
+// point per Step, read from the Resolution rollup.
+type WorkloadUsageHistory struct {
+	WorkloadID string               `json:"workloadId"`
+	Resolution string               `json:"resolution"` // 1m, 5m or 1h
+	Step       time.Duration        `json:"step"`
+	From       time.Time            `json:"from"`
+	To         time.Time            `json:"to"`
+	Points     []WorkloadUsagePoint `json:"points"`
+	CPUTrend   string               `json:"cpuTrend,omitempty"` // increasing, decreasing or stable
+}
+
 type WorkloadReason struct {
 	Code           string    `json:"code,omitempty"`
 	Message        string    `json:"message,omitempty"`
@@ -238,6 +826,20 @@ type ManagedVolumeRecord struct {
 	AttachedNodes []string  `json:"attachedNodes,omitempty"`
 	CreatedAt     time.Time `json:"createdAt,omitempty"`
 	UpdatedAt     time.Time `json:"updatedAt,omitempty"`
+
+	NodeID         string                  `json:"nodeId,omitempty"` // node holding the data of a local volume
+	SourceVolumeID string                  `json:"sourceVolumeId,omitempty"`
+	SourceSnapshot string                  `json:"sourceSnapshot,omitempty"`
+	Snapshots      []ManagedVolumeSnapshot `json:"snapshots,omitempty"`
+	ReleasedAt     time.Time               `json:"releasedAt,omitempty"` // when the volume entered Released
+}
+
+// ManagedVolumeSnapshot is a point-in-time copy of a managed volume, kept
+// next to the volume by the agent.
+type ManagedVolumeSnapshot struct {
+	Name      string    `json:"name"`
+	SizeGB    int64     `json:"sizeGb,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitempty"`
 }
 
 // VolumeAttachmentRecord is the control-plane source of truth for node/workload attachment.
@@ -256,6 +858,38 @@ type VolumeAttachmentRecord struct {
 	UpdatedAt      time.Time `json:"updatedAt,omitempty"`
 }
 
+// StateArchiveVersion is the archive format written by ExportState.
+const StateArchiveVersion = 1
+
+// Archive sources.
+const (
+	StateSourceEtcd   = "etcd"   // read from the state store
+	StateSourceFrozen = "frozen" // in-memory snapshot taken on entering degraded/recovery mode
+)
+
+// StateArchive is a portable copy of the control-plane state, used to restore
+// a scheduler whose etcd was lost.
+type StateArchive struct {
+	Version     int                      `json:"version"`
+	CreatedAt   time.Time                `json:"createdAt"`
+	Source      string                   `json:"source"`
+	Reason      string                   `json:"reason,omitempty"`
+	Nodes       []Node                   `json:"nodes"`
+	Workloads   []Workload               `json:"workloads"` // spec and status
+	Assignments []AssignmentRecord       `json:"assignments"`
+	Namespaces  []Namespace              `json:"namespaces,omitempty"`
+	Secrets     []SealedSecret           `json:"secrets,omitempty"` // still sealed; import needs the same KEK
+	Configs     []Config                 `json:"configs,omitempty"`
+	Services    []Service                `json:"services,omitempty"`
+	Stacks      []Stack                  `json:"stacks,omitempty"`
+	ReplicaSets []ReplicaSet             `json:"replicaSets,omitempty"`
+	Jobs        []Job                    `json:"jobs,omitempty"`
+	CronJobs    []CronJob                `json:"cronJobs,omitempty"`
+	Revisions   []WorkloadRevision       `json:"revisions,omitempty"`
+	Volumes     []ManagedVolumeRecord    `json:"volumes,omitempty"`
+	Attachments []VolumeAttachmentRecord `json:"attachments,omitempty"`
+}
+
 // AgentCommand represents a command payload for the agent API
 type AgentCommand struct {
 	Command string `json:"command"`
@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -229,8 +230,12 @@ func normalizeProjectName(name string) string {
 	return out
 }
 
-func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentpb.ApplyWorkloadRequest, error) {
+func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentpb.ApplyWorkloadRequest, resolvedRefs, error) {
 	s.ensureWorkloadRevision(&workload)
+	refs, err := s.resolveWorkloadRefs(workload)
+	if err != nil {
+		return nil, refs, err
+	}
 
 	req := &agentpb.ApplyWorkloadRequest{
 		Id:           workload.ID,
@@ -244,12 +249,15 @@ func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentp
 		req.Type = agentpb.WorkloadType_WORKLOAD_TYPE_CONTAINER
 		containerSpec := &agentpb.ContainerSpec{
 			Image:          workload.Image,
-			Env:            workload.EnvVars,
+			Env:            refs.withEnv(workload.EnvVars),
+			Files:          refs.files,
 			Labels:         workload.Labels,
 			ManagedVolumes: toAgentManagedVolumes(workload.ManagedVolumes),
 			RestartPolicy: &agentpb.RestartPolicy{
 				Policy: workload.RestartPolicy,
 			},
+			LivenessProbe:  toAgentProbe(workload.LivenessProbe),
+			ReadinessProbe: toAgentProbe(workload.ReadinessProbe),
 		}
 		if len(workload.CommandList) > 0 {
 			containerSpec.Command = append([]string{}, workload.CommandList...)
@@ -270,17 +278,17 @@ func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentp
 				MemoryBytes: int64(workload.Resources.MemoryUsage) * 1024 * 1024,
 			}
 		}
-		for _, port := range workload.Ports {
+		for _, port := range workloadPorts(workload) {
 			pm, err := parsePortMapping(port)
 			if err != nil {
-				return nil, err
+				return nil, refs, err
 			}
 			containerSpec.Ports = append(containerSpec.Ports, pm)
 		}
 		for _, volume := range workload.Volumes {
 			vm, err := parseVolumeMount(volume)
 			if err != nil {
-				return nil, err
+				return nil, refs, err
 			}
 			containerSpec.Volumes = append(containerSpec.Volumes, vm)
 		}
@@ -292,8 +300,9 @@ func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentp
 		if composeYAML == "" {
 			composeYAML = strings.TrimSpace(workload.Compose)
 		}
-		if composeYAML == "" {
-			return nil, fmt.Errorf("compose_yaml is required for compose workloads")
+		gitRepo := strings.TrimSpace(workload.GitRepo)
+		if composeYAML == "" && gitRepo == "" {
+			return nil, refs, fmt.Errorf("compose_yaml or git_repo is required for compose workloads")
 		}
 		projectName := strings.TrimSpace(workload.ProjectName)
 		if projectName == "" {
@@ -302,33 +311,52 @@ func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentp
 		req.Spec.Spec = &agentpb.WorkloadSpec_Compose{Compose: &agentpb.ComposeSpec{
 			ProjectName: projectName,
 			ComposeYaml: composeYAML,
-			Env:         workload.EnvVars,
+			Env:         refs.withEnv(workload.EnvVars),
+			Files:       refs.files,
+			GitRepo:     gitRepo,
+			GitRef:      strings.TrimSpace(workload.GitBranch),
+			GitToken:    refs.gitToken,
 		}}
 
 	case "vm":
 		req.Type = agentpb.WorkloadType_WORKLOAD_TYPE_VM
 		if workload.VM == nil {
-			return nil, fmt.Errorf("vm spec is required for vm workloads")
+			return nil, refs, fmt.Errorf("vm spec is required for vm workloads")
+		}
+		cloudInit, err := renderCloudInit("cloud_init", workload.VM.CloudInit, refs.vars)
+		if err != nil {
+			return nil, refs, err
 		}
 		vmSpec := &agentpb.VMSpec{
 			Name:           workload.VM.Name,
 			Vcpus:          workload.VM.VCPUs,
 			MemoryMb:       workload.VM.MemoryMB,
-			CloudInit:      workload.VM.CloudInit,
+			CloudInit:      cloudInit,
 			Metadata:       workload.VM.Metadata,
 			ManagedVolumes: toAgentManagedVolumes(workload.VM.ManagedVolumes),
+			LivenessProbe:  toAgentProbe(workload.LivenessProbe),
+			ReadinessProbe: toAgentProbe(workload.ReadinessProbe),
 		}
 		if workload.VM.CloudInitConfig != nil {
+			userData, err := renderCloudInit("user_data", workload.VM.CloudInitConfig.UserData, refs.vars)
+			if err != nil {
+				return nil, refs, err
+			}
+			vendorData, err := renderCloudInit("vendor_data", workload.VM.CloudInitConfig.VendorData, refs.vars)
+			if err != nil {
+				return nil, refs, err
+			}
 			vmSpec.CloudInitConfig = &agentpb.CloudInitConfig{
-				UserData:      workload.VM.CloudInitConfig.UserData,
+				UserData:      userData,
 				MetaData:      workload.VM.CloudInitConfig.MetaData,
 				NetworkConfig: workload.VM.CloudInitConfig.NetworkConfig,
-				VendorData:    workload.VM.CloudInitConfig.VendorData,
+				VendorData:    vendorData,
 			}
 		}
 		for _, disk := range workload.VM.Disks {
 			disk = normalizeVMDiskForAgent(workload.ID, disk)
 			vmSpec.Disks = append(vmSpec.Disks, &agentpb.DiskConfig{
+				Pool:   disk.Pool,
 				Path:   disk.Path,
 				Device: disk.Device,
 				Format: disk.Format,
@@ -347,12 +375,15 @@ func (s *Scheduler) buildApplyWorkloadRequest(workload models.Workload) (*agentp
 		req.Spec.Spec = &agentpb.WorkloadSpec_Vm{Vm: vmSpec}
 
 	default:
-		return nil, fmt.Errorf("unsupported workload type: %s", workload.Type)
+		return nil, refs, fmt.Errorf("unsupported workload type: %s", workload.Type)
 	}
 
-	return req, nil
+	return req, refs, nil
 }
 
+// defaultVMDiskSizeGB is the size given to VM disks that do not set one.
+const defaultVMDiskSizeGB = 20
+
 func normalizeVMDiskForAgent(workloadID string, disk models.VMDiskConfig) models.VMDiskConfig {
 	if strings.TrimSpace(disk.Device) == "" {
 		disk.Device = "vda"
@@ -364,7 +395,7 @@ func normalizeVMDiskForAgent(workloadID string, disk models.VMDiskConfig) models
 		disk.Type = "disk"
 	}
 	if disk.SizeGB <= 0 {
-		disk.SizeGB = 20
+		disk.SizeGB = defaultVMDiskSizeGB
 	}
 	if strings.TrimSpace(disk.Path) == "" {
 		ext := ".qcow2"
@@ -397,28 +428,72 @@ func toAgentManagedVolumes(in []models.ManagedVolumeSpec) []*agentpb.ManagedVolu
 	return out
 }
 
+func toAgentProbe(in *models.Probe) *agentpb.Probe {
+	if in == nil {
+		return nil
+	}
+	out := &agentpb.Probe{
+		InitialDelaySeconds: int32(in.InitialDelaySeconds),
+		PeriodSeconds:       int32(in.PeriodSeconds),
+		TimeoutSeconds:      int32(in.TimeoutSeconds),
+		SuccessThreshold:    int32(in.SuccessThreshold),
+		FailureThreshold:    int32(in.FailureThreshold),
+	}
+	switch {
+	case in.HTTPGet != nil:
+		out.Handler = &agentpb.Probe_HttpGet{HttpGet: &agentpb.HTTPGetAction{
+			Path:    in.HTTPGet.Path,
+			Port:    int32(in.HTTPGet.Port),
+			Scheme:  in.HTTPGet.Scheme,
+			Headers: in.HTTPGet.Headers,
+		}}
+	case in.TCPSocket != nil:
+		out.Handler = &agentpb.Probe_TcpSocket{TcpSocket: &agentpb.TCPSocketAction{Port: int32(in.TCPSocket.Port)}}
+	case in.Exec != nil:
+		out.Handler = &agentpb.Probe_Exec{Exec: &agentpb.ExecAction{Command: append([]string{}, in.Exec.Command...)}}
+	}
+	return out
+}
+
 func (s *Scheduler) applyWorkloadOnNode(ctx context.Context, node models.Node, workload models.Workload) (resp *agentpb.ApplyWorkloadResponse, err error) {
 	start := time.Now()
 	defer func() {
 		metricspkg.ObserveAgentRPC("ApplyWorkload", err, time.Since(start))
 	}()
 
-	client, conn, err := s.newAgentClient(node)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Close()
-
-	req, err := s.buildApplyWorkloadRequest(workload)
+	req, refs, err := s.buildApplyWorkloadRequest(workload)
 	if err != nil {
 		return nil, err
 	}
+	// Callers log agent messages and errors; keep secret values out of them.
+	defer func() {
+		if resp != nil {
+			resp.Message = refs.redact(resp.Message)
+		}
+		if err != nil {
+			if msg := err.Error(); refs.redact(msg) != msg {
+				err = errors.New(refs.redact(msg))
+			}
+		}
+	}()
 
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	ctx, cancel := context.WithTimeout(ctx, s.rpcTimeout())
 	defer cancel()
+
+	// Prefer the agent's ControlStream; dial only when no stream is attached.
+	resp, err = s.applyWorkloadViaStream(ctx, node, req)
+	if !errors.Is(err, errAgentStreamUnavailable) {
+		return resp, err
+	}
+
+	client, conn, err := s.newAgentClient(node)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
 	resp, err = client.ApplyWorkload(ctx, req)
 	if err != nil {
 		return nil, err
@@ -432,6 +507,10 @@ func (s *Scheduler) getWorkloadStatusFromNode(ctx context.Context, node models.N
 		metricspkg.ObserveAgentRPC("GetWorkloadStatus", err, time.Since(start))
 	}()
 
+	if streamed, ok, streamErr := s.streamWorkloadStatus(node, workloadID); ok {
+		return streamed, streamErr
+	}
+
 	client, conn, err := s.newAgentClient(node)
 	if err != nil {
 		return nil, err
@@ -457,6 +536,10 @@ func (s *Scheduler) listWorkloadsFromNode(ctx context.Context, node models.Node)
 		metricspkg.ObserveAgentRPC("ListWorkloads", err, time.Since(start))
 	}()
 
+	if streamed, ok := s.streamWorkloadList(node); ok {
+		return streamed, nil
+	}
+
 	client, conn, err := s.newAgentClient(node)
 	if err != nil {
 		return nil, err
@@ -506,17 +589,22 @@ func (s *Scheduler) deleteWorkloadFromNode(ctx context.Context, node models.Node
 		metricspkg.ObserveAgentRPC("DeleteWorkload", err, time.Since(start))
 	}()
 
-	client, conn, err := s.newAgentClient(node)
-	if err != nil {
-		return nil, err
-	}
-	defer conn.Close()
-
 	if ctx == nil {
 		ctx = context.Background()
 	}
 	ctx, cancel := context.WithTimeout(ctx, s.rpcTimeout())
 	defer cancel()
+
+	resp, err = s.deleteWorkloadViaStream(ctx, node, workloadID)
+	if !errors.Is(err, errAgentStreamUnavailable) {
+		return resp, err
+	}
+
+	client, conn, err := s.newAgentClient(node)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
 	resp, err = client.DeleteWorkload(ctx, &agentpb.DeleteWorkloadRequest{Id: workloadID})
 	return resp, err
 }
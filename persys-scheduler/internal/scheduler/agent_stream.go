@@ -0,0 +1,812 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	agentpb "github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	controlv1 "github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var streamLogger = logging.C("scheduler.agent_stream")
+
+const (
+	defaultStreamResumeWindow = 2 * time.Minute
+	agentStreamOutboxSize     = 64
+)
+
+// errAgentStreamUnavailable signals that a node has no attached ControlStream
+// and the caller should fall back to dialing the agent directly.
+var errAgentStreamUnavailable = errors.New("agent control stream unavailable")
+
+// agentStreamSession is the scheduler-side state of one node's ControlStream.
+// It outlives individual connections so an agent can reconnect with the same
+// session_id and receive any pushes it has not acknowledged yet.
+type agentStreamSession struct {
+	nodeID    string
+	sessionID string
+
+	mu          sync.Mutex
+	nextSeq     uint64
+	lastInbound uint64
+	unacked     []*controlv1.ControlMessage
+	pending     map[string]chan *controlv1.ControlMessage
+	deletes     map[string]string // correlation ID -> workload ID of delete pushes
+	statuses    map[string]*controlv1.WorkloadStatus
+	snapshotAt  time.Time
+	conn        *AgentStreamConn
+	detachedAt  time.Time
+}
+
+// AgentStreamConn is a single attached transport for an agent stream session.
+type AgentStreamConn struct {
+	session   *agentStreamSession
+	outbox    chan *controlv1.ControlMessage
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+type agentStreamRegistry struct {
+	mu           sync.Mutex
+	sessions     map[string]*agentStreamSession
+	resumeWindow time.Duration
+}
+
+func newAgentStreamRegistry(resumeWindow time.Duration) *agentStreamRegistry {
+	if resumeWindow <= 0 {
+		resumeWindow = defaultStreamResumeWindow
+	}
+	return &agentStreamRegistry{sessions: map[string]*agentStreamSession{}, resumeWindow: resumeWindow}
+}
+
+func (s *Scheduler) agentStreamsEnabled() bool {
+	if s.cfg != nil {
+		return s.cfg.SchedulerAgentStreamEnabled
+	}
+	return true
+}
+
+// AttachAgentStream binds a new ControlStream connection to the node's
+// session. When sessionID matches a session still inside the resume window,
+// unacknowledged pushes after ackSeq are replayed and resumed is true.
+func (s *Scheduler) AttachAgentStream(nodeID, sessionID string, ackSeq uint64) (*AgentStreamConn, bool) {
+	reg := s.agentStreams
+	nodeID = strings.TrimSpace(nodeID)
+	sessionID = strings.TrimSpace(sessionID)
+
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.pruneLocked()
+
+	existing := reg.sessions[nodeID]
+	resumed := existing != nil && sessionID != "" && existing.sessionID == sessionID
+	sess := existing
+	if !resumed {
+		if existing != nil {
+			existing.expire("superseded by new session")
+		}
+		sess = &agentStreamSession{
+			nodeID:    nodeID,
+			sessionID: uuid.NewString(),
+			pending:   map[string]chan *controlv1.ControlMessage{},
+			deletes:   map[string]string{},
+			statuses:  map[string]*controlv1.WorkloadStatus{},
+		}
+		reg.sessions[nodeID] = sess
+	}
+
+	conn := &AgentStreamConn{
+		session: sess,
+		outbox:  make(chan *controlv1.ControlMessage, agentStreamOutboxSize),
+		done:    make(chan struct{}),
+	}
+
+	sess.mu.Lock()
+	previous := sess.conn
+	sess.conn = conn
+	sess.detachedAt = time.Time{}
+	if resumed {
+		sess.ackLocked(ackSeq)
+		for _, msg := range sess.unacked {
+			select {
+			case conn.outbox <- msg:
+			default:
+				streamLogger.WithField("node_id", nodeID).Warn("agent stream replay exceeded outbox; remaining pushes will time out")
+			}
+		}
+	}
+	sess.mu.Unlock()
+	if previous != nil {
+		previous.closeTransport()
+	}
+
+	streamLogger.WithFields(logrus.Fields{
+		"node_id":    nodeID,
+		"session_id": sess.sessionID,
+		"resumed":    resumed,
+	}).Info("agent control stream attached")
+	return conn, resumed
+}
+
+// pruneLocked drops sessions that stayed detached beyond the resume window.
+func (r *agentStreamRegistry) pruneLocked() {
+	now := time.Now()
+	for nodeID, sess := range r.sessions {
+		sess.mu.Lock()
+		expired := sess.conn == nil && !sess.detachedAt.IsZero() && now.Sub(sess.detachedAt) > r.resumeWindow
+		sess.mu.Unlock()
+		if expired {
+			sess.expire("resume window elapsed")
+			delete(r.sessions, nodeID)
+		}
+	}
+}
+
+// expireAll drops every session, closing attached connections so their
+// agents reconnect.
+func (r *agentStreamRegistry) expireAll(reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for nodeID, sess := range r.sessions {
+		sess.expire(reason)
+		delete(r.sessions, nodeID)
+	}
+}
+
+// liveSession returns the node's session only while a connection is attached.
+func (r *agentStreamRegistry) liveSession(nodeID string) *agentStreamSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pruneLocked()
+	sess := r.sessions[strings.TrimSpace(nodeID)]
+	if sess == nil {
+		return nil
+	}
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.conn == nil {
+		return nil
+	}
+	return sess
+}
+
+// HasAgentStream reports whether the node currently holds an attached ControlStream.
+func (s *Scheduler) HasAgentStream(nodeID string) bool {
+	return s.agentStreams.liveSession(nodeID) != nil
+}
+
+func (sess *agentStreamSession) expire(reason string) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	for id, ch := range sess.pending {
+		close(ch)
+		delete(sess.pending, id)
+	}
+	sess.unacked = nil
+	if sess.conn != nil {
+		sess.conn.closeTransport()
+		sess.conn = nil
+	}
+	streamLogger.WithFields(logrus.Fields{
+		"node_id":    sess.nodeID,
+		"session_id": sess.sessionID,
+		"reason":     reason,
+	}).Info("agent control stream session expired")
+}
+
+func (sess *agentStreamSession) ackLocked(seq uint64) {
+	if seq == 0 || len(sess.unacked) == 0 {
+		return
+	}
+	keep := sess.unacked[:0]
+	for _, msg := range sess.unacked {
+		if msg.GetSequence() > seq {
+			keep = append(keep, msg)
+		}
+	}
+	sess.unacked = keep
+}
+
+func (sess *agentStreamSession) dropUnackedLocked(correlationID string) {
+	keep := sess.unacked[:0]
+	for _, msg := range sess.unacked {
+		if msg.GetCorrelationId() != correlationID {
+			keep = append(keep, msg)
+		}
+	}
+	sess.unacked = keep
+}
+
+func (c *AgentStreamConn) SessionID() string { return c.session.sessionID }
+
+func (c *AgentStreamConn) NodeID() string { return c.session.nodeID }
+
+// Outbound yields messages to write to the agent, in order.
+func (c *AgentStreamConn) Outbound() <-chan *controlv1.ControlMessage { return c.outbox }
+
+// Done is closed once this connection is detached or superseded.
+func (c *AgentStreamConn) Done() <-chan struct{} { return c.done }
+
+func (c *AgentStreamConn) closeTransport() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// Close detaches the connection. The session is kept for the resume window
+// so pending pushes can complete after the agent reconnects.
+func (c *AgentStreamConn) Close() {
+	sess := c.session
+	sess.mu.Lock()
+	if sess.conn == c {
+		sess.conn = nil
+		sess.detachedAt = time.Now()
+	}
+	sess.mu.Unlock()
+	c.closeTransport()
+	streamLogger.WithFields(logrus.Fields{
+		"node_id":    sess.nodeID,
+		"session_id": sess.sessionID,
+	}).Info("agent control stream detached")
+}
+
+// Send queues an unsequenced reply (acks and results) for the agent.
+func (c *AgentStreamConn) Send(ctx context.Context, msg *controlv1.ControlMessage) error {
+	msg.SessionId = c.session.sessionID
+	msg.NodeId = c.session.nodeID
+	c.session.mu.Lock()
+	msg.AckSequence = c.session.lastInbound
+	c.session.mu.Unlock()
+	select {
+	case c.outbox <- msg:
+		return nil
+	case <-c.done:
+		return errAgentStreamUnavailable
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Receive records envelope state for an inbound message. It returns false when
+// the message is a replayed duplicate or was fully handled here (push results).
+func (c *AgentStreamConn) Receive(msg *controlv1.ControlMessage) bool {
+	sess := c.session
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	sess.ackLocked(msg.GetAckSequence())
+	if seq := msg.GetSequence(); seq != 0 {
+		if seq <= sess.lastInbound {
+			return false
+		}
+		sess.lastInbound = seq
+	}
+
+	switch m := msg.GetMessage().(type) {
+	case *controlv1.ControlMessage_ApplyResult, *controlv1.ControlMessage_DeleteResult:
+		correlationID := strings.TrimSpace(msg.GetCorrelationId())
+		if workloadID, ok := sess.deletes[correlationID]; ok {
+			if msg.GetDeleteResult().GetSuccess() {
+				delete(sess.statuses, workloadID)
+			}
+			delete(sess.deletes, correlationID)
+		}
+		if ch, ok := sess.pending[correlationID]; ok {
+			ch <- msg
+			delete(sess.pending, correlationID)
+			sess.dropUnackedLocked(correlationID)
+			return false
+		}
+		// Results for pushes without a waiter come from agent-initiated
+		// requests being echoed back or from expired pushes; nothing to do.
+		return false
+	case *controlv1.ControlMessage_WorkloadStatus:
+		sess.observeStatusLocked(m.WorkloadStatus)
+	case *controlv1.ControlMessage_Heartbeat:
+		sess.replaceStatusesLocked(m.Heartbeat)
+	}
+	return true
+}
+
+// replaceStatusesLocked makes a heartbeat's workload statuses the full set
+// known for the node, so workloads gone from the agent drop out. Statuses
+// recorded after the heartbeat was taken (applies in flight) are kept.
+func (sess *agentStreamSession) replaceStatusesLocked(hb *controlv1.HeartbeatRequest) {
+	takenAt := time.Now()
+	if hb.GetTimestamp() != nil {
+		takenAt = hb.GetTimestamp().AsTime()
+	}
+	previous := sess.statuses
+	sess.statuses = make(map[string]*controlv1.WorkloadStatus, len(hb.GetWorkloadStatuses()))
+	for _, ws := range hb.GetWorkloadStatuses() {
+		sess.observeStatusLocked(ws)
+	}
+	for id, ws := range previous {
+		if _, ok := sess.statuses[id]; ok {
+			continue
+		}
+		if ws.GetLastTransition() != nil && ws.GetLastTransition().AsTime().After(takenAt) {
+			sess.statuses[id] = ws
+		}
+	}
+	sess.snapshotAt = time.Now()
+}
+
+func (sess *agentStreamSession) observeStatusLocked(ws *controlv1.WorkloadStatus) {
+	if ws == nil || strings.TrimSpace(ws.GetWorkloadId()) == "" {
+		return
+	}
+	sess.statuses[ws.GetWorkloadId()] = ws
+}
+
+// push sends a sequenced request to the agent and waits for its correlated
+// result. errAgentStreamUnavailable is returned only when the message could not
+// be handed to a live connection, so callers can safely fall back to dialing.
+func (s *Scheduler) pushToAgentStream(ctx context.Context, nodeID string, msg *controlv1.ControlMessage) (*controlv1.ControlMessage, error) {
+	if !s.agentStreamsEnabled() {
+		return nil, errAgentStreamUnavailable
+	}
+	sess := s.agentStreams.liveSession(nodeID)
+	if sess == nil {
+		return nil, errAgentStreamUnavailable
+	}
+
+	correlationID := uuid.NewString()
+	result := make(chan *controlv1.ControlMessage, 1)
+
+	sess.mu.Lock()
+	conn := sess.conn
+	if conn == nil {
+		sess.mu.Unlock()
+		return nil, errAgentStreamUnavailable
+	}
+	sess.nextSeq++
+	msg.Sequence = sess.nextSeq
+	msg.AckSequence = sess.lastInbound
+	msg.SessionId = sess.sessionID
+	msg.NodeId = sess.nodeID
+	msg.CorrelationId = correlationID
+	sess.pending[correlationID] = result
+	if del := msg.GetDelete(); del != nil {
+		sess.deletes[correlationID] = del.GetWorkloadId()
+	}
+	sess.unacked = append(sess.unacked, msg)
+	sess.mu.Unlock()
+
+	forget := func() {
+		sess.mu.Lock()
+		delete(sess.pending, correlationID)
+		delete(sess.deletes, correlationID)
+		sess.dropUnackedLocked(correlationID)
+		sess.mu.Unlock()
+	}
+
+	select {
+	case conn.outbox <- msg:
+	case <-conn.done:
+		forget()
+		return nil, errAgentStreamUnavailable
+	case <-ctx.Done():
+		forget()
+		return nil, errAgentStreamUnavailable
+	}
+
+	select {
+	case reply, ok := <-result:
+		if !ok {
+			return nil, fmt.Errorf("agent stream session for node %s expired before result", nodeID)
+		}
+		return reply, nil
+	case <-ctx.Done():
+		forget()
+		return nil, status.Errorf(codes.DeadlineExceeded, "agent stream result from node %s: %v", nodeID, ctx.Err())
+	}
+}
+
+func (s *Scheduler) applyWorkloadViaStream(ctx context.Context, node models.Node, req *agentpb.ApplyWorkloadRequest) (*agentpb.ApplyWorkloadResponse, error) {
+	if !s.agentStreamsEnabled() || s.agentStreams.liveSession(node.NodeID) == nil {
+		return nil, errAgentStreamUnavailable
+	}
+	apply, err := controlApplyFromAgentRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := s.pushToAgentStream(ctx, node.NodeID, &controlv1.ControlMessage{
+		Message: &controlv1.ControlMessage_Apply{Apply: apply},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := reply.GetApplyResult()
+	if result == nil {
+		return nil, fmt.Errorf("agent stream returned unexpected reply to apply")
+	}
+	resp := &agentpb.ApplyWorkloadResponse{Applied: result.GetSuccess(), Message: strings.TrimSpace(result.GetErrorMessage())}
+	if code := agentFailureCode(result.GetFailureReason()); !result.GetSuccess() && code != "" {
+		resp.Message = strings.TrimSpace(fmt.Sprintf("%s: %s", code, resp.Message))
+	}
+	if result.GetSuccess() {
+		pending := &controlv1.WorkloadStatus{
+			WorkloadId:     req.GetId(),
+			RevisionId:     req.GetRevisionId(),
+			State:          "Pending",
+			LastTransition: timestamppb.Now(),
+		}
+		if sess := s.agentStreams.liveSession(node.NodeID); sess != nil {
+			sess.mu.Lock()
+			if _, ok := sess.statuses[req.GetId()]; !ok {
+				sess.observeStatusLocked(pending)
+			}
+			sess.mu.Unlock()
+		}
+	}
+	return resp, nil
+}
+
+func (s *Scheduler) deleteWorkloadViaStream(ctx context.Context, node models.Node, workloadID string) (*agentpb.DeleteWorkloadResponse, error) {
+	reply, err := s.pushToAgentStream(ctx, node.NodeID, &controlv1.ControlMessage{
+		Message: &controlv1.ControlMessage_Delete{Delete: &controlv1.DeleteWorkloadRequest{WorkloadId: workloadID}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := reply.GetDeleteResult()
+	if result == nil {
+		return nil, fmt.Errorf("agent stream returned unexpected reply to delete")
+	}
+	return &agentpb.DeleteWorkloadResponse{Success: result.GetSuccess(), Message: strings.TrimSpace(result.GetErrorMessage())}, nil
+}
+
+// streamWorkloadStatus serves status from what the agent pushed over its
+// stream. ok is false when the stream cannot answer and the caller should poll.
+func (s *Scheduler) streamWorkloadStatus(node models.Node, workloadID string) (*agentpb.WorkloadStatus, bool, error) {
+	if !s.agentStreamsEnabled() {
+		return nil, false, nil
+	}
+	sess := s.agentStreams.liveSession(node.NodeID)
+	if sess == nil {
+		return nil, false, nil
+	}
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if ws, ok := sess.statuses[workloadID]; ok {
+		return agentStatusFromControl(ws), true, nil
+	}
+	if !sess.snapshotAt.IsZero() {
+		return nil, true, status.Errorf(codes.NotFound, "workload status not found for %s", workloadID)
+	}
+	return nil, false, nil
+}
+
+func (s *Scheduler) streamWorkloadList(node models.Node) ([]*agentpb.WorkloadStatus, bool) {
+	if !s.agentStreamsEnabled() {
+		return nil, false
+	}
+	sess := s.agentStreams.liveSession(node.NodeID)
+	if sess == nil {
+		return nil, false
+	}
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if sess.snapshotAt.IsZero() {
+		return nil, false
+	}
+	out := make([]*agentpb.WorkloadStatus, 0, len(sess.statuses))
+	for _, ws := range sess.statuses {
+		out = append(out, agentStatusFromControl(ws))
+	}
+	return out, true
+}
+
+func agentStatusFromControl(ws *controlv1.WorkloadStatus) *agentpb.WorkloadStatus {
+	out := &agentpb.WorkloadStatus{
+		Id:          ws.GetWorkloadId(),
+		RevisionId:  ws.GetRevisionId(),
+		ActualState: actualStateFromString(ws.GetState()),
+		Message:     ws.GetMessage(),
+		Exited:      ws.GetExited(),
+		ExitCode:    ws.GetExitCode(),
+	}
+	if ws.GetLastTransition() != nil {
+		out.UpdatedAt = ws.GetLastTransition().AsTime().Unix()
+	}
+	if code := agentFailureCode(ws.GetFailureReason()); code != "" {
+		out.Metadata = map[string]string{"failure_reason": code}
+	}
+	if u := ws.GetUsage(); u != nil {
+		out.Usage = &agentpb.WorkloadUsageSnapshot{
+			WorkloadId:     u.GetWorkloadId(),
+			CpuPercent:     u.GetCpuPercent(),
+			MemoryBytes:    u.GetMemoryBytes(),
+			DiskReadBytes:  u.GetDiskReadBytes(),
+			DiskWriteBytes: u.GetDiskWriteBytes(),
+			NetRxBytes:     u.GetNetRxBytes(),
+			NetTxBytes:     u.GetNetTxBytes(),
+			Source:         u.GetSource(),
+		}
+		if u.GetCollectedAt() != nil {
+			out.Usage.CollectedAt = u.GetCollectedAt().AsTime().Unix()
+		}
+	}
+	for _, r := range ws.GetProbeResults() {
+		result := &agentpb.ProbeResult{Probe: r.GetProbe(), Success: r.GetSuccess(), Message: r.GetMessage()}
+		if r.GetCheckedAt() != nil {
+			result.CheckedAt = r.GetCheckedAt().AsTime().Unix()
+		}
+		out.ProbeResults = append(out.ProbeResults, result)
+	}
+	return out
+}
+
+func actualStateFromString(state string) agentpb.ActualState {
+	switch strings.ToLower(strings.TrimSpace(state)) {
+	case "pending", "updating", "creating":
+		return agentpb.ActualState_ACTUAL_STATE_PENDING
+	case "running":
+		return agentpb.ActualState_ACTUAL_STATE_RUNNING
+	case "stopped", "exited":
+		return agentpb.ActualState_ACTUAL_STATE_STOPPED
+	case "failed":
+		return agentpb.ActualState_ACTUAL_STATE_FAILED
+	default:
+		return agentpb.ActualState_ACTUAL_STATE_UNKNOWN
+	}
+}
+
+// agentFailureCode translates a ControlStream failure reason into the code
+// the agent's runtime API reports, so the reconciler treats both transports
+// alike: invalid specs and missing images stop retries, everything else is
+// retried. It returns "" for an unspecified reason.
+func agentFailureCode(reason controlv1.FailureReason) string {
+	switch reason {
+	case controlv1.FailureReason_FAILURE_REASON_UNSPECIFIED:
+		return ""
+	case controlv1.FailureReason_INVALID_SPEC:
+		return "INVALID_SPECIFICATION"
+	case controlv1.FailureReason_IMAGE_NOT_FOUND:
+		return "INVALID_IMAGE"
+	}
+	return reason.String()
+}
+
+// controlApplyFromAgentRequest maps the runtime apply request onto the control
+// contract carried by ControlStream. VM specs travel embedded in metadata under
+// persys.vm_spec_b64, matching what ApplyWorkload already accepts.
+func controlApplyFromAgentRequest(req *agentpb.ApplyWorkloadRequest) (*controlv1.ApplyWorkloadRequest, error) {
+	out := &controlv1.ApplyWorkloadRequest{
+		WorkloadId:   req.GetId(),
+		RevisionId:   req.GetRevisionId(),
+		DesiredState: "Running",
+		Spec:         &controlv1.WorkloadSpec{Metadata: map[string]string{}},
+	}
+	if req.GetDesiredState() == agentpb.DesiredState_DESIRED_STATE_STOPPED {
+		out.DesiredState = "Stopped"
+	}
+
+	switch spec := req.GetSpec().GetSpec().(type) {
+	case *agentpb.WorkloadSpec_Container:
+		c := spec.Container
+		out.Spec.Type = "container"
+		container := &controlv1.ContainerSpec{
+			Image:          c.GetImage(),
+			Command:        c.GetCommand(),
+			Args:           c.GetArgs(),
+			Env:            c.GetEnv(),
+			RestartPolicy:  c.GetRestartPolicy().GetPolicy(),
+			ManagedVolumes: controlManagedVolumes(c.GetManagedVolumes()),
+			LivenessProbe:  controlProbe(c.GetLivenessProbe()),
+			ReadinessProbe: controlProbe(c.GetReadinessProbe()),
+			Files:          controlFiles(c.GetFiles()),
+		}
+		for _, v := range c.GetVolumes() {
+			container.Volumes = append(container.Volumes, &controlv1.VolumeMount{HostPath: v.GetHostPath(), ContainerPath: v.GetContainerPath(), ReadOnly: v.GetReadOnly()})
+		}
+		for _, p := range c.GetPorts() {
+			container.Ports = append(container.Ports, &controlv1.Port{HostPort: p.GetHostPort(), ContainerPort: p.GetContainerPort(), Protocol: p.GetProtocol()})
+		}
+		if r := c.GetResources(); r != nil {
+			out.Spec.Resources = &controlv1.ResourceRequirements{
+				CpuMillicores: r.GetCpuShares(),
+				MemoryMb:      r.GetMemoryBytes() / (1024 * 1024),
+			}
+		}
+		for k, v := range c.GetLabels() {
+			out.Spec.Metadata[k] = v
+		}
+		out.Spec.Workload = &controlv1.WorkloadSpec_Container{Container: container}
+	case *agentpb.WorkloadSpec_Compose:
+		c := spec.Compose
+		out.Spec.Type = "compose"
+		out.Spec.Metadata["compose.project_name"] = c.GetProjectName()
+		compose := &controlv1.ComposeSpec{
+			InlineYaml: c.GetComposeYaml(),
+			GitRepo:    c.GetGitRepo(),
+			GitRef:     c.GetGitRef(),
+			GitToken:   c.GetGitToken(),
+			Env:        c.GetEnv(),
+			Files:      controlFiles(c.GetFiles()),
+		}
+		switch {
+		case c.GetComposeYaml() != "":
+			compose.SourceType = "inline"
+		case c.GetGitRepo() != "":
+			compose.SourceType = "git"
+		}
+		out.Spec.Workload = &controlv1.WorkloadSpec_Compose{Compose: compose}
+	case *agentpb.WorkloadSpec_Vm:
+		v := spec.Vm
+		out.Spec.Type = "vm"
+		embedded, err := embedVMSpec(v)
+		if err != nil {
+			return nil, err
+		}
+		out.Spec.Metadata["persys.vm_spec_b64"] = embedded
+		vm := &controlv1.VMSpec{
+			Vcpus:          v.GetVcpus(),
+			MemoryMb:       v.GetMemoryMb(),
+			ManagedVolumes: controlManagedVolumes(v.GetManagedVolumes()),
+			LivenessProbe:  controlProbe(v.GetLivenessProbe()),
+			ReadinessProbe: controlProbe(v.GetReadinessProbe()),
+		}
+		if ci := v.GetCloudInitConfig(); ci != nil {
+			vm.CloudInit = &controlv1.CloudInitConfig{UserData: ci.GetUserData(), MetaData: ci.GetMetaData(), NetworkConfig: ci.GetNetworkConfig(), VendorData: ci.GetVendorData()}
+		}
+		for _, d := range v.GetDisks() {
+			vm.Disks = append(vm.Disks, &controlv1.DiskConfig{PoolName: d.GetPool(), SizeGb: d.GetSizeGb(), MountPoint: d.GetDevice()})
+		}
+		for _, n := range v.GetNetworks() {
+			vm.Networks = append(vm.Networks, &controlv1.NetworkConfig{Bridge: n.GetNetwork(), StaticIp: n.GetIpAddress(), Dhcp: strings.TrimSpace(n.GetIpAddress()) == ""})
+		}
+		out.Spec.Workload = &controlv1.WorkloadSpec_Vm{Vm: vm}
+	default:
+		return nil, fmt.Errorf("unsupported workload spec for stream apply of %s", req.GetId())
+	}
+	return out, nil
+}
+
+func controlManagedVolumes(in []*agentpb.ManagedVolumeSpec) []*controlv1.ManagedVolumeSpec {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.ManagedVolumeSpec, 0, len(in))
+	for _, mv := range in {
+		out = append(out, &controlv1.ManagedVolumeSpec{
+			Name:         mv.GetName(),
+			Driver:       mv.GetDriver(),
+			SizeGb:       mv.GetSizeGb(),
+			AccessMode:   mv.GetAccessMode(),
+			FsType:       mv.GetFsType(),
+			MountPath:    mv.GetMountPath(),
+			ReadOnly:     mv.GetReadOnly(),
+			RetainPolicy: mv.GetRetainPolicy(),
+		})
+	}
+	return out
+}
+
+// controlFiles carries resolved file mounts; the content is already
+// resolved, so the references stay empty.
+func controlFiles(in []*agentpb.FileMount) []*controlv1.FileMount {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*controlv1.FileMount, 0, len(in))
+	for _, f := range in {
+		out = append(out, &controlv1.FileMount{Path: f.GetPath(), Content: f.GetContent(), Mode: f.GetMode()})
+	}
+	return out
+}
+
+func controlProbe(in *agentpb.Probe) *controlv1.Probe {
+	if in == nil {
+		return nil
+	}
+	out := &controlv1.Probe{
+		InitialDelaySeconds: in.GetInitialDelaySeconds(),
+		PeriodSeconds:       in.GetPeriodSeconds(),
+		TimeoutSeconds:      in.GetTimeoutSeconds(),
+		SuccessThreshold:    in.GetSuccessThreshold(),
+		FailureThreshold:    in.GetFailureThreshold(),
+	}
+	switch h := in.GetHandler().(type) {
+	case *agentpb.Probe_HttpGet:
+		out.Handler = &controlv1.Probe_HttpGet{HttpGet: &controlv1.HTTPGetAction{
+			Path:    h.HttpGet.GetPath(),
+			Port:    h.HttpGet.GetPort(),
+			Scheme:  h.HttpGet.GetScheme(),
+			Headers: h.HttpGet.GetHeaders(),
+		}}
+	case *agentpb.Probe_TcpSocket:
+		out.Handler = &controlv1.Probe_TcpSocket{TcpSocket: &controlv1.TCPSocketAction{Port: h.TcpSocket.GetPort()}}
+	case *agentpb.Probe_Exec:
+		out.Handler = &controlv1.Probe_Exec{Exec: &controlv1.ExecAction{Command: h.Exec.GetCommand()}}
+	}
+	return out
+}
+
+func embedVMSpec(vm *agentpb.VMSpec) (string, error) {
+	type disk struct {
+		Pool   string `json:"pool,omitempty"`
+		Path   string `json:"path"`
+		Device string `json:"device"`
+		Format string `json:"format"`
+		SizeGB int64  `json:"size_gb"`
+		Type   string `json:"type"`
+		Boot   bool   `json:"boot"`
+	}
+	type network struct {
+		Network   string `json:"network"`
+		MAC       string `json:"mac_address"`
+		IPAddress string `json:"ip_address"`
+	}
+	type cloudInit struct {
+		UserData      string `json:"user_data"`
+		MetaData      string `json:"meta_data"`
+		NetworkConfig string `json:"network_config"`
+		VendorData    string `json:"vendor_data"`
+	}
+	type managedVolume struct {
+		Name         string `json:"name"`
+		Driver       string `json:"driver"`
+		SizeGB       int64  `json:"size_gb"`
+		AccessMode   string `json:"access_mode"`
+		FSType       string `json:"fs_type"`
+		MountPath    string `json:"mount_path"`
+		ReadOnly     bool   `json:"read_only"`
+		RetainPolicy string `json:"retain_policy"`
+	}
+	spec := struct {
+		Name            string            `json:"name"`
+		VCPUs           int32             `json:"vcpus"`
+		MemoryMB        int64             `json:"memory_mb"`
+		Disks           []disk            `json:"disks"`
+		Networks        []network         `json:"networks"`
+		CloudInit       string            `json:"cloud_init"`
+		Metadata        map[string]string `json:"metadata"`
+		CloudInitConfig *cloudInit        `json:"cloud_init_config,omitempty"`
+		ManagedVolumes  []managedVolume   `json:"managed_volumes"`
+	}{
+		Name:      vm.GetName(),
+		VCPUs:     vm.GetVcpus(),
+		MemoryMB:  vm.GetMemoryMb(),
+		CloudInit: vm.GetCloudInit(),
+		Metadata:  vm.GetMetadata(),
+	}
+	if ci := vm.GetCloudInitConfig(); ci != nil {
+		spec.CloudInitConfig = &cloudInit{UserData: ci.GetUserData(), MetaData: ci.GetMetaData(), NetworkConfig: ci.GetNetworkConfig(), VendorData: ci.GetVendorData()}
+	}
+	for _, d := range vm.GetDisks() {
+		spec.Disks = append(spec.Disks, disk{Pool: d.GetPool(), Path: d.GetPath(), Device: d.GetDevice(), Format: d.GetFormat(), SizeGB: d.GetSizeGb(), Type: d.GetType(), Boot: d.GetBoot()})
+	}
+	for _, n := range vm.GetNetworks() {
+		spec.Networks = append(spec.Networks, network{Network: n.GetNetwork(), MAC: n.GetMacAddress(), IPAddress: n.GetIpAddress()})
+	}
+	for _, mv := range vm.GetManagedVolumes() {
+		spec.ManagedVolumes = append(spec.ManagedVolumes, managedVolume{
+			Name:         mv.GetName(),
+			Driver:       mv.GetDriver(),
+			SizeGB:       mv.GetSizeGb(),
+			AccessMode:   mv.GetAccessMode(),
+			FSType:       mv.GetFsType(),
+			MountPath:    mv.GetMountPath(),
+			ReadOnly:     mv.GetReadOnly(),
+			RetainPolicy: mv.GetRetainPolicy(),
+		})
+	}
+	payload, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("encode vm spec for stream apply: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(payload), nil
+}
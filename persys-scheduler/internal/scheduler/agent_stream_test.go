@@ -0,0 +1,129 @@
+package scheduler
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/controlv1"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestControlApplyFromAgentRequestKeepsArgs(t *testing.T) {
+	req := &agentpb.ApplyWorkloadRequest{
+		Id: "w1",
+		Spec: &agentpb.WorkloadSpec{Spec: &agentpb.WorkloadSpec_Container{Container: &agentpb.ContainerSpec{
+			Image:   "nginx",
+			Command: []string{"/bin/sh", "-c"},
+			Args:    []string{"echo hi"},
+		}}},
+	}
+	out, err := controlApplyFromAgentRequest(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := out.GetSpec().GetContainer()
+	if strings.Join(c.GetCommand(), "|") != "/bin/sh|-c" || strings.Join(c.GetArgs(), "|") != "echo hi" {
+		t.Fatalf("expected command and args kept apart, got command=%q args=%q", c.GetCommand(), c.GetArgs())
+	}
+}
+
+func TestControlApplyFromAgentRequestKeepsComposeSource(t *testing.T) {
+	cases := []struct {
+		name   string
+		spec   *agentpb.ComposeSpec
+		source string
+	}{
+		{name: "git", spec: &agentpb.ComposeSpec{ProjectName: "p", GitRepo: "https://git.example/app.git", GitRef: "main", GitToken: "tok"}, source: "git"},
+		{name: "inline", spec: &agentpb.ComposeSpec{ProjectName: "p", ComposeYaml: "c2VydmljZXM6IHt9"}, source: "inline"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out, err := controlApplyFromAgentRequest(&agentpb.ApplyWorkloadRequest{
+				Id:   "w1",
+				Spec: &agentpb.WorkloadSpec{Spec: &agentpb.WorkloadSpec_Compose{Compose: tc.spec}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c := out.GetSpec().GetCompose()
+			if c.GetSourceType() != tc.source {
+				t.Fatalf("expected source type %q, got %q", tc.source, c.GetSourceType())
+			}
+			if c.GetInlineYaml() != tc.spec.GetComposeYaml() || c.GetGitRepo() != tc.spec.GetGitRepo() ||
+				c.GetGitRef() != tc.spec.GetGitRef() || c.GetGitToken() != tc.spec.GetGitToken() {
+				t.Fatalf("compose fields changed in transit: %v", c)
+			}
+		})
+	}
+}
+
+// answerApply replies to the next push on conn with result.
+func answerApply(t *testing.T, conn *AgentStreamConn, result *controlv1.ApplyWorkloadResponse) {
+	t.Helper()
+	select {
+	case msg := <-conn.Outbound():
+		if msg.GetApply() == nil {
+			t.Errorf("expected an apply push, got %v", msg)
+			return
+		}
+		conn.Receive(&controlv1.ControlMessage{
+			CorrelationId: msg.GetCorrelationId(),
+			Message:       &controlv1.ControlMessage_ApplyResult{ApplyResult: result},
+		})
+	case <-time.After(5 * time.Second):
+		t.Errorf("no apply pushed to the stream")
+	}
+}
+
+func TestApplyWorkloadViaStreamReportsAgentFailureCode(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	s.agentStreams = newAgentStreamRegistry(time.Minute)
+	conn, _ := s.AttachAgentStream("n1", "", 0)
+	req := &agentpb.ApplyWorkloadRequest{
+		Id:   "w1",
+		Spec: &agentpb.WorkloadSpec{Spec: &agentpb.WorkloadSpec_Container{Container: &agentpb.ContainerSpec{Image: "nginx"}}},
+	}
+
+	go answerApply(t, conn, &controlv1.ApplyWorkloadResponse{FailureReason: controlv1.FailureReason_INVALID_SPEC, ErrorMessage: "bad port"})
+	resp, err := s.applyWorkloadViaStream(context.Background(), models.Node{NodeID: "n1"}, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetApplied() || resp.GetMessage() != "INVALID_SPECIFICATION: bad port" {
+		t.Fatalf("expected a non-retryable failure code, got %v", resp)
+	}
+
+	go answerApply(t, conn, &controlv1.ApplyWorkloadResponse{FailureReason: controlv1.FailureReason_RUNTIME_ERROR, ErrorMessage: "daemon down"})
+	resp, err = s.applyWorkloadViaStream(context.Background(), models.Node{NodeID: "n1"}, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetMessage() != "RUNTIME_ERROR: daemon down" {
+		t.Fatalf("expected the runtime error to pass through, got %v", resp)
+	}
+}
+
+func TestStreamStatusCarriesAgentFailureCode(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	s.agentStreams = newAgentStreamRegistry(time.Minute)
+	conn, _ := s.AttachAgentStream("n1", "", 0)
+	conn.Receive(&controlv1.ControlMessage{Message: &controlv1.ControlMessage_Heartbeat{Heartbeat: &controlv1.HeartbeatRequest{
+		WorkloadStatuses: []*controlv1.WorkloadStatus{
+			{WorkloadId: "w1", RevisionId: "r1", State: "Failed", FailureReason: controlv1.FailureReason_IMAGE_NOT_FOUND},
+		},
+	}}})
+
+	ws, ok, err := s.streamWorkloadStatus(models.Node{NodeID: "n1"}, "w1")
+	if !ok || err != nil {
+		t.Fatalf("expected the stream to answer, ok=%v err=%v", ok, err)
+	}
+	if got := ws.GetMetadata()["failure_reason"]; got != "INVALID_IMAGE" {
+		t.Fatalf("expected failure_reason INVALID_IMAGE, got %q", got)
+	}
+	if _, terminal := nonRetryableFailureReasons[ws.GetMetadata()["failure_reason"]]; !terminal {
+		t.Fatalf("expected a missing image to stop retries")
+	}
+}
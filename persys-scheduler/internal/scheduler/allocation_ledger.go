@@ -0,0 +1,330 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// allocationCASAttempts bounds how often a reservation is retried when
+	// another scheduler call updated the same node ledger concurrently.
+	allocationCASAttempts = 8
+	// allocationAdoptGrace keeps fresh reservations during heartbeat resync,
+	// covering the gap between reserving and persisting the assignment.
+	allocationAdoptGrace = 2 * time.Minute
+	// allocationDriftRatio is how far agent-reported allocation may differ
+	// from the ledger (as a share of node capacity) before it is logged.
+	allocationDriftRatio = 0.1
+)
+
+var errInsufficientCapacity = errors.New("insufficient node capacity")
+
+// workloadAllocation is the reservation a workload needs. VMs without explicit
+// resources fall back to their vCPU and memory sizing.
+func workloadAllocation(w models.Workload) models.WorkloadAllocation {
+	alloc := models.WorkloadAllocation{
+		WorkloadID: w.ID,
+		CPU:        w.Resources.CPUUsage,
+		MemoryMB:   int64(math.Ceil(w.Resources.MemoryUsage)),
+		DiskGB:     int64(w.Resources.DiskUsage),
+		HostPorts:  allocatedHostPortKeys(w),
+	}
+	if w.VM != nil {
+		if alloc.CPU <= 0 {
+			alloc.CPU = float64(w.VM.VCPUs)
+		}
+		if alloc.MemoryMB <= 0 {
+			alloc.MemoryMB = w.VM.MemoryMB
+		}
+	}
+	for pool, gb := range workloadStorageDemand(w) {
+		alloc.DiskGB += gb
+		if pool == "" {
+			continue
+		}
+		if alloc.Pools == nil {
+			alloc.Pools = map[string]int64{}
+		}
+		alloc.Pools[pool] += gb
+	}
+	return alloc
+}
+
+func sameAllocationAmounts(a, b models.WorkloadAllocation) bool {
+	if a.CPU != b.CPU || a.MemoryMB != b.MemoryMB || a.DiskGB != b.DiskGB || len(a.Pools) != len(b.Pools) || len(a.HostPorts) != len(b.HostPorts) {
+		return false
+	}
+	for pool, gb := range a.Pools {
+		if b.Pools[pool] != gb {
+			return false
+		}
+	}
+	for i, key := range a.HostPorts {
+		if b.HostPorts[i] != key {
+			return false
+		}
+	}
+	return true
+}
+
+func summarizeAllocationLedger(ledger models.NodeAllocationLedger) models.NodeAllocationSummary {
+	sum := models.NodeAllocationSummary{Pools: map[string]int64{}, HostPorts: map[string]string{}}
+	for id, alloc := range ledger.Allocations {
+		sum.CPU += alloc.CPU
+		sum.MemoryMB += alloc.MemoryMB
+		sum.DiskGB += alloc.DiskGB
+		for pool, gb := range alloc.Pools {
+			sum.Pools[pool] += gb
+		}
+		for _, key := range alloc.HostPorts {
+			sum.HostPorts[key] = id
+		}
+		sum.Workloads++
+	}
+	return sum
+}
+
+// getAllocationLedger returns the ledger for nodeID and its etcd mod revision
+// (0 when the node has no ledger yet).
+func (s *Scheduler) getAllocationLedger(nodeID string) (models.NodeAllocationLedger, int64, error) {
+	ledger := models.NodeAllocationLedger{NodeID: nodeID, Allocations: map[string]models.WorkloadAllocation{}}
+	resp, err := s.RetryableEtcdGet(allocationKey(nodeID))
+	if err != nil {
+		return ledger, 0, fmt.Errorf("failed to get allocation ledger for node %s: %w", nodeID, err)
+	}
+	if resp == nil || len(resp.Kvs) == 0 {
+		return ledger, 0, nil
+	}
+	if err := json.Unmarshal(resp.Kvs[0].Value, &ledger); err != nil {
+		return ledger, 0, fmt.Errorf("failed to unmarshal allocation ledger for node %s: %w", nodeID, err)
+	}
+	if ledger.Allocations == nil {
+		ledger.Allocations = map[string]models.WorkloadAllocation{}
+	}
+	return ledger, resp.Kvs[0].ModRevision, nil
+}
+
+// updateAllocationLedger applies mutate to the node ledger with
+// compare-and-swap, re-reading and retrying on conflict. mutate returning
+// false means nothing changed and no write is needed.
+func (s *Scheduler) updateAllocationLedger(nodeID string, mutate func(*models.NodeAllocationLedger) (bool, error)) error {
+	for attempt := 0; attempt < allocationCASAttempts; attempt++ {
+		ledger, rev, err := s.getAllocationLedger(nodeID)
+		if err != nil {
+			return err
+		}
+		changed, err := mutate(&ledger)
+		if err != nil || !changed {
+			return err
+		}
+		ledger.NodeID = nodeID
+		ledger.UpdatedAt = time.Now().UTC()
+		payload, err := json.Marshal(ledger)
+		if err != nil {
+			return fmt.Errorf("failed to marshal allocation ledger for node %s: %w", nodeID, err)
+		}
+		ok, err := s.RetryableEtcdCompareAndPut(allocationKey(nodeID), string(payload), rev)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		schedulerLogger.WithFields(logrus.Fields{
+			"node_id": nodeID,
+			"attempt": attempt + 1,
+		}).Debug("allocation ledger changed concurrently; retrying")
+	}
+	return fmt.Errorf("allocation ledger for node %s is contended; gave up after %d attempts", nodeID, allocationCASAttempts)
+}
+
+// reserveAllocation records the workload's requested resources and host
+// ports on node, failing with errInsufficientCapacity when the node's
+// capacity or a host port is already promised to other workloads. It returns
+// the workload's port mappings with allocated host ports filled in.
+// Re-reserving an unchanged workload is a no-op.
+func (s *Scheduler) reserveAllocation(node models.Node, workload models.Workload) ([]string, error) {
+	want := workloadAllocation(workload)
+	want.AllocatedAt = time.Now().UTC()
+	start, end := s.hostPortRange()
+	var hostPorts []string
+	err := s.updateAllocationLedger(node.NodeID, func(ledger *models.NodeAllocationLedger) (bool, error) {
+		existing, reserved := ledger.Allocations[workload.ID]
+		delete(ledger.Allocations, workload.ID)
+		allocated := summarizeAllocationLedger(*ledger)
+		resolved, keys, err := resolveHostPorts(workload, allocated.HostPorts, start, end)
+		if err != nil {
+			return false, fmt.Errorf("node %s: %w", node.NodeID, err)
+		}
+		hostPorts = resolved
+		want.HostPorts = keys
+		if reserved && sameAllocationAmounts(existing, want) {
+			return false, nil
+		}
+		if err := checkAllocationFits(node, allocated, want); err != nil {
+			return false, err
+		}
+		ledger.Allocations[workload.ID] = want
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return hostPorts, nil
+}
+
+func checkAllocationFits(node models.Node, allocated models.NodeAllocationSummary, want models.WorkloadAllocation) error {
+	const epsilon = 1e-6
+	if want.CPU > 0 && node.TotalCPU > 0 && allocated.CPU+want.CPU > node.TotalCPU+epsilon {
+		return fmt.Errorf("%w: node %s cpu need=%.3f allocated=%.3f total=%.3f", errInsufficientCapacity, node.NodeID, want.CPU, allocated.CPU, node.TotalCPU)
+	}
+	if want.MemoryMB > 0 && node.TotalMemory > 0 && allocated.MemoryMB+want.MemoryMB > node.TotalMemory {
+		return fmt.Errorf("%w: node %s memory need=%dMB allocated=%dMB total=%dMB", errInsufficientCapacity, node.NodeID, want.MemoryMB, allocated.MemoryMB, node.TotalMemory)
+	}
+	for pool, gb := range want.Pools {
+		p, ok := findStoragePool(node, pool)
+		if !ok {
+			continue
+		}
+		if allocated.Pools[pool]+gb > p.TotalGB {
+			return fmt.Errorf("%w: node %s pool %s need=%dGB allocated=%dGB total=%dGB", errInsufficientCapacity, node.NodeID, pool, gb, allocated.Pools[pool], p.TotalGB)
+		}
+	}
+	return nil
+}
+
+// releaseAllocation drops the workload's reservation on nodeID.
+func (s *Scheduler) releaseAllocation(nodeID, workloadID string) error {
+	if strings.TrimSpace(nodeID) == "" {
+		return nil
+	}
+	return s.updateAllocationLedger(nodeID, func(ledger *models.NodeAllocationLedger) (bool, error) {
+		if _, ok := ledger.Allocations[workloadID]; !ok {
+			return false, nil
+		}
+		delete(ledger.Allocations, workloadID)
+		return true, nil
+	})
+}
+
+// GetNodeAllocation totals the reservations on a node.
+func (s *Scheduler) GetNodeAllocation(nodeID string) (models.NodeAllocationSummary, error) {
+	ledger, _, err := s.getAllocationLedger(nodeID)
+	if err != nil {
+		return models.NodeAllocationSummary{}, err
+	}
+	return summarizeAllocationLedger(ledger), nil
+}
+
+// listNodeAllocations totals every node ledger, keyed by node ID.
+func (s *Scheduler) listNodeAllocations() (map[string]models.NodeAllocationSummary, error) {
+	resp, err := s.RetryableEtcdGet(allocationsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list allocation ledgers: %w", err)
+	}
+	out := make(map[string]models.NodeAllocationSummary, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var ledger models.NodeAllocationLedger
+		if err := json.Unmarshal(kv.Value, &ledger); err != nil {
+			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal allocation ledger")
+			continue
+		}
+		out[ledger.NodeID] = summarizeAllocationLedger(ledger)
+	}
+	return out, nil
+}
+
+// ReconcileNodeAllocations resyncs a node ledger with the workloads assigned
+// to the node: reservations for workloads that left are dropped, workloads
+// without one (e.g. placed before the ledger existed) are adopted, and
+// changed resource requests are picked up. reportedCPU/reportedMemoryMB are
+// the agent's own allocation figures (negative when not reported) and are only
+// compared against the ledger.
+func (s *Scheduler) ReconcileNodeAllocations(nodeID string, reportedCPU float64, reportedMemoryMB int64) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	workloads, err := s.GetWorkloadsByNode(nodeID)
+	if err != nil {
+		return err
+	}
+	assigned := make(map[string]models.Workload, len(workloads))
+	for _, w := range workloads {
+		if !strings.EqualFold(w.DesiredState, "Deleted") && !jobChildFinished(w) {
+			assigned[w.ID] = w
+		}
+	}
+
+	var summary models.NodeAllocationSummary
+	err = s.updateAllocationLedger(nodeID, func(ledger *models.NodeAllocationLedger) (bool, error) {
+		changed := false
+		now := time.Now().UTC()
+		// Victims of a pending preemption have already given up their
+		// reservation; they must not be adopted back while being stopped.
+		preempted := map[string]bool{}
+		for _, alloc := range ledger.Allocations {
+			for _, id := range alloc.Victims {
+				preempted[id] = true
+			}
+		}
+		for id, alloc := range ledger.Allocations {
+			if _, ok := assigned[id]; ok {
+				continue
+			}
+			if now.Sub(alloc.AllocatedAt) < allocationAdoptGrace {
+				continue
+			}
+			delete(ledger.Allocations, id)
+			changed = true
+		}
+		for id, w := range assigned {
+			want := workloadAllocation(w)
+			existing, ok := ledger.Allocations[id]
+			if ok && sameAllocationAmounts(existing, want) {
+				continue
+			}
+			if !ok && preempted[id] {
+				continue
+			}
+			want.AllocatedAt = now
+			if ok {
+				want.AllocatedAt = existing.AllocatedAt
+				want.Victims = existing.Victims
+			}
+			ledger.Allocations[id] = want
+			changed = true
+		}
+		summary = summarizeAllocationLedger(*ledger)
+		return changed, nil
+	})
+	if err != nil {
+		schedulerLogger.WithError(err).WithField("node_id", nodeID).Warn("allocation ledger resync failed")
+		return err
+	}
+
+	node, err := s.GetNodeByID(nodeID)
+	if err != nil {
+		return nil
+	}
+	fields := logrus.Fields{"node_id": nodeID}
+	if reportedCPU >= 0 && node.TotalCPU > 0 && math.Abs(reportedCPU-summary.CPU) > node.TotalCPU*allocationDriftRatio {
+		fields["ledger_cpu"] = summary.CPU
+		fields["reported_cpu"] = reportedCPU
+	}
+	if reportedMemoryMB >= 0 && node.TotalMemory > 0 && math.Abs(float64(reportedMemoryMB-summary.MemoryMB)) > float64(node.TotalMemory)*allocationDriftRatio {
+		fields["ledger_memory_mb"] = summary.MemoryMB
+		fields["reported_memory_mb"] = reportedMemoryMB
+	}
+	if len(fields) > 1 {
+		schedulerLogger.WithFields(fields).Warn("agent-reported allocation differs from scheduler ledger")
+	}
+	return nil
+}
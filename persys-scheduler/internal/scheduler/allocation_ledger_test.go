@@ -0,0 +1,346 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	pb "go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// fakeKV is an in-memory etcd KV that understands the single-key gets and
+// mod-revision compare-and-put transactions the allocation ledger uses.
+type fakeKV struct {
+	mu   sync.Mutex
+	rev  int64
+	kvs  map[string]*mvccpb.KeyValue
+	txns int
+	// beforeCommit runs before each transaction is evaluated, standing in
+	// for another scheduler call writing concurrently.
+	beforeCommit func(f *fakeKV)
+}
+
+func newFakeKV() *fakeKV {
+	return &fakeKV{kvs: map[string]*mvccpb.KeyValue{}}
+}
+
+func (f *fakeKV) putLocked(key, value string) {
+	f.rev++
+	f.kvs[key] = &mvccpb.KeyValue{Key: []byte(key), Value: []byte(value), ModRevision: f.rev}
+}
+
+func (f *fakeKV) Put(_ context.Context, key, value string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.putLocked(key, value)
+	return &clientv3.PutResponse{Header: &pb.ResponseHeader{Revision: f.rev}}, nil
+}
+
+func (f *fakeKV) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	op := clientv3.OpGet(key, opts...)
+	resp := &clientv3.GetResponse{Header: &pb.ResponseHeader{Revision: f.rev}}
+	for k, kv := range f.kvs {
+		if k == key || (len(op.RangeBytes()) > 0 && strings.HasPrefix(k, key)) {
+			copied := *kv
+			resp.Kvs = append(resp.Kvs, &copied)
+		}
+	}
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func (f *fakeKV) Delete(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.kvs, key)
+	f.rev++
+	return &clientv3.DeleteResponse{Header: &pb.ResponseHeader{Revision: f.rev}}, nil
+}
+
+func (f *fakeKV) Compact(context.Context, int64, ...clientv3.CompactOption) (*clientv3.CompactResponse, error) {
+	return nil, errors.New("fakeKV: compact not supported")
+}
+
+func (f *fakeKV) Do(context.Context, clientv3.Op) (clientv3.OpResponse, error) {
+	return clientv3.OpResponse{}, errors.New("fakeKV: do not supported")
+}
+
+func (f *fakeKV) Txn(context.Context) clientv3.Txn { return &fakeTxn{kv: f} }
+
+func (f *fakeKV) ledger(t *testing.T, nodeID string) models.NodeAllocationLedger {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var ledger models.NodeAllocationLedger
+	kv, ok := f.kvs[allocationKey(nodeID)]
+	if !ok {
+		return ledger
+	}
+	if err := json.Unmarshal(kv.Value, &ledger); err != nil {
+		t.Fatalf("unmarshal ledger: %v", err)
+	}
+	return ledger
+}
+
+func (f *fakeKV) seedLedger(t *testing.T, nodeID string, allocs ...models.WorkloadAllocation) {
+	t.Helper()
+	ledger := models.NodeAllocationLedger{NodeID: nodeID, Allocations: map[string]models.WorkloadAllocation{}}
+	for _, a := range allocs {
+		ledger.Allocations[a.WorkloadID] = a
+	}
+	payload, err := json.Marshal(ledger)
+	if err != nil {
+		t.Fatalf("marshal ledger: %v", err)
+	}
+	f.mu.Lock()
+	f.putLocked(allocationKey(nodeID), string(payload))
+	f.mu.Unlock()
+}
+
+type fakeTxn struct {
+	kv   *fakeKV
+	cmps []clientv3.Cmp
+	ops  []clientv3.Op
+}
+
+func (t *fakeTxn) If(cs ...clientv3.Cmp) clientv3.Txn { t.cmps = append(t.cmps, cs...); return t }
+func (t *fakeTxn) Then(ops ...clientv3.Op) clientv3.Txn {
+	t.ops = append(t.ops, ops...)
+	return t
+}
+func (t *fakeTxn) Else(...clientv3.Op) clientv3.Txn { return t }
+
+func (t *fakeTxn) Commit() (*clientv3.TxnResponse, error) {
+	if hook := t.kv.beforeCommit; hook != nil {
+		hook(t.kv)
+	}
+	f := t.kv
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.txns++
+	ok := true
+	for _, cmp := range t.cmps {
+		target, isMod := cmp.TargetUnion.(*pb.Compare_ModRevision)
+		if !isMod || cmp.Result != pb.Compare_EQUAL {
+			return nil, errors.New("fakeKV: only mod revision equality is supported")
+		}
+		var current int64
+		if kv, exists := f.kvs[string(cmp.Key)]; exists {
+			current = kv.ModRevision
+		}
+		if current != target.ModRevision {
+			ok = false
+		}
+	}
+	if ok {
+		for _, op := range t.ops {
+			if !op.IsPut() {
+				return nil, errors.New("fakeKV: only puts are supported in transactions")
+			}
+			f.putLocked(string(op.KeyBytes()), string(op.ValueBytes()))
+		}
+	}
+	return &clientv3.TxnResponse{Succeeded: ok, Header: &pb.ResponseHeader{Revision: f.rev}}, nil
+}
+
+func newLedgerTestScheduler(kv *fakeKV) *Scheduler {
+	return &Scheduler{
+		etcdClient:       &clientv3.Client{KV: kv},
+		mode:             ModeNormal,
+		cacheNodes:       map[string]models.Node{},
+		cacheWorkloads:   map[string]models.Workload{},
+		cacheAssignments: map[string]models.AssignmentRecord{},
+	}
+}
+
+func cpuWorkload(id string, cpu float64, memoryMB float64) models.Workload {
+	return models.Workload{ID: id, Resources: models.Resources{CPUUsage: cpu, MemoryUsage: memoryMB}}
+}
+
+func TestReserveAllocation(t *testing.T) {
+	node := models.Node{NodeID: "n1", TotalCPU: 4, TotalMemory: 4096}
+	tests := []struct {
+		name       string
+		existing   []models.WorkloadAllocation
+		workload   models.Workload
+		wantErr    error
+		wantTxns   int
+		wantOnNode []string
+	}{
+		{
+			name:       "fits on an empty node",
+			workload:   cpuWorkload("w1", 2, 1024),
+			wantTxns:   1,
+			wantOnNode: []string{"w1"},
+		},
+		{
+			name:       "cpu promised to other workloads",
+			existing:   []models.WorkloadAllocation{{WorkloadID: "other", CPU: 3}},
+			workload:   cpuWorkload("w1", 2, 0),
+			wantErr:    errInsufficientCapacity,
+			wantOnNode: []string{"other"},
+		},
+		{
+			name:       "memory promised to other workloads",
+			existing:   []models.WorkloadAllocation{{WorkloadID: "other", MemoryMB: 4000}},
+			workload:   cpuWorkload("w1", 0, 512),
+			wantErr:    errInsufficientCapacity,
+			wantOnNode: []string{"other"},
+		},
+		{
+			name:       "unchanged reservation is not rewritten",
+			existing:   []models.WorkloadAllocation{{WorkloadID: "w1", CPU: 2, MemoryMB: 1024}},
+			workload:   cpuWorkload("w1", 2, 1024),
+			wantTxns:   0,
+			wantOnNode: []string{"w1"},
+		},
+		{
+			name:       "growing a reservation does not count the old one",
+			existing:   []models.WorkloadAllocation{{WorkloadID: "w1", CPU: 3}},
+			workload:   cpuWorkload("w1", 4, 0),
+			wantTxns:   1,
+			wantOnNode: []string{"w1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV()
+			if len(tt.existing) > 0 {
+				kv.seedLedger(t, node.NodeID, tt.existing...)
+			}
+			s := newLedgerTestScheduler(kv)
+			_, err := s.reserveAllocation(node, tt.workload)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("reserveAllocation: %v", err)
+			}
+			if kv.txns != tt.wantTxns {
+				t.Fatalf("expected %d ledger writes, got %d", tt.wantTxns, kv.txns)
+			}
+			ledger := kv.ledger(t, node.NodeID)
+			if len(ledger.Allocations) != len(tt.wantOnNode) {
+				t.Fatalf("unexpected reservations: %#v", ledger.Allocations)
+			}
+			for _, id := range tt.wantOnNode {
+				if _, ok := ledger.Allocations[id]; !ok {
+					t.Fatalf("expected a reservation for %s, got %#v", id, ledger.Allocations)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateAllocationLedgerConflicts(t *testing.T) {
+	node := models.Node{NodeID: "n1", TotalCPU: 4, TotalMemory: 4096}
+	tests := []struct {
+		name       string
+		concurrent float64 // CPU a concurrent writer reserves before each of the first conflicts commits
+		conflicts  int     // how many commits race with that writer; -1 races every one
+		wantErr    string
+		wantIs     error
+		wantTxns   int
+	}{
+		{
+			name:       "retries and keeps the concurrent reservation",
+			concurrent: 1,
+			conflicts:  1,
+			wantTxns:   2,
+		},
+		{
+			name:       "re-checks capacity after a conflict",
+			concurrent: 3,
+			conflicts:  1,
+			wantIs:     errInsufficientCapacity,
+			wantTxns:   1,
+		},
+		{
+			name:       "gives up when the ledger stays contended",
+			concurrent: 0,
+			conflicts:  -1,
+			wantErr:    "contended",
+			wantTxns:   allocationCASAttempts,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV()
+			kv.seedLedger(t, node.NodeID)
+			raced := 0
+			kv.beforeCommit = func(f *fakeKV) {
+				if tt.conflicts >= 0 && raced >= tt.conflicts {
+					return
+				}
+				raced++
+				ledger := f.ledger(t, node.NodeID)
+				if ledger.Allocations == nil {
+					ledger.Allocations = map[string]models.WorkloadAllocation{}
+				}
+				ledger.Allocations["concurrent"] = models.WorkloadAllocation{WorkloadID: "concurrent", CPU: tt.concurrent}
+				payload, _ := json.Marshal(ledger)
+				f.mu.Lock()
+				f.putLocked(allocationKey(node.NodeID), string(payload))
+				f.mu.Unlock()
+			}
+			s := newLedgerTestScheduler(kv)
+			_, err := s.reserveAllocation(node, cpuWorkload("w1", 2, 0))
+			switch {
+			case tt.wantIs != nil:
+				if !errors.Is(err, tt.wantIs) {
+					t.Fatalf("expected %v, got %v", tt.wantIs, err)
+				}
+			case tt.wantErr != "":
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+			case err != nil:
+				t.Fatalf("reserveAllocation: %v", err)
+			}
+			if kv.txns != tt.wantTxns {
+				t.Fatalf("expected %d ledger writes, got %d", tt.wantTxns, kv.txns)
+			}
+			if tt.wantIs == nil && tt.wantErr == "" {
+				ledger := kv.ledger(t, node.NodeID)
+				if _, ok := ledger.Allocations["concurrent"]; !ok {
+					t.Fatalf("concurrent reservation was lost: %#v", ledger.Allocations)
+				}
+				if _, ok := ledger.Allocations["w1"]; !ok {
+					t.Fatalf("expected w1 to be reserved: %#v", ledger.Allocations)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckAllocationFitsStoragePools(t *testing.T) {
+	node := models.Node{NodeID: "n1", StoragePools: []models.StoragePool{{Name: "fast", TotalGB: 100}}}
+	tests := []struct {
+		name      string
+		allocated int64
+		need      int64
+		wantErr   bool
+	}{
+		{name: "fits", allocated: 40, need: 60},
+		{name: "over capacity", allocated: 50, need: 60, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allocated := models.NodeAllocationSummary{Pools: map[string]int64{"fast": tt.allocated}}
+			want := models.WorkloadAllocation{Pools: map[string]int64{"fast": tt.need}}
+			err := checkAllocationFits(node, allocated, want)
+			if tt.wantErr != errors.Is(err, errInsufficientCapacity) {
+				t.Fatalf("wantErr=%v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,394 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/robfig/cron/v3"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// maxMissedCronRuns bounds how far back a pass walks a schedule looking for
+// the latest missed run, e.g. after a long scheduler outage.
+const maxMissedCronRuns = 1000
+
+var cronJobLogger = logging.C("scheduler.cronjob")
+
+// cronJobRunID is the ID of the job a cron job starts at scheduled. User IDs
+// cannot contain '.', so it never collides with a job created directly.
+func cronJobRunID(cronJobID string, scheduled time.Time) string {
+	return fmt.Sprintf("%s.%d", cronJobID, scheduled.Unix())
+}
+
+func normalizeCronJob(cj *models.CronJob) (cron.Schedule, error) {
+	cj.ID = strings.TrimSpace(cj.ID)
+	if cj.ID == "" {
+		cj.ID = uuid.NewString()
+	}
+	cj.Schedule = strings.TrimSpace(cj.Schedule)
+	schedule, err := cron.ParseStandard(cj.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cron job %s: invalid schedule %q: %v", cj.ID, cj.Schedule, err)
+	}
+	switch strings.ToLower(strings.TrimSpace(cj.ConcurrencyPolicy)) {
+	case "", strings.ToLower(models.ConcurrencyAllow):
+		cj.ConcurrencyPolicy = models.ConcurrencyAllow
+	case strings.ToLower(models.ConcurrencyForbid):
+		cj.ConcurrencyPolicy = models.ConcurrencyForbid
+	case strings.ToLower(models.ConcurrencyReplace):
+		cj.ConcurrencyPolicy = models.ConcurrencyReplace
+	default:
+		return nil, fmt.Errorf("cron job %s: unknown concurrency policy %q", cj.ID, cj.ConcurrencyPolicy)
+	}
+	if cj.StartingDeadlineSeconds < 0 || cj.SuccessfulJobsHistoryLimit < 0 || cj.FailedJobsHistoryLimit < 0 {
+		return nil, fmt.Errorf("cron job %s: deadline and history limits must not be negative", cj.ID)
+	}
+	// The template is checked the same way ApplyJob checks it; its ID is
+	// assigned per run.
+	template := cj.JobTemplate
+	template.ID = cj.ID
+	if err := normalizeJob(&template); err != nil {
+		return nil, err
+	}
+	template.ID = ""
+	template.CronJobID = ""
+	template.Status = models.JobStatus{}
+	cj.JobTemplate = template
+	return schedule, nil
+}
+
+// ApplyCronJob creates or updates a cron job. Changes apply to runs started
+// afterwards; jobs already created keep their spec.
+func (s *Scheduler) ApplyCronJob(cj models.CronJob) (models.CronJob, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.CronJob{}, err
+	}
+	schedule, err := normalizeCronJob(&cj)
+	if err != nil {
+		return models.CronJob{}, err
+	}
+	if _, err := s.workloadRefsRevision(cj.JobTemplate.Template); err != nil {
+		return models.CronJob{}, fmt.Errorf("cron job %s: %w", cj.ID, err)
+	}
+	now := time.Now().UTC()
+	current, found, err := s.getCronJobRecord(cj.ID)
+	if err != nil {
+		return models.CronJob{}, err
+	}
+	if found {
+		if current.DesiredState == "Deleted" {
+			return models.CronJob{}, fmt.Errorf("cron job %s is being deleted", cj.ID)
+		}
+		cj.CreatedAt = current.CreatedAt
+		cj.Status = current.Status
+	} else {
+		if err := validateObjectID("cron job", cj.ID); err != nil {
+			return models.CronJob{}, err
+		}
+		cj.CreatedAt = now
+	}
+	cj.DesiredState = ""
+	cj.UpdatedAt = now
+	cj.Status.NextScheduleAt = schedule.Next(now)
+	if err := s.saveCronJobRecord(cj); err != nil {
+		return models.CronJob{}, err
+	}
+	eventType := "CronJobCreated"
+	if found {
+		eventType = "CronJobUpdated"
+	}
+	s.emitEvent(eventType, "", "", "Cron job applied", map[string]interface{}{
+		"cronjob_id": cj.ID,
+		"schedule":   cj.Schedule,
+		"suspend":    cj.Suspend,
+	})
+	return cj, nil
+}
+
+// DeleteCronJob marks a cron job for deletion. Its jobs are deleted by the
+// reconciliation loop and the record goes once they are gone.
+func (s *Scheduler) DeleteCronJob(cronJobID string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	cj, err := s.GetCronJob(cronJobID)
+	if err != nil {
+		return err
+	}
+	if cj.DesiredState == "Deleted" {
+		return nil
+	}
+	cj.DesiredState = "Deleted"
+	cj.UpdatedAt = time.Now().UTC()
+	if err := s.saveCronJobRecord(cj); err != nil {
+		return err
+	}
+	s.emitEvent("CronJobUpdated", "", "", "Marked for deletion", map[string]interface{}{"cronjob_id": cj.ID})
+	return nil
+}
+
+// GetCronJob retrieves a cron job by ID.
+func (s *Scheduler) GetCronJob(cronJobID string) (models.CronJob, error) {
+	cj, found, err := s.getCronJobRecord(cronJobID)
+	if err != nil {
+		return models.CronJob{}, err
+	}
+	if !found {
+		return models.CronJob{}, fmt.Errorf("cron job %s not found", cronJobID)
+	}
+	return cj, nil
+}
+
+// ListCronJobs returns all cron jobs.
+func (s *Scheduler) ListCronJobs() ([]models.CronJob, error) {
+	resp, err := s.RetryableEtcdGet(cronJobsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cron jobs: %v", err)
+	}
+	out := make([]models.CronJob, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var cj models.CronJob
+		if err := json.Unmarshal(kv.Value, &cj); err != nil {
+			cronJobLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal cron job")
+			continue
+		}
+		out = append(out, cj)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) getCronJobRecord(cronJobID string) (models.CronJob, bool, error) {
+	resp, err := s.RetryableEtcdGet(cronJobKey(cronJobID))
+	if err != nil {
+		return models.CronJob{}, false, fmt.Errorf("failed to get cron job %s: %v", cronJobID, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.CronJob{}, false, nil
+	}
+	var cj models.CronJob
+	if err := json.Unmarshal(resp.Kvs[0].Value, &cj); err != nil {
+		return models.CronJob{}, false, fmt.Errorf("failed to unmarshal cron job %s: %v", cronJobID, err)
+	}
+	return cj, true, nil
+}
+
+func (s *Scheduler) saveCronJobRecord(cj models.CronJob) error {
+	payload, err := json.Marshal(cj)
+	if err != nil {
+		return fmt.Errorf("marshal cron job %s: %w", cj.ID, err)
+	}
+	return s.RetryableEtcdPut(cronJobKey(cj.ID), string(payload))
+}
+
+// ReconcileCronJobs starts the jobs every cron job is due for and prunes
+// finished ones beyond its history limits.
+func (s *Scheduler) ReconcileCronJobs() error {
+	if !s.isWritable() {
+		return errControlPlaneFrozen
+	}
+	cronJobs, err := s.ListCronJobs()
+	if err != nil {
+		return err
+	}
+	if len(cronJobs) == 0 {
+		return nil
+	}
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return err
+	}
+	owned := make(map[string][]models.Job)
+	for _, job := range jobs {
+		if job.CronJobID != "" {
+			owned[job.CronJobID] = append(owned[job.CronJobID], job)
+		}
+	}
+	now := time.Now().UTC()
+	for _, cj := range cronJobs {
+		if err := s.reconcileCronJob(cj, owned[cj.ID], now); err != nil {
+			cronJobLogger.WithError(err).WithField("cronjob_id", cj.ID).Warn("failed to reconcile cron job")
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcileCronJob(cj models.CronJob, jobs []models.Job, now time.Time) error {
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+
+	if cj.DesiredState == "Deleted" {
+		for _, job := range jobs {
+			if err := s.DeleteJob(job.ID); err != nil {
+				return fmt.Errorf("delete job %s: %w", job.ID, err)
+			}
+		}
+		if len(jobs) > 0 {
+			return nil
+		}
+		if err := s.RetryableEtcdDelete(cronJobKey(cj.ID)); err != nil {
+			return err
+		}
+		s.emitEvent("CronJobDeleted", "", "", "All jobs removed", map[string]interface{}{"cronjob_id": cj.ID})
+		return nil
+	}
+
+	schedule, err := cron.ParseStandard(cj.Schedule)
+	if err != nil {
+		return fmt.Errorf("invalid schedule %q: %v", cj.Schedule, err)
+	}
+
+	status := models.CronJobStatus{
+		LastScheduleAt:   cj.Status.LastScheduleAt,
+		LastSuccessfulAt: cj.Status.LastSuccessfulAt,
+	}
+	var active, succeeded, failed []models.Job
+	for _, job := range jobs {
+		if job.DesiredState == "Deleted" {
+			continue
+		}
+		switch job.Status.Phase {
+		case models.JobSucceeded:
+			succeeded = append(succeeded, job)
+			if job.Status.CompletedAt.After(status.LastSuccessfulAt) {
+				status.LastSuccessfulAt = job.Status.CompletedAt
+			}
+		case models.JobFailed:
+			failed = append(failed, job)
+		default:
+			active = append(active, job)
+		}
+	}
+
+	if !cj.Suspend {
+		if scheduled, ok := latestMissedRun(schedule, cj, now); ok {
+			active, err = s.startCronRun(cj, scheduled, active, now)
+			if err != nil {
+				return err
+			}
+			status.LastScheduleAt = scheduled
+		}
+	}
+
+	if err := s.pruneJobHistory(succeeded, cj.SuccessfulJobsHistoryLimit); err != nil {
+		return err
+	}
+	if err := s.pruneJobHistory(failed, cj.FailedJobsHistoryLimit); err != nil {
+		return err
+	}
+
+	for _, job := range active {
+		status.ActiveJobIDs = append(status.ActiveJobIDs, job.ID)
+	}
+	if !cj.Suspend {
+		status.NextScheduleAt = schedule.Next(now)
+	}
+	if cronJobStatusEqual(status, cj.Status) {
+		return nil
+	}
+
+	// Re-read so a spec change or delete that landed during this pass is kept.
+	current, found, err := s.getCronJobRecord(cj.ID)
+	if err != nil || !found {
+		return err
+	}
+	current.Status = status
+	return s.saveCronJobRecord(current)
+}
+
+// latestMissedRun returns the most recent scheduled time after the last run
+// that is due by now. Older missed times are skipped: a cron job that was
+// down for a while runs once, not once per missed slot. A run missed by
+// more than the starting deadline is not started at all.
+func latestMissedRun(schedule cron.Schedule, cj models.CronJob, now time.Time) (time.Time, bool) {
+	since := cj.Status.LastScheduleAt
+	if since.IsZero() {
+		since = cj.CreatedAt
+	}
+	if cj.StartingDeadlineSeconds > 0 {
+		if earliest := now.Add(-time.Duration(cj.StartingDeadlineSeconds) * time.Second); since.Before(earliest) {
+			since = earliest
+		}
+	}
+	var latest time.Time
+	for i, next := 0, schedule.Next(since); i < maxMissedCronRuns && !next.IsZero() && !next.After(now); i, next = i+1, schedule.Next(next) {
+		latest = next
+	}
+	return latest, !latest.IsZero()
+}
+
+func (s *Scheduler) startCronRun(cj models.CronJob, scheduled time.Time, active []models.Job, now time.Time) ([]models.Job, error) {
+	fields := logrus.Fields{"cronjob_id": cj.ID, "scheduled_at": scheduled.Format(time.RFC3339)}
+	if len(active) > 0 {
+		switch cj.ConcurrencyPolicy {
+		case models.ConcurrencyForbid:
+			cronJobLogger.WithFields(fields).Info("skipping cron run; previous job still active")
+			s.emitEvent("CronJobSkipped", "", "", "Previous job still active", map[string]interface{}{
+				"cronjob_id":   cj.ID,
+				"scheduled_at": scheduled.Format(time.RFC3339),
+			})
+			return active, nil
+		case models.ConcurrencyReplace:
+			for _, job := range active {
+				if err := s.DeleteJob(job.ID); err != nil {
+					return active, fmt.Errorf("replace job %s: %w", job.ID, err)
+				}
+			}
+			active = nil
+		}
+	}
+
+	job := cj.JobTemplate
+	job.ID = cronJobRunID(cj.ID, scheduled)
+	job.CronJobID = cj.ID
+	if existing, found, err := s.getJobRecord(job.ID); err != nil {
+		return active, err
+	} else if found {
+		// Already started by an earlier pass that failed to record it.
+		return append(active, existing), nil
+	}
+	metadata := make(map[string]interface{}, len(cj.JobTemplate.Template.Metadata)+1)
+	for k, v := range cj.JobTemplate.Template.Metadata {
+		metadata[k] = v
+	}
+	metadata["cronjob_id"] = cj.ID
+	job.Template.Metadata = metadata
+	if err := normalizeJob(&job); err != nil {
+		return active, fmt.Errorf("create job %s: %w", job.ID, err)
+	}
+	created, err := s.createJob(job)
+	if err != nil {
+		return active, fmt.Errorf("create job %s: %w", job.ID, err)
+	}
+	cronJobLogger.WithFields(fields).WithField("job_id", created.ID).Info("started cron run")
+	s.emitEvent("CronJobScheduled", "", "", "Job created", map[string]interface{}{
+		"cronjob_id":   cj.ID,
+		"job_id":       created.ID,
+		"scheduled_at": scheduled.Format(time.RFC3339),
+		"late_by":      now.Sub(scheduled).Round(time.Second).String(),
+	})
+	return append(active, created), nil
+}
+
+// pruneJobHistory deletes the oldest of the finished jobs beyond limit.
+// jobs is ordered oldest first.
+func (s *Scheduler) pruneJobHistory(jobs []models.Job, limit int) error {
+	for i := 0; i < len(jobs)-limit; i++ {
+		if err := s.DeleteJob(jobs[i].ID); err != nil {
+			return fmt.Errorf("prune job %s: %w", jobs[i].ID, err)
+		}
+	}
+	return nil
+}
+
+func cronJobStatusEqual(a, b models.CronJobStatus) bool {
+	return a.LastScheduleAt.Equal(b.LastScheduleAt) &&
+		a.LastSuccessfulAt.Equal(b.LastSuccessfulAt) &&
+		a.NextScheduleAt.Equal(b.NextScheduleAt) &&
+		strings.Join(a.ActiveJobIDs, ",") == strings.Join(b.ActiveJobIDs, ",")
+}
@@ -0,0 +1,525 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	metricspkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/metrics"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	deschedulerStrategyLowNodeUtilization = "lowNodeUtilization"
+	deschedulerStrategyRemoveDuplicates   = "removeDuplicates"
+	deschedulerStrategyViolatedAffinity   = "violatedAffinity"
+
+	// doNotMoveLabel set to "true" on a workload keeps the descheduler away
+	// from it. It is not matched against node labels.
+	doNotMoveLabel = "persys.io/do-not-move"
+
+	descheduledFromMetadataKey = "descheduled_from"
+	descheduledAtMetadataKey   = "descheduled_at"
+
+	// deschedulerCooldown leaves a workload where the descheduler put it, so
+	// two strategies cannot bounce it between nodes.
+	deschedulerCooldown = time.Hour
+)
+
+var deschedulerLogger = logging.C("scheduler.descheduler")
+
+var deschedulerStrategies = []string{
+	deschedulerStrategyLowNodeUtilization,
+	deschedulerStrategyRemoveDuplicates,
+	deschedulerStrategyViolatedAffinity,
+}
+
+// DeschedulerOptions tunes one descheduler pass. Zero values fall back to the
+// SCHEDULER_DESCHEDULER_* settings.
+type DeschedulerOptions struct {
+	DryRun     bool
+	Strategies []string
+	MaxMoves   int
+}
+
+// DeschedulerMove is one workload the descheduler moved, or would move in a
+// dry run. ToNode is the node placement picked; for an executed move it is
+// where the workload went.
+type DeschedulerMove struct {
+	WorkloadID string
+	Strategy   string
+	FromNode   string
+	ToNode     string
+	Reason     string
+	Executed   bool
+	Error      string
+}
+
+// ReconcileDescheduler runs a descheduler pass when the descheduler is
+// enabled and SCHEDULER_DESCHEDULER_INTERVAL has passed since the last one.
+func (s *Scheduler) ReconcileDescheduler() error {
+	if s.cfg == nil || !s.cfg.SchedulerDeschedulerEnabled || !s.isWritable() {
+		return nil
+	}
+	s.deschedulerMu.Lock()
+	due := time.Since(s.deschedulerLastRun) >= s.cfg.SchedulerDeschedulerInterval
+	s.deschedulerMu.Unlock()
+	if !due {
+		return nil
+	}
+	_, err := s.RunDescheduler(DeschedulerOptions{DryRun: s.cfg.SchedulerDeschedulerDryRun})
+	s.deschedulerMu.Lock()
+	s.deschedulerLastRun = time.Now()
+	s.deschedulerMu.Unlock()
+	return err
+}
+
+// RunDescheduler runs the strategies in order, sharing one move budget, and
+// returns the moves it made. Each move goes through evictWorkload, so the
+// workload is placed by the normal pipeline and started on its new node before
+// it is removed from the old one. A dry run plans the same moves without
+// making them and works while the control plane is frozen.
+func (s *Scheduler) RunDescheduler(opts DeschedulerOptions) ([]DeschedulerMove, error) {
+	if !opts.DryRun && !s.isWritable() {
+		return nil, errControlPlaneFrozen
+	}
+	if len(opts.Strategies) == 0 && s.cfg != nil {
+		opts.Strategies = s.cfg.SchedulerDeschedulerStrategies
+	}
+	if len(opts.Strategies) == 0 {
+		opts.Strategies = deschedulerStrategies
+	}
+	for _, strategy := range opts.Strategies {
+		if !isDeschedulerStrategy(strategy) {
+			return nil, fmt.Errorf("unknown descheduler strategy %q (want one of %s)", strategy, strings.Join(deschedulerStrategies, ", "))
+		}
+	}
+	if opts.MaxMoves <= 0 {
+		opts.MaxMoves = s.deschedulerMaxMoves()
+	}
+
+	s.deschedulerMu.Lock()
+	defer s.deschedulerMu.Unlock()
+
+	p, err := s.newDeschedulerPass(opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, strategy := range opts.Strategies {
+		if p.budget <= 0 {
+			break
+		}
+		switch strategy {
+		case deschedulerStrategyLowNodeUtilization:
+			p.lowNodeUtilization()
+		case deschedulerStrategyRemoveDuplicates:
+			p.removeDuplicates()
+		case deschedulerStrategyViolatedAffinity:
+			p.violatedAffinity()
+		}
+	}
+	if len(p.moves) > 0 {
+		deschedulerLogger.WithFields(logrus.Fields{
+			"dry_run": opts.DryRun,
+			"moves":   len(p.moves),
+		}).Info("descheduler pass finished")
+	}
+	return p.moves, nil
+}
+
+func (s *Scheduler) deschedulerMaxMoves() int {
+	if s.cfg != nil && s.cfg.SchedulerDeschedulerMaxMoves > 0 {
+		return s.cfg.SchedulerDeschedulerMaxMoves
+	}
+	return 5
+}
+
+func isDeschedulerStrategy(name string) bool {
+	for _, strategy := range deschedulerStrategies {
+		if name == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+type deschedulerPass struct {
+	s           *Scheduler
+	dryRun      bool
+	budget      int
+	now         time.Time
+	nodes       []models.Node
+	byNode      map[string][]models.Workload
+	allocations map[string]models.NodeAllocationSummary
+	// busyReplicaSets have a member that is not ready or was already moved
+	// this pass; like a drain, only one member of a replica set is in flight.
+	busyReplicaSets map[string]bool
+	taken           map[string]bool
+	moves           []DeschedulerMove
+}
+
+func (s *Scheduler) newDeschedulerPass(opts DeschedulerOptions) (*deschedulerPass, error) {
+	nodes, err := s.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return nil, err
+	}
+	allocations, err := s.listNodeAllocations()
+	if err != nil {
+		deschedulerLogger.WithError(err).Warn("failed to load allocation ledgers; using heartbeat usage only")
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeID < nodes[j].NodeID })
+
+	p := &deschedulerPass{
+		s:               s,
+		dryRun:          opts.DryRun,
+		budget:          opts.MaxMoves,
+		now:             time.Now().UTC(),
+		nodes:           nodes,
+		byNode:          map[string][]models.Workload{},
+		allocations:     allocations,
+		busyReplicaSets: map[string]bool{},
+		taken:           map[string]bool{},
+	}
+	for _, w := range workloads {
+		if strings.EqualFold(w.DesiredState, "Deleted") || w.NodeID == "" {
+			continue
+		}
+		p.byNode[w.NodeID] = append(p.byNode[w.NodeID], w)
+		if rsID, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok && !WorkloadReady(w) {
+			p.busyReplicaSets[rsID] = true
+		}
+	}
+	for nodeID := range p.byNode {
+		sort.Slice(p.byNode[nodeID], func(i, j int) bool { return p.byNode[nodeID][i].ID < p.byNode[nodeID][j].ID })
+	}
+	return p, nil
+}
+
+// source reports whether workloads may be taken off node. Cordoned and
+// draining nodes are left to the drain; unready ones to the reconciler.
+func (p *deschedulerPass) source(node models.Node) bool {
+	ready, _ := filterNodeReady(nil, node)
+	return ready && !node.Unschedulable
+}
+
+// candidates returns the workloads on node the descheduler may move.
+func (p *deschedulerPass) candidates(node models.Node) []models.Workload {
+	if !p.source(node) {
+		return nil
+	}
+	var out []models.Workload
+	for _, w := range p.byNode[node.NodeID] {
+		if p.taken[w.ID] || strings.EqualFold(w.DesiredState, "Stopped") || !WorkloadReady(w) {
+			continue
+		}
+		if _, isJob := metadataString(w.Metadata, jobIDMetadataKey); isJob {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(w.Labels[doNotMoveLabel]), "true") {
+			continue
+		}
+		if at, ok := metadataTimestamp(w.Metadata, descheduledAtMetadataKey); ok && p.now.Sub(at) < deschedulerCooldown {
+			continue
+		}
+		if rsID, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok && p.busyReplicaSets[rsID] {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+// move places w away from node and, unless this is a dry run, moves it there.
+// accept vets the node placement picked; a rejected pick leaves w where it is.
+func (p *deschedulerPass) move(strategy string, node models.Node, w models.Workload, reason string, accept func(models.Node) bool) (models.Node, bool) {
+	if p.budget <= 0 {
+		return models.Node{}, false
+	}
+	w = descheduledCopy(w, node.NodeID, p.now)
+	decision := p.s.evaluatePlacement(w, p.nodes)
+	if decision.Node == nil || decision.Node.NodeID == node.NodeID || !accept(*decision.Node) {
+		return models.Node{}, false
+	}
+	target := *decision.Node
+
+	p.budget--
+	p.taken[w.ID] = true
+	if rsID, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok {
+		p.busyReplicaSets[rsID] = true
+	}
+	move := DeschedulerMove{
+		WorkloadID: w.ID,
+		Strategy:   strategy,
+		FromNode:   node.NodeID,
+		ToNode:     target.NodeID,
+		Reason:     reason,
+	}
+	details := map[string]interface{}{
+		"strategy":  strategy,
+		"from_node": node.NodeID,
+		"to_node":   target.NodeID,
+	}
+
+	if p.dryRun {
+		metricspkg.IncDeschedulerMove(strategy, "planned")
+		p.s.emitEvent("DeschedulePlanned", w.ID, node.NodeID, reason, details)
+		p.moves = append(p.moves, move)
+		return target, true
+	}
+
+	movedTo, err := p.s.evictWorkload(node, w, fmt.Sprintf("descheduler %s: %s", strategy, reason))
+	if err != nil {
+		move.Error = err.Error()
+		metricspkg.IncDeschedulerMove(strategy, "failed")
+		deschedulerLogger.WithError(err).WithFields(logrus.Fields{
+			"workload_id": w.ID,
+			"node_id":     node.NodeID,
+			"strategy":    strategy,
+		}).Warn("descheduler move failed")
+		p.moves = append(p.moves, move)
+		return models.Node{}, false
+	}
+	move.ToNode = movedTo
+	move.Executed = true
+	details["to_node"] = movedTo
+	metricspkg.IncDeschedulerMove(strategy, "moved")
+	p.s.emitEvent("WorkloadDescheduled", w.ID, movedTo, reason, details)
+	p.moves = append(p.moves, move)
+	if movedTo != target.NodeID {
+		for _, n := range p.nodes {
+			if n.NodeID == movedTo {
+				return n, true
+			}
+		}
+	}
+	return target, true
+}
+
+// descheduledCopy returns w marked as being moved off fromNode. The marks
+// persist with the new assignment: descheduled_from keeps placement off the
+// old node while the workload still sits there, descheduled_at starts the
+// cooldown.
+func descheduledCopy(w models.Workload, fromNode string, now time.Time) models.Workload {
+	metadata := make(map[string]interface{}, len(w.Metadata)+2)
+	for k, v := range w.Metadata {
+		metadata[k] = v
+	}
+	metadata[descheduledFromMetadataKey] = fromNode
+	metadata[descheduledAtMetadataKey] = now.Format(time.RFC3339)
+	w.Metadata = metadata
+	return w
+}
+
+// filterDescheduleSource rejects the node the descheduler is moving a
+// workload off. It stops applying once the workload has left that node.
+func filterDescheduleSource(pc *placementContext, node models.Node) (bool, string) {
+	from, ok := metadataString(pc.workload.Metadata, descheduledFromMetadataKey)
+	if !ok || from != node.NodeID || pc.workload.NodeID != node.NodeID {
+		return true, ""
+	}
+	return false, "descheduling_source"
+}
+
+// nodeLoad is the share of a node's CPU and memory in use: the larger of
+// heartbeat usage and ledger reservations.
+type nodeLoad struct {
+	cpu    float64
+	memory float64
+}
+
+func (p *deschedulerPass) load(node models.Node) (nodeLoad, bool) {
+	if node.TotalCPU <= 0 || node.TotalMemory <= 0 {
+		return nodeLoad{}, false
+	}
+	usedCPU := node.TotalCPU - node.AvailableCPU
+	usedMemory := float64(node.TotalMemory - node.AvailableMemory)
+	if allocated, ok := p.allocations[node.NodeID]; ok {
+		if allocated.CPU > usedCPU {
+			usedCPU = allocated.CPU
+		}
+		if float64(allocated.MemoryMB) > usedMemory {
+			usedMemory = float64(allocated.MemoryMB)
+		}
+	}
+	return nodeLoad{cpu: usedCPU / node.TotalCPU, memory: usedMemory / float64(node.TotalMemory)}, true
+}
+
+// lowNodeUtilization moves workloads off nodes above the high threshold on
+// CPU or memory onto nodes below the low threshold on both. It stops on a hot
+// node once its estimated load is back under the threshold. Workloads without
+// resource requests are not moved: nothing says moving them helps.
+func (p *deschedulerPass) lowNodeUtilization() {
+	low, high := 0.2, 0.8
+	if cfg := p.s.cfg; cfg != nil {
+		low = float64(cfg.SchedulerDeschedulerLowUtilization) / 100
+		high = float64(cfg.SchedulerDeschedulerHighUtilization) / 100
+	}
+	loads := map[string]nodeLoad{}
+	cold := map[string]bool{}
+	var hot []models.Node
+	for _, node := range p.nodes {
+		l, ok := p.load(node)
+		if !ok {
+			continue
+		}
+		loads[node.NodeID] = l
+		switch {
+		case l.cpu > high || l.memory > high:
+			if p.source(node) {
+				hot = append(hot, node)
+			}
+		case l.cpu < low && l.memory < low:
+			if ready, _ := filterNodeReady(nil, node); ready && !node.Unschedulable {
+				cold[node.NodeID] = true
+			}
+		}
+	}
+	if len(hot) == 0 || len(cold) == 0 {
+		return
+	}
+	peak := func(l nodeLoad) float64 {
+		if l.cpu > l.memory {
+			return l.cpu
+		}
+		return l.memory
+	}
+	sort.SliceStable(hot, func(i, j int) bool { return peak(loads[hot[i].NodeID]) > peak(loads[hot[j].NodeID]) })
+
+	for _, node := range hot {
+		candidates := p.candidates(node)
+		share := func(w models.Workload) float64 {
+			alloc := workloadAllocation(w)
+			return alloc.CPU/node.TotalCPU + float64(alloc.MemoryMB)/float64(node.TotalMemory)
+		}
+		// Lowest priority first, then the largest share of the node.
+		sort.SliceStable(candidates, func(i, j int) bool {
+			if candidates[i].Priority != candidates[j].Priority {
+				return candidates[i].Priority < candidates[j].Priority
+			}
+			return share(candidates[i]) > share(candidates[j])
+		})
+		for _, w := range candidates {
+			l := loads[node.NodeID]
+			if l.cpu <= high && l.memory <= high {
+				break
+			}
+			alloc := workloadAllocation(w)
+			if alloc.CPU <= 0 && alloc.MemoryMB <= 0 {
+				continue
+			}
+			reason := fmt.Sprintf("node %s above %.0f%% utilization (cpu=%.0f%% memory=%.0f%%)", node.NodeID, high*100, l.cpu*100, l.memory*100)
+			target, ok := p.move(deschedulerStrategyLowNodeUtilization, node, w, reason, func(n models.Node) bool { return cold[n.NodeID] })
+			if !ok {
+				if p.budget <= 0 {
+					return
+				}
+				continue
+			}
+			l.cpu -= alloc.CPU / node.TotalCPU
+			l.memory -= float64(alloc.MemoryMB) / float64(node.TotalMemory)
+			loads[node.NodeID] = l
+			if t, ok := loads[target.NodeID]; ok {
+				t.cpu += alloc.CPU / target.TotalCPU
+				t.memory += float64(alloc.MemoryMB) / float64(target.TotalMemory)
+				loads[target.NodeID] = t
+				if t.cpu >= low || t.memory >= low {
+					delete(cold, target.NodeID)
+				}
+			}
+			if len(cold) == 0 {
+				return
+			}
+		}
+	}
+}
+
+// removeDuplicates spreads out members of a placement group (replica set or
+// anti-affinity group) that share a node, keeping the oldest one in place.
+// A duplicate only moves to a node that hosts no member of its group.
+func (p *deschedulerPass) removeDuplicates() {
+	peers := map[string]map[string]int{} // group -> node ID -> members
+	for nodeID, workloads := range p.byNode {
+		for _, w := range workloads {
+			group := placementGroup(w)
+			if group == "" || strings.EqualFold(w.DesiredState, "Stopped") {
+				continue
+			}
+			if peers[group] == nil {
+				peers[group] = map[string]int{}
+			}
+			peers[group][nodeID]++
+		}
+	}
+
+	for _, node := range p.nodes {
+		byGroup := map[string][]models.Workload{}
+		for _, w := range p.candidates(node) {
+			if group := placementGroup(w); group != "" && peers[group][node.NodeID] > 1 {
+				byGroup[group] = append(byGroup[group], w)
+			}
+		}
+		groups := make([]string, 0, len(byGroup))
+		for group := range byGroup {
+			groups = append(groups, group)
+		}
+		sort.Strings(groups)
+		for _, group := range groups {
+			members := byGroup[group]
+			sort.SliceStable(members, func(i, j int) bool { return members[i].CreatedAt.After(members[j].CreatedAt) })
+			for _, w := range members {
+				count := peers[group][node.NodeID]
+				if count <= 1 {
+					break
+				}
+				reason := fmt.Sprintf("%d members of group %s on node %s", count, group, node.NodeID)
+				target, ok := p.move(deschedulerStrategyRemoveDuplicates, node, w, reason, func(n models.Node) bool { return peers[group][n.NodeID] == 0 })
+				if !ok {
+					if p.budget <= 0 {
+						return
+					}
+					continue
+				}
+				peers[group][node.NodeID]--
+				peers[group][target.NodeID]++
+			}
+		}
+	}
+}
+
+// violatedAffinity moves workloads whose node no longer satisfies their node
+// selector or required node affinity, typically after node labels changed.
+func (p *deschedulerPass) violatedAffinity() {
+	for _, node := range p.nodes {
+		for _, w := range p.candidates(node) {
+			reason := affinityViolation(w, node)
+			if reason == "" {
+				continue
+			}
+			if _, ok := p.move(deschedulerStrategyViolatedAffinity, node, w, reason, func(models.Node) bool { return true }); !ok && p.budget <= 0 {
+				return
+			}
+		}
+	}
+}
+
+func affinityViolation(w models.Workload, node models.Node) string {
+	if !matchesLabels(w.Labels, node.Labels) {
+		return fmt.Sprintf("node %s no longer matches the workload labels", node.NodeID)
+	}
+	if w.Placement == nil {
+		return ""
+	}
+	for _, req := range w.Placement.RequiredNodeAffinity {
+		if !nodeSelectorRequirementMatches(req, node.Labels) {
+			return fmt.Sprintf("node %s no longer satisfies node affinity key=%s op=%s values=%v", node.NodeID, req.Key, req.Operator, req.Values)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestAffinityViolation(t *testing.T) {
+	node := models.Node{NodeID: "n1", Labels: map[string]string{"zone": "a", "disk": "ssd"}}
+	tests := []struct {
+		name      string
+		workload  models.Workload
+		violation bool
+	}{
+		{name: "no constraints", workload: models.Workload{}},
+		{name: "labels still match", workload: models.Workload{Labels: map[string]string{"zone": "a"}}},
+		{name: "label changed", workload: models.Workload{Labels: map[string]string{"zone": "b"}}, violation: true},
+		{name: "do-not-move label is ignored", workload: models.Workload{Labels: map[string]string{doNotMoveLabel: "true"}}},
+		{
+			name: "required affinity still holds",
+			workload: models.Workload{Placement: &models.PlacementPolicy{RequiredNodeAffinity: []models.NodeSelectorRequirement{
+				{Key: "disk", Operator: "In", Values: []string{"ssd", "nvme"}},
+			}}},
+		},
+		{
+			name: "required affinity broken",
+			workload: models.Workload{Placement: &models.PlacementPolicy{RequiredNodeAffinity: []models.NodeSelectorRequirement{
+				{Key: "gpu", Operator: "Exists"},
+			}}},
+			violation: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := affinityViolation(tt.workload, node); (got != "") != tt.violation {
+				t.Fatalf("violation=%v, got %q", tt.violation, got)
+			}
+		})
+	}
+}
+
+func TestFilterDescheduleSource(t *testing.T) {
+	moving := descheduledCopy(models.Workload{ID: "w1", NodeID: "n1"}, "n1", time.Now())
+	moved := moving
+	moved.NodeID = "n2"
+	tests := []struct {
+		name     string
+		workload models.Workload
+		node     string
+		pass     bool
+	}{
+		{name: "source node is rejected while the workload is there", workload: moving, node: "n1"},
+		{name: "other nodes pass", workload: moving, node: "n2", pass: true},
+		{name: "source node passes once the workload left", workload: moved, node: "n1", pass: true},
+		{name: "workloads not being moved pass", workload: models.Workload{ID: "w2", NodeID: "n1"}, node: "n1", pass: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pc := &placementContext{workload: tt.workload}
+			if ok, reason := filterDescheduleSource(pc, models.Node{NodeID: tt.node}); ok != tt.pass {
+				t.Fatalf("pass=%v, got %v (%s)", tt.pass, ok, reason)
+			}
+		})
+	}
+}
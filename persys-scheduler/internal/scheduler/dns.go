@@ -1,12 +1,14 @@
 package scheduler
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
 	"strings"
 
 	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 func (s *Scheduler) UpdateCoreDNS(node models.Node) error {
@@ -86,29 +88,73 @@ func (s *Scheduler) RegisterSchedulerInCoreDNS(ipAddress string, port int) error
 // RegisterSchedulerSelfInCoreDNS registers this scheduler instance into CoreDNS.
 // It resolves advertise IP/port from env with sane defaults.
 func (s *Scheduler) RegisterSchedulerSelfInCoreDNS(defaultPort int) error {
+	ipAddress, port, err := s.advertiseAddress(defaultPort)
+	if err != nil {
+		return err
+	}
+	if err := s.RegisterSchedulerInCoreDNS(ipAddress, port); err != nil {
+		return err
+	}
+	schedulerLogger.WithFields(map[string]interface{}{
+		"ip":             ipAddress,
+		"port":           port,
+		"scheduler_name": "persys-scheduler",
+		"domain":         s.domain,
+	}).Info("registered scheduler in CoreDNS")
+	return nil
+}
+
+func (s *Scheduler) advertiseAddress(defaultPort int) (string, int, error) {
 	ipAddress := strings.TrimSpace(s.cfg.SchedulerAdvertiseIP)
 	if ipAddress == "" {
 		resolved, err := firstNonLoopbackIPv4()
 		if err != nil {
-			return fmt.Errorf("resolve scheduler advertise IP: %w", err)
+			return "", 0, fmt.Errorf("resolve scheduler advertise IP: %w", err)
 		}
 		ipAddress = resolved
 	}
-
 	port := s.cfg.SchedulerAdvertisePort
 	if port <= 0 {
 		port = defaultPort
 	}
+	return ipAddress, port, nil
+}
 
-	if err := s.RegisterSchedulerInCoreDNS(ipAddress, port); err != nil {
-		return err
+func leaderSRVKey(domain string) string {
+	return fmt.Sprintf("/skydns/%s/_persys-scheduler-leader", reverseDomain(domain))
+}
+
+func leaderAKey(domain string) string {
+	return fmt.Sprintf("/skydns/%s/persys-scheduler-leader", reverseDomain(domain))
+}
+
+// registerLeaderInCoreDNS publishes _persys-scheduler-leader.<domain> (SRV)
+// and persys-scheduler-leader.<domain> (A) under the leader's lease, so the
+// records disappear with the lease if the leader dies.
+func (s *Scheduler) registerLeaderInCoreDNS(ipAddress string, port int, lease clientv3.LeaseID, ttl int) error {
+	srvJSON, err := json.Marshal(struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+		TTL  int    `json:"ttl"`
+	}{Host: ipAddress, Port: port, TTL: ttl})
+	if err != nil {
+		return fmt.Errorf("failed to marshal leader SRV record: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	if _, err := s.etcdClient.Put(ctx, leaderSRVKey(s.domain), string(srvJSON), clientv3.WithLease(lease)); err != nil {
+		return fmt.Errorf("failed to register leader SRV record: %v", err)
+	}
+	aJSON, err := json.Marshal(struct {
+		Host string `json:"host"`
+		TTL  int    `json:"ttl"`
+	}{Host: ipAddress, TTL: ttl})
+	if err != nil {
+		return fmt.Errorf("failed to marshal leader A record: %v", err)
+	}
+	if _, err := s.etcdClient.Put(ctx, leaderAKey(s.domain), string(aJSON), clientv3.WithLease(lease)); err != nil {
+		return fmt.Errorf("failed to register leader A record: %v", err)
 	}
-	schedulerLogger.WithFields(map[string]interface{}{
-		"ip":             ipAddress,
-		"port":           port,
-		"scheduler_name": "persys-scheduler",
-		"domain":         s.domain,
-	}).Info("registered scheduler in CoreDNS")
 	return nil
 }
 
@@ -221,6 +221,8 @@ func (s *Scheduler) compareNodeDrift(node models.Node, expected map[string]model
 				"agent_revision":     aw.GetRevisionId(),
 				"drift_type":         "revision_mismatch",
 			}).Warn("drift detected")
+		} else if ro := sw.StatusInfo.Rollout; ro != nil && ro.Phase != models.RolloutComplete {
+			s.observeAgentRevision(id, mapActualStateToSchedulerStatus(aw.GetActualState()), aw.GetRevisionId())
 		}
 	}
 
@@ -12,17 +12,20 @@ import (
 
 var etcdLogger = logging.C("scheduler.etcd")
 
-// RetryableEtcdPut performs a put operation with retries.
-func (s *Scheduler) RetryableEtcdPut(key, value string) error {
+// RetryableEtcdPut performs a put operation with retries. Successful writes
+// to mirrored keys are applied to the state informer right away.
+func (s *Scheduler) RetryableEtcdPut(key, value string, opts ...clientv3.OpOption) error {
 	if err := s.requireWritable(); err != nil {
 		return err
 	}
 	var err error
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
-		_, err = s.etcdClient.Put(ctx, key, value)
+		var resp *clientv3.PutResponse
+		resp, err = s.etcdClient.Put(ctx, key, value, opts...)
 		cancel()
 		if err == nil {
+			s.informer.apply(key, []byte(value), resp.Header.Revision)
 			return nil
 		}
 		etcdLogger.WithError(err).WithFields(logrus.Fields{
@@ -67,9 +70,14 @@ func (s *Scheduler) RetryableEtcdDelete(key string, opts ...clientv3.OpOption) e
 	var err error
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
-		_, err = s.etcdClient.Delete(ctx, key, opts...)
+		var resp *clientv3.DeleteResponse
+		resp, err = s.etcdClient.Delete(ctx, key, opts...)
 		cancel()
 		if err == nil {
+			// Ranged deletes reach the informer through its watch.
+			if len(opts) == 0 {
+				s.informer.remove(key, resp.Header.Revision)
+			}
 			return nil
 		}
 		etcdLogger.WithError(err).WithFields(logrus.Fields{
@@ -83,3 +91,83 @@ func (s *Scheduler) RetryableEtcdDelete(key string, opts ...clientv3.OpOption) e
 	s.enterDegraded(fmt.Sprintf("etcd delete failure key=%s: %v", key, err))
 	return fmt.Errorf("failed to delete key %s after %d attempts: %v", key, maxRetries+1, err)
 }
+
+// RetryableEtcdCompareAndPut writes key only if its mod revision still equals
+// modRevision (0 means the key must not exist). It reports false when another
+// writer got there first; transport errors are retried like the other helpers.
+func (s *Scheduler) RetryableEtcdCompareAndPut(key, value string, modRevision int64) (bool, error) {
+	return s.RetryableEtcdCompareAndPutAll(key, modRevision, map[string]string{key: value})
+}
+
+// RetryableEtcdCompareAndPutAll is RetryableEtcdCompareAndPut for several
+// keys written in one transaction, guarded by the mod revision of key.
+func (s *Scheduler) RetryableEtcdCompareAndPutAll(key string, modRevision int64, puts map[string]string) (bool, error) {
+	if err := s.requireWritable(); err != nil {
+		return false, err
+	}
+	ops := make([]clientv3.Op, 0, len(puts))
+	for k, v := range puts {
+		ops = append(ops, clientv3.OpPut(k, v))
+	}
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+		var resp *clientv3.TxnResponse
+		resp, err = s.etcdClient.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", modRevision)).
+			Then(ops...).
+			Commit()
+		cancel()
+		if err == nil {
+			if resp.Succeeded {
+				for k, v := range puts {
+					s.informer.apply(k, []byte(v), resp.Header.Revision)
+				}
+			}
+			return resp.Succeeded, nil
+		}
+		etcdLogger.WithError(err).WithFields(logrus.Fields{
+			"attempt": attempt + 1,
+			"key":     key,
+		}).Warn("etcd compare-and-put attempt failed")
+		if attempt < maxRetries {
+			time.Sleep(retryWaitTime)
+		}
+	}
+	s.enterDegraded(fmt.Sprintf("etcd write failure key=%s: %v", key, err))
+	return false, fmt.Errorf("failed to compare-and-put key %s after %d attempts: %v", key, maxRetries+1, err)
+}
+
+// RetryableEtcdCompareAndDelete deletes key only if its mod revision still
+// equals modRevision. It reports false when another writer changed or removed
+// the key first.
+func (s *Scheduler) RetryableEtcdCompareAndDelete(key string, modRevision int64) (bool, error) {
+	if err := s.requireWritable(); err != nil {
+		return false, err
+	}
+	var err error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+		var resp *clientv3.TxnResponse
+		resp, err = s.etcdClient.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", modRevision)).
+			Then(clientv3.OpDelete(key)).
+			Commit()
+		cancel()
+		if err == nil {
+			if resp.Succeeded {
+				s.informer.remove(key, resp.Header.Revision)
+			}
+			return resp.Succeeded, nil
+		}
+		etcdLogger.WithError(err).WithFields(logrus.Fields{
+			"attempt": attempt + 1,
+			"key":     key,
+		}).Warn("etcd compare-and-delete attempt failed")
+		if attempt < maxRetries {
+			time.Sleep(retryWaitTime)
+		}
+	}
+	s.enterDegraded(fmt.Sprintf("etcd delete failure key=%s: %v", key, err))
+	return false, fmt.Errorf("failed to compare-and-delete key %s after %d attempts: %v", key, maxRetries+1, err)
+}
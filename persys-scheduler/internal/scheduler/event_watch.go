@@ -0,0 +1,164 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/redis/go-redis/v9"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// redisEventReadBlock bounds each blocking stream read, so a watcher notices a
+// cancelled context even when no events arrive.
+const redisEventReadBlock = 5 * time.Second
+
+// ErrEventsCompacted is returned by WatchEvents when the requested revision is
+// older than the oldest event still retained.
+var ErrEventsCompacted = errors.New("requested event revision is no longer retained")
+
+// EventFilter selects the events WatchEvents delivers. Empty fields match
+// everything; Types matches any of the listed event types.
+type EventFilter struct {
+	WorkloadID string
+	NodeID     string
+	Types      []string
+}
+
+// Matches reports whether event passes the filter.
+func (f EventFilter) Matches(event models.SchedulerEvent) bool {
+	if f.WorkloadID != "" && event.WorkloadID != f.WorkloadID {
+		return false
+	}
+	if f.NodeID != "" && event.NodeID != f.NodeID {
+		return false
+	}
+	if len(f.Types) == 0 {
+		return true
+	}
+	for _, t := range f.Types {
+		if strings.EqualFold(strings.TrimSpace(t), event.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// WatchEvents calls send for every event matching filter until ctx ends or
+// send fails. With fromRevision > 0 it first replays retained events from that
+// revision on. Events come from the Redis event stream, read in revision
+// order, when Redis holds them and from an etcd watch on the events prefix
+// otherwise.
+func (s *Scheduler) WatchEvents(ctx context.Context, filter EventFilter, fromRevision int64, send func(models.SchedulerEvent) error) error {
+	if s.redisClient != nil {
+		return s.watchRedisEvents(ctx, filter, fromRevision, send)
+	}
+	return s.watchEtcdEvents(ctx, filter, fromRevision, send)
+}
+
+func (s *Scheduler) watchEtcdEvents(ctx context.Context, filter EventFilter, fromRevision int64, send func(models.SchedulerEvent) error) error {
+	watchCtx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+	opts := []clientv3.OpOption{clientv3.WithPrefix()}
+	if fromRevision > 0 {
+		opts = append(opts, clientv3.WithRev(fromRevision))
+	}
+	for resp := range s.etcdClient.Watch(watchCtx, eventsPrefix, opts...) {
+		if resp.CompactRevision != 0 {
+			return fmt.Errorf("%w: oldest revision is %d", ErrEventsCompacted, resp.CompactRevision)
+		}
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		for _, ev := range resp.Events {
+			if ev.Type != clientv3.EventTypePut {
+				continue
+			}
+			var event models.SchedulerEvent
+			if err := json.Unmarshal(ev.Kv.Value, &event); err != nil {
+				continue
+			}
+			event.Revision = ev.Kv.ModRevision
+			if !filter.Matches(event) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
+
+func (s *Scheduler) watchRedisEvents(ctx context.Context, filter EventFilter, fromRevision int64, send func(models.SchedulerEvent) error) error {
+	// The revision counter and the stream move together, so the counter is
+	// the revision of the newest entry even when the stream has expired.
+	last, err := s.redisClient.Get(ctx, redisEventRevisionKey).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return fmt.Errorf("read redis event revision: %w", err)
+	}
+	after := last
+	if fromRevision > 0 {
+		oldest := last + 1
+		first, err := s.redisClient.XRangeN(ctx, redisEventStreamKey, "-", "+", 1).Result()
+		if err != nil {
+			return fmt.Errorf("read redis event stream: %w", err)
+		}
+		if len(first) > 0 {
+			if revision, ok := redisEventRevision(first[0].ID); ok {
+				oldest = revision
+			}
+		}
+		if fromRevision < oldest && fromRevision <= last {
+			return fmt.Errorf("%w: oldest revision is %d", ErrEventsCompacted, oldest)
+		}
+		after = fromRevision - 1
+	}
+
+	for {
+		streams, err := s.redisClient.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{redisEventStreamKey, fmt.Sprintf("%d-0", after)},
+			Count:   100,
+			Block:   redisEventReadBlock,
+		}).Result()
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("read redis event stream: %w", err)
+		}
+		for _, stream := range streams {
+			for _, msg := range stream.Messages {
+				revision, ok := redisEventRevision(msg.ID)
+				if !ok {
+					continue
+				}
+				// Revisions are consecutive; a jump means the stream was
+				// trimmed past events this watcher had not read yet.
+				if revision != after+1 {
+					return fmt.Errorf("%w: missed revisions %d to %d", ErrEventsCompacted, after+1, revision-1)
+				}
+				after = revision
+				raw, _ := msg.Values[redisEventField].(string)
+				var event models.SchedulerEvent
+				if err := json.Unmarshal([]byte(raw), &event); err != nil {
+					continue
+				}
+				event.Revision = revision
+				if !filter.Matches(event) {
+					continue
+				}
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
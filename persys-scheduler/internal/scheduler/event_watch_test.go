@@ -0,0 +1,23 @@
+package scheduler
+
+import "testing"
+
+func TestRedisEventRevision(t *testing.T) {
+	cases := []struct {
+		id       string
+		revision int64
+		ok       bool
+	}{
+		{id: "42-0", revision: 42, ok: true},
+		{id: "1-0", revision: 1, ok: true},
+		{id: "0-0"},
+		{id: "abc-0"},
+		{id: "42"},
+	}
+	for _, tc := range cases {
+		revision, ok := redisEventRevision(tc.id)
+		if ok != tc.ok || (ok && revision != tc.revision) {
+			t.Fatalf("redisEventRevision(%q) = %d, %v; want %d, %v", tc.id, revision, ok, tc.revision, tc.ok)
+		}
+	}
+}
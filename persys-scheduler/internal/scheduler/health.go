@@ -0,0 +1,283 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	defaultProbeSuccessThreshold = 1
+	defaultProbeFailureThreshold = 3
+
+	livenessRestartBackoffBase = 10 * time.Second
+	livenessRestartBackoffMax  = 5 * time.Minute
+
+	// livenessRestartResetAfter is how long the liveness probe has to keep
+	// passing after a restart before the restart count, and with it the
+	// backoff, starts over.
+	livenessRestartResetAfter = 10 * time.Minute
+
+	// probeStateRefreshInterval bounds how stale the stored check time and
+	// message of a probe get while its results keep agreeing with its status.
+	probeStateRefreshInterval = time.Minute
+)
+
+func probeThresholds(p *models.Probe) (success, failure int) {
+	success, failure = defaultProbeSuccessThreshold, defaultProbeFailureThreshold
+	if p == nil {
+		return success, failure
+	}
+	if p.SuccessThreshold > 0 {
+		success = p.SuccessThreshold
+	}
+	if p.FailureThreshold > 0 {
+		failure = p.FailureThreshold
+	}
+	return success, failure
+}
+
+// probeFor returns the spec and state slot of the named probe, creating the
+// health record and an Unknown state on first use.
+func probeFor(w *models.Workload, kind string) (*models.Probe, **models.ProbeState) {
+	if w.StatusInfo.Health == nil {
+		w.StatusInfo.Health = &models.WorkloadHealth{}
+	}
+	var spec *models.Probe
+	var slot **models.ProbeState
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case models.ProbeLiveness:
+		spec, slot = w.LivenessProbe, &w.StatusInfo.Health.Liveness
+	case models.ProbeReadiness:
+		spec, slot = w.ReadinessProbe, &w.StatusInfo.Health.Readiness
+	}
+	if spec == nil {
+		return nil, nil
+	}
+	if *slot == nil {
+		*slot = &models.ProbeState{Status: models.ProbeUnknown}
+	}
+	return spec, slot
+}
+
+// WorkloadReady reports whether w should receive traffic: it is running, its
+// liveness probe is not failing and, if it has a readiness probe, that probe
+// is passing.
+func WorkloadReady(w models.Workload) bool {
+	if !strings.EqualFold(w.Status, "Running") {
+		return false
+	}
+	health := w.StatusInfo.Health
+	if health != nil && health.Liveness != nil && health.Liveness.Status == models.ProbeFailing {
+		return false
+	}
+	if w.ReadinessProbe == nil {
+		return true
+	}
+	return health != nil && health.Readiness != nil && health.Readiness.Status == models.ProbePassing
+}
+
+// RecordProbeResults folds the probe runs an agent reported into the
+// workload's health. Results for probes the workload does not define, results
+// older than the last one seen, and results inside the initial delay after a
+// (re)start are ignored. The workload is only saved when a result moves a
+// probe towards or across a threshold, when the restart count is reset, or
+// when the stored check time is older than probeStateRefreshInterval; results
+// that agree with the current status change nothing else.
+func (s *Scheduler) RecordProbeResults(workloadID string, results []models.ProbeResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return err
+	}
+	if workload.LivenessProbe == nil && workload.ReadinessProbe == nil {
+		return nil
+	}
+	wasReady := WorkloadReady(workload)
+
+	now := time.Now().UTC()
+	changed := false
+	persist := false
+	var transitions []string
+	for _, result := range results {
+		spec, slot := probeFor(&workload, result.Probe)
+		if spec == nil {
+			continue
+		}
+		state := *slot
+		checkedAt := result.CheckedAt.UTC()
+		if checkedAt.IsZero() {
+			checkedAt = now
+		}
+		if !state.LastCheckedAt.IsZero() && !checkedAt.After(state.LastCheckedAt) {
+			continue
+		}
+		startedAt := workload.StatusInfo.Health.LastRestartAt
+		if startedAt.IsZero() {
+			startedAt = workload.CreatedAt
+		}
+		if spec.InitialDelaySeconds > 0 && checkedAt.Before(startedAt.Add(time.Duration(spec.InitialDelaySeconds)*time.Second)) {
+			continue
+		}
+
+		successThreshold, failureThreshold := probeThresholds(spec)
+		previous := state.Status
+		// A result that agrees with the status and breaks no streak of the
+		// other kind changes nothing the next verdict depends on.
+		agrees := (result.Success && previous == models.ProbePassing && state.ConsecutiveFailures == 0) ||
+			(!result.Success && previous == models.ProbeFailing && state.ConsecutiveSuccesses == 0)
+		if !agrees || checkedAt.Sub(state.LastCheckedAt) >= probeStateRefreshInterval {
+			persist = true
+		}
+		if result.Success {
+			state.ConsecutiveSuccesses++
+			state.ConsecutiveFailures = 0
+			if state.ConsecutiveSuccesses >= successThreshold {
+				state.Status = models.ProbePassing
+			}
+		} else {
+			state.ConsecutiveFailures++
+			state.ConsecutiveSuccesses = 0
+			if state.ConsecutiveFailures >= failureThreshold {
+				state.Status = models.ProbeFailing
+			}
+		}
+		state.LastMessage = strings.TrimSpace(result.Message)
+		state.LastCheckedAt = checkedAt
+		if state.Status != previous {
+			state.LastTransitionAt = now
+			if strings.EqualFold(result.Probe, models.ProbeLiveness) && state.Status == models.ProbeFailing {
+				transitions = append(transitions, "LivenessProbeFailed")
+			}
+		}
+		changed = true
+	}
+	health := workload.StatusInfo.Health
+	if changed && health != nil && health.Restarts > 0 && health.Liveness != nil &&
+		health.Liveness.Status == models.ProbePassing && now.Sub(health.LastRestartAt) >= livenessRestartResetAfter {
+		health.Restarts = 0
+		persist = true
+	}
+	if !changed || !persist {
+		return nil
+	}
+	if err := s.saveWorkload(workload); err != nil {
+		return fmt.Errorf("failed to record probe results for workload %s: %w", workloadID, err)
+	}
+
+	for _, eventType := range transitions {
+		s.emitEvent(eventType, workload.ID, workload.NodeID, workload.StatusInfo.Health.Liveness.LastMessage, map[string]interface{}{
+			"consecutive_failures": workload.StatusInfo.Health.Liveness.ConsecutiveFailures,
+		})
+	}
+	if ready := WorkloadReady(workload); ready != wasReady {
+		eventType, message := "WorkloadReady", "Workload is ready"
+		if !ready {
+			eventType, message = "WorkloadNotReady", "Workload is not ready"
+			if r := workload.StatusInfo.Health.Readiness; r != nil && r.LastMessage != "" {
+				message = r.LastMessage
+			}
+		}
+		s.emitEvent(eventType, workload.ID, workload.NodeID, message, nil)
+	}
+	return nil
+}
+
+func probeResultsFromAgent(in []*agentpb.ProbeResult) []models.ProbeResult {
+	out := make([]models.ProbeResult, 0, len(in))
+	for _, r := range in {
+		if r == nil {
+			continue
+		}
+		result := models.ProbeResult{
+			Probe:   strings.ToLower(strings.TrimSpace(r.GetProbe())),
+			Success: r.GetSuccess(),
+			Message: r.GetMessage(),
+		}
+		if r.GetCheckedAt() > 0 {
+			result.CheckedAt = time.Unix(r.GetCheckedAt(), 0).UTC()
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+// livenessRestartBackoff is how long to wait after the previous liveness
+// restart before the next one. The first restart happens right away.
+func livenessRestartBackoff(restarts int) time.Duration {
+	if restarts <= 0 {
+		return 0
+	}
+	backoff := livenessRestartBackoffBase
+	for i := 1; i < restarts && backoff < livenessRestartBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > livenessRestartBackoffMax {
+		return livenessRestartBackoffMax
+	}
+	return backoff
+}
+
+// livenessRestartDue reports whether w's liveness probe is failing and the
+// restart backoff has elapsed.
+func livenessRestartDue(w models.Workload) bool {
+	if w.LivenessProbe == nil || w.StatusInfo.Health == nil {
+		return false
+	}
+	health := w.StatusInfo.Health
+	if health.Liveness == nil || health.Liveness.Status != models.ProbeFailing {
+		return false
+	}
+	return time.Since(health.LastRestartAt) >= livenessRestartBackoff(health.Restarts)
+}
+
+// resetProbeStates forgets probe verdicts, e.g. after a restart or when a new
+// revision starts, so stale results do not carry over.
+func resetProbeStates(w *models.Workload) {
+	health := w.StatusInfo.Health
+	if health == nil {
+		return
+	}
+	health.Liveness = nil
+	health.Readiness = nil
+}
+
+// recordLivenessRestart notes a restart triggered by a failing liveness probe.
+func (s *Scheduler) recordLivenessRestart(workloadID, reason string) {
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return
+	}
+	if workload.StatusInfo.Health == nil {
+		workload.StatusInfo.Health = &models.WorkloadHealth{}
+	}
+	health := workload.StatusInfo.Health
+	health.Restarts++
+	health.LastRestartAt = time.Now().UTC()
+	health.LastRestartReason = reason
+	resetProbeStates(&workload)
+	if workload.Metadata == nil {
+		workload.Metadata = map[string]interface{}{}
+	}
+	workload.Metadata["last_action"] = "RestartUnhealthy"
+	if err := s.saveWorkload(workload); err != nil {
+		reconcilerLogger.WithError(err).WithField("workload_id", workloadID).Warn("failed to persist liveness restart")
+		return
+	}
+	reconcilerLogger.WithFields(logrus.Fields{
+		"workload_id": workloadID,
+		"restarts":    health.Restarts,
+	}).Info("restarted workload after liveness probe failure")
+	s.emitEvent("WorkloadRestarted", workload.ID, workload.NodeID, reason, map[string]interface{}{
+		"restarts": health.Restarts,
+	})
+}
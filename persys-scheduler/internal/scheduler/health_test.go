@@ -0,0 +1,101 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func (f *fakeKV) revision() int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rev
+}
+
+func TestRecordProbeResultsSavesOnlyWhatChanges(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	start := time.Now().UTC().Add(-time.Hour)
+	workload := models.Workload{
+		ID:            "w1",
+		Type:          "container",
+		Status:        "Running",
+		CreatedAt:     start,
+		LivenessProbe: &models.Probe{FailureThreshold: 2},
+	}
+	if err := s.insertWorkload(workload); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+
+	at := time.Now().UTC().Add(-10 * time.Minute)
+	record := func(success bool) (saved bool) {
+		t.Helper()
+		at = at.Add(10 * time.Second)
+		before := kv.revision()
+		if err := s.RecordProbeResults("w1", []models.ProbeResult{{Probe: models.ProbeLiveness, Success: success, CheckedAt: at}}); err != nil {
+			t.Fatalf("RecordProbeResults: %v", err)
+		}
+		return kv.revision() != before
+	}
+
+	if !record(true) {
+		t.Fatalf("the first verdict must be saved")
+	}
+	if record(true) {
+		t.Fatalf("a result agreeing with the status must not be saved")
+	}
+	if !record(false) {
+		t.Fatalf("a failure starting a streak must be saved")
+	}
+	if !record(true) {
+		t.Fatalf("a success breaking the failure streak must be saved")
+	}
+	if !record(false) {
+		t.Fatalf("a failure starting a new streak must be saved")
+	}
+	if stored, _ := s.GetWorkloadByID("w1"); stored.StatusInfo.Health.Liveness.Status != models.ProbePassing {
+		t.Fatalf("one failure after a success must not fail the probe, got %s", stored.StatusInfo.Health.Liveness.Status)
+	}
+	record(true)
+	for i := 0; i < 5; i++ {
+		if record(true) {
+			t.Fatalf("steady results must not be saved before the refresh interval")
+		}
+	}
+	at = at.Add(probeStateRefreshInterval)
+	if !record(true) {
+		t.Fatalf("a stale check time must be refreshed")
+	}
+}
+
+func TestRecordProbeResultsResetsRestartsAfterRecovery(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	lastRestart := time.Now().UTC().Add(-livenessRestartResetAfter - time.Minute)
+	workload := models.Workload{
+		ID:            "w1",
+		Type:          "container",
+		Status:        "Running",
+		CreatedAt:     lastRestart.Add(-time.Hour),
+		LivenessProbe: &models.Probe{},
+		StatusInfo: models.WorkloadStatusInfo{Health: &models.WorkloadHealth{
+			Restarts:      4,
+			LastRestartAt: lastRestart,
+			Liveness:      &models.ProbeState{Status: models.ProbePassing, LastCheckedAt: time.Now().UTC().Add(-5 * time.Second)},
+		}},
+	}
+	if err := s.insertWorkload(workload); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+	if err := s.RecordProbeResults("w1", []models.ProbeResult{{Probe: models.ProbeLiveness, Success: true, CheckedAt: time.Now().UTC()}}); err != nil {
+		t.Fatalf("RecordProbeResults: %v", err)
+	}
+	stored, err := s.GetWorkloadByID("w1")
+	if err != nil {
+		t.Fatalf("GetWorkloadByID: %v", err)
+	}
+	if stored.StatusInfo.Health.Restarts != 0 {
+		t.Fatalf("expected restarts to reset after %s of passing, got %d", livenessRestartResetAfter, stored.StatusInfo.Health.Restarts)
+	}
+}
@@ -0,0 +1,198 @@
+package scheduler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+const (
+	defaultHostPortRangeStart = 30000
+	defaultHostPortRangeEnd   = 32767
+)
+
+// hostPortError rejects a node whose host ports cannot satisfy a workload.
+// It counts as insufficient capacity, so a reservation that loses a race for
+// a port re-runs placement.
+type hostPortError struct {
+	reason string
+}
+
+func (e *hostPortError) Error() string { return e.reason }
+func (e *hostPortError) Unwrap() error { return errInsufficientCapacity }
+
+// hostPortKey identifies a host port in the allocation ledger, e.g. "8080/tcp".
+func hostPortKey(port int32, protocol string) string {
+	return strconv.Itoa(int(port)) + "/" + protocol
+}
+
+// workloadPorts returns the port mappings to apply: the resolved HostPorts
+// once the workload is placed, Ports before that.
+func workloadPorts(w models.Workload) []string {
+	if len(w.HostPorts) > 0 && len(w.HostPorts) == len(w.Ports) {
+		return w.HostPorts
+	}
+	return w.Ports
+}
+
+// validateWorkloadPorts checks port mappings when a workload is created or
+// updated. Host port 0 asks the scheduler to pick one.
+func validateWorkloadPorts(ports []string) error {
+	seen := map[string]bool{}
+	for _, raw := range ports {
+		mapping, err := parsePortMapping(raw)
+		if err != nil {
+			return err
+		}
+		if mapping.GetHostPort() < 0 || mapping.GetHostPort() > 65535 {
+			return fmt.Errorf("invalid host port in %q: must be between 0 and 65535", raw)
+		}
+		if mapping.GetContainerPort() < 1 || mapping.GetContainerPort() > 65535 {
+			return fmt.Errorf("invalid container port in %q: must be between 1 and 65535", raw)
+		}
+		switch mapping.GetProtocol() {
+		case "tcp", "udp":
+		default:
+			return fmt.Errorf("unsupported protocol in %q", raw)
+		}
+		if mapping.GetHostPort() == 0 {
+			continue
+		}
+		key := hostPortKey(mapping.GetHostPort(), mapping.GetProtocol())
+		if seen[key] {
+			return fmt.Errorf("host port %s is mapped twice", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func (s *Scheduler) hostPortRange() (int, int) {
+	if s.cfg != nil && s.cfg.SchedulerHostPortRangeStart > 0 && s.cfg.SchedulerHostPortRangeEnd >= s.cfg.SchedulerHostPortRangeStart {
+		return s.cfg.SchedulerHostPortRangeStart, s.cfg.SchedulerHostPortRangeEnd
+	}
+	return defaultHostPortRangeStart, defaultHostPortRangeEnd
+}
+
+// resolveHostPorts fills in the host ports of w for a node whose ports are
+// taken as given (ledger key -> workload ID). Fixed host ports must be free.
+// Host port 0 keeps the port the workload had before when it is still free
+// and in range, and otherwise gets the lowest free port in [start, end].
+// It returns the resolved mappings, in the order of w.Ports, and their
+// sorted ledger keys.
+func resolveHostPorts(w models.Workload, taken map[string]string, start, end int) ([]string, []string, error) {
+	if len(w.Ports) == 0 {
+		return nil, nil, nil
+	}
+	used := map[string]bool{}
+	free := func(key string) bool {
+		if used[key] {
+			return false
+		}
+		owner, ok := taken[key]
+		return !ok || owner == w.ID
+	}
+
+	resolved := make([]string, 0, len(w.Ports))
+	keys := make([]string, 0, len(w.Ports))
+	for i, raw := range w.Ports {
+		mapping, err := parsePortMapping(raw)
+		if err != nil {
+			return nil, nil, err
+		}
+		protocol := mapping.GetProtocol()
+		hostPort := mapping.GetHostPort()
+		if hostPort != 0 {
+			key := hostPortKey(hostPort, protocol)
+			if !free(key) {
+				return nil, nil, &hostPortError{reason: fmt.Sprintf("host_port_conflict port=%s workload=%s", key, taken[key])}
+			}
+		} else {
+			if i < len(w.HostPorts) {
+				if prev, err := parsePortMapping(w.HostPorts[i]); err == nil &&
+					prev.GetContainerPort() == mapping.GetContainerPort() && prev.GetProtocol() == protocol &&
+					int(prev.GetHostPort()) >= start && int(prev.GetHostPort()) <= end &&
+					free(hostPortKey(prev.GetHostPort(), protocol)) {
+					hostPort = prev.GetHostPort()
+				}
+			}
+			for port := start; hostPort == 0 && port <= end; port++ {
+				if free(hostPortKey(int32(port), protocol)) {
+					hostPort = int32(port)
+				}
+			}
+			if hostPort == 0 {
+				return nil, nil, &hostPortError{reason: fmt.Sprintf("host_port_range_exhausted range=%d-%d protocol=%s", start, end, protocol)}
+			}
+		}
+		key := hostPortKey(hostPort, protocol)
+		used[key] = true
+		keys = append(keys, key)
+		resolved = append(resolved, fmt.Sprintf("%d:%d/%s", hostPort, mapping.GetContainerPort(), protocol))
+	}
+	sort.Strings(keys)
+	return resolved, keys, nil
+}
+
+// allocatedHostPortKeys returns the ledger keys of the host ports a workload
+// holds: its resolved ports when placed, otherwise its fixed ones.
+func allocatedHostPortKeys(w models.Workload) []string {
+	var keys []string
+	for _, raw := range workloadPorts(w) {
+		mapping, err := parsePortMapping(raw)
+		if err != nil || mapping.GetHostPort() == 0 {
+			continue
+		}
+		keys = append(keys, hostPortKey(mapping.GetHostPort(), mapping.GetProtocol()))
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func filterHostPorts(pc *placementContext, node models.Node) (bool, string) {
+	if len(pc.workload.Ports) == 0 {
+		return true, ""
+	}
+	taken := pc.allocations[node.NodeID].HostPorts
+	if _, _, err := resolveHostPorts(pc.workload, taken, pc.hostPortStart, pc.hostPortEnd); err != nil {
+		return false, err.Error()
+	}
+	return true, ""
+}
+
+// reserveHostPorts re-resolves the host ports of a placed workload whose port
+// mappings changed, updating its reservation on the node it runs on.
+func (s *Scheduler) reserveHostPorts(w *models.Workload) error {
+	if strings.TrimSpace(w.NodeID) == "" {
+		return nil
+	}
+	node, err := s.GetNodeByID(w.NodeID)
+	if err != nil {
+		return err
+	}
+	hostPorts, err := s.reserveAllocation(node, *w)
+	if err != nil {
+		return err
+	}
+	w.HostPorts = hostPorts
+	return nil
+}
+
+// PlacedPorts returns the port mappings of a placed workload as applied on
+// its node, or nil when it is not placed.
+func PlacedPorts(w models.Workload) []*agentpb.PortMapping {
+	if strings.TrimSpace(w.NodeID) == "" {
+		return nil
+	}
+	out := make([]*agentpb.PortMapping, 0, len(w.Ports))
+	for _, raw := range workloadPorts(w) {
+		if mapping, err := parsePortMapping(raw); err == nil {
+			out = append(out, mapping)
+		}
+	}
+	return out
+}
@@ -0,0 +1,551 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	metricspkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/metrics"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var informerLogger = logging.C("scheduler.informer")
+
+// informerPrefixes are the etcd prefixes mirrored by the state informer.
+var informerPrefixes = []string{nodesPrefix, workloadsPrefix, workloadStatusPrefix}
+
+var errInformerCompacted = errors.New("informer watch revision was compacted")
+
+const (
+	informerMinBackoff = time.Second
+	informerMaxBackoff = 30 * time.Second
+)
+
+// stateInformer mirrors nodes and workloads in memory. It lists the mirrored
+// prefixes at one revision, then follows them with watches from the next
+// revision, so reads never have to scan etcd. A compacted or broken watch
+// marks it unsynced until a fresh list completes.
+//
+// Every key remembers the revision it was last changed at, and deleted keys
+// keep a tombstone until their watch has moved past it, so writes applied
+// through the etcd helpers and the same changes arriving later on the watch
+// converge no matter which comes first.
+type stateInformer struct {
+	mu       sync.RWMutex
+	synced   bool
+	revision int64 // revision of the last full list
+
+	nodes     map[string]models.Node
+	specs     map[string]workloadSpec
+	statuses  map[string]workloadStatus
+	workloads map[string]models.Workload // merged spec and status, keyed by ID
+	revs      map[string]int64           // key -> mod revision of live keys
+	deleted   map[string]int64           // key -> revision it was deleted at
+
+	byNode   map[string]map[string]struct{} // node ID -> workload IDs
+	byStatus map[string]map[string]struct{} // lower-cased status -> workload IDs
+	byLabel  map[string]map[string]struct{} // "key=value" -> workload IDs
+}
+
+func newStateInformer() *stateInformer {
+	i := &stateInformer{}
+	i.resetLocked(0)
+	return i
+}
+
+func (i *stateInformer) resetLocked(revision int64) {
+	i.revision = revision
+	i.nodes = map[string]models.Node{}
+	i.specs = map[string]workloadSpec{}
+	i.statuses = map[string]workloadStatus{}
+	i.workloads = map[string]models.Workload{}
+	i.revs = map[string]int64{}
+	i.deleted = map[string]int64{}
+	i.byNode = map[string]map[string]struct{}{}
+	i.byStatus = map[string]map[string]struct{}{}
+	i.byLabel = map[string]map[string]struct{}{}
+}
+
+func (i *stateInformer) isSynced() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.synced
+}
+
+func (i *stateInformer) markUnsynced() {
+	i.mu.Lock()
+	i.synced = false
+	i.mu.Unlock()
+}
+
+// runStateInformer keeps the informer in sync until ctx ends, relisting with
+// backoff whenever the watch is compacted or fails.
+func (s *Scheduler) runStateInformer(ctx context.Context) {
+	backoff := informerMinBackoff
+	reason := "initial"
+	for ctx.Err() == nil {
+		metricspkg.IncStateCacheResync(reason)
+		rev, err := s.informer.list(ctx, s.etcdClient)
+		if err == nil {
+			backoff = informerMinBackoff
+			informerLogger.WithField("revision", rev).Debug("state informer synced")
+			err = s.informer.watch(ctx, s.etcdClient, rev)
+		}
+		s.informer.markUnsynced()
+		if ctx.Err() != nil {
+			return
+		}
+		reason = "watch_error"
+		if errors.Is(err, errInformerCompacted) {
+			reason = "compacted"
+		}
+		informerLogger.WithError(err).WithFields(logrus.Fields{
+			"reason":  reason,
+			"backoff": backoff.String(),
+		}).Warn("state informer lost sync; relisting")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > informerMaxBackoff {
+			backoff = informerMaxBackoff
+		}
+	}
+}
+
+// list replaces the informer contents with every mirrored key read at a
+// single revision and returns that revision.
+func (i *stateInformer) list(ctx context.Context, cli *clientv3.Client) (int64, error) {
+	var rev int64
+	lists := make([]*clientv3.GetResponse, 0, len(informerPrefixes))
+	for _, prefix := range informerPrefixes {
+		opts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if rev > 0 {
+			opts = append(opts, clientv3.WithRev(rev))
+		}
+		listCtx, cancel := context.WithTimeout(ctx, etcdTimeout)
+		resp, err := cli.Get(listCtx, prefix, opts...)
+		cancel()
+		if err != nil {
+			return 0, fmt.Errorf("list %s: %w", prefix, err)
+		}
+		if rev == 0 {
+			rev = resp.Header.Revision
+		}
+		lists = append(lists, resp)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.resetLocked(rev)
+	for _, resp := range lists {
+		for _, kv := range resp.Kvs {
+			i.applyLocked(string(kv.Key), kv.Value, kv.ModRevision)
+		}
+	}
+	i.synced = true
+	return rev, nil
+}
+
+// watch follows every mirrored prefix from the revision after rev until one
+// of the watches fails.
+func (i *stateInformer) watch(ctx context.Context, cli *clientv3.Client, rev int64) error {
+	watchCtx, cancel := context.WithCancel(clientv3.WithRequireLeader(ctx))
+	defer cancel()
+	errs := make(chan error, len(informerPrefixes))
+	for _, prefix := range informerPrefixes {
+		ch := cli.Watch(watchCtx, prefix, clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+		go func(prefix string) {
+			errs <- i.follow(watchCtx, prefix, ch)
+		}(prefix)
+	}
+	err := <-errs
+	cancel()
+	for n := 1; n < len(informerPrefixes); n++ {
+		<-errs
+	}
+	return err
+}
+
+func (i *stateInformer) follow(ctx context.Context, prefix string, ch clientv3.WatchChan) error {
+	for resp := range ch {
+		if resp.CompactRevision != 0 {
+			return fmt.Errorf("%w: %s oldest revision is %d", errInformerCompacted, prefix, resp.CompactRevision)
+		}
+		if err := resp.Err(); err != nil {
+			return err
+		}
+		if len(resp.Events) == 0 {
+			continue
+		}
+		i.mu.Lock()
+		var last int64
+		for _, ev := range resp.Events {
+			switch ev.Type {
+			case clientv3.EventTypePut:
+				i.applyLocked(string(ev.Kv.Key), ev.Kv.Value, ev.Kv.ModRevision)
+			case clientv3.EventTypeDelete:
+				i.removeLocked(string(ev.Kv.Key), ev.Kv.ModRevision)
+			}
+			last = ev.Kv.ModRevision
+		}
+		i.pruneLocked(prefix, last)
+		i.mu.Unlock()
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("watch on %s closed", prefix)
+}
+
+// apply records a put of key at rev. Writes made through the etcd helpers are
+// applied right away so this replica reads its own writes.
+func (i *stateInformer) apply(key string, value []byte, rev int64) {
+	if i == nil || !isInformerKey(key) {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.applyLocked(key, value, rev)
+}
+
+// remove records a delete of key at rev.
+func (i *stateInformer) remove(key string, rev int64) {
+	if i == nil || !isInformerKey(key) {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.removeLocked(key, rev)
+}
+
+func isInformerKey(key string) bool {
+	for _, prefix := range informerPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// staleLocked reports whether a change at rev is older than what the informer
+// already holds for key.
+func (i *stateInformer) staleLocked(key string, rev int64) bool {
+	if cur, ok := i.revs[key]; ok && rev <= cur {
+		return true
+	}
+	if del, ok := i.deleted[key]; ok && rev <= del {
+		return true
+	}
+	return false
+}
+
+func (i *stateInformer) applyLocked(key string, value []byte, rev int64) {
+	if i.staleLocked(key, rev) {
+		return
+	}
+	switch {
+	case strings.HasPrefix(key, nodesPrefix):
+		if isNodeStatusSubKey(key) {
+			return
+		}
+		var node models.Node
+		if err := json.Unmarshal(value, &node); err != nil {
+			informerLogger.WithError(err).WithField("key", key).Warn("failed to unmarshal node data")
+			return
+		}
+		i.nodes[strings.TrimPrefix(key, nodesPrefix)] = node
+	case strings.HasPrefix(key, workloadsPrefix):
+		id, ok := workloadIDFromKey(workloadsPrefix, key)
+		if !ok {
+			return
+		}
+		var spec workloadSpec
+		if err := json.Unmarshal(value, &spec); err != nil {
+			informerLogger.WithError(err).WithField("key", key).Warn("failed to unmarshal workload spec data")
+			return
+		}
+		i.specs[id] = spec
+		i.refreshWorkloadLocked(id)
+	case strings.HasPrefix(key, workloadStatusPrefix):
+		id, ok := workloadIDFromKey(workloadStatusPrefix, key)
+		if !ok {
+			return
+		}
+		var st workloadStatus
+		if err := json.Unmarshal(value, &st); err != nil {
+			informerLogger.WithError(err).WithField("key", key).Warn("failed to unmarshal workload status data")
+			return
+		}
+		i.statuses[id] = st
+		i.refreshWorkloadLocked(id)
+	default:
+		return
+	}
+	i.revs[key] = rev
+	delete(i.deleted, key)
+}
+
+func (i *stateInformer) removeLocked(key string, rev int64) {
+	if i.staleLocked(key, rev) {
+		return
+	}
+	switch {
+	case strings.HasPrefix(key, nodesPrefix):
+		delete(i.nodes, strings.TrimPrefix(key, nodesPrefix))
+	case strings.HasPrefix(key, workloadsPrefix):
+		if id, ok := workloadIDFromKey(workloadsPrefix, key); ok {
+			delete(i.specs, id)
+			i.refreshWorkloadLocked(id)
+		}
+	case strings.HasPrefix(key, workloadStatusPrefix):
+		if id, ok := workloadIDFromKey(workloadStatusPrefix, key); ok {
+			delete(i.statuses, id)
+			i.refreshWorkloadLocked(id)
+		}
+	}
+	delete(i.revs, key)
+	i.deleted[key] = rev
+}
+
+// pruneLocked drops tombstones under prefix that the watch has moved past; no
+// older change for them can arrive any more.
+func (i *stateInformer) pruneLocked(prefix string, rev int64) {
+	for key, del := range i.deleted {
+		if del <= rev && strings.HasPrefix(key, prefix) {
+			delete(i.deleted, key)
+		}
+	}
+}
+
+// refreshWorkloadLocked rebuilds the merged workload for id and its index
+// entries. A workload exists while its spec does.
+func (i *stateInformer) refreshWorkloadLocked(id string) {
+	if old, ok := i.workloads[id]; ok {
+		i.indexLocked(old, false)
+		delete(i.workloads, id)
+	}
+	spec, ok := i.specs[id]
+	if !ok {
+		return
+	}
+	var st *workloadStatus
+	if v, ok := i.statuses[id]; ok && v.ID != "" {
+		st = &v
+	}
+	w := workloadFromProjections(spec, st)
+	i.workloads[id] = w
+	i.indexLocked(w, true)
+}
+
+func (i *stateInformer) indexLocked(w models.Workload, add bool) {
+	update := func(index map[string]map[string]struct{}, key string) {
+		if add {
+			if index[key] == nil {
+				index[key] = map[string]struct{}{}
+			}
+			index[key][w.ID] = struct{}{}
+			return
+		}
+		delete(index[key], w.ID)
+		if len(index[key]) == 0 {
+			delete(index, key)
+		}
+	}
+	update(i.byNode, w.NodeID)
+	update(i.byStatus, strings.ToLower(strings.TrimSpace(w.Status)))
+	for k, v := range w.Labels {
+		update(i.byLabel, k+"="+v)
+	}
+}
+
+func (i *stateInformer) listNodes() []models.Node {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	out := make([]models.Node, 0, len(i.nodes))
+	for _, node := range i.nodes {
+		out = append(out, cloneNode(node))
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].NodeID < out[b].NodeID })
+	return out
+}
+
+func (i *stateInformer) node(id string) (models.Node, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	node, ok := i.nodes[id]
+	if !ok {
+		return models.Node{}, false
+	}
+	return cloneNode(node), true
+}
+
+func (i *stateInformer) workload(id string) (models.Workload, bool) {
+	if i == nil {
+		return models.Workload{}, false
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	w, ok := i.workloads[id]
+	if !ok {
+		return models.Workload{}, false
+	}
+	return cloneWorkload(w), true
+}
+
+func (i *stateInformer) listWorkloads() []models.Workload {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	ids := make([]string, 0, len(i.workloads))
+	for id := range i.workloads {
+		ids = append(ids, id)
+	}
+	return i.copyWorkloadsLocked(ids)
+}
+
+// workloadsByIndex returns the workloads under every one of the given index
+// entries. The node, status and "key=value" label indexes can be mixed.
+func (i *stateInformer) workloadsByIndex(lookups ...func(*stateInformer) map[string]struct{}) []models.Workload {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	var ids []string
+	for n, lookup := range lookups {
+		set := lookup(i)
+		if n == 0 {
+			for id := range set {
+				ids = append(ids, id)
+			}
+			continue
+		}
+		kept := ids[:0]
+		for _, id := range ids {
+			if _, ok := set[id]; ok {
+				kept = append(kept, id)
+			}
+		}
+		ids = kept
+	}
+	return i.copyWorkloadsLocked(ids)
+}
+
+func byNodeIndex(nodeID string) func(*stateInformer) map[string]struct{} {
+	return func(i *stateInformer) map[string]struct{} { return i.byNode[nodeID] }
+}
+
+func byStatusIndex(status string) func(*stateInformer) map[string]struct{} {
+	status = strings.ToLower(strings.TrimSpace(status))
+	return func(i *stateInformer) map[string]struct{} { return i.byStatus[status] }
+}
+
+func byLabelIndex(key, value string) func(*stateInformer) map[string]struct{} {
+	return func(i *stateInformer) map[string]struct{} { return i.byLabel[key+"="+value] }
+}
+
+func (i *stateInformer) copyWorkloadsLocked(ids []string) []models.Workload {
+	sort.Strings(ids)
+	out := make([]models.Workload, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, cloneWorkload(i.workloads[id]))
+	}
+	return out
+}
+
+// snapshot returns copies of the informer contents keyed by ID, for the
+// degraded-mode cache.
+func (i *stateInformer) snapshot() (map[string]models.Node, map[string]models.Workload, bool) {
+	if i == nil {
+		return nil, nil, false
+	}
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	if i.revision == 0 {
+		return nil, nil, false
+	}
+	nodes := make(map[string]models.Node, len(i.nodes))
+	for _, node := range i.nodes {
+		nodes[node.NodeID] = cloneNode(node)
+	}
+	workloads := make(map[string]models.Workload, len(i.workloads))
+	for id, w := range i.workloads {
+		workloads[id] = cloneWorkload(w)
+	}
+	return nodes, workloads, true
+}
+
+// informerReady reports whether reads can be served from the informer. A
+// frozen control plane keeps the etcd reads with their degraded-cache
+// fallback.
+func (s *Scheduler) informerReady() bool {
+	return s.informer != nil && s.isWritable() && s.informer.isSynced()
+}
+
+// cloneNode copies the maps, slices and pointers of a node so callers can
+// change the copy without touching the informer.
+func cloneNode(n models.Node) models.Node {
+	n.Labels = copyStringMap(n.Labels)
+	n.SupportedWorkloadTypes = append([]string(nil), n.SupportedWorkloadTypes...)
+	n.SupportedStorageDrivers = append([]string(nil), n.SupportedStorageDrivers...)
+	n.StoragePools = append([]models.StoragePool(nil), n.StoragePools...)
+	n.Taints = append([]models.Taint(nil), n.Taints...)
+	n.AgentTaints = append([]models.Taint(nil), n.AgentTaints...)
+	n.OperatorTaints = append([]models.Taint(nil), n.OperatorTaints...)
+	if n.Drain != nil {
+		drain := *n.Drain
+		n.Drain = &drain
+	}
+	return n
+}
+
+// cloneWorkload copies the maps, slices and pointers of a workload so callers
+// can change the copy without touching the informer.
+func cloneWorkload(w models.Workload) models.Workload {
+	w.CommandList = append([]string(nil), w.CommandList...)
+	w.EnvVars = copyStringMap(w.EnvVars)
+	w.Labels = copyStringMap(w.Labels)
+	w.Ports = append([]string(nil), w.Ports...)
+	w.HostPorts = append([]string(nil), w.HostPorts...)
+	w.Volumes = append([]string(nil), w.Volumes...)
+	w.ManagedVolumes = append([]models.ManagedVolumeSpec(nil), w.ManagedVolumes...)
+	w.Tolerations = append([]models.Toleration(nil), w.Tolerations...)
+	w.EnvFrom = append([]models.EnvFromSource(nil), w.EnvFrom...)
+	w.Files = append([]models.FileMount(nil), w.Files...)
+	if w.Metadata != nil {
+		metadata := make(map[string]interface{}, len(w.Metadata))
+		for k, v := range w.Metadata {
+			metadata[k] = v
+		}
+		w.Metadata = metadata
+	}
+	w.Usage = clonePtr(w.Usage)
+	w.VM = clonePtr(w.VM)
+	w.Placement = clonePtr(w.Placement)
+	w.Rollout = clonePtr(w.Rollout)
+	w.LivenessProbe = clonePtr(w.LivenessProbe)
+	w.ReadinessProbe = clonePtr(w.ReadinessProbe)
+	w.StatusInfo.Reason = clonePtr(w.StatusInfo.Reason)
+	w.StatusInfo.Rollout = clonePtr(w.StatusInfo.Rollout)
+	if w.StatusInfo.Health != nil {
+		health := *w.StatusInfo.Health
+		health.Liveness = clonePtr(health.Liveness)
+		health.Readiness = clonePtr(health.Readiness)
+		w.StatusInfo.Health = &health
+	}
+	return w
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
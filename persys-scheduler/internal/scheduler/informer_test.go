@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestStateInformerOrdering(t *testing.T) {
+	spec := func(name string) []byte {
+		b, _ := json.Marshal(workloadSpecFromWorkload(models.Workload{ID: "w1", Name: name}))
+		return b
+	}
+	status := func(nodeID string) []byte {
+		b, _ := json.Marshal(workloadStatusFromWorkload(models.Workload{ID: "w1", NodeID: nodeID, Status: "Running"}))
+		return b
+	}
+	type step struct {
+		remove bool
+		key    string
+		value  []byte
+		rev    int64
+	}
+	tests := []struct {
+		name     string
+		steps    []step
+		wantName string // empty when the workload must be gone
+		wantNode string
+	}{
+		{
+			name: "spec and status merge",
+			steps: []step{
+				{key: workloadSpecKey("default", "w1"), value: spec("api"), rev: 1},
+				{key: workloadStatusKey("default", "w1"), value: status("n1"), rev: 2},
+			},
+			wantName: "api",
+			wantNode: "n1",
+		},
+		{
+			name: "an older revision does not overwrite a newer one",
+			steps: []step{
+				{key: workloadSpecKey("default", "w1"), value: spec("new"), rev: 5},
+				{key: workloadSpecKey("default", "w1"), value: spec("old"), rev: 4},
+			},
+			wantName: "new",
+		},
+		{
+			name: "a put older than the delete is dropped",
+			steps: []step{
+				{key: workloadSpecKey("default", "w1"), value: spec("api"), rev: 3},
+				{remove: true, key: workloadSpecKey("default", "w1"), rev: 6},
+				{key: workloadSpecKey("default", "w1"), value: spec("late"), rev: 5},
+			},
+		},
+		{
+			name: "a newer put recreates the workload",
+			steps: []step{
+				{remove: true, key: workloadSpecKey("default", "w1"), rev: 6},
+				{key: workloadSpecKey("default", "w1"), value: spec("again"), rev: 7},
+			},
+			wantName: "again",
+		},
+		{
+			name: "status alone is not a workload",
+			steps: []step{
+				{key: workloadStatusKey("default", "w1"), value: status("n1"), rev: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			inf := newStateInformer()
+			for _, st := range tt.steps {
+				if st.remove {
+					inf.remove(st.key, st.rev)
+					continue
+				}
+				inf.apply(st.key, st.value, st.rev)
+			}
+			w, ok := inf.workload("w1")
+			if tt.wantName == "" {
+				if ok {
+					t.Fatalf("expected no workload, got %#v", w)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("expected workload w1")
+			}
+			if w.Name != tt.wantName || w.NodeID != tt.wantNode {
+				t.Fatalf("expected name=%s node=%s, got name=%s node=%s", tt.wantName, tt.wantNode, w.Name, w.NodeID)
+			}
+		})
+	}
+}
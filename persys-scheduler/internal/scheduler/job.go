@@ -0,0 +1,515 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	jobIDMetadataKey      = "job_id"
+	jobIndexMetadataKey   = "job_index"
+	jobOutcomeMetadataKey = "job_outcome"
+	exitCodeMetadataKey   = "exit_code"
+
+	jobRetryBackoffBase = 10 * time.Second
+	jobRetryBackoffMax  = 6 * time.Minute
+)
+
+var jobLogger = logging.C("scheduler.job")
+
+// jobChildID is the workload ID of a job run. User IDs cannot contain '.',
+// and the "run-" prefix keeps it apart from replicaSetChildID.
+func jobChildID(jobID string, index int) string {
+	return fmt.Sprintf("%s.run-%d", jobID, index)
+}
+
+// jobIDOf returns the job that owns w, if any.
+func jobIDOf(w models.Workload) string {
+	id, _ := metadataString(w.Metadata, jobIDMetadataKey)
+	return strings.TrimSpace(id)
+}
+
+// jobChildOutcome returns Succeeded or Failed once a job run has finished.
+func jobChildOutcome(w models.Workload) string {
+	outcome, _ := metadataString(w.Metadata, jobOutcomeMetadataKey)
+	return outcome
+}
+
+// jobChildFinished reports whether w is a job run that has exited. Finished
+// runs keep their record for logs but hold no resources and are left alone
+// by reconciliation and status sync.
+func jobChildFinished(w models.Workload) bool {
+	return jobIDOf(w) != "" && jobChildOutcome(w) != ""
+}
+
+func normalizeJob(job *models.Job) error {
+	job.ID = strings.TrimSpace(job.ID)
+	if job.ID == "" {
+		job.ID = uuid.NewString()
+	}
+	switch canonicalWorkloadType(job.Template.Type) {
+	case "container", "compose":
+	case "":
+		return fmt.Errorf("job template type is required")
+	default:
+		return fmt.Errorf("job template type %q cannot run to completion", job.Template.Type)
+	}
+	if job.Completions == 0 {
+		job.Completions = 1
+	}
+	if job.Parallelism == 0 {
+		job.Parallelism = 1
+	}
+	if job.Completions < 0 || job.Parallelism < 0 || job.BackoffLimit < 0 || job.ActiveDeadlineSeconds < 0 || job.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("job %s: completions, parallelism, backoff limit, deadline and ttl must not be negative", job.ID)
+	}
+	job.Template.ID = ""
+	job.Template.RevisionID = ""
+	job.Template.DesiredState = ""
+	return nil
+}
+
+// ApplyJob creates a job. Jobs are immutable once created; delete and
+// re-create one to change it.
+func (s *Scheduler) ApplyJob(job models.Job) (models.Job, error) {
+	if err := normalizeJob(&job); err != nil {
+		return models.Job{}, err
+	}
+	if err := validateObjectID("job", job.ID); err != nil {
+		return models.Job{}, err
+	}
+	return s.createJob(job)
+}
+
+// createJob creates a normalized job whose ID is already set, such as a
+// cron job run.
+func (s *Scheduler) createJob(job models.Job) (models.Job, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Job{}, err
+	}
+	if _, err := s.workloadRefsRevision(job.Template); err != nil {
+		return models.Job{}, fmt.Errorf("job %s: %w", job.ID, err)
+	}
+	if _, found, err := s.getJobRecord(job.ID); err != nil {
+		return models.Job{}, err
+	} else if found {
+		return models.Job{}, fmt.Errorf("job %s already exists", job.ID)
+	}
+	now := time.Now().UTC()
+	job.DesiredState = ""
+	job.CreatedAt = now
+	job.UpdatedAt = now
+	job.Status = models.JobStatus{Phase: models.JobPending}
+	if err := s.saveJobRecord(job); err != nil {
+		return models.Job{}, err
+	}
+	s.emitEvent("JobCreated", "", "", "Job created", map[string]interface{}{
+		"job_id":      job.ID,
+		"completions": job.Completions,
+		"parallelism": job.Parallelism,
+		"cronjob_id":  job.CronJobID,
+	})
+	return job, nil
+}
+
+// DeleteJob marks a job for deletion. Its runs are deleted by the
+// reconciliation loop and the record goes once they are gone.
+func (s *Scheduler) DeleteJob(jobID string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	job, err := s.GetJob(jobID)
+	if err != nil {
+		return err
+	}
+	if job.DesiredState == "Deleted" {
+		return nil
+	}
+	job.DesiredState = "Deleted"
+	job.UpdatedAt = time.Now().UTC()
+	if err := s.saveJobRecord(job); err != nil {
+		return err
+	}
+	s.emitEvent("JobUpdated", "", "", "Marked for deletion", map[string]interface{}{"job_id": job.ID})
+	return nil
+}
+
+// GetJob retrieves a job by ID.
+func (s *Scheduler) GetJob(jobID string) (models.Job, error) {
+	job, found, err := s.getJobRecord(jobID)
+	if err != nil {
+		return models.Job{}, err
+	}
+	if !found {
+		return models.Job{}, fmt.Errorf("job %s not found", jobID)
+	}
+	return job, nil
+}
+
+// ListJobs returns all jobs.
+func (s *Scheduler) ListJobs() ([]models.Job, error) {
+	resp, err := s.RetryableEtcdGet(jobsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get jobs: %v", err)
+	}
+	out := make([]models.Job, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var job models.Job
+		if err := json.Unmarshal(kv.Value, &job); err != nil {
+			jobLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal job")
+			continue
+		}
+		out = append(out, job)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) getJobRecord(jobID string) (models.Job, bool, error) {
+	resp, err := s.RetryableEtcdGet(jobKey(jobID))
+	if err != nil {
+		return models.Job{}, false, fmt.Errorf("failed to get job %s: %v", jobID, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.Job{}, false, nil
+	}
+	var job models.Job
+	if err := json.Unmarshal(resp.Kvs[0].Value, &job); err != nil {
+		return models.Job{}, false, fmt.Errorf("failed to unmarshal job %s: %v", jobID, err)
+	}
+	return job, true, nil
+}
+
+func (s *Scheduler) saveJobRecord(job models.Job) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("marshal job %s: %w", job.ID, err)
+	}
+	return s.RetryableEtcdPut(jobKey(job.ID), string(payload))
+}
+
+// jobChild builds the workload for run index of job. The agent is told not
+// to restart it unless the template asks for on-failure restarts; retries
+// beyond that are new runs counted against the backoff limit.
+func jobChild(job models.Job, index int) models.Workload {
+	child := job.Template
+	child.ID = jobChildID(job.ID, index)
+	base := strings.TrimSpace(job.Template.Name)
+	if base == "" {
+		base = job.ID
+	}
+	child.Name = fmt.Sprintf("%s-%d", base, index)
+	child.DesiredState = "Running"
+	if !strings.EqualFold(strings.TrimSpace(child.RestartPolicy), "on-failure") {
+		child.RestartPolicy = "no"
+	}
+	child.EnvVars = copyStringMap(job.Template.EnvVars)
+	child.Labels = copyStringMap(job.Template.Labels)
+	child.Metadata = map[string]interface{}{}
+	for k, v := range job.Template.Metadata {
+		child.Metadata[k] = v
+	}
+	child.Metadata[jobIDMetadataKey] = job.ID
+	child.Metadata[jobIndexMetadataKey] = index
+	return child
+}
+
+// RecordWorkloadExit notes the exit code an agent reported for a workload
+// whose process ended on its own. For a job run this finishes the run.
+func (s *Scheduler) RecordWorkloadExit(workloadID string, exitCode int) error {
+	if err := s.UpdateWorkloadMetadata(workloadID, map[string]string{exitCodeMetadataKey: strconv.Itoa(exitCode)}); err != nil {
+		return err
+	}
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return err
+	}
+	if jobIDOf(workload) == "" || jobChildFinished(workload) {
+		return nil
+	}
+	if exitCode == 0 {
+		return s.finishJobChild(workload, models.JobSucceeded, "exited with code 0")
+	}
+	return s.finishJobChild(workload, models.JobFailed, fmt.Sprintf("exited with code %d", exitCode))
+}
+
+// completeJobChildFromState finishes a job run from the state the agent
+// reported when no exit code came with it: stopped counts as success and
+// failed as failure. It reports whether the run was finished.
+func (s *Scheduler) completeJobChildFromState(workload models.Workload, actualState string) bool {
+	if jobIDOf(workload) == "" {
+		return false
+	}
+	// The status read may have just recorded an exit; use the stored record.
+	workload, err := s.GetWorkloadByID(workload.ID)
+	if err != nil || jobChildFinished(workload) {
+		return err == nil
+	}
+	if code, ok := metadataInt(workload.Metadata, exitCodeMetadataKey); ok {
+		if code == 0 {
+			return s.finishJobChild(workload, models.JobSucceeded, "exited with code 0") == nil
+		}
+		return s.finishJobChild(workload, models.JobFailed, fmt.Sprintf("exited with code %d", code)) == nil
+	}
+	switch {
+	case strings.EqualFold(actualState, "Stopped"):
+		return s.finishJobChild(workload, models.JobSucceeded, "stopped") == nil
+	case strings.EqualFold(actualState, "Failed"):
+		reason := strings.TrimSpace(runtimeFailureReason(workload.Metadata))
+		if reason == "" {
+			reason = "failed"
+		}
+		return s.finishJobChild(workload, models.JobFailed, reason) == nil
+	default:
+		return false
+	}
+}
+
+func (s *Scheduler) finishJobChild(workload models.Workload, outcome, reason string) error {
+	if workload.Metadata == nil {
+		workload.Metadata = map[string]interface{}{}
+	}
+	now := time.Now().UTC()
+	workload.Metadata[jobOutcomeMetadataKey] = outcome
+	workload.Metadata["last_action"] = "Completed"
+	workload.DesiredState = "Stopped"
+	workload.Status = outcome
+	workload.StatusInfo.ActualState = outcome
+	workload.StatusInfo.LastUpdated = now
+	if outcome == models.JobFailed {
+		workload.StatusInfo.FailureReason = reason
+	}
+	if err := s.saveWorkload(workload); err != nil {
+		return fmt.Errorf("failed to record completion of workload %s: %w", workload.ID, err)
+	}
+	if err := s.releaseAllocation(workload.NodeID, workload.ID); err != nil {
+		jobLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to release finished job run allocation")
+	}
+	s.activatePendingQueue("job run finished")
+	eventType := "WorkloadSucceeded"
+	if outcome == models.JobFailed {
+		eventType = "WorkloadFailed"
+	}
+	s.emitEvent(eventType, workload.ID, workload.NodeID, reason, map[string]interface{}{"job_id": jobIDOf(workload)})
+	jobLogger.WithFields(logrus.Fields{
+		"job_id":      jobIDOf(workload),
+		"workload_id": workload.ID,
+		"outcome":     outcome,
+		"reason":      reason,
+	}).Info("job run finished")
+	return nil
+}
+
+// jobRetryBackoff is how long to wait after the latest failed run before
+// starting another, doubling from 10s up to 6m.
+func jobRetryBackoff(failures int) time.Duration {
+	if failures <= 0 {
+		return 0
+	}
+	backoff := jobRetryBackoffBase
+	for i := 1; i < failures && backoff < jobRetryBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > jobRetryBackoffMax {
+		return jobRetryBackoffMax
+	}
+	return backoff
+}
+
+// ReconcileJobs starts, counts and cleans up the runs of every job.
+func (s *Scheduler) ReconcileJobs() error {
+	if !s.isWritable() {
+		return errControlPlaneFrozen
+	}
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return err
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	children := make(map[string][]models.Workload)
+	for _, w := range workloads {
+		if id := jobIDOf(w); id != "" {
+			children[id] = append(children[id], w)
+		}
+	}
+	for _, job := range jobs {
+		if err := s.reconcileJob(job, children[job.ID]); err != nil {
+			jobLogger.WithError(err).WithField("job_id", job.ID).Warn("failed to reconcile job")
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcileJob(job models.Job, children []models.Workload) error {
+	sort.Slice(children, func(i, j int) bool {
+		ii, _ := metadataInt(children[i].Metadata, jobIndexMetadataKey)
+		ij, _ := metadataInt(children[j].Metadata, jobIndexMetadataKey)
+		return ii < ij
+	})
+	now := time.Now().UTC()
+
+	if job.DesiredState == "Deleted" {
+		for _, child := range children {
+			if strings.EqualFold(child.DesiredState, "Deleted") {
+				continue
+			}
+			if err := s.MarkWorkloadDeleted(child.ID); err != nil {
+				return fmt.Errorf("delete run %s: %w", child.ID, err)
+			}
+		}
+		if len(children) > 0 {
+			return nil
+		}
+		if err := s.RetryableEtcdDelete(jobKey(job.ID)); err != nil {
+			return err
+		}
+		s.emitEvent("JobDeleted", "", "", "All runs removed", map[string]interface{}{"job_id": job.ID})
+		return nil
+	}
+
+	status := models.JobStatus{
+		Phase:       job.Status.Phase,
+		Reason:      job.Status.Reason,
+		StartedAt:   job.Status.StartedAt,
+		CompletedAt: job.Status.CompletedAt,
+	}
+	nextIndex := 0
+	var active []models.Workload
+	var lastFailure time.Time
+	for _, child := range children {
+		if index, ok := metadataInt(child.Metadata, jobIndexMetadataKey); ok && index >= nextIndex {
+			nextIndex = index + 1
+		}
+		if strings.EqualFold(child.DesiredState, "Deleted") {
+			continue
+		}
+		status.WorkloadIDs = append(status.WorkloadIDs, child.ID)
+		switch jobChildOutcome(child) {
+		case models.JobSucceeded:
+			status.Succeeded++
+		case models.JobFailed:
+			status.Failed++
+			if child.StatusInfo.LastUpdated.After(lastFailure) {
+				lastFailure = child.StatusInfo.LastUpdated
+			}
+		default:
+			active = append(active, child)
+		}
+	}
+
+	finished := status.Phase == models.JobSucceeded || status.Phase == models.JobFailed
+	if !finished {
+		failReason := ""
+		switch {
+		case status.Succeeded >= job.Completions:
+			status.Phase = models.JobSucceeded
+			status.Reason = fmt.Sprintf("%d of %d runs succeeded", status.Succeeded, job.Completions)
+		case status.Failed > job.BackoffLimit:
+			failReason = fmt.Sprintf("BackoffLimitExceeded: %d runs failed (limit %d)", status.Failed, job.BackoffLimit)
+		case job.ActiveDeadlineSeconds > 0 && !status.StartedAt.IsZero() && now.Sub(status.StartedAt) > time.Duration(job.ActiveDeadlineSeconds)*time.Second:
+			failReason = fmt.Sprintf("DeadlineExceeded: job ran longer than %ds", job.ActiveDeadlineSeconds)
+		}
+		if failReason != "" {
+			status.Phase = models.JobFailed
+			status.Reason = failReason
+		}
+		if status.Phase == models.JobSucceeded || status.Phase == models.JobFailed {
+			status.CompletedAt = now
+			// Runs still going when the job ends are not needed any more.
+			for _, child := range active {
+				if err := s.MarkWorkloadDeleted(child.ID); err != nil {
+					return fmt.Errorf("stop run %s: %w", child.ID, err)
+				}
+			}
+			active = nil
+			eventType := "JobSucceeded"
+			if status.Phase == models.JobFailed {
+				eventType = "JobFailed"
+			}
+			s.emitEvent(eventType, "", "", status.Reason, map[string]interface{}{
+				"job_id":     job.ID,
+				"succeeded":  status.Succeeded,
+				"failed":     status.Failed,
+				"cronjob_id": job.CronJobID,
+			})
+			jobLogger.WithFields(logrus.Fields{
+				"job_id": job.ID,
+				"phase":  status.Phase,
+				"reason": status.Reason,
+			}).Info("job finished")
+		}
+	}
+
+	if status.Phase != models.JobSucceeded && status.Phase != models.JobFailed {
+		want := job.Parallelism
+		if remaining := job.Completions - status.Succeeded; remaining < want {
+			want = remaining
+		}
+		waitingOnBackoff := status.Failed > 0 && now.Before(lastFailure.Add(jobRetryBackoff(status.Failed)))
+		for len(active) < want && !waitingOnBackoff {
+			child, err := s.createWorkload(jobChild(job, nextIndex))
+			if err != nil {
+				return fmt.Errorf("start run %s: %w", jobChildID(job.ID, nextIndex), err)
+			}
+			jobLogger.WithFields(logrus.Fields{
+				"job_id":      job.ID,
+				"workload_id": child.ID,
+			}).Info("started job run")
+			nextIndex++
+			active = append(active, child)
+			status.WorkloadIDs = append(status.WorkloadIDs, child.ID)
+			if status.StartedAt.IsZero() {
+				status.StartedAt = now
+			}
+		}
+		if len(active) > 0 {
+			status.Phase = models.JobRunning
+		}
+	}
+	status.Active = len(active)
+
+	if status.Phase == models.JobSucceeded || status.Phase == models.JobFailed {
+		if job.TTLSecondsAfterFinished > 0 && now.Sub(status.CompletedAt) > time.Duration(job.TTLSecondsAfterFinished)*time.Second {
+			return s.DeleteJob(job.ID)
+		}
+	}
+
+	if jobStatusEqual(status, job.Status) {
+		return nil
+	}
+	status.LastReconciledAt = now
+
+	// Re-read so a delete that landed during this pass is not lost.
+	current, found, err := s.getJobRecord(job.ID)
+	if err != nil || !found {
+		return err
+	}
+	current.Status = status
+	return s.saveJobRecord(current)
+}
+
+func jobStatusEqual(a, b models.JobStatus) bool {
+	return a.Phase == b.Phase &&
+		a.Active == b.Active &&
+		a.Succeeded == b.Succeeded &&
+		a.Failed == b.Failed &&
+		a.Reason == b.Reason &&
+		a.StartedAt.Equal(b.StartedAt) &&
+		a.CompletedAt.Equal(b.CompletedAt) &&
+		strings.Join(a.WorkloadIDs, ",") == strings.Join(b.WorkloadIDs, ",")
+}
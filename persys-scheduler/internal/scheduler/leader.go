@@ -0,0 +1,237 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	metricspkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/metrics"
+	"github.com/sirupsen/logrus"
+	"go.etcd.io/etcd/client/v3/concurrency"
+)
+
+var leaderLogger = logging.C("scheduler.leader")
+
+const leaderElectionRetryInterval = 2 * time.Second
+
+// ErrNotLeader is returned for work only the leader may do, such as restoring
+// state or holding agent control streams.
+var ErrNotLeader = errors.New("scheduler instance is not the leader")
+
+// LeaderInfo identifies the scheduler instance holding the leader lease.
+type LeaderInfo struct {
+	InstanceID string    `json:"instanceId"`
+	Address    string    `json:"address"`
+	ElectedAt  time.Time `json:"electedAt"`
+}
+
+// LeaderSnapshot reports whether this instance leads and who the current
+// leader is, as last observed. The leader is empty until one is observed.
+func (s *Scheduler) LeaderSnapshot() (bool, LeaderInfo) {
+	s.leaderMu.RLock()
+	defer s.leaderMu.RUnlock()
+	return s.leading, s.leader
+}
+
+// IsLeader reports whether this instance runs the leader-only loops.
+func (s *Scheduler) IsLeader() bool {
+	leading, _ := s.LeaderSnapshot()
+	return leading
+}
+
+// RequireLeader returns ErrNotLeader, naming the observed leader, unless this
+// instance leads.
+func (s *Scheduler) RequireLeader() error {
+	leading, leader := s.LeaderSnapshot()
+	if leading {
+		return nil
+	}
+	if leader.Address != "" {
+		return fmt.Errorf("%w; the leader is %s at %s", ErrNotLeader, leader.InstanceID, leader.Address)
+	}
+	return ErrNotLeader
+}
+
+func (s *Scheduler) setLeading(leading bool) {
+	s.leaderMu.Lock()
+	s.leading = leading
+	s.leaderMu.Unlock()
+	metricspkg.SetLeader(leading)
+}
+
+func (s *Scheduler) setObservedLeader(leader LeaderInfo) {
+	s.leaderMu.Lock()
+	s.leader = leader
+	s.leaderMu.Unlock()
+}
+
+// StartLeaderElection runs the background loops that must not run on more
+// than one replica (node and workload monitoring, drift detection and
+// reconciliation) only while this instance holds the etcd leader lease. With
+// election disabled the loops start right away.
+func (s *Scheduler) StartLeaderElection(ctx context.Context, grpcPort int) {
+	ip, port, err := s.advertiseAddress(grpcPort)
+	if err != nil {
+		leaderLogger.WithError(err).Warn("failed to resolve advertise address; leader will be advertised without one")
+	}
+	self := LeaderInfo{InstanceID: s.cfg.SchedulerInstanceID}
+	if ip != "" {
+		self.Address = net.JoinHostPort(ip, strconv.Itoa(port))
+	}
+
+	if !s.cfg.SchedulerLeaderElectionEnabled {
+		self.ElectedAt = time.Now().UTC()
+		s.setObservedLeader(self)
+		s.setLeading(true)
+		leaderLogger.Info("leader election disabled; running leader loops on this instance")
+		s.startLeaderLoops(ctx, &s.bgWG)
+		return
+	}
+
+	s.bgWG.Add(1)
+	go func() {
+		defer s.bgWG.Done()
+		for ctx.Err() == nil {
+			if err := s.campaign(ctx, self, ip, port); err != nil && ctx.Err() == nil {
+				leaderLogger.WithError(err).Warn("leader election failed; retrying")
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(leaderElectionRetryInterval):
+			}
+		}
+	}()
+}
+
+// campaign runs one election term: it waits to become leader, runs the
+// leader loops until the lease is lost or ctx ends, then steps down.
+func (s *Scheduler) campaign(ctx context.Context, self LeaderInfo, ip string, port int) error {
+	ttl := int(s.cfg.SchedulerLeaderLeaseTTL / time.Second)
+	// The session keeps its own context so Close can still revoke the lease
+	// on shutdown, which lets another replica take over immediately.
+	session, err := concurrency.NewSession(s.etcdClient, concurrency.WithTTL(ttl))
+	if err != nil {
+		return fmt.Errorf("create election session: %w", err)
+	}
+	defer session.Close()
+
+	termCtx, cancelTerm := context.WithCancel(ctx)
+	defer cancelTerm()
+	go func() {
+		select {
+		case <-session.Done():
+			cancelTerm()
+		case <-termCtx.Done():
+		}
+	}()
+
+	election := concurrency.NewElection(session, leaderElectionPrefix)
+	go s.observeLeader(termCtx, election)
+
+	self.ElectedAt = time.Now().UTC()
+	payload, err := json.Marshal(self)
+	if err != nil {
+		return fmt.Errorf("marshal leader info: %w", err)
+	}
+	leaderLogger.WithField("instance_id", self.InstanceID).Info("campaigning for scheduler leadership")
+	if err := election.Campaign(termCtx, string(payload)); err != nil {
+		if termCtx.Err() != nil && ctx.Err() == nil {
+			return fmt.Errorf("election session expired while campaigning")
+		}
+		return err
+	}
+
+	// Campaign only returns once every earlier candidate is gone, so the
+	// elected time is when leadership was actually acquired.
+	self.ElectedAt = time.Now().UTC()
+	s.setObservedLeader(self)
+	s.setLeading(true)
+	leaderLogger.WithFields(logrus.Fields{
+		"instance_id": self.InstanceID,
+		"address":     self.Address,
+	}).Info("became scheduler leader")
+	if ip != "" {
+		if err := s.registerLeaderInCoreDNS(ip, port, session.Lease(), ttl); err != nil {
+			leaderLogger.WithError(err).Warn("failed to advertise leader in CoreDNS")
+		}
+	}
+
+	var loops sync.WaitGroup
+	s.startLeaderLoops(termCtx, &loops)
+	<-termCtx.Done()
+	loops.Wait()
+	s.setLeading(false)
+	// Pushes only come from the leader, so agents must reattach to the new one.
+	s.agentStreams.expireAll("scheduler stepped down as leader")
+
+	if ctx.Err() != nil {
+		resignCtx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+		defer cancel()
+		if err := election.Resign(resignCtx); err != nil {
+			leaderLogger.WithError(err).Warn("failed to resign scheduler leadership")
+		}
+		leaderLogger.Info("stepped down as scheduler leader")
+		return nil
+	}
+	leaderLogger.Warn("lost scheduler leader lease; leader loops stopped")
+	return fmt.Errorf("leader lease lost")
+}
+
+// observeLeader tracks the current leader so followers can advertise it.
+func (s *Scheduler) observeLeader(ctx context.Context, election *concurrency.Election) {
+	for resp := range election.Observe(ctx) {
+		if len(resp.Kvs) == 0 {
+			continue
+		}
+		var leader LeaderInfo
+		if err := json.Unmarshal(resp.Kvs[0].Value, &leader); err != nil {
+			leaderLogger.WithError(err).Warn("failed to decode observed leader")
+			continue
+		}
+		s.setObservedLeader(leader)
+	}
+}
+
+// startLeaderLoops starts the loops that act on cluster state and must run on
+// a single replica. They stop when ctx ends.
+func (s *Scheduler) startLeaderLoops(ctx context.Context, wg *sync.WaitGroup) {
+	s.runMigrations()
+
+	// Start node monitoring
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.MonitorNodes(ctx)
+	}()
+
+	// Start workload monitoring
+	if s.monitor != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.monitor.MonitorWorkloads(ctx, 60*time.Second)
+		}()
+	}
+
+	// Start drift detection loop (agent state vs scheduler state)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.StartDriftDetection(ctx, s.driftDetectInterval())
+	}()
+
+	if s.reconciler != nil {
+		interval := s.cfg.SchedulerReconcileInterval
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.reconciler.StartReconciliationLoop(ctx, interval)
+		}()
+	}
+}
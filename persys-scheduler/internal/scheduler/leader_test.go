@@ -0,0 +1,52 @@
+package scheduler
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestImportStateRequiresLeader(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	s.setObservedLeader(LeaderInfo{InstanceID: "scheduler-1", Address: "10.0.0.1:8085"})
+
+	archive := models.StateArchive{Version: 1, Source: "etcd", CreatedAt: time.Now().UTC()}
+	err := s.ImportState(archive, true)
+	if !errors.Is(err, ErrNotLeader) {
+		t.Fatalf("expected ErrNotLeader, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "10.0.0.1:8085") {
+		t.Fatalf("expected the error to name the leader, got %v", err)
+	}
+
+	s.setLeading(true)
+	if err := s.RequireLeader(); err != nil {
+		t.Fatalf("RequireLeader on the leader: %v", err)
+	}
+}
+
+func TestExpireAllDetachesAgentStreams(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	s.agentStreams = newAgentStreamRegistry(time.Minute)
+	conn, _ := s.AttachAgentStream("n1", "", 0)
+	if !s.HasAgentStream("n1") {
+		t.Fatalf("expected an attached stream")
+	}
+
+	s.agentStreams.expireAll("scheduler stepped down as leader")
+	select {
+	case <-conn.Done():
+	default:
+		t.Fatalf("expected the connection to be closed")
+	}
+	if s.HasAgentStream("n1") {
+		t.Fatalf("expected no stream after step-down")
+	}
+	if _, resumed := s.AttachAgentStream("n1", conn.SessionID(), 0); resumed {
+		t.Fatalf("a session expired on step-down must not be resumed")
+	}
+}
@@ -0,0 +1,282 @@
+package scheduler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+var migrationLogger = logging.C("scheduler.migrations")
+
+// migration is a one-time rewrite of stored state. Once it succeeds a marker
+// under migrationsPrefix records it, so it runs once per cluster.
+type migration struct {
+	name string
+	run  func(s *Scheduler) error
+}
+
+var migrations = []migration{
+	{name: "workload-namespace-keys", run: (*Scheduler).migrateWorkloadKeys},
+	{name: "git-token-secrets", run: (*Scheduler).migrateGitTokensToSecrets},
+}
+
+// runMigrations runs, in order, the migrations this cluster has not completed.
+// A failed migration stops the ones after it; they are retried the next time
+// this replica becomes leader.
+func (s *Scheduler) runMigrations() {
+	for _, m := range migrations {
+		logger := migrationLogger.WithField("migration", m.name)
+		resp, err := s.RetryableEtcdGet(migrationKey(m.name))
+		if err != nil {
+			logger.WithError(err).Warn("failed to read migration marker")
+			return
+		}
+		if resp != nil && len(resp.Kvs) > 0 {
+			continue
+		}
+		if err := m.run(s); err != nil {
+			logger.WithError(err).Warn("migration failed; it is retried on the next leadership")
+			return
+		}
+		if err := s.RetryableEtcdPut(migrationKey(m.name), time.Now().UTC().Format(time.RFC3339)); err != nil {
+			logger.WithError(err).Warn("failed to record migration")
+			return
+		}
+		logger.Info("migration completed")
+	}
+}
+
+// migrateWorkloadKeys moves workloads stored before keys carried the
+// namespace (/workloads-spec/<id> and /workloads-status/<id>, and full
+// objects under /workloads/<id>) to /workloads/<namespace>/<id> and
+// /workloads-status/<namespace>/<id>, recording each ID under
+// /workload-ids/. A workload whose ID is already recorded keeps its new keys.
+// Old keys are deleted last, so a run cut short is finished by the next one.
+func (s *Scheduler) migrateWorkloadKeys() error {
+	statusResp, err := s.RetryableEtcdGet(workloadStatusPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	statuses := map[string]string{}
+	for _, kv := range statusResp.Kvs {
+		if id := strings.TrimPrefix(string(kv.Key), workloadStatusPrefix); !strings.Contains(id, "/") {
+			statuses[id] = string(kv.Value)
+		}
+	}
+
+	specResp, err := s.RetryableEtcdGet(legacyWorkloadSpecPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range specResp.Kvs {
+		id := strings.TrimPrefix(string(kv.Key), legacyWorkloadSpecPrefix)
+		var spec workloadSpec
+		if err := json.Unmarshal(kv.Value, &spec); err != nil {
+			migrationLogger.WithError(err).WithField("key", string(kv.Key)).Warn("skipping undecodable workload spec")
+			continue
+		}
+		if err := s.moveWorkloadKeys(id, normalizeNamespace(spec.Namespace), string(kv.Value), statuses[id], string(kv.Key)); err != nil {
+			return err
+		}
+		delete(statuses, id)
+	}
+
+	fullResp, err := s.RetryableEtcdGet(workloadsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return err
+	}
+	for _, kv := range fullResp.Kvs {
+		id := strings.TrimPrefix(string(kv.Key), workloadsPrefix)
+		if strings.Contains(id, "/") {
+			continue
+		}
+		var w models.Workload
+		if err := json.Unmarshal(kv.Value, &w); err != nil {
+			migrationLogger.WithError(err).WithField("key", string(kv.Key)).Warn("skipping undecodable workload")
+			continue
+		}
+		w.ID = id
+		spec, err := json.Marshal(workloadSpecFromWorkload(w))
+		if err != nil {
+			return err
+		}
+		status, err := json.Marshal(workloadStatusFromWorkload(w))
+		if err != nil {
+			return err
+		}
+		if err := s.moveWorkloadKeys(id, WorkloadNamespace(w), string(spec), string(status), string(kv.Key)); err != nil {
+			return err
+		}
+		delete(statuses, id)
+	}
+
+	// Statuses without a spec belong to nothing.
+	for id := range statuses {
+		if err := s.RetryableEtcdDelete(workloadStatusPrefix + id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveWorkloadKeys stores one workload at its namespaced keys and deletes
+// its old status key and then oldKey.
+func (s *Scheduler) moveWorkloadKeys(id, namespace, spec, status, oldKey string) error {
+	puts := map[string]string{
+		workloadIDKey(id):              namespace,
+		workloadSpecKey(namespace, id): spec,
+	}
+	if status != "" {
+		puts[workloadStatusKey(namespace, id)] = status
+	}
+	moved, err := s.RetryableEtcdCompareAndPutAll(workloadIDKey(id), 0, puts)
+	if err != nil {
+		return err
+	}
+	if moved {
+		migrationLogger.WithFields(logrus.Fields{"workload_id": id, "namespace": namespace}).Info("moved workload to namespaced keys")
+	}
+	if err := s.RetryableEtcdDelete(workloadStatusPrefix + id); err != nil {
+		return err
+	}
+	return s.RetryableEtcdDelete(oldKey)
+}
+
+const gitTokenSecretKey = "token"
+
+// migrateGitTokensToSecrets moves Git tokens that older releases stored in
+// plain text into sealed secrets. Each record holding one is rewritten to
+// reference its secret with gitTokenRef; the plaintext goes away in the same
+// write, after the secret is stored.
+func (s *Scheduler) migrateGitTokensToSecrets() error {
+	stored := map[string]bool{}
+	for _, prefix := range []string{workloadsPrefix, legacyWorkloadSpecPrefix, revisionsPrefix, replicaSetsPrefix, jobsPrefix, cronJobsPrefix, stacksPrefix} {
+		resp, err := s.RetryableEtcdGet(prefix, clientv3.WithPrefix())
+		if err != nil {
+			return err
+		}
+		for _, kv := range resp.Kvs {
+			if !strings.Contains(string(kv.Value), `"gitToken"`) {
+				continue
+			}
+			dec := json.NewDecoder(strings.NewReader(string(kv.Value)))
+			dec.UseNumber()
+			var record interface{}
+			if err := dec.Decode(&record); err != nil {
+				migrationLogger.WithError(err).WithField("key", string(kv.Key)).Warn("skipping undecodable record")
+				continue
+			}
+			changed, err := s.moveGitTokens(record, "", stored)
+			if err != nil {
+				return fmt.Errorf("%s: %w", kv.Key, err)
+			}
+			if !changed {
+				continue
+			}
+			payload, err := json.Marshal(record)
+			if err != nil {
+				return fmt.Errorf("%s: %w", kv.Key, err)
+			}
+			ok, err := s.RetryableEtcdCompareAndPut(string(kv.Key), string(payload), kv.ModRevision)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("%s changed during the migration", kv.Key)
+			}
+			migrationLogger.WithField("key", string(kv.Key)).Info("moved plaintext git token into a secret")
+		}
+	}
+	return nil
+}
+
+// moveGitTokens replaces every "gitToken" in a JSON-decoded record with a
+// gitTokenRef to a secret in the namespace of the object holding it, and
+// reports whether it changed anything. Nested templates inherit the
+// namespace of their parent unless they set their own.
+func (s *Scheduler) moveGitTokens(v interface{}, namespace string, stored map[string]bool) (bool, error) {
+	changed := false
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if ns, ok := t["namespace"].(string); ok && strings.TrimSpace(ns) != "" {
+			namespace = ns
+		}
+		if raw, ok := t["gitToken"]; ok {
+			if token, _ := raw.(string); token != "" {
+				name, err := s.storeGitTokenSecret(normalizeNamespace(namespace), token, stored)
+				if err != nil {
+					return false, err
+				}
+				if _, ok := t["gitTokenRef"]; !ok {
+					t["gitTokenRef"] = map[string]interface{}{"secret": name, "key": gitTokenSecretKey}
+				}
+			}
+			delete(t, "gitToken")
+			changed = true
+		}
+		for _, child := range t {
+			moved, err := s.moveGitTokens(child, namespace, stored)
+			if err != nil {
+				return false, err
+			}
+			changed = moved || changed
+		}
+	case []interface{}:
+		for _, child := range t {
+			moved, err := s.moveGitTokens(child, namespace, stored)
+			if err != nil {
+				return false, err
+			}
+			changed = moved || changed
+		}
+	}
+	return changed, nil
+}
+
+// storeGitTokenSecret seals token into a secret of namespace and returns its
+// name. The name derives from the token, so records sharing a token share a
+// secret and a rerun after a partial migration finds the secret it stored.
+func (s *Scheduler) storeGitTokenSecret(namespace, token string, stored map[string]bool) (string, error) {
+	sum := sha256.Sum256([]byte(namespace + "\x00" + token))
+	name := "git-token-" + hex.EncodeToString(sum[:6])
+	key := secretKey(namespace, name)
+	if stored[key] {
+		return name, nil
+	}
+	if _, found, err := s.getSealedSecret(namespace, name); err != nil {
+		return "", err
+	} else if found {
+		stored[key] = true
+		return name, nil
+	}
+	data := map[string]string{gitTokenSecretKey: token}
+	now := time.Now().UTC()
+	record := models.SealedSecret{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{"persys.io/migrated-from": "gitToken"},
+		Keys:      sortedKeys(data),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	var err error
+	record.KEK, record.WrappedKey, record.Ciphertext, err = s.sealSecretData(namespace, name, data)
+	if err != nil {
+		return "", err
+	}
+	if err := s.saveSealedSecret(record); err != nil {
+		return "", err
+	}
+	stored[key] = true
+	migrationLogger.WithFields(logrus.Fields{"namespace": namespace, "secret": name}).Info("stored plaintext git token as a secret")
+	return name, nil
+}
@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestMigrateGitTokensToSecrets(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	s.secretsKEK = newTestKEK(t, 1)
+	records := map[string]string{
+		workloadSpecKey("default", "web"):   `{"id":"web","type":"compose","gitRepo":"r","gitToken":"ghp_one"}`,
+		replicaSetKey("api"):                `{"id":"api","template":{"namespace":"team-a","gitRepo":"r","gitToken":"ghp_two"}}`,
+		revisionPrefix("web") + "1":         `{"revision":1,"spec":{"gitRepo":"r","gitToken":"ghp_one"}}`,
+		workloadSpecKey("default", "plain"): `{"id":"plain","gitRepo":"r"}`,
+	}
+	for key, value := range records {
+		if _, err := kv.Put(context.Background(), key, value); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+
+	s.runMigrations()
+
+	var spec models.Workload
+	decodeKV(t, kv, workloadSpecKey("default", "web"), &spec)
+	if spec.GitTokenRef == nil || spec.GitTokenRef.Key != gitTokenSecretKey {
+		t.Fatalf("expected the workload to reference a secret, got %+v", spec.GitTokenRef)
+	}
+	if token := openGitTokenSecret(t, s, "default", spec.GitTokenRef.Secret); token != "ghp_one" {
+		t.Fatalf("expected the secret to hold the token, got %q", token)
+	}
+
+	var rs models.ReplicaSet
+	decodeKV(t, kv, replicaSetKey("api"), &rs)
+	if rs.Template.GitTokenRef == nil {
+		t.Fatalf("expected the replica set template to reference a secret")
+	}
+	if token := openGitTokenSecret(t, s, "team-a", rs.Template.GitTokenRef.Secret); token != "ghp_two" {
+		t.Fatalf("expected the template's secret in its namespace, got %q", token)
+	}
+
+	var revision struct {
+		Spec models.Workload `json:"spec"`
+	}
+	decodeKV(t, kv, revisionPrefix("web")+"1", &revision)
+	if revision.Spec.GitTokenRef == nil || revision.Spec.GitTokenRef.Secret != spec.GitTokenRef.Secret {
+		t.Fatalf("expected the revision to share the workload's secret, got %+v", revision.Spec.GitTokenRef)
+	}
+
+	for key, kvRecord := range kv.kvs {
+		if strings.Contains(string(kvRecord.Value), "ghp_") {
+			t.Fatalf("plaintext token left in %s", key)
+		}
+	}
+	if _, ok := kv.kvs[migrationKey("git-token-secrets")]; !ok {
+		t.Fatalf("expected the migration to be recorded")
+	}
+}
+
+func TestMigrateGitTokensWithoutKEKKeepsTokens(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	original := `{"id":"web","gitRepo":"r","gitToken":"ghp_one"}`
+	if _, err := kv.Put(context.Background(), workloadSpecKey("default", "web"), original); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	s.runMigrations()
+
+	if got := string(kv.kvs[workloadSpecKey("default", "web")].Value); got != original {
+		t.Fatalf("expected the record to be left alone until secrets are configured, got %s", got)
+	}
+	if _, ok := kv.kvs[migrationKey("git-token-secrets")]; ok {
+		t.Fatalf("expected the migration to stay pending")
+	}
+}
+
+func TestMigrateWorkloadKeys(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	records := map[string]string{
+		legacyWorkloadSpecPrefix + "web":   `{"id":"web","namespace":"team-a","image":"nginx"}`,
+		workloadStatusPrefix + "web":       `{"id":"web","status":"Running","nodeId":"n1"}`,
+		legacyWorkloadSpecPrefix + "plain": `{"id":"plain","image":"busybox"}`,
+		workloadsPrefix + "old":            `{"id":"old","namespace":"team-b","image":"redis","status":"Pending"}`,
+		workloadStatusPrefix + "ghost":     `{"id":"ghost","status":"Running"}`,
+		// Left behind by a run cut short after "moved" got its new keys.
+		legacyWorkloadSpecPrefix + "moved":  `{"id":"moved","image":"stale"}`,
+		workloadIDKey("moved"):              "default",
+		workloadSpecKey("default", "moved"): `{"id":"moved","image":"current"}`,
+	}
+	for key, value := range records {
+		if _, err := kv.Put(context.Background(), key, value); err != nil {
+			t.Fatalf("put: %v", err)
+		}
+	}
+
+	s.runMigrations()
+
+	tests := []struct {
+		id, namespace, image, status string
+	}{
+		{id: "web", namespace: "team-a", image: "nginx", status: "Running"},
+		{id: "plain", namespace: "default", image: "busybox"},
+		{id: "old", namespace: "team-b", image: "redis", status: "Pending"},
+		{id: "moved", namespace: "default", image: "current"},
+	}
+	for _, tt := range tests {
+		w, err := s.GetWorkloadByID(tt.id)
+		if err != nil {
+			t.Fatalf("GetWorkloadByID(%s): %v", tt.id, err)
+		}
+		if WorkloadNamespace(w) != tt.namespace || w.Image != tt.image || w.Status != tt.status {
+			t.Fatalf("%s: expected %s/%s/%q, got %s/%s/%q", tt.id, tt.namespace, tt.image, tt.status, WorkloadNamespace(w), w.Image, w.Status)
+		}
+		if _, ok := kv.kvs[workloadSpecKey(tt.namespace, tt.id)]; !ok {
+			t.Fatalf("%s: expected the spec under its namespace", tt.id)
+		}
+	}
+	for key := range kv.kvs {
+		for _, prefix := range []string{legacyWorkloadSpecPrefix, workloadStatusPrefix, workloadsPrefix} {
+			if strings.HasPrefix(key, prefix) && !strings.Contains(strings.TrimPrefix(key, prefix), "/") {
+				t.Fatalf("old key %s left behind", key)
+			}
+		}
+	}
+	if _, ok := kv.kvs[migrationKey("workload-namespace-keys")]; !ok {
+		t.Fatalf("expected the migration to be recorded")
+	}
+}
+
+func decodeKV(t *testing.T, kv *fakeKV, key string, into interface{}) {
+	t.Helper()
+	record, ok := kv.kvs[key]
+	if !ok {
+		t.Fatalf("missing key %s", key)
+	}
+	if err := json.Unmarshal(record.Value, into); err != nil {
+		t.Fatalf("unmarshal %s: %v", key, err)
+	}
+}
+
+func openGitTokenSecret(t *testing.T, s *Scheduler, namespace, name string) string {
+	t.Helper()
+	record, found, err := s.getSealedSecret(namespace, name)
+	if err != nil || !found {
+		t.Fatalf("secret %s/%s: found=%v err=%v", namespace, name, found, err)
+	}
+	data, err := s.openSecret(record)
+	if err != nil {
+		t.Fatalf("open secret: %v", err)
+	}
+	return data[gitTokenSecretKey]
+}
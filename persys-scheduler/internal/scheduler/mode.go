@@ -25,8 +25,19 @@ type FrozenState struct {
 	Nodes       map[string]models.Node             `json:"nodes"`
 	Workloads   map[string]models.Workload         `json:"workloads"`
 	Assignments map[string]models.AssignmentRecord `json:"assignments"`
+	// Records holds the archive sections that are not cached above, as of
+	// the last refresh before the freeze: namespaces, secrets, configs,
+	// services, stacks, replica sets, jobs, cron jobs, revisions, volumes and
+	// attachments.
+	Records models.StateArchive `json:"records"`
 }
 
+// recordCacheInterval is how often the mode supervisor re-reads the records a
+// frozen snapshot carries besides nodes, workloads and assignments. Unlike
+// those, they are not mirrored by the informer, and etcd is gone by the time
+// the snapshot is taken.
+const recordCacheInterval = 30 * time.Second
+
 func (s *Scheduler) currentMode() OperatingMode {
 	s.modeMu.RLock()
 	defer s.modeMu.RUnlock()
@@ -67,14 +78,8 @@ func (s *Scheduler) enterDegraded(reason string) {
 	s.mode = ModeDegraded
 	s.modeReasonText = reason
 	s.modeChangedAt = time.Now().UTC()
-	nodes, workloads, assignments := s.cacheCopies()
-	s.frozen = &FrozenState{
-		CreatedAt:   s.modeChangedAt,
-		Reason:      reason,
-		Nodes:       nodes,
-		Workloads:   workloads,
-		Assignments: assignments,
-	}
+	s.frozen = s.newFrozenState(reason)
+	go s.persistFrozenSnapshot(s.frozen)
 	schedulerLogger.WithField("reason", reason).Warn("scheduler entered degraded mode")
 }
 
@@ -88,18 +93,30 @@ func (s *Scheduler) enterRecovery(reason string) {
 	s.modeReasonText = reason
 	s.modeChangedAt = time.Now().UTC()
 	if s.frozen == nil {
-		nodes, workloads, assignments := s.cacheCopies()
-		s.frozen = &FrozenState{
-			CreatedAt:   s.modeChangedAt,
-			Reason:      reason,
-			Nodes:       nodes,
-			Workloads:   workloads,
-			Assignments: assignments,
-		}
+		s.frozen = s.newFrozenState(reason)
+		go s.persistFrozenSnapshot(s.frozen)
 	}
 	schedulerLogger.WithField("reason", reason).Warn("scheduler entered recovery mode")
 }
 
+// newFrozenState captures the cached control-plane state for a mode that
+// freezes it. The caller holds modeMu and has set modeChangedAt.
+func (s *Scheduler) newFrozenState(reason string) *FrozenState {
+	s.seedCacheFromInformer()
+	nodes, workloads, assignments := s.cacheCopies()
+	s.cacheMu.RLock()
+	records := s.cacheRecords
+	s.cacheMu.RUnlock()
+	return &FrozenState{
+		CreatedAt:   s.modeChangedAt,
+		Reason:      reason,
+		Nodes:       nodes,
+		Workloads:   workloads,
+		Assignments: assignments,
+		Records:     records,
+	}
+}
+
 func (s *Scheduler) enterNormal(reason string) {
 	s.modeMu.Lock()
 	defer s.modeMu.Unlock()
@@ -141,6 +158,7 @@ func (s *Scheduler) evaluateMode() {
 
 	mode := s.currentMode()
 	if mode == ModeNormal {
+		s.refreshRecordCache()
 		return
 	}
 
@@ -156,6 +174,26 @@ func (s *Scheduler) evaluateMode() {
 	s.enterRecovery("etcd recovered but state is empty; waiting for restore/import")
 }
 
+// refreshRecordCache re-reads the records a frozen snapshot carries besides
+// nodes, workloads and assignments, at most every recordCacheInterval.
+func (s *Scheduler) refreshRecordCache() {
+	s.cacheMu.RLock()
+	due := time.Since(s.cacheRecordsAt) >= recordCacheInterval
+	s.cacheMu.RUnlock()
+	if !due {
+		return
+	}
+	var records models.StateArchive
+	if err := s.exportRecords(&records); err != nil {
+		schedulerLogger.WithError(err).Warn("failed to refresh cached records for frozen snapshots")
+		return
+	}
+	s.withCacheLock(func() {
+		s.cacheRecords = records
+		s.cacheRecordsAt = time.Now()
+	})
+}
+
 func (s *Scheduler) pingEtcd() error {
 	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
 	defer cancel()
@@ -166,7 +204,7 @@ func (s *Scheduler) pingEtcd() error {
 func (s *Scheduler) etcdHasState() (bool, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
 	defer cancel()
-	prefixes := []string{nodesPrefix, workloadsPrefix, assignmentsPrefix}
+	prefixes := []string{nodesPrefix, workloadsPrefix, legacyWorkloadSpecPrefix, assignmentsPrefix}
 	for _, p := range prefixes {
 		resp, err := s.etcdClient.Get(ctx, p, clientv3.WithPrefix(), clientv3.WithLimit(1))
 		if err != nil {
@@ -209,6 +247,20 @@ func (s *Scheduler) cacheCopies() (map[string]models.Node, map[string]models.Wor
 	return cloneNodeMap(s.cacheNodes), cloneWorkloadMap(s.cacheWorkloads), cloneAssignmentMap(s.cacheAssignments)
 }
 
+// seedCacheFromInformer replaces the cached nodes and workloads with the
+// informer contents, which follow changes made by every replica. The cache is
+// what a frozen control plane serves.
+func (s *Scheduler) seedCacheFromInformer() {
+	nodes, workloads, ok := s.informer.snapshot()
+	if !ok {
+		return
+	}
+	s.withCacheLock(func() {
+		s.cacheNodes = nodes
+		s.cacheWorkloads = workloads
+	})
+}
+
 func cacheSnapshot[T any](in map[string]T) []T {
 	out := make([]T, 0, len(in))
 	for _, v := range in {
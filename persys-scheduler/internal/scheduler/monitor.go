@@ -68,6 +68,9 @@ func (m *Monitor) syncWorkloadStatus(workloadID string) error {
 	if len(statusResp.GetMetadata()) > 0 {
 		_ = m.scheduler.UpdateWorkloadMetadata(workload.ID, statusResp.GetMetadata())
 	}
+	if len(statusResp.GetProbeResults()) > 0 {
+		_ = m.scheduler.RecordProbeResults(workload.ID, probeResultsFromAgent(statusResp.GetProbeResults()))
+	}
 	return nil
 }
 
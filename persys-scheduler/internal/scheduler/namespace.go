@@ -0,0 +1,285 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// DefaultNamespace holds workloads created without a namespace. It always
+// exists and has no quota unless one is applied to it.
+const DefaultNamespace = "default"
+
+// ErrQuotaExceeded is wrapped by errors from CreateWorkload and
+// UpdateWorkloadSpec when the namespace quota would be exceeded.
+var ErrQuotaExceeded = errors.New("namespace quota exceeded")
+
+var (
+	namespaceLogger      = logging.C("scheduler.namespace")
+	namespaceNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+)
+
+// WorkloadNamespace returns the namespace w belongs to.
+func WorkloadNamespace(w models.Workload) string {
+	return normalizeNamespace(w.Namespace)
+}
+
+func normalizeNamespace(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return DefaultNamespace
+	}
+	return name
+}
+
+func validateNamespaceName(name string) error {
+	if !namespaceNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid namespace %q: use lowercase letters, digits and '-', at most 63 characters", name)
+	}
+	return nil
+}
+
+func validateQuota(q models.ResourceQuota) error {
+	if q.CPU < 0 || q.MemoryMB < 0 || q.DiskGB < 0 || q.Workloads < 0 || q.VMs < 0 {
+		return fmt.Errorf("quota values must not be negative")
+	}
+	return nil
+}
+
+// ApplyNamespace creates a namespace or replaces its labels and quota. A
+// lowered quota does not evict anything; it only rejects further growth.
+func (s *Scheduler) ApplyNamespace(ns models.Namespace) (models.Namespace, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Namespace{}, err
+	}
+	ns.Name = normalizeNamespace(ns.Name)
+	if err := validateNamespaceName(ns.Name); err != nil {
+		return models.Namespace{}, err
+	}
+	if err := validateQuota(ns.Quota); err != nil {
+		return models.Namespace{}, fmt.Errorf("namespace %s: %w", ns.Name, err)
+	}
+	now := time.Now().UTC()
+	current, found, err := s.getNamespaceRecord(ns.Name)
+	if err != nil {
+		return models.Namespace{}, err
+	}
+	ns.CreatedAt = now
+	if found {
+		ns.CreatedAt = current.CreatedAt
+	}
+	ns.UpdatedAt = now
+	if err := s.saveNamespaceRecord(ns); err != nil {
+		return models.Namespace{}, err
+	}
+	// Usage is only tracked while there is a quota; a ledger left from an
+	// earlier quota is stale, and a new one is seeded from the workloads.
+	if current.Quota == (models.ResourceQuota{}) || ns.Quota == (models.ResourceQuota{}) {
+		if err := s.RetryableEtcdDelete(quotaUsageKey(ns.Name)); err != nil {
+			return models.Namespace{}, err
+		}
+	}
+	eventType := "NamespaceCreated"
+	if found {
+		eventType = "NamespaceUpdated"
+	}
+	s.emitEvent(eventType, "", "", "Namespace applied", map[string]interface{}{
+		"namespace":       ns.Name,
+		"quota_cpu":       ns.Quota.CPU,
+		"quota_memory_mb": ns.Quota.MemoryMB,
+		"quota_disk_gb":   ns.Quota.DiskGB,
+		"quota_workloads": ns.Quota.Workloads,
+		"quota_vms":       ns.Quota.VMs,
+	})
+	return ns, nil
+}
+
+// DeleteNamespace removes a namespace without workloads, secrets, configs or services. The default namespace cannot
+// be deleted, only have its quota cleared.
+func (s *Scheduler) DeleteNamespace(name string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	name = normalizeNamespace(name)
+	if name == DefaultNamespace {
+		return fmt.Errorf("the %s namespace cannot be deleted", DefaultNamespace)
+	}
+	if _, found, err := s.getNamespaceRecord(name); err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("namespace %s not found", name)
+	}
+	used, err := s.NamespaceUsage(name)
+	if err != nil {
+		return err
+	}
+	if used.Workloads > 0 {
+		return fmt.Errorf("namespace %s still has %d workloads; delete them first", name, used.Workloads)
+	}
+	secrets, err := s.listSealedSecrets(name)
+	if err != nil {
+		return err
+	}
+	configs, err := s.ListConfigs(name)
+	if err != nil {
+		return err
+	}
+	if len(secrets) > 0 || len(configs) > 0 {
+		return fmt.Errorf("namespace %s still has %d secrets and %d configs; delete them first", name, len(secrets), len(configs))
+	}
+	services, err := s.ListServices(name)
+	if err != nil {
+		return err
+	}
+	if len(services) > 0 {
+		return fmt.Errorf("namespace %s still has %d services; delete them first", name, len(services))
+	}
+	stacks, err := s.ListStacks(name)
+	if err != nil {
+		return err
+	}
+	if len(stacks) > 0 {
+		return fmt.Errorf("namespace %s still has %d stacks; delete them first", name, len(stacks))
+	}
+	if err := s.RetryableEtcdDelete(namespaceKey(name)); err != nil {
+		return err
+	}
+	_ = s.RetryableEtcdDelete(quotaUsageKey(name))
+	s.emitEvent("NamespaceDeleted", "", "", "Namespace deleted", map[string]interface{}{"namespace": name})
+	return nil
+}
+
+// GetNamespace retrieves a namespace. The default namespace is returned even
+// when it has never been applied.
+func (s *Scheduler) GetNamespace(name string) (models.Namespace, error) {
+	name = normalizeNamespace(name)
+	ns, found, err := s.getNamespaceRecord(name)
+	if err != nil {
+		return models.Namespace{}, err
+	}
+	if !found {
+		if name == DefaultNamespace {
+			return models.Namespace{Name: DefaultNamespace}, nil
+		}
+		return models.Namespace{}, fmt.Errorf("namespace %s not found", name)
+	}
+	return ns, nil
+}
+
+// ListNamespaces returns all namespaces, sorted by name.
+func (s *Scheduler) ListNamespaces() ([]models.Namespace, error) {
+	out, err := s.listNamespaceRecords()
+	if err != nil {
+		return nil, err
+	}
+	hasDefault := false
+	for _, ns := range out {
+		hasDefault = hasDefault || ns.Name == DefaultNamespace
+	}
+	if !hasDefault {
+		out = append(out, models.Namespace{Name: DefaultNamespace})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func (s *Scheduler) listNamespaceRecords() ([]models.Namespace, error) {
+	resp, err := s.RetryableEtcdGet(namespacesPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespaces: %v", err)
+	}
+	out := make([]models.Namespace, 0, len(resp.Kvs)+1)
+	for _, kv := range resp.Kvs {
+		var ns models.Namespace
+		if err := json.Unmarshal(kv.Value, &ns); err != nil {
+			namespaceLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal namespace")
+			continue
+		}
+		out = append(out, ns)
+	}
+	return out, nil
+}
+
+// NamespaceUsage sums what the live workloads of a namespace request.
+// Deleted workloads and finished job runs do not count.
+func (s *Scheduler) NamespaceUsage(name string) (models.ResourceQuota, error) {
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return models.ResourceQuota{}, err
+	}
+	return namespaceUsage(workloads, normalizeNamespace(name), ""), nil
+}
+
+func namespaceUsage(workloads []models.Workload, namespace, skipID string) models.ResourceQuota {
+	var used models.ResourceQuota
+	for _, w := range workloads {
+		if w.ID == skipID || WorkloadNamespace(w) != namespace {
+			continue
+		}
+		if strings.EqualFold(w.DesiredState, "Deleted") || jobChildFinished(w) {
+			continue
+		}
+		addQuotaUsage(&used, w)
+	}
+	return used
+}
+
+func addQuotaUsage(used *models.ResourceQuota, w models.Workload) {
+	alloc := workloadAllocation(w)
+	used.CPU += alloc.CPU
+	used.MemoryMB += alloc.MemoryMB
+	used.DiskGB += alloc.DiskGB
+	used.Workloads++
+	if canonicalWorkloadType(w.Type) == "vm" {
+		used.VMs++
+	}
+}
+
+func (s *Scheduler) getNamespaceRecord(name string) (models.Namespace, bool, error) {
+	resp, err := s.RetryableEtcdGet(namespaceKey(name))
+	if err != nil {
+		return models.Namespace{}, false, fmt.Errorf("failed to get namespace %s: %v", name, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.Namespace{}, false, nil
+	}
+	var ns models.Namespace
+	if err := json.Unmarshal(resp.Kvs[0].Value, &ns); err != nil {
+		return models.Namespace{}, false, fmt.Errorf("failed to unmarshal namespace %s: %v", name, err)
+	}
+	return ns, true, nil
+}
+
+func (s *Scheduler) saveNamespaceRecord(ns models.Namespace) error {
+	payload, err := json.Marshal(ns)
+	if err != nil {
+		return fmt.Errorf("marshal namespace %s: %w", ns.Name, err)
+	}
+	return s.RetryableEtcdPut(namespaceKey(ns.Name), string(payload))
+}
+
+// admitToNamespace normalizes the namespace of w, checks that it exists and
+// charges w to the namespace quota (see chargeQuota). previous is the stored
+// version of w when it is being updated.
+func (s *Scheduler) admitToNamespace(w *models.Workload, previous *models.Workload) error {
+	w.Namespace = normalizeNamespace(w.Namespace)
+	if err := validateNamespaceName(w.Namespace); err != nil {
+		return err
+	}
+	ns, err := s.GetNamespace(w.Namespace)
+	if err != nil {
+		return err
+	}
+	if ns.Quota == (models.ResourceQuota{}) {
+		return nil
+	}
+	return s.chargeQuota(ns, *w, previous)
+}
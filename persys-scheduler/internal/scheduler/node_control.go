@@ -13,7 +13,7 @@ import (
 
 var nodeLogger = logging.C("scheduler.node_control")
 
-func (s *Scheduler) UpdateNodeHeartbeat(nodeID, status string, availableCPU float64, availableMemory int64) error {
+func (s *Scheduler) UpdateNodeHeartbeat(nodeID, status string, availableCPU float64, availableMemory int64, disk *models.NodeDiskUsage) error {
 	if err := s.requireWritable(); err != nil {
 		return err
 	}
@@ -40,6 +40,7 @@ func (s *Scheduler) UpdateNodeHeartbeat(nodeID, status string, availableCPU floa
 			} else {
 				node.StatusReason = fmt.Sprintf("status transition %s -> %s via heartbeat", previousStatus, status)
 				nodeLogger.WithField("node_id", nodeID).Info("node recovered to Ready via heartbeat")
+				defer s.activatePendingQueue("node recovered")
 			}
 			node.StatusUpdatedBy = "heartbeat"
 			node.StatusUpdatedAt = time.Now().UTC()
@@ -55,6 +56,9 @@ func (s *Scheduler) UpdateNodeHeartbeat(nodeID, status string, availableCPU floa
 	if availableMemory >= 0 {
 		node.AvailableMemory = availableMemory
 	}
+	if disk != nil {
+		applyNodeDiskUsage(&node, *disk)
+	}
 
 	updatedNodeJSON, err := json.Marshal(node)
 	if err != nil {
@@ -204,3 +208,31 @@ func (s *Scheduler) markNodeNotReady(nodeID, reason, source string) error {
 	}).Warn("marked node NotReady")
 	return nil
 }
+
+// applyNodeDiskUsage copies heartbeat disk figures onto the node. Per-pool
+// numbers are matched by name against the pools announced at registration;
+// agents that only send totals get them attributed to their single pool.
+func applyNodeDiskUsage(node *models.Node, disk models.NodeDiskUsage) {
+	allocated, used := disk.AllocatedGB, disk.UsedGB
+	if len(disk.Pools) > 0 {
+		var poolAllocated, poolUsed int64
+		for _, reported := range disk.Pools {
+			poolAllocated += reported.AllocatedGB
+			poolUsed += reported.UsedGB
+			for i := range node.StoragePools {
+				if node.StoragePools[i].Name == reported.Name {
+					node.StoragePools[i].AllocatedGB = reported.AllocatedGB
+					node.StoragePools[i].UsedGB = reported.UsedGB
+				}
+			}
+		}
+		if allocated == 0 && used == 0 {
+			allocated, used = poolAllocated, poolUsed
+		}
+	} else if len(node.StoragePools) == 1 {
+		node.StoragePools[0].AllocatedGB = allocated
+		node.StoragePools[0].UsedGB = used
+	}
+	node.DiskAllocatedGB = allocated
+	node.DiskUsedGB = used
+}
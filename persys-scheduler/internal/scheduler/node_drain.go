@@ -0,0 +1,385 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// CordonNode takes a node out of the placement pool without touching the
+// workloads already running on it.
+func (s *Scheduler) CordonNode(nodeID, reason string) (models.Node, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Node{}, err
+	}
+	node, err := s.GetNodeByID(nodeID)
+	if err != nil {
+		return models.Node{}, err
+	}
+	if node.Unschedulable {
+		return node, nil
+	}
+	node.Unschedulable = true
+	if err := s.saveNodeRecord(node); err != nil {
+		return models.Node{}, err
+	}
+	s.emitEvent("NodeCordoned", "", nodeID, strings.TrimSpace(reason), nil)
+	nodeLogger.WithFields(logrus.Fields{"node_id": nodeID, "reason": reason}).Info("cordoned node")
+	return node, nil
+}
+
+// UncordonNode returns a node to the placement pool and cancels any drain in
+// progress. Workloads already moved stay where they are.
+func (s *Scheduler) UncordonNode(nodeID string) (models.Node, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Node{}, err
+	}
+	node, err := s.GetNodeByID(nodeID)
+	if err != nil {
+		return models.Node{}, err
+	}
+	if !node.Unschedulable && node.Drain == nil {
+		return node, nil
+	}
+	if node.Drain != nil && node.Drain.State == models.NodeDrainStateDraining {
+		s.emitEvent("NodeDrainCancelled", "", nodeID, "node uncordoned", map[string]interface{}{
+			"migrated":  node.Drain.Migrated,
+			"remaining": node.Drain.Remaining,
+		})
+	}
+	node.Unschedulable = false
+	node.Drain = nil
+	if err := s.saveNodeRecord(node); err != nil {
+		return models.Node{}, err
+	}
+	s.emitEvent("NodeUncordoned", "", nodeID, "", nil)
+	s.activatePendingQueue("node uncordoned")
+	nodeLogger.WithField("node_id", nodeID).Info("uncordoned node")
+	return node, nil
+}
+
+// SetNodeTaints replaces the operator taints of a node. The node's effective
+// taints are recomputed from them and the taints its agent registered with;
+// NoExecute taints are enforced by the next drain pass.
+func (s *Scheduler) SetNodeTaints(nodeID string, taints []models.Taint) (models.Node, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Node{}, err
+	}
+	node, err := s.GetNodeByID(nodeID)
+	if err != nil {
+		return models.Node{}, err
+	}
+	previous := formatTaints(node.Taints)
+	if node.AgentTaints == nil && node.OperatorTaints == nil {
+		// Registered before operator taints existed: every taint came from the agent.
+		node.AgentTaints = node.Taints
+	}
+	node.OperatorTaints = append([]models.Taint(nil), taints...)
+	node.Taints = mergeTaints(node.OperatorTaints, node.AgentTaints)
+	if err := s.saveNodeRecord(node); err != nil {
+		return models.Node{}, err
+	}
+	current := formatTaints(node.Taints)
+	s.emitEvent("NodeTaintsUpdated", "", nodeID, current, map[string]interface{}{
+		"operator_taints": formatTaints(node.OperatorTaints),
+		"previous":        previous,
+	})
+	if current != previous {
+		// Removing a taint can make room for queued workloads.
+		s.activatePendingQueue("node taints updated")
+	}
+	nodeLogger.WithFields(logrus.Fields{"node_id": nodeID, "taints": current}).Info("updated node taints")
+	return node, nil
+}
+
+// DrainNode cordons a node and starts moving its workloads elsewhere. The
+// move itself happens in ReconcileNodeDrains, at most maxUnavailable
+// workloads at a time (0 uses SCHEDULER_DRAIN_MAX_UNAVAILABLE). Calling it
+// again on a draining node only updates the budget.
+func (s *Scheduler) DrainNode(nodeID, reason string, maxUnavailable int) (models.Node, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Node{}, err
+	}
+	if maxUnavailable < 0 {
+		return models.Node{}, fmt.Errorf("max_unavailable must not be negative")
+	}
+	node, err := s.GetNodeByID(nodeID)
+	if err != nil {
+		return models.Node{}, err
+	}
+	node.Unschedulable = true
+	if node.Drain != nil && node.Drain.State == models.NodeDrainStateDraining {
+		if maxUnavailable > 0 {
+			node.Drain.MaxUnavailable = maxUnavailable
+		}
+		if err := s.saveNodeRecord(node); err != nil {
+			return models.Node{}, err
+		}
+		return node, nil
+	}
+	if maxUnavailable == 0 {
+		maxUnavailable = s.drainMaxUnavailable()
+	}
+	workloads, err := s.GetWorkloadsByNode(nodeID)
+	if err != nil {
+		return models.Node{}, fmt.Errorf("failed to list workloads on node %s: %w", nodeID, err)
+	}
+	total := 0
+	for _, w := range workloads {
+		if !strings.EqualFold(w.DesiredState, "Deleted") && !jobChildFinished(w) {
+			total++
+		}
+	}
+	node.Drain = &models.NodeDrainStatus{
+		State:          models.NodeDrainStateDraining,
+		Reason:         strings.TrimSpace(reason),
+		MaxUnavailable: maxUnavailable,
+		StartedAt:      time.Now().UTC(),
+		Total:          total,
+		Remaining:      total,
+	}
+	if err := s.saveNodeRecord(node); err != nil {
+		return models.Node{}, err
+	}
+	s.emitEvent("NodeDrainStarted", "", nodeID, node.Drain.Reason, map[string]interface{}{
+		"workloads":       total,
+		"max_unavailable": maxUnavailable,
+	})
+	nodeLogger.WithFields(logrus.Fields{
+		"node_id":         nodeID,
+		"workloads":       total,
+		"max_unavailable": maxUnavailable,
+	}).Info("draining node")
+	return node, nil
+}
+
+// ReconcileNodeDrains advances every drain in progress and evicts workloads
+// that do not tolerate a NoExecute taint on their node.
+func (s *Scheduler) ReconcileNodeDrains() error {
+	if !s.isWritable() {
+		return errControlPlaneFrozen
+	}
+	nodes, err := s.GetNodes()
+	if err != nil {
+		return err
+	}
+	var workloads []models.Workload
+	for _, node := range nodes {
+		draining := node.Drain != nil && node.Drain.State == models.NodeDrainStateDraining
+		noExecute := hasTaintEffect(node.Taints, models.TaintEffectNoExecute)
+		if !draining && !noExecute {
+			continue
+		}
+		if workloads == nil {
+			if workloads, err = s.GetWorkloads(); err != nil {
+				return err
+			}
+		}
+		if draining {
+			if err := s.reconcileNodeDrain(node, workloads); err != nil {
+				nodeLogger.WithError(err).WithField("node_id", node.NodeID).Warn("drain pass failed")
+			}
+			continue
+		}
+		s.evictNoExecuteWorkloads(node, workloads)
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcileNodeDrain(node models.Node, workloads []models.Workload) error {
+	drain := *node.Drain
+	byID := make(map[string]models.Workload, len(workloads))
+	for _, w := range workloads {
+		byID[w.ID] = w
+	}
+
+	// Settle moves from earlier passes: a workload stops counting against the
+	// budget once it runs on its new node, fails, or is deleted.
+	inFlight := make([]string, 0, len(drain.InFlight))
+	busyReplicaSets := map[string]bool{}
+	for _, id := range drain.InFlight {
+		w, ok := byID[id]
+		switch {
+		case !ok || strings.EqualFold(w.DesiredState, "Deleted"):
+			drain.Migrated++
+		case strings.EqualFold(w.Status, "Running"):
+			drain.Migrated++
+		case strings.EqualFold(w.Status, "Failed"):
+			drain.Failed++
+			drain.LastError = fmt.Sprintf("workload %s failed on node %s after eviction", w.ID, w.NodeID)
+		default:
+			inFlight = append(inFlight, id)
+			if rsID, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok && rsID != "" {
+				busyReplicaSets[rsID] = true
+			}
+		}
+	}
+
+	pending := make([]models.Workload, 0)
+	for _, w := range workloads {
+		if w.NodeID == node.NodeID && !strings.EqualFold(w.DesiredState, "Deleted") && !jobChildFinished(w) {
+			pending = append(pending, w)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool { return pending[i].ID < pending[j].ID })
+
+	budget := drain.MaxUnavailable - len(inFlight)
+	moved := 0
+	for _, w := range pending {
+		if budget <= 0 {
+			break
+		}
+		// Keep at most one replica of a replica set in flight so the set
+		// never loses more than one member to the drain at a time.
+		rsID, _ := metadataString(w.Metadata, replicaSetIDMetadataKey)
+		if rsID != "" && busyReplicaSets[rsID] {
+			continue
+		}
+		targetNode, err := s.evictWorkload(node, w, "node drain")
+		if err != nil {
+			drain.LastError = err.Error()
+			continue
+		}
+		moved++
+		if strings.EqualFold(w.DesiredState, "Stopped") {
+			drain.Migrated++
+			continue
+		}
+		inFlight = append(inFlight, w.ID)
+		budget--
+		if rsID != "" {
+			busyReplicaSets[rsID] = true
+		}
+		nodeLogger.WithFields(logrus.Fields{
+			"node_id":     node.NodeID,
+			"workload_id": w.ID,
+			"to_node":     targetNode,
+		}).Info("drain moved workload")
+	}
+
+	drain.InFlight = inFlight
+	drain.Remaining = len(pending) - moved
+	if done := drain.Migrated + drain.Failed + len(drain.InFlight) + drain.Remaining; done > drain.Total {
+		drain.Total = done
+	}
+	if drain.Remaining == 0 && len(drain.InFlight) == 0 {
+		drain.State = models.NodeDrainStateDrained
+		drain.CompletedAt = time.Now().UTC()
+	}
+
+	// Heartbeats rewrite the node record; re-read it and only touch the drain.
+	current, err := s.GetNodeByID(node.NodeID)
+	if err != nil {
+		return err
+	}
+	if current.Drain == nil || !current.Drain.StartedAt.Equal(drain.StartedAt) {
+		// Uncordoned or restarted while this pass ran.
+		return nil
+	}
+	if current.Drain.MaxUnavailable != node.Drain.MaxUnavailable {
+		drain.MaxUnavailable = current.Drain.MaxUnavailable
+	}
+	current.Drain = &drain
+	if err := s.saveNodeRecord(current); err != nil {
+		return err
+	}
+	if drain.State == models.NodeDrainStateDrained {
+		s.emitEvent("NodeDrained", "", node.NodeID, drain.Reason, map[string]interface{}{
+			"migrated": drain.Migrated,
+			"failed":   drain.Failed,
+		})
+		nodeLogger.WithFields(logrus.Fields{
+			"node_id":  node.NodeID,
+			"migrated": drain.Migrated,
+			"failed":   drain.Failed,
+		}).Info("node drained")
+	}
+	return nil
+}
+
+// evictNoExecuteWorkloads moves workloads that do not tolerate a NoExecute
+// taint on node, bounded by SCHEDULER_DRAIN_MAX_UNAVAILABLE per pass.
+func (s *Scheduler) evictNoExecuteWorkloads(node models.Node, workloads []models.Workload) {
+	budget := s.drainMaxUnavailable()
+	for _, w := range workloads {
+		if budget <= 0 {
+			return
+		}
+		if w.NodeID != node.NodeID || strings.EqualFold(w.DesiredState, "Deleted") || jobChildFinished(w) {
+			continue
+		}
+		taints := untoleratedTaints(node.Taints, w.Tolerations, models.TaintEffectNoExecute)
+		if len(taints) == 0 {
+			continue
+		}
+		if _, err := s.evictWorkload(node, w, "taint "+formatTaints(taints)); err != nil {
+			nodeLogger.WithError(err).WithFields(logrus.Fields{
+				"node_id":     node.NodeID,
+				"workload_id": w.ID,
+			}).Warn("NoExecute eviction pending")
+			continue
+		}
+		budget--
+	}
+}
+
+// evictWorkload reschedules w away from node through ScheduleWorkload and then
+// removes the old copy, so the workload is started elsewhere before it stops here.
+func (s *Scheduler) evictWorkload(node models.Node, w models.Workload, cause string) (string, error) {
+	if w.Metadata == nil {
+		w.Metadata = map[string]interface{}{}
+	}
+	w.Metadata["previous_node"] = node.NodeID
+	w.Retry.Attempts = 0
+	w.Retry.NextRetryAt = time.Time{}
+
+	targetNode, err := s.ScheduleWorkload(w)
+	if err != nil {
+		current, getErr := s.GetWorkloadByID(w.ID)
+		if getErr != nil || current.NodeID == node.NodeID || current.NodeID == "" {
+			return "", fmt.Errorf("evict workload %s from node %s: %w", w.ID, node.NodeID, err)
+		}
+		// Assigned elsewhere but the first apply failed; the reconciler
+		// retries it there, so the old copy still has to go.
+		targetNode = current.NodeID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.deleteTimeout())
+	defer cancel()
+	if _, delErr := s.deleteWorkloadFromNode(ctx, node, w.ID); delErr != nil && !isWorkloadStatusNotFound(delErr) {
+		nodeLogger.WithError(delErr).WithFields(logrus.Fields{
+			"node_id":     node.NodeID,
+			"workload_id": w.ID,
+		}).Warn("failed to remove evicted workload from old node")
+	}
+
+	reason := fmt.Sprintf("evicted from node %s (%s)", node.NodeID, cause)
+	_ = s.UpdateWorkloadLogs(w.ID, fmt.Sprintf("Evicted from node %s to %s: %s", node.NodeID, targetNode, cause))
+	s.emitEvent("WorkloadEvicted", w.ID, targetNode, reason, map[string]interface{}{"previous_node": node.NodeID})
+	return targetNode, nil
+}
+
+func (s *Scheduler) drainMaxUnavailable() int {
+	if s.cfg != nil && s.cfg.SchedulerDrainMaxUnavailable > 0 {
+		return s.cfg.SchedulerDrainMaxUnavailable
+	}
+	return 1
+}
+
+func (s *Scheduler) saveNodeRecord(node models.Node) error {
+	payload, err := json.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node %s: %w", node.NodeID, err)
+	}
+	if err := s.RetryableEtcdPut(nodesPrefix+node.NodeID, string(payload)); err != nil {
+		return fmt.Errorf("failed to persist node %s: %w", node.NodeID, err)
+	}
+	s.cacheNode(node)
+	return nil
+}
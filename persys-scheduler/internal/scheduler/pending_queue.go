@@ -0,0 +1,253 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	pendingBackoffBase = 5 * time.Second
+	pendingBackoffMax  = 2 * time.Minute
+
+	// workloadQueuedMetadataKey marks workloads that have a /pending entry, so
+	// assignment only touches the queue when there is something to remove.
+	workloadQueuedMetadataKey = "scheduling_queued"
+)
+
+// errWorkloadQueued means placement found no node and the workload now waits
+// in the pending queue; it is not a failure.
+var errWorkloadQueued = errors.New("workload queued until capacity is available")
+
+// unschedulableError reports that placement ran but rejected every node.
+type unschedulableError struct {
+	decision PlacementDecision
+	msg      string
+}
+
+func (e *unschedulableError) Error() string { return e.msg }
+
+func pendingBackoff(attempts int) time.Duration {
+	backoff := pendingBackoffBase
+	for i := 1; i < attempts && backoff < pendingBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > pendingBackoffMax {
+		return pendingBackoffMax
+	}
+	return backoff
+}
+
+func nodeRejections(decision PlacementDecision) []models.NodeRejection {
+	out := make([]models.NodeRejection, 0, len(decision.Evaluations))
+	for _, eval := range decision.Evaluations {
+		if eval.Feasible {
+			continue
+		}
+		out = append(out, models.NodeRejection{
+			NodeID: eval.NodeID,
+			Filter: rejectingFilter(eval),
+			Reason: eval.RejectionReason(),
+		})
+	}
+	return out
+}
+
+// activatePendingQueue makes every queued workload due on the next pass,
+// regardless of backoff. Call it when cluster capacity may have grown. The
+// signal is a write to pendingActivationKey, so it reaches the leader's queue
+// pass from whichever replica saw the change; entries whose last attempt is
+// older than that write are due.
+func (s *Scheduler) activatePendingQueue(cause string) {
+	if err := s.RetryableEtcdPut(pendingActivationKey, cause); err != nil {
+		schedulerLogger.WithError(err).WithField("cause", cause).Warn("failed to activate pending queue")
+		return
+	}
+	schedulerLogger.WithField("cause", cause).Debug("pending queue activated")
+}
+
+// pendingActivation returns the etcd revision of the last queue activation,
+// or 0 if the queue was never activated.
+func (s *Scheduler) pendingActivation() (int64, error) {
+	resp, err := s.RetryableEtcdGet(pendingActivationKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read pending queue activation: %w", err)
+	}
+	if resp == nil || len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	return resp.Kvs[0].ModRevision, nil
+}
+
+// enqueuePending records (or refreshes) the workload's queue entry with the
+// placement rejections and the next backoff, and marks the workload Pending.
+func (s *Scheduler) enqueuePending(workload *models.Workload, cause *unschedulableError) error {
+	entry, found, err := s.getPendingWorkload(workload.ID)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	if !found {
+		entry = models.PendingWorkload{WorkloadID: workload.ID, QueuedAt: now}
+	}
+	entry.Priority = workload.Priority
+	entry.PriorityClass = workload.PriorityClass
+	entry.Attempts++
+	entry.LastAttemptAt = now
+	entry.NextAttemptAt = now.Add(pendingBackoff(entry.Attempts))
+	entry.Message = cause.Error()
+	entry.Rejections = nodeRejections(cause.decision)
+
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending entry for workload %s: %w", workload.ID, err)
+	}
+	if err := s.RetryableEtcdPut(pendingKey(workload.ID), string(payload)); err != nil {
+		return fmt.Errorf("failed to queue workload %s: %w", workload.ID, err)
+	}
+
+	if workload.Metadata == nil {
+		workload.Metadata = map[string]interface{}{}
+	}
+	workload.Status = "Pending"
+	workload.StatusInfo.ActualState = "Pending"
+	workload.StatusInfo.LastUpdated = now
+	workload.Metadata[workloadQueuedMetadataKey] = true
+	workload.Metadata["last_action"] = "Queued"
+	workload.Metadata["scheduling_message"] = entry.Message
+	if err := s.saveWorkload(*workload); err != nil {
+		return err
+	}
+	if !found {
+		s.emitEvent("WorkloadQueued", workload.ID, "", entry.Message, map[string]interface{}{"priority": entry.Priority})
+	}
+	return nil
+}
+
+// dequeuePending drops the workload's queue entry and clears its marker.
+// The caller persists the workload.
+func (s *Scheduler) dequeuePending(workload *models.Workload) {
+	if !isMetadataTrue(workload.Metadata, workloadQueuedMetadataKey) {
+		return
+	}
+	delete(workload.Metadata, workloadQueuedMetadataKey)
+	delete(workload.Metadata, "scheduling_message")
+	if err := s.RetryableEtcdDelete(pendingKey(workload.ID)); err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to remove pending queue entry")
+	}
+}
+
+func (s *Scheduler) getPendingWorkload(workloadID string) (models.PendingWorkload, bool, error) {
+	resp, err := s.RetryableEtcdGet(pendingKey(workloadID))
+	if err != nil {
+		return models.PendingWorkload{}, false, fmt.Errorf("failed to get pending entry for workload %s: %w", workloadID, err)
+	}
+	if resp == nil || len(resp.Kvs) == 0 {
+		return models.PendingWorkload{}, false, nil
+	}
+	var entry models.PendingWorkload
+	if err := json.Unmarshal(resp.Kvs[0].Value, &entry); err != nil {
+		return models.PendingWorkload{}, false, fmt.Errorf("failed to unmarshal pending entry for workload %s: %w", workloadID, err)
+	}
+	return entry, true, nil
+}
+
+// pendingQueueEntry is a queue entry with the etcd revision of its last write,
+// which is its last placement attempt.
+type pendingQueueEntry struct {
+	models.PendingWorkload
+	modRevision int64
+}
+
+// ListPendingWorkloads returns the scheduling queue, highest priority first,
+// then oldest first.
+func (s *Scheduler) ListPendingWorkloads() ([]models.PendingWorkload, error) {
+	entries, err := s.listPendingQueue()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]models.PendingWorkload, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, entry.PendingWorkload)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) listPendingQueue() ([]pendingQueueEntry, error) {
+	resp, err := s.RetryableEtcdGet(pendingPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending workloads: %w", err)
+	}
+	out := make([]pendingQueueEntry, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var entry models.PendingWorkload
+		if err := json.Unmarshal(kv.Value, &entry); err != nil {
+			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal pending entry")
+			continue
+		}
+		out = append(out, pendingQueueEntry{PendingWorkload: entry, modRevision: kv.ModRevision})
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Priority != out[j].Priority {
+			return out[i].Priority > out[j].Priority
+		}
+		if !out[i].QueuedAt.Equal(out[j].QueuedAt) {
+			return out[i].QueuedAt.Before(out[j].QueuedAt)
+		}
+		return out[i].WorkloadID < out[j].WorkloadID
+	})
+	return out, nil
+}
+
+// ProcessPendingQueue retries placement for queued workloads in queue order.
+// An entry is due once its backoff expires or the queue was activated after
+// its last attempt.
+func (s *Scheduler) ProcessPendingQueue() error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	entries, err := s.listPendingQueue()
+	if err != nil {
+		return err
+	}
+	activatedRev, err := s.pendingActivation()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, entry := range entries {
+		if now.Before(entry.NextAttemptAt) && entry.modRevision > activatedRev {
+			continue
+		}
+		workload, err := s.GetWorkloadByID(entry.WorkloadID)
+		if err != nil {
+			if errors.Is(err, ErrWorkloadNotFound) {
+				_ = s.RetryableEtcdDelete(pendingKey(entry.WorkloadID))
+			}
+			continue
+		}
+		if strings.EqualFold(workload.DesiredState, "Deleted") || strings.TrimSpace(workload.NodeID) != "" {
+			if isMetadataTrue(workload.Metadata, workloadQueuedMetadataKey) {
+				s.dequeuePending(&workload)
+				_ = s.saveWorkload(workload)
+			} else {
+				_ = s.RetryableEtcdDelete(pendingKey(entry.WorkloadID))
+			}
+			continue
+		}
+		if err := s.EnsureWorkloadAssigned(&workload); err != nil && !errors.Is(err, errWorkloadQueued) {
+			schedulerLogger.WithError(err).WithFields(logrus.Fields{
+				"workload_id": workload.ID,
+				"attempts":    entry.Attempts,
+			}).Warn("pending workload placement failed")
+		}
+	}
+	return nil
+}
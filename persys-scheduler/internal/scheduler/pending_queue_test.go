@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestPendingBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: 5 * time.Second},
+		{attempts: 1, want: 5 * time.Second},
+		{attempts: 2, want: 10 * time.Second},
+		{attempts: 3, want: 20 * time.Second},
+		{attempts: 5, want: 80 * time.Second},
+		{attempts: 6, want: 2 * time.Minute},
+		{attempts: 1000, want: 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := pendingBackoff(tt.attempts); got != tt.want {
+			t.Fatalf("pendingBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestPendingQueueActivationReachesOtherReplicas(t *testing.T) {
+	kv := newFakeKV()
+	leader := newLedgerTestScheduler(kv)
+	follower := newLedgerTestScheduler(kv)
+
+	entry := models.PendingWorkload{WorkloadID: "gone", Attempts: 1, NextAttemptAt: time.Now().Add(time.Hour)}
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatalf("marshal pending entry: %v", err)
+	}
+	if _, err := kv.Put(context.Background(), pendingKey("gone"), string(payload)); err != nil {
+		t.Fatalf("put pending entry: %v", err)
+	}
+
+	if err := leader.ProcessPendingQueue(); err != nil {
+		t.Fatalf("ProcessPendingQueue: %v", err)
+	}
+	if pending, _ := leader.ListPendingWorkloads(); len(pending) != 1 {
+		t.Fatalf("an entry in backoff must wait, got %d entries", len(pending))
+	}
+
+	follower.activatePendingQueue("node registered")
+	if err := leader.ProcessPendingQueue(); err != nil {
+		t.Fatalf("ProcessPendingQueue: %v", err)
+	}
+	if pending, _ := leader.ListPendingWorkloads(); len(pending) != 0 {
+		t.Fatalf("expected the activated entry of a missing workload to be dropped, got %d entries", len(pending))
+	}
+}
+
+func TestGetWorkloadByIDNotFound(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	if _, err := s.GetWorkloadByID("missing"); !errors.Is(err, ErrWorkloadNotFound) {
+		t.Fatalf("expected ErrWorkloadNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,672 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	cfgpkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/config"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+const (
+	placementStrategySpread  = "spread"
+	placementStrategyBinpack = "binpack"
+)
+
+// PluginResult is the outcome of one placement plugin on one node.
+type PluginResult struct {
+	Plugin string  `json:"plugin"`
+	Passed bool    `json:"passed"`
+	Reason string  `json:"reason,omitempty"`
+	Score  float64 `json:"score,omitempty"`
+	Weight float64 `json:"weight,omitempty"`
+}
+
+// NodeEvaluation collects the filter and score results for one node.
+type NodeEvaluation struct {
+	NodeID   string         `json:"nodeId"`
+	Feasible bool           `json:"feasible"`
+	Filters  []PluginResult `json:"filters,omitempty"`
+	Scores   []PluginResult `json:"scores,omitempty"`
+	Total    float64        `json:"total"`
+	Rank     int            `json:"rank,omitempty"` // 1 for the selected node, 0 when infeasible
+}
+
+// RejectionReason returns the reason of the filter that rejected the node.
+func (e NodeEvaluation) RejectionReason() string {
+	for _, f := range e.Filters {
+		if !f.Passed {
+			return f.Reason
+		}
+	}
+	return ""
+}
+
+// PlacementDecision is the result of running the placement pipeline. Node is
+// only set when at least one node was feasible.
+type PlacementDecision struct {
+	Node        *models.Node
+	Reason      string
+	Strategy    string
+	Evaluations []NodeEvaluation
+}
+
+type placementContext struct {
+	workload       models.Workload
+	policy         models.PlacementPolicy
+	strategy       string
+	group          string
+	storageDrivers []string
+	storageDemand  map[string]int64
+	volumeNodes    map[string]string // local volumes attached by ID -> node holding them
+	request        models.WorkloadAllocation
+	allocations    map[string]models.NodeAllocationSummary
+	nodes          []models.Node
+	peersByNode    map[string]int
+	affinityPeers  map[string]int
+	hostPortStart  int
+	hostPortEnd    int
+	now            time.Time
+}
+
+type filterPlugin interface {
+	Name() string
+	// Filter reports whether node can host the workload, and why not.
+	Filter(pc *placementContext, node models.Node) (bool, string)
+}
+
+type scorePlugin interface {
+	Name() string
+	// Score returns a value in [0, 100]; higher is better.
+	Score(pc *placementContext, node models.Node) float64
+}
+
+type filterFunc struct {
+	name string
+	fn   func(pc *placementContext, node models.Node) (bool, string)
+}
+
+func (f filterFunc) Name() string { return f.name }
+func (f filterFunc) Filter(pc *placementContext, node models.Node) (bool, string) {
+	return f.fn(pc, node)
+}
+
+type scoreFunc struct {
+	name string
+	fn   func(pc *placementContext, node models.Node) float64
+}
+
+func (f scoreFunc) Name() string { return f.name }
+func (f scoreFunc) Score(pc *placementContext, node models.Node) float64 {
+	return f.fn(pc, node)
+}
+
+// placementPipeline runs filters in order, stopping at the first rejection,
+// then ranks feasible nodes by the weighted sum of all scores.
+type placementPipeline struct {
+	filters  []filterPlugin
+	scorers  []scorePlugin
+	weights  map[string]float64
+	strategy string
+}
+
+func newPlacementPipeline(cfg *cfgpkg.Config) *placementPipeline {
+	p := &placementPipeline{
+		filters: []filterPlugin{
+			filterFunc{"node_ready", filterNodeReady},
+			filterFunc{"heartbeat", filterHeartbeatFresh},
+			filterFunc{"unschedulable", filterUnschedulable},
+			filterFunc{"deschedule_source", filterDescheduleSource},
+			filterFunc{"taints", filterTaints},
+			filterFunc{"node_selector", filterNodeSelector},
+			filterFunc{"node_affinity", filterRequiredNodeAffinity},
+			filterFunc{"workload_type", filterWorkloadType},
+			filterFunc{"storage_driver", filterStorageDrivers},
+			filterFunc{"volume_locality", filterVolumeLocality},
+			filterFunc{"storage_capacity", filterStorageCapacity},
+			filterFunc{"host_ports", filterHostPorts},
+			filterFunc{"resources", filterResources},
+			filterFunc{"anti_affinity", filterRequiredAntiAffinity},
+			filterFunc{"affinity", filterAffinityGroup},
+		},
+		scorers: []scorePlugin{
+			scoreFunc{"utilization", scoreUtilization},
+			scoreFunc{"topology_spread", scoreTopologySpread},
+			scoreFunc{"anti_affinity", scoreAntiAffinity},
+			scoreFunc{"node_affinity", scorePreferredNodeAffinity},
+			scoreFunc{"taint", scorePreferNoScheduleTaints},
+		},
+		weights: map[string]float64{
+			"utilization":     1,
+			"topology_spread": 2,
+			"anti_affinity":   2,
+			"node_affinity":   1,
+		},
+		strategy: placementStrategySpread,
+	}
+	if cfg != nil {
+		for name, weight := range cfg.SchedulerScoreWeights {
+			p.weights[name] = weight
+		}
+		if cfg.SchedulerPlacementStrategy != "" {
+			p.strategy = cfg.SchedulerPlacementStrategy
+		}
+	}
+	return p
+}
+
+func (p *placementPipeline) weight(name string) float64 {
+	if w, ok := p.weights[name]; ok {
+		return w
+	}
+	return 1
+}
+
+func (p *placementPipeline) evaluate(pc *placementContext) PlacementDecision {
+	decision := PlacementDecision{Strategy: pc.strategy, Evaluations: make([]NodeEvaluation, 0, len(pc.nodes))}
+	feasible := make([]int, 0, len(pc.nodes))
+	for _, node := range pc.nodes {
+		eval := NodeEvaluation{NodeID: node.NodeID, Feasible: true}
+		for _, f := range p.filters {
+			ok, reason := f.Filter(pc, node)
+			eval.Filters = append(eval.Filters, PluginResult{Plugin: f.Name(), Passed: ok, Reason: reason})
+			if !ok {
+				eval.Feasible = false
+				break
+			}
+		}
+		if eval.Feasible {
+			for _, sc := range p.scorers {
+				w := p.weight(sc.Name())
+				if w == 0 {
+					continue
+				}
+				score := sc.Score(pc, node)
+				eval.Scores = append(eval.Scores, PluginResult{Plugin: sc.Name(), Passed: true, Score: score, Weight: w})
+				eval.Total += w * score
+			}
+			feasible = append(feasible, len(decision.Evaluations))
+		}
+		decision.Evaluations = append(decision.Evaluations, eval)
+	}
+	if len(feasible) == 0 {
+		return decision
+	}
+
+	sort.SliceStable(feasible, func(i, j int) bool {
+		a, b := decision.Evaluations[feasible[i]], decision.Evaluations[feasible[j]]
+		if a.Total != b.Total {
+			return a.Total > b.Total
+		}
+		ua, ub := tiebreakUtilization(pc.strategy, pc.nodes[feasible[i]]), tiebreakUtilization(pc.strategy, pc.nodes[feasible[j]])
+		if ua != ub {
+			return ua > ub
+		}
+		return a.NodeID < b.NodeID
+	})
+	for i, idx := range feasible {
+		decision.Evaluations[idx].Rank = i + 1
+	}
+	best := feasible[0]
+	node := pc.nodes[best]
+	decision.Node = &node
+	decision.Reason = placementReason(pc.strategy, decision.Evaluations[best])
+	return decision
+}
+
+// tiebreakUtilization orders nodes with equal scores: binpack prefers the
+// busier node, spread the idler one. Nodes without known capacity come last
+// under either strategy.
+func tiebreakUtilization(strategy string, node models.Node) float64 {
+	if node.TotalCPU <= 0 || node.TotalMemory <= 0 {
+		return math.Inf(-1)
+	}
+	used := nodeUtilizationScore(node)
+	if strategy == placementStrategyBinpack {
+		return used
+	}
+	return -used
+}
+
+func placementReason(strategy string, eval NodeEvaluation) string {
+	parts := make([]string, 0, len(eval.Scores))
+	for _, sc := range eval.Scores {
+		parts = append(parts, fmt.Sprintf("%s=%.1f", sc.Plugin, sc.Score))
+	}
+	return fmt.Sprintf("selected by %s placement score %.2f (%s)", strategy, eval.Total, strings.Join(parts, " "))
+}
+
+// placementGroup is the co-location group used by anti-affinity and topology
+// spread. Replica set children fall back to their replica set.
+func placementGroup(w models.Workload) string {
+	if w.Placement != nil && strings.TrimSpace(w.Placement.AntiAffinityGroup) != "" {
+		return strings.TrimSpace(w.Placement.AntiAffinityGroup)
+	}
+	if id, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok {
+		return strings.TrimSpace(id)
+	}
+	return ""
+}
+
+func (s *Scheduler) newPlacementContext(workload models.Workload, nodes []models.Node, defaultStrategy string) *placementContext {
+	pc := &placementContext{
+		workload:       workload,
+		strategy:       defaultStrategy,
+		group:          placementGroup(workload),
+		storageDrivers: requiredStorageDrivers(workload),
+		storageDemand:  workloadStorageDemand(workload),
+		volumeNodes:    s.localVolumeNodes(workload),
+		request:        workloadAllocation(workload),
+		nodes:          nodes,
+		peersByNode:    map[string]int{},
+		affinityPeers:  map[string]int{},
+		now:            time.Now(),
+	}
+	pc.hostPortStart, pc.hostPortEnd = s.hostPortRange()
+	if workload.Placement != nil {
+		pc.policy = *workload.Placement
+		switch strings.ToLower(strings.TrimSpace(workload.Placement.Strategy)) {
+		case placementStrategySpread:
+			pc.strategy = placementStrategySpread
+		case placementStrategyBinpack:
+			pc.strategy = placementStrategyBinpack
+		}
+	}
+	if allocations, err := s.listNodeAllocations(); err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to load allocation ledgers for placement")
+	} else {
+		pc.allocations = allocations
+	}
+	affinityGroup := strings.TrimSpace(pc.policy.AffinityGroup)
+	if pc.group == "" && affinityGroup == "" {
+		return pc
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to load workloads for placement group")
+		return pc
+	}
+	for _, w := range workloads {
+		if w.ID == workload.ID || strings.EqualFold(w.DesiredState, "Deleted") {
+			continue
+		}
+		nodeID := w.NodeID
+		if nodeID == "" {
+			nodeID = w.AssignedNode
+		}
+		if nodeID == "" {
+			continue
+		}
+		if pc.group != "" && placementGroup(w) == pc.group {
+			pc.peersByNode[nodeID]++
+		}
+		if affinityGroup != "" && w.Placement != nil && strings.TrimSpace(w.Placement.AffinityGroup) == affinityGroup {
+			pc.affinityPeers[nodeID]++
+		}
+	}
+	return pc
+}
+
+// evaluatePlacement runs the placement pipeline for workload over nodes.
+func (s *Scheduler) evaluatePlacement(workload models.Workload, nodes []models.Node) PlacementDecision {
+	pipeline := s.placement
+	if pipeline == nil {
+		pipeline = newPlacementPipeline(s.cfg)
+	}
+	return pipeline.evaluate(s.newPlacementContext(workload, nodes, pipeline.strategy))
+}
+
+func filterNodeReady(_ *placementContext, node models.Node) (bool, string) {
+	if strings.EqualFold(node.Status, "active") || strings.EqualFold(node.Status, "ready") {
+		return true, ""
+	}
+	reason := strings.TrimSpace(node.StatusReason)
+	if reason == "" {
+		reason = "unspecified"
+	}
+	return false, fmt.Sprintf("status=%s reason=%q by=%s at=%s", node.Status, reason, node.StatusUpdatedBy, node.StatusUpdatedAt.UTC().Format(time.RFC3339))
+}
+
+func filterHeartbeatFresh(pc *placementContext, node models.Node) (bool, string) {
+	if pc.now.Sub(node.LastHeartbeat) > 10*time.Minute {
+		return false, fmt.Sprintf("heartbeat_stale last=%s", node.LastHeartbeat.UTC().Format(time.RFC3339))
+	}
+	return true, ""
+}
+
+func filterUnschedulable(_ *placementContext, node models.Node) (bool, string) {
+	if !node.Unschedulable {
+		return true, ""
+	}
+	if node.Drain != nil {
+		return false, fmt.Sprintf("node_unschedulable drain=%s", node.Drain.State)
+	}
+	return false, "node_unschedulable cordoned"
+}
+
+func filterTaints(pc *placementContext, node models.Node) (bool, string) {
+	taints := untoleratedTaints(node.Taints, pc.workload.Tolerations, models.TaintEffectNoSchedule, models.TaintEffectNoExecute)
+	if len(taints) > 0 {
+		return false, fmt.Sprintf("taint_not_tolerated taints=%s", formatTaints(taints))
+	}
+	return true, ""
+}
+
+func filterNodeSelector(pc *placementContext, node models.Node) (bool, string) {
+	if !matchesLabels(pc.workload.Labels, node.Labels) {
+		return false, "label_mismatch"
+	}
+	return true, ""
+}
+
+func filterRequiredNodeAffinity(pc *placementContext, node models.Node) (bool, string) {
+	for _, req := range pc.policy.RequiredNodeAffinity {
+		if !nodeSelectorRequirementMatches(req, node.Labels) {
+			return false, fmt.Sprintf("node_affinity_unsatisfied key=%s op=%s values=%v", req.Key, req.Operator, req.Values)
+		}
+	}
+	return true, ""
+}
+
+func filterWorkloadType(pc *placementContext, node models.Node) (bool, string) {
+	if !nodeSupportsWorkloadType(node, pc.workload.Type) {
+		return false, fmt.Sprintf("workload_type_unsupported need=%s supports=%v", canonicalWorkloadType(pc.workload.Type), node.SupportedWorkloadTypes)
+	}
+	return true, ""
+}
+
+func filterStorageDrivers(pc *placementContext, node models.Node) (bool, string) {
+	if !nodeSupportsStorageDrivers(node, pc.storageDrivers) {
+		return false, fmt.Sprintf("storage_driver_unsupported need=%v supports=%v", pc.storageDrivers, node.SupportedStorageDrivers)
+	}
+	return true, ""
+}
+
+// filterStorageCapacity checks disk demand against the pools the node
+// reported. Demand for a named pool needs that pool; unpooled demand lands on
+// the agent's default pool, so it has to fit in a single pool. Nodes that do
+// not report pools are only rejected for named pools.
+func filterStorageCapacity(pc *placementContext, node models.Node) (bool, string) {
+	if len(pc.storageDemand) == 0 {
+		return true, ""
+	}
+	pools := make([]string, 0, len(pc.storageDemand))
+	for pool := range pc.storageDemand {
+		if pool != "" {
+			pools = append(pools, pool)
+		}
+	}
+	sort.Strings(pools)
+	for _, name := range pools {
+		need := pc.storageDemand[name]
+		pool, ok := findStoragePool(node, name)
+		if !ok {
+			return false, fmt.Sprintf("storage_pool_missing pool=%s pools=%v", name, storagePoolNames(node))
+		}
+		if free := pc.storagePoolFreeGB(node.NodeID, pool); free < need {
+			return false, fmt.Sprintf("storage_insufficient pool=%s need=%dGB have=%dGB", name, need, free)
+		}
+	}
+	need := pc.storageDemand[""]
+	if need <= 0 || len(node.StoragePools) == 0 {
+		return true, ""
+	}
+	best := int64(-1)
+	for _, pool := range node.StoragePools {
+		free := pc.storagePoolFreeGB(node.NodeID, pool) + pc.ownPoolGB(node.NodeID, "") - pc.storageDemand[pool.Name]
+		if free > best {
+			best = free
+		}
+	}
+	if best < need {
+		if best < 0 {
+			best = 0
+		}
+		return false, fmt.Sprintf("storage_insufficient pool=any need=%dGB have=%dGB", need, best)
+	}
+	return true, ""
+}
+
+// filterResources checks the request against what is both unused (agent
+// heartbeat) and unreserved (allocation ledger). The workload's own
+// reservation on the node, if any, does not count against it.
+func filterResources(pc *placementContext, node models.Node) (bool, string) {
+	availableCPU, availableMemory := node.AvailableCPU, node.AvailableMemory
+	if allocated, ok := pc.allocations[node.NodeID]; ok {
+		if node.TotalCPU > 0 {
+			if free := node.TotalCPU - allocated.CPU + pc.ownCPU(node.NodeID); free < availableCPU {
+				availableCPU = free
+			}
+		}
+		if node.TotalMemory > 0 {
+			if free := node.TotalMemory - allocated.MemoryMB + pc.ownMemoryMB(node.NodeID); free < availableMemory {
+				availableMemory = free
+			}
+		}
+	}
+	if need := pc.request.CPU; need > 0 && availableCPU < need {
+		return false, fmt.Sprintf("cpu_insufficient need=%.3f have=%.3f", need, availableCPU)
+	}
+	if need := pc.request.MemoryMB; need > 0 && availableMemory < need {
+		return false, fmt.Sprintf("memory_insufficient need=%dMB have=%dMB", need, availableMemory)
+	}
+	return true, ""
+}
+
+// ownCPU and ownMemoryMB return the workload's current reservation on nodeID,
+// so re-placing a workload on its own node is not rejected by itself.
+func (pc *placementContext) ownCPU(nodeID string) float64 {
+	if pc.workload.NodeID != nodeID {
+		return 0
+	}
+	return pc.request.CPU
+}
+
+func (pc *placementContext) ownMemoryMB(nodeID string) int64 {
+	if pc.workload.NodeID != nodeID {
+		return 0
+	}
+	return pc.request.MemoryMB
+}
+
+// ownPoolGB is the same for disk: the workload's demand on pool ("" for
+// unpooled disks) when it already occupies nodeID.
+func (pc *placementContext) ownPoolGB(nodeID, pool string) int64 {
+	if pc.workload.NodeID != nodeID {
+		return 0
+	}
+	return pc.storageDemand[pool]
+}
+
+func filterRequiredAntiAffinity(pc *placementContext, node models.Node) (bool, string) {
+	if !pc.policy.AntiAffinityRequired || pc.group == "" {
+		return true, ""
+	}
+	if peers := pc.peersByNode[node.NodeID]; peers > 0 {
+		return false, fmt.Sprintf("anti_affinity_conflict group=%s peers=%d", pc.group, peers)
+	}
+	return true, ""
+}
+
+// filterAffinityGroup keeps the members of an affinity group together: once
+// one of them is placed, only nodes already hosting a member pass.
+func filterAffinityGroup(pc *placementContext, node models.Node) (bool, string) {
+	if len(pc.affinityPeers) == 0 || pc.affinityPeers[node.NodeID] > 0 {
+		return true, ""
+	}
+	nodes := make([]string, 0, len(pc.affinityPeers))
+	for nodeID := range pc.affinityPeers {
+		nodes = append(nodes, nodeID)
+	}
+	sort.Strings(nodes)
+	return false, fmt.Sprintf("affinity_group_elsewhere group=%s nodes=%v", pc.policy.AffinityGroup, nodes)
+}
+
+func scoreUtilization(pc *placementContext, node models.Node) float64 {
+	if node.TotalCPU <= 0 || node.TotalMemory <= 0 {
+		return 0
+	}
+	used := nodeUtilizationScore(node)
+	if used < 0 {
+		used = 0
+	}
+	if used > 1 {
+		used = 1
+	}
+	if pc.strategy == placementStrategyBinpack {
+		return used * 100
+	}
+	return (1 - used) * 100
+}
+
+// scoreTopologySpread prefers nodes whose topology domain (the value of the
+// spread label) holds the fewest members of the workload's group.
+func scoreTopologySpread(pc *placementContext, node models.Node) float64 {
+	key := strings.TrimSpace(pc.policy.SpreadTopologyKey)
+	if key == "" || pc.group == "" {
+		return 0
+	}
+	domain, ok := node.Labels[key]
+	if !ok {
+		return 0
+	}
+	peers := 0
+	for _, other := range pc.nodes {
+		if other.Labels[key] == domain {
+			peers += pc.peersByNode[other.NodeID]
+		}
+	}
+	return 100 / float64(1+peers)
+}
+
+func scoreAntiAffinity(pc *placementContext, node models.Node) float64 {
+	if pc.group == "" {
+		return 0
+	}
+	return 100 / float64(1+pc.peersByNode[node.NodeID])
+}
+
+func scorePreferredNodeAffinity(pc *placementContext, node models.Node) float64 {
+	total, matched := 0, 0
+	for _, pref := range pc.policy.PreferredNodeAffinity {
+		if pref.Weight <= 0 {
+			continue
+		}
+		total += pref.Weight
+		if nodeSelectorRequirementMatches(pref.Requirement, node.Labels) {
+			matched += pref.Weight
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(matched) / float64(total)
+}
+
+func scorePreferNoScheduleTaints(pc *placementContext, node models.Node) float64 {
+	return 100 / float64(1+len(untoleratedTaints(node.Taints, pc.workload.Tolerations, models.TaintEffectPreferNoSchedule)))
+}
+
+func nodeSelectorRequirementMatches(req models.NodeSelectorRequirement, labels map[string]string) bool {
+	value, ok := labels[req.Key]
+	switch strings.ToLower(strings.TrimSpace(req.Operator)) {
+	case "", "in":
+		return ok && containsString(req.Values, value)
+	case "notin":
+		return !ok || !containsString(req.Values, value)
+	case "exists":
+		return ok
+	case "doesnotexist":
+		return !ok
+	default:
+		return false
+	}
+}
+
+// workloadStorageDemand sums the node-local disk a workload needs, in GB, per
+// storage pool. The empty key is disk that may come from any pool. Remote
+// managed volumes (nfs, ceph-rbd) and volumes attached by ID, which already
+// exist, do not consume new node storage.
+func workloadStorageDemand(w models.Workload) map[string]int64 {
+	demand := map[string]int64{}
+	addVolumes := func(volumes []models.ManagedVolumeSpec) {
+		for _, mv := range volumes {
+			if strings.TrimSpace(mv.VolumeID) != "" {
+				continue
+			}
+			switch strings.ToLower(strings.TrimSpace(mv.Driver)) {
+			case "", "local":
+				if mv.SizeGB > 0 {
+					demand[""] += mv.SizeGB
+				}
+			}
+		}
+	}
+	addVolumes(w.ManagedVolumes)
+	if w.VM != nil {
+		for _, disk := range w.VM.Disks {
+			if strings.EqualFold(strings.TrimSpace(disk.Type), "cdrom") {
+				continue
+			}
+			size := disk.SizeGB
+			if size <= 0 {
+				size = defaultVMDiskSizeGB
+			}
+			demand[strings.TrimSpace(disk.Pool)] += size
+		}
+		addVolumes(w.VM.ManagedVolumes)
+	}
+	if len(demand) == 0 {
+		return nil
+	}
+	return demand
+}
+
+func findStoragePool(node models.Node, name string) (models.StoragePool, bool) {
+	for _, pool := range node.StoragePools {
+		if pool.Name == name {
+			return pool, true
+		}
+	}
+	return models.StoragePool{}, false
+}
+
+func storagePoolNames(node models.Node) []string {
+	names := make([]string, 0, len(node.StoragePools))
+	for _, pool := range node.StoragePools {
+		names = append(names, pool.Name)
+	}
+	return names
+}
+
+// storagePoolFreeGB also subtracts disks the ledger has reserved in the
+// pool but the agent has not provisioned yet. The workload's own disks in the
+// pool, if it already runs on the node, do not count against it.
+func (pc *placementContext) storagePoolFreeGB(nodeID string, pool models.StoragePool) int64 {
+	free := storagePoolFreeGB(pool)
+	if allocated, ok := pc.allocations[nodeID]; ok {
+		if ledgerFree := pool.TotalGB - allocated.Pools[pool.Name]; ledgerFree < free {
+			free = ledgerFree
+		}
+	}
+	if free < 0 {
+		free = 0
+	}
+	return free + pc.ownPoolGB(nodeID, pool.Name)
+}
+
+// storagePoolFreeGB is what is left after provisioned disks. Thin-provisioned
+// pools can report used above allocated, so the larger of the two counts.
+func storagePoolFreeGB(pool models.StoragePool) int64 {
+	consumed := pool.AllocatedGB
+	if pool.UsedGB > consumed {
+		consumed = pool.UsedGB
+	}
+	if free := pool.TotalGB - consumed; free > 0 {
+		return free
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestFilterStorageCapacity(t *testing.T) {
+	vm := func(nodeID string, disks ...models.VMDiskConfig) models.Workload {
+		return models.Workload{ID: "vm", NodeID: nodeID, VM: &models.VMSpec{Disks: disks}}
+	}
+	tests := []struct {
+		name     string
+		workload models.Workload
+		pool     models.StoragePool
+		ledger   map[string]int64
+		want     bool
+	}{
+		{
+			name:     "named pool fits",
+			workload: vm("", models.VMDiskConfig{Pool: "fast", SizeGB: 40}),
+			pool:     models.StoragePool{Name: "fast", TotalGB: 100, AllocatedGB: 50},
+			ledger:   map[string]int64{"fast": 50},
+			want:     true,
+		},
+		{
+			name:     "named pool full",
+			workload: vm("", models.VMDiskConfig{Pool: "fast", SizeGB: 60}),
+			pool:     models.StoragePool{Name: "fast", TotalGB: 100, AllocatedGB: 50},
+			ledger:   map[string]int64{"fast": 50},
+		},
+		{
+			name:     "re-placed on its own node with a named pool",
+			workload: vm("n1", models.VMDiskConfig{Pool: "fast", SizeGB: 60}),
+			pool:     models.StoragePool{Name: "fast", TotalGB: 100, AllocatedGB: 90},
+			ledger:   map[string]int64{"fast": 90},
+			want:     true,
+		},
+		{
+			name:     "re-placed on its own node with an unpooled disk",
+			workload: vm("n1", models.VMDiskConfig{SizeGB: 60}),
+			pool:     models.StoragePool{Name: "default", TotalGB: 100, AllocatedGB: 90},
+			want:     true,
+		},
+		{
+			name:     "unpooled disk on another node",
+			workload: vm("n2", models.VMDiskConfig{SizeGB: 60}),
+			pool:     models.StoragePool{Name: "default", TotalGB: 100, AllocatedGB: 90},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := models.Node{NodeID: "n1", StoragePools: []models.StoragePool{tt.pool}}
+			pc := &placementContext{
+				workload:      tt.workload,
+				request:       workloadAllocation(tt.workload),
+				storageDemand: workloadStorageDemand(tt.workload),
+				allocations:   map[string]models.NodeAllocationSummary{node.NodeID: {Pools: tt.ledger}},
+			}
+			if got, reason := filterStorageCapacity(pc, node); got != tt.want {
+				t.Fatalf("filterStorageCapacity = %v (%s), want %v", got, reason, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlacementTiebreakFollowsStrategy(t *testing.T) {
+	nodes := []models.Node{
+		{NodeID: "a-unknown"},
+		{NodeID: "b-idle", TotalCPU: 4, AvailableCPU: 3, TotalMemory: 4096, AvailableMemory: 3072},
+		{NodeID: "c-busy", TotalCPU: 4, AvailableCPU: 1, TotalMemory: 4096, AvailableMemory: 1024},
+	}
+	// No scorers, so every node ties on score and only the tiebreak decides.
+	pipeline := &placementPipeline{}
+	for strategy, want := range map[string]string{placementStrategyBinpack: "c-busy", placementStrategySpread: "b-idle"} {
+		decision := pipeline.evaluate(&placementContext{strategy: strategy, nodes: nodes})
+		if decision.Node == nil || decision.Node.NodeID != want {
+			t.Fatalf("%s: expected %s, got %+v", strategy, want, decision.Node)
+		}
+	}
+}
+
+func TestSimulatePlacementMarksInvalidWorkloads(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	for name, w := range map[string]models.Workload{
+		"missing type":   {ID: "w1"},
+		"unknown class":  {ID: "w1", Type: "container", PriorityClass: "gold"},
+		"malformed port": {ID: "w1", Type: "container", Ports: []string{"http"}},
+	} {
+		if _, err := s.SimulatePlacement(w); !errors.Is(err, ErrInvalidSimulation) {
+			t.Fatalf("%s: expected ErrInvalidSimulation, got %v", name, err)
+		}
+	}
+	if _, err := s.SimulatePlacement(models.Workload{ID: "w1", Type: "container"}); err != nil {
+		t.Fatalf("expected a valid workload to simulate, got %v", err)
+	}
+}
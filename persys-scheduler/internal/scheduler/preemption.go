@@ -0,0 +1,466 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// preemptibleFilters are the rejections that removing workloads from a node
+// can fix. Nodes rejected for anything else are never preemption targets.
+var preemptibleFilters = map[string]bool{
+	"resources":        true,
+	"storage_capacity": true,
+	"anti_affinity":    true,
+}
+
+// preemptionPlan is the victim set chosen on one node.
+type preemptionPlan struct {
+	node    models.Node
+	victims []models.Workload
+}
+
+func (p preemptionPlan) maxVictimPriority() int {
+	highest := p.victims[0].Priority
+	for _, v := range p.victims[1:] {
+		if v.Priority > highest {
+			highest = v.Priority
+		}
+	}
+	return highest
+}
+
+func (p preemptionPlan) victimPrioritySum() int {
+	sum := 0
+	for _, v := range p.victims {
+		sum += v.Priority
+	}
+	return sum
+}
+
+func (p preemptionPlan) victimIDs() []string {
+	ids := make([]string, 0, len(p.victims))
+	for _, v := range p.victims {
+		ids = append(ids, v.ID)
+	}
+	return ids
+}
+
+// resolveWorkloadPriority sets Priority from PriorityClass. A class always
+// wins over an explicit priority; unknown classes are rejected.
+func (s *Scheduler) resolveWorkloadPriority(workload *models.Workload) error {
+	class := strings.ToLower(strings.TrimSpace(workload.PriorityClass))
+	workload.PriorityClass = class
+	if class == "" {
+		return nil
+	}
+	if s.cfg == nil {
+		return fmt.Errorf("unknown priority class %q", class)
+	}
+	priority, ok := s.cfg.SchedulerPriorityClasses[class]
+	if !ok {
+		return fmt.Errorf("unknown priority class %q", class)
+	}
+	workload.Priority = priority
+	return nil
+}
+
+func (s *Scheduler) preemptionEnabled() bool {
+	return s.cfg == nil || s.cfg.SchedulerPreemptionEnabled
+}
+
+// selectNodeOrPreempt runs placement and, when no node fits, tries to make
+// room by preempting lower-priority workloads. The preemptor's capacity is
+// reserved before any victim is stopped, and the node is nominated on the
+// preemptor so a retry finishes that preemption instead of choosing new
+// victims.
+func (s *Scheduler) selectNodeOrPreempt(workload models.Workload) (models.Node, string, error) {
+	if s.preemptionEnabled() {
+		if node, reason, ok, err := s.resumeNomination(workload); ok || err != nil {
+			return node, reason, err
+		}
+	}
+	node, reason, err := s.selectNodeForWorkload(workload)
+	if err == nil || err == errControlPlaneFrozen || !s.preemptionEnabled() {
+		return node, reason, err
+	}
+
+	s.preemptionMu.Lock()
+	defer s.preemptionMu.Unlock()
+	// A concurrent attempt may have nominated a node while we waited.
+	if node, reason, ok, rerr := s.resumeNomination(workload); ok || rerr != nil {
+		return node, reason, rerr
+	}
+	plan, ok := s.planPreemption(workload)
+	if !ok {
+		return models.Node{}, "", err
+	}
+	if rerr := s.reservePreemption(plan.node, workload, plan.victims); rerr != nil {
+		return models.Node{}, "", fmt.Errorf("%w; reserving node %s for preemption failed: %v", err, plan.node.NodeID, rerr)
+	}
+	if nerr := s.nominateNode(workload.ID, plan.node.NodeID); nerr != nil {
+		schedulerLogger.WithError(nerr).WithFields(logrus.Fields{
+			"workload_id": workload.ID,
+			"node_id":     plan.node.NodeID,
+		}).Warn("failed to record nominated node")
+	}
+
+	ids := plan.victimIDs()
+	s.emitEvent("PreemptionTriggered", workload.ID, plan.node.NodeID,
+		fmt.Sprintf("no node fits; preempting %d lower-priority workload(s) on %s", len(ids), plan.node.NodeID),
+		map[string]interface{}{"victims": ids, "priority": workload.Priority})
+	schedulerLogger.WithFields(logrus.Fields{
+		"workload_id": workload.ID,
+		"node_id":     plan.node.NodeID,
+		"victims":     ids,
+	}).Info("reserved node capacity; preempting lower-priority workloads")
+
+	if perr := s.preemptVictims(workload, plan); perr != nil {
+		return models.Node{}, "", fmt.Errorf("%w; preemption on node %s failed: %v", err, plan.node.NodeID, perr)
+	}
+	reason = fmt.Sprintf("preempted %d lower-priority workload(s): %s", len(ids), strings.Join(ids, ","))
+	return plan.node, reason, nil
+}
+
+// resumeNomination returns the node an earlier preemption reserved for the
+// workload, first stopping any of its victims still assigned there. ok is
+// false when there is no live nomination.
+func (s *Scheduler) resumeNomination(workload models.Workload) (models.Node, string, bool, error) {
+	nominated := workload.NominatedNode
+	if stored, err := s.GetWorkloadByID(workload.ID); err == nil {
+		nominated = stored.NominatedNode
+	}
+	if strings.TrimSpace(nominated) == "" {
+		return models.Node{}, "", false, nil
+	}
+	ledger, _, err := s.getAllocationLedger(nominated)
+	if err != nil {
+		return models.Node{}, "", false, err
+	}
+	alloc, reserved := ledger.Allocations[workload.ID]
+	if !reserved {
+		return models.Node{}, "", false, nil
+	}
+	node, err := s.GetNodeByID(nominated)
+	if err != nil {
+		return models.Node{}, "", false, nil
+	}
+	plan := preemptionPlan{node: node}
+	for _, id := range alloc.Victims {
+		victim, err := s.GetWorkloadByID(id)
+		if err != nil || victim.NodeID != node.NodeID {
+			continue
+		}
+		plan.victims = append(plan.victims, victim)
+	}
+	if err := s.preemptVictims(workload, plan); err != nil {
+		return models.Node{}, "", true, fmt.Errorf("preemption on nominated node %s failed: %w", node.NodeID, err)
+	}
+	return node, fmt.Sprintf("nominated by preemption on %s", node.NodeID), true, nil
+}
+
+// reservePreemption reserves the preemptor's capacity on node in the same
+// ledger write that returns the victims' reservations, so nothing is stopped
+// unless the preemptor is certain to fit.
+func (s *Scheduler) reservePreemption(node models.Node, preemptor models.Workload, victims []models.Workload) error {
+	want := workloadAllocation(preemptor)
+	want.AllocatedAt = time.Now().UTC()
+	for _, v := range victims {
+		want.Victims = append(want.Victims, v.ID)
+	}
+	start, end := s.hostPortRange()
+	return s.updateAllocationLedger(node.NodeID, func(ledger *models.NodeAllocationLedger) (bool, error) {
+		delete(ledger.Allocations, preemptor.ID)
+		for _, id := range want.Victims {
+			delete(ledger.Allocations, id)
+		}
+		allocated := summarizeAllocationLedger(*ledger)
+		_, keys, err := resolveHostPorts(preemptor, allocated.HostPorts, start, end)
+		if err != nil {
+			return false, fmt.Errorf("node %s: %w", node.NodeID, err)
+		}
+		want.HostPorts = keys
+		if err := checkAllocationFits(node, allocated, want); err != nil {
+			return false, err
+		}
+		ledger.Allocations[preemptor.ID] = want
+		return true, nil
+	})
+}
+
+// nominateNode records the node a preemption reserved on the preemptor. A
+// workload that is not stored yet keeps the nomination in the ledger only.
+func (s *Scheduler) nominateNode(workloadID, nodeID string) error {
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return nil
+	}
+	if workload.NominatedNode == nodeID {
+		return nil
+	}
+	workload.NominatedNode = nodeID
+	return s.saveWorkload(workload)
+}
+
+// planPreemption picks the node that needs the fewest victims, preferring
+// lower-priority victims, and returns the minimal victim set for it.
+func (s *Scheduler) planPreemption(workload models.Workload) (preemptionPlan, bool) {
+	nodes, err := s.GetNodes()
+	if err != nil || len(nodes) == 0 {
+		return preemptionPlan{}, false
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to load workloads for preemption")
+		return preemptionPlan{}, false
+	}
+	candidatesByNode := map[string][]models.Workload{}
+	for _, w := range workloads {
+		if w.ID == workload.ID || w.Priority >= workload.Priority || strings.TrimSpace(w.NodeID) == "" {
+			continue
+		}
+		if strings.EqualFold(w.DesiredState, "Deleted") || jobChildFinished(w) {
+			continue
+		}
+		candidatesByNode[w.NodeID] = append(candidatesByNode[w.NodeID], w)
+	}
+	if len(candidatesByNode) == 0 {
+		return preemptionPlan{}, false
+	}
+
+	pipeline := s.placement
+	if pipeline == nil {
+		pipeline = newPlacementPipeline(s.cfg)
+	}
+	pc := s.newPlacementContext(workload, nodes, pipeline.strategy)
+
+	var best *preemptionPlan
+	for _, eval := range pipeline.evaluate(pc).Evaluations {
+		candidates := candidatesByNode[eval.NodeID]
+		if len(candidates) == 0 || !preemptibleFilters[rejectingFilter(eval)] {
+			continue
+		}
+		var node models.Node
+		for _, n := range nodes {
+			if n.NodeID == eval.NodeID {
+				node = n
+				break
+			}
+		}
+		victims, ok := minimalVictims(pipeline, pc, node, candidates)
+		if !ok {
+			continue
+		}
+		plan := preemptionPlan{node: node, victims: victims}
+		if best == nil || betterPreemptionPlan(plan, *best) {
+			best = &plan
+		}
+	}
+	if best == nil {
+		return preemptionPlan{}, false
+	}
+	return *best, true
+}
+
+func rejectingFilter(eval NodeEvaluation) string {
+	for _, f := range eval.Filters {
+		if !f.Passed {
+			return f.Plugin
+		}
+	}
+	return ""
+}
+
+func betterPreemptionPlan(a, b preemptionPlan) bool {
+	if len(a.victims) != len(b.victims) {
+		return len(a.victims) < len(b.victims)
+	}
+	if am, bm := a.maxVictimPriority(), b.maxVictimPriority(); am != bm {
+		return am < bm
+	}
+	if as, bs := a.victimPrioritySum(), b.victimPrioritySum(); as != bs {
+		return as < bs
+	}
+	return a.node.NodeID < b.node.NodeID
+}
+
+// minimalVictims adds candidates (lowest priority first, larger reservations
+// first within a priority) until the workload fits, then gives back every
+// victim the workload can fit without, highest priority first.
+func minimalVictims(pipeline *placementPipeline, pc *placementContext, node models.Node, candidates []models.Workload) ([]models.Workload, bool) {
+	sorted := append([]models.Workload(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		ai, aj := workloadAllocation(sorted[i]), workloadAllocation(sorted[j])
+		if ai.CPU != aj.CPU {
+			return ai.CPU > aj.CPU
+		}
+		if ai.MemoryMB != aj.MemoryMB {
+			return ai.MemoryMB > aj.MemoryMB
+		}
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	victims := make([]models.Workload, 0, len(sorted))
+	fits := false
+	for _, candidate := range sorted {
+		victims = append(victims, candidate)
+		if fitsWithoutVictims(pipeline, pc, node, victims) {
+			fits = true
+			break
+		}
+	}
+	if !fits {
+		return nil, false
+	}
+	for i := len(victims) - 1; i >= 0; i-- {
+		trial := append(append([]models.Workload(nil), victims[:i]...), victims[i+1:]...)
+		if len(trial) > 0 && fitsWithoutVictims(pipeline, pc, node, trial) {
+			victims = trial
+		}
+	}
+	return victims, true
+}
+
+// fitsWithoutVictims re-runs the filters as if victims had already left the
+// node: their reservations are returned to the ledger and heartbeat
+// availability, and they no longer count as group peers.
+func fitsWithoutVictims(pipeline *placementPipeline, pc *placementContext, node models.Node, victims []models.Workload) bool {
+	sim := *pc
+	sim.allocations = make(map[string]models.NodeAllocationSummary, len(pc.allocations))
+	for id, sum := range pc.allocations {
+		sim.allocations[id] = sum
+	}
+	sim.peersByNode = make(map[string]int, len(pc.peersByNode))
+	for id, n := range pc.peersByNode {
+		sim.peersByNode[id] = n
+	}
+
+	simNode := node
+	simNode.StoragePools = append([]models.StoragePool(nil), node.StoragePools...)
+	allocated, hasLedger := sim.allocations[node.NodeID]
+	if hasLedger {
+		pools := make(map[string]int64, len(allocated.Pools))
+		for name, gb := range allocated.Pools {
+			pools[name] = gb
+		}
+		allocated.Pools = pools
+	}
+	for _, v := range victims {
+		freed := workloadAllocation(v)
+		simNode.AvailableCPU += freed.CPU
+		simNode.AvailableMemory += freed.MemoryMB
+		for i := range simNode.StoragePools {
+			pool := &simNode.StoragePools[i]
+			gb := freed.Pools[pool.Name]
+			if pool.AllocatedGB -= gb; pool.AllocatedGB < 0 {
+				pool.AllocatedGB = 0
+			}
+			if pool.UsedGB -= gb; pool.UsedGB < 0 {
+				pool.UsedGB = 0
+			}
+		}
+		if hasLedger {
+			allocated.CPU -= freed.CPU
+			allocated.MemoryMB -= freed.MemoryMB
+			allocated.DiskGB -= freed.DiskGB
+			for name, gb := range freed.Pools {
+				allocated.Pools[name] -= gb
+			}
+		}
+		if pc.group != "" && placementGroup(v) == pc.group {
+			sim.peersByNode[node.NodeID]--
+		}
+	}
+	if node.TotalCPU > 0 && simNode.AvailableCPU > node.TotalCPU {
+		simNode.AvailableCPU = node.TotalCPU
+	}
+	if node.TotalMemory > 0 && simNode.AvailableMemory > node.TotalMemory {
+		simNode.AvailableMemory = node.TotalMemory
+	}
+	if hasLedger {
+		sim.allocations[node.NodeID] = allocated
+	}
+	for _, f := range pipeline.filters {
+		if ok, _ := f.Filter(&sim, simNode); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// preemptVictims stops every victim on the plan's node and puts it back in
+// the pending queue. The preemptor already holds its reservation; once all
+// victims are gone the victim list is dropped from it.
+func (s *Scheduler) preemptVictims(preemptor models.Workload, plan preemptionPlan) error {
+	for _, victim := range plan.victims {
+		ctx, cancel := context.WithTimeout(context.Background(), s.deleteTimeout())
+		_, err := s.deleteWorkloadFromNode(ctx, plan.node, victim.ID)
+		cancel()
+		if err != nil && !isWorkloadStatusNotFound(err) {
+			return fmt.Errorf("stop victim %s: %w", victim.ID, err)
+		}
+		if err := s.requeuePreemptedWorkload(victim.ID, preemptor, plan.node.NodeID); err != nil {
+			return fmt.Errorf("requeue victim %s: %w", victim.ID, err)
+		}
+		reason := fmt.Sprintf("preempted by %s (priority %d > %d)", preemptor.ID, preemptor.Priority, victim.Priority)
+		_ = s.UpdateWorkloadLogs(victim.ID, fmt.Sprintf("Preempted on node %s by %s (priority %d); waiting for capacity", plan.node.NodeID, preemptor.ID, preemptor.Priority))
+		s.emitEvent("WorkloadPreempted", victim.ID, plan.node.NodeID, reason, map[string]interface{}{
+			"preemptor":          preemptor.ID,
+			"preemptor_priority": preemptor.Priority,
+			"victim_priority":    victim.Priority,
+		})
+	}
+	return s.updateAllocationLedger(plan.node.NodeID, func(ledger *models.NodeAllocationLedger) (bool, error) {
+		alloc, ok := ledger.Allocations[preemptor.ID]
+		if !ok || len(alloc.Victims) == 0 {
+			return false, nil
+		}
+		alloc.Victims = nil
+		ledger.Allocations[preemptor.ID] = alloc
+		return true, nil
+	})
+}
+
+// requeuePreemptedWorkload unassigns a victim so the reconciler places it
+// again once capacity frees up.
+func (s *Scheduler) requeuePreemptedWorkload(workloadID string, preemptor models.Workload, nodeID string) error {
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return err
+	}
+	if workload.Metadata == nil {
+		workload.Metadata = map[string]interface{}{}
+	}
+	now := time.Now().UTC()
+	workload.NodeID = ""
+	workload.AssignedNode = ""
+	workload.Status = "Pending"
+	workload.StatusInfo.ActualState = "Pending"
+	workload.StatusInfo.LastUpdated = now
+	workload.Retry.Attempts = 0
+	workload.Retry.NextRetryAt = time.Time{}
+	clearReapplyMetadata(&workload)
+	workload.Metadata["last_action"] = "Preempted"
+	workload.Metadata["preempted_by"] = preemptor.ID
+	workload.Metadata["preempted_from"] = nodeID
+	workload.Metadata["preempted_at"] = now.Format(time.RFC3339)
+	if err := s.saveWorkload(workload); err != nil {
+		return err
+	}
+	_ = s.RetryableEtcdDelete(assignmentKey(workload.ID))
+	if err := s.releaseAllocation(nodeID, workload.ID); err != nil {
+		schedulerLogger.WithError(err).WithFields(logrus.Fields{
+			"workload_id": workload.ID,
+			"node_id":     nodeID,
+		}).Warn("failed to release allocation of preempted workload")
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package scheduler
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestMinimalVictims(t *testing.T) {
+	// A full node: the agent reports nothing free and the ledger holds all of it.
+	node := models.Node{NodeID: "n1", Status: "Ready", TotalCPU: 8, TotalMemory: 8192}
+	pipeline := &placementPipeline{filters: []filterPlugin{filterFunc{"resources", filterResources}}}
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	victim := func(id string, priority int, cpu float64, age time.Duration) models.Workload {
+		w := cpuWorkload(id, cpu, 0)
+		w.NodeID = node.NodeID
+		w.Priority = priority
+		w.CreatedAt = base.Add(-age)
+		return w
+	}
+
+	tests := []struct {
+		name       string
+		need       float64
+		candidates []models.Workload
+		want       []string // nil when no victim set makes room
+	}{
+		{
+			name:       "one large victim beats two small ones",
+			need:       4,
+			candidates: []models.Workload{victim("small", 1, 2, 0), victim("large", 1, 4, 0)},
+			want:       []string{"large"},
+		},
+		{
+			name: "lower priority goes first",
+			need: 4,
+			candidates: []models.Workload{
+				victim("low-a", 0, 2, time.Hour),
+				victim("high", 5, 4, 0),
+				victim("low-b", 0, 2, 0),
+			},
+			want: []string{"low-a", "low-b"},
+		},
+		{
+			name:       "victims that are not needed are given back",
+			need:       4,
+			candidates: []models.Workload{victim("tiny", 0, 1, 0), victim("big", 1, 4, 0)},
+			want:       []string{"big"},
+		},
+		{
+			name:       "not enough even with every candidate",
+			need:       4,
+			candidates: []models.Workload{victim("tiny", 0, 1, 0), victim("small", 0, 2, 0)},
+		},
+		{
+			name:       "no candidates",
+			need:       1,
+			candidates: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			preemptor := cpuWorkload("preemptor", tt.need, 0)
+			preemptor.Priority = 10
+			pc := &placementContext{
+				workload:    preemptor,
+				request:     workloadAllocation(preemptor),
+				allocations: map[string]models.NodeAllocationSummary{node.NodeID: {CPU: node.TotalCPU, MemoryMB: node.TotalMemory}},
+				peersByNode: map[string]int{},
+				now:         base,
+			}
+			victims, ok := minimalVictims(pipeline, pc, node, tt.candidates)
+			if tt.want == nil {
+				if ok {
+					t.Fatalf("expected no victim set, got %v", workloadIDs(victims))
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("expected victims %v, found none", tt.want)
+			}
+			got := workloadIDs(victims)
+			sort.Strings(got)
+			want := append([]string(nil), tt.want...)
+			sort.Strings(want)
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Fatalf("expected victims %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestBetterPreemptionPlan(t *testing.T) {
+	plan := func(nodeID string, priorities ...int) preemptionPlan {
+		p := preemptionPlan{node: models.Node{NodeID: nodeID}}
+		for _, prio := range priorities {
+			p.victims = append(p.victims, models.Workload{Priority: prio})
+		}
+		return p
+	}
+	tests := []struct {
+		name string
+		a, b preemptionPlan
+		want bool
+	}{
+		{name: "fewer victims", a: plan("n2", 5), b: plan("n1", 0, 0), want: true},
+		{name: "lower highest priority", a: plan("n2", 1, 1), b: plan("n1", 0, 2), want: true},
+		{name: "lower priority sum", a: plan("n2", 0, 2), b: plan("n1", 2, 2), want: true},
+		{name: "node id breaks ties", a: plan("n2", 1), b: plan("n1", 1), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := betterPreemptionPlan(tt.a, tt.b); got != tt.want {
+				t.Fatalf("betterPreemptionPlan = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReservePreemptionCreditsVictims(t *testing.T) {
+	node := models.Node{NodeID: "n1", TotalCPU: 4, TotalMemory: 4096}
+	tests := []struct {
+		name     string
+		victims  []string
+		wantErr  bool
+		wantLeft []string
+	}{
+		{
+			name:     "victims are released in the same write",
+			victims:  []string{"low"},
+			wantLeft: []string{"keep", "high"},
+		},
+		{
+			name:     "nothing changes when the preemptor still does not fit",
+			victims:  nil,
+			wantErr:  true,
+			wantLeft: []string{"keep", "low"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV()
+			kv.seedLedger(t, node.NodeID,
+				models.WorkloadAllocation{WorkloadID: "keep", CPU: 1},
+				models.WorkloadAllocation{WorkloadID: "low", CPU: 3},
+			)
+			s := newLedgerTestScheduler(kv)
+			var victims []models.Workload
+			for _, id := range tt.victims {
+				victims = append(victims, models.Workload{ID: id})
+			}
+			err := s.reservePreemption(node, cpuWorkload("high", 3, 0), victims)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("wantErr=%v, got %v", tt.wantErr, err)
+			}
+			ledger := kv.ledger(t, node.NodeID)
+			if len(ledger.Allocations) != len(tt.wantLeft) {
+				t.Fatalf("unexpected reservations: %#v", ledger.Allocations)
+			}
+			for _, id := range tt.wantLeft {
+				if _, ok := ledger.Allocations[id]; !ok {
+					t.Fatalf("expected a reservation for %s, got %#v", id, ledger.Allocations)
+				}
+			}
+			if !tt.wantErr {
+				if got := ledger.Allocations["high"].Victims; len(got) != 1 || got[0] != "low" {
+					t.Fatalf("expected the reservation to record its victims, got %v", got)
+				}
+			}
+		})
+	}
+}
+
+func workloadIDs(workloads []models.Workload) []string {
+	ids := make([]string, 0, len(workloads))
+	for _, w := range workloads {
+		ids = append(ids, w.ID)
+	}
+	return ids
+}
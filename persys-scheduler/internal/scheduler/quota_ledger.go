@@ -0,0 +1,272 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// quotaCASAttempts bounds how often a quota charge is retried when
+	// another scheduler call updated the same namespace ledger concurrently.
+	quotaCASAttempts = 8
+	// quotaChargeGrace keeps fresh charges during resync, covering the gap
+	// between charging a workload and saving it.
+	quotaChargeGrace = 2 * time.Minute
+)
+
+// countsTowardQuota reports whether w is charged to its namespace. Deleted
+// workloads and finished job runs are not.
+func countsTowardQuota(w models.Workload) bool {
+	return !strings.EqualFold(w.DesiredState, "Deleted") && !jobChildFinished(w)
+}
+
+func quotaChargeOf(w models.Workload) models.ResourceQuota {
+	var charge models.ResourceQuota
+	addQuotaUsage(&charge, w)
+	return charge
+}
+
+func addQuotaCharge(used *models.ResourceQuota, charge models.ResourceQuota) {
+	used.CPU += charge.CPU
+	used.MemoryMB += charge.MemoryMB
+	used.DiskGB += charge.DiskGB
+	used.Workloads += charge.Workloads
+	used.VMs += charge.VMs
+}
+
+func summarizeQuotaCharges(ledger models.NamespaceUsageLedger, skipID string) models.ResourceQuota {
+	var used models.ResourceQuota
+	for id, charge := range ledger.Charges {
+		if id != skipID {
+			addQuotaCharge(&used, charge.ResourceQuota)
+		}
+	}
+	return used
+}
+
+// getNamespaceUsageLedger returns the ledger of namespace and its etcd mod
+// revision. A missing ledger (revision 0) is seeded from the workloads when
+// seed is set and left empty otherwise.
+func (s *Scheduler) getNamespaceUsageLedger(namespace string, seed bool) (models.NamespaceUsageLedger, int64, error) {
+	ledger := models.NamespaceUsageLedger{Namespace: namespace, Charges: map[string]models.QuotaCharge{}}
+	resp, err := s.RetryableEtcdGet(quotaUsageKey(namespace))
+	if err != nil {
+		return ledger, 0, fmt.Errorf("failed to get quota usage for namespace %s: %w", namespace, err)
+	}
+	if resp == nil || len(resp.Kvs) == 0 {
+		if !seed {
+			return ledger, 0, nil
+		}
+		workloads, err := s.GetWorkloads()
+		if err != nil {
+			return ledger, 0, err
+		}
+		now := time.Now().UTC()
+		for _, w := range workloads {
+			if WorkloadNamespace(w) == namespace && countsTowardQuota(w) {
+				ledger.Charges[w.ID] = models.QuotaCharge{ResourceQuota: quotaChargeOf(w), ChargedAt: now}
+			}
+		}
+		return ledger, 0, nil
+	}
+	if err := json.Unmarshal(resp.Kvs[0].Value, &ledger); err != nil {
+		return ledger, 0, fmt.Errorf("failed to unmarshal quota usage for namespace %s: %w", namespace, err)
+	}
+	if ledger.Charges == nil {
+		ledger.Charges = map[string]models.QuotaCharge{}
+	}
+	return ledger, resp.Kvs[0].ModRevision, nil
+}
+
+// updateNamespaceUsage applies mutate to the namespace ledger with
+// compare-and-swap, re-reading and retrying on conflict. Without seed a
+// namespace that has no ledger is left alone. mutate returning false means
+// nothing changed and no write is needed.
+func (s *Scheduler) updateNamespaceUsage(namespace string, seed bool, mutate func(*models.NamespaceUsageLedger) (bool, error)) error {
+	for attempt := 0; attempt < quotaCASAttempts; attempt++ {
+		ledger, rev, err := s.getNamespaceUsageLedger(namespace, seed)
+		if err != nil {
+			return err
+		}
+		if rev == 0 && !seed {
+			return nil
+		}
+		changed, err := mutate(&ledger)
+		if err != nil {
+			return err
+		}
+		if !changed && rev != 0 {
+			return nil
+		}
+		ledger.Namespace = namespace
+		ledger.UpdatedAt = time.Now().UTC()
+		payload, err := json.Marshal(ledger)
+		if err != nil {
+			return fmt.Errorf("failed to marshal quota usage for namespace %s: %w", namespace, err)
+		}
+		ok, err := s.RetryableEtcdCompareAndPut(quotaUsageKey(namespace), string(payload), rev)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		namespaceLogger.WithFields(logrus.Fields{
+			"namespace": namespace,
+			"attempt":   attempt + 1,
+		}).Debug("quota usage changed concurrently; retrying")
+	}
+	return fmt.Errorf("quota usage for namespace %s is contended; gave up after %d attempts", namespace, quotaCASAttempts)
+}
+
+// chargeQuota charges w to the ledger of ns, failing with ErrQuotaExceeded
+// when that would take the namespace past its quota. previous is the stored
+// version of w when it is being updated; a dimension only counts as exceeded
+// when w asks for more of it than before, so a namespace already over a
+// lowered quota can still shrink or change other fields.
+func (s *Scheduler) chargeQuota(ns models.Namespace, w models.Workload, previous *models.Workload) error {
+	want := quotaChargeOf(w)
+	var reason string
+	err := s.updateNamespaceUsage(ns.Name, true, func(ledger *models.NamespaceUsageLedger) (bool, error) {
+		existing, charged := ledger.Charges[w.ID]
+		had := existing.ResourceQuota
+		if !charged && previous != nil {
+			had = quotaChargeOf(*previous)
+		}
+		if charged && existing.ResourceQuota == want {
+			return false, nil
+		}
+		used := summarizeQuotaCharges(*ledger, w.ID)
+		addQuotaCharge(&used, want)
+
+		var over []string
+		q := ns.Quota
+		if q.CPU > 0 && used.CPU > q.CPU && want.CPU > had.CPU {
+			over = append(over, fmt.Sprintf("cpu %g/%g cores (requested %g)", used.CPU, q.CPU, want.CPU))
+		}
+		if q.MemoryMB > 0 && used.MemoryMB > q.MemoryMB && want.MemoryMB > had.MemoryMB {
+			over = append(over, fmt.Sprintf("memory %d/%d MB (requested %d)", used.MemoryMB, q.MemoryMB, want.MemoryMB))
+		}
+		if q.DiskGB > 0 && used.DiskGB > q.DiskGB && want.DiskGB > had.DiskGB {
+			over = append(over, fmt.Sprintf("disk %d/%d GB (requested %d)", used.DiskGB, q.DiskGB, want.DiskGB))
+		}
+		if q.Workloads > 0 && used.Workloads > q.Workloads && !charged && previous == nil {
+			over = append(over, fmt.Sprintf("workloads %d/%d", used.Workloads, q.Workloads))
+		}
+		if q.VMs > 0 && used.VMs > q.VMs && want.VMs > had.VMs {
+			over = append(over, fmt.Sprintf("vms %d/%d", used.VMs, q.VMs))
+		}
+		if len(over) > 0 {
+			reason = strings.Join(over, ", ")
+			return false, fmt.Errorf("%w: namespace %s would use %s", ErrQuotaExceeded, ns.Name, reason)
+		}
+		ledger.Charges[w.ID] = models.QuotaCharge{ResourceQuota: want, ChargedAt: time.Now().UTC()}
+		return true, nil
+	})
+	if err != nil && reason != "" {
+		namespaceLogger.WithFields(logrus.Fields{
+			"namespace":   ns.Name,
+			"workload_id": w.ID,
+			"exceeded":    reason,
+		}).Warn("workload rejected by namespace quota")
+		s.emitEvent("QuotaExceeded", w.ID, "", reason, map[string]interface{}{"namespace": ns.Name})
+	}
+	return err
+}
+
+// releaseQuota drops the charge of workloadID from the namespace ledger.
+func (s *Scheduler) releaseQuota(namespace, workloadID string) error {
+	return s.updateNamespaceUsage(namespace, false, func(ledger *models.NamespaceUsageLedger) (bool, error) {
+		if _, ok := ledger.Charges[workloadID]; !ok {
+			return false, nil
+		}
+		delete(ledger.Charges, workloadID)
+		return true, nil
+	})
+}
+
+// ReconcileNamespaceUsage resyncs the ledgers of namespaces with a quota
+// with their workloads: charges of workloads that are gone or no longer
+// count are dropped, workloads without one are adopted, and changed requests
+// are picked up. Charges younger than quotaChargeGrace are left alone, since
+// their workload may still be being saved. Ledgers of namespaces without a
+// quota are removed.
+func (s *Scheduler) ReconcileNamespaceUsage() error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	namespaces, err := s.ListNamespaces()
+	if err != nil {
+		return err
+	}
+	withQuota := map[string]bool{}
+	for _, ns := range namespaces {
+		if ns.Quota != (models.ResourceQuota{}) {
+			withQuota[ns.Name] = true
+		}
+	}
+	resp, err := s.RetryableEtcdGet(quotaUsagePrefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return fmt.Errorf("failed to list quota usage: %w", err)
+	}
+	for _, kv := range resp.Kvs {
+		name := strings.TrimPrefix(string(kv.Key), quotaUsagePrefix)
+		if !withQuota[name] {
+			if err := s.RetryableEtcdDelete(string(kv.Key)); err != nil {
+				return err
+			}
+		}
+	}
+	if len(withQuota) == 0 {
+		return nil
+	}
+
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	live := map[string]map[string]models.Workload{}
+	for _, w := range workloads {
+		ns := WorkloadNamespace(w)
+		if !withQuota[ns] || !countsTowardQuota(w) {
+			continue
+		}
+		if live[ns] == nil {
+			live[ns] = map[string]models.Workload{}
+		}
+		live[ns][w.ID] = w
+	}
+	for name := range withQuota {
+		err := s.updateNamespaceUsage(name, true, func(ledger *models.NamespaceUsageLedger) (bool, error) {
+			changed := false
+			now := time.Now().UTC()
+			for id, charge := range ledger.Charges {
+				if _, ok := live[name][id]; ok || now.Sub(charge.ChargedAt) < quotaChargeGrace {
+					continue
+				}
+				delete(ledger.Charges, id)
+				changed = true
+			}
+			for id, w := range live[name] {
+				want := quotaChargeOf(w)
+				existing, ok := ledger.Charges[id]
+				if ok && (existing.ResourceQuota == want || now.Sub(existing.ChargedAt) < quotaChargeGrace) {
+					continue
+				}
+				ledger.Charges[id] = models.QuotaCharge{ResourceQuota: want, ChargedAt: now}
+				changed = true
+			}
+			return changed, nil
+		})
+		if err != nil {
+			namespaceLogger.WithError(err).WithField("namespace", name).Warn("quota usage resync failed")
+		}
+	}
+	return nil
+}
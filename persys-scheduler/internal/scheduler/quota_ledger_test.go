@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func seedNamespace(t *testing.T, s *Scheduler, ns models.Namespace) {
+	t.Helper()
+	if err := s.saveNamespaceRecord(ns); err != nil {
+		t.Fatalf("saveNamespaceRecord: %v", err)
+	}
+}
+
+func quotaLedger(t *testing.T, s *Scheduler, namespace string) models.NamespaceUsageLedger {
+	t.Helper()
+	ledger, _, err := s.getNamespaceUsageLedger(namespace, false)
+	if err != nil {
+		t.Fatalf("getNamespaceUsageLedger: %v", err)
+	}
+	return ledger
+}
+
+func TestAdmitToNamespaceCountsUnsavedCharges(t *testing.T) {
+	s := newLedgerTestScheduler(newFakeKV())
+	seedNamespace(t, s, models.Namespace{Name: "team-a", Quota: models.ResourceQuota{CPU: 4, Workloads: 2}})
+
+	first := cpuWorkload("a", 3, 0)
+	first.Namespace = "team-a"
+	if err := s.admitToNamespace(&first, nil); err != nil {
+		t.Fatalf("admit first: %v", err)
+	}
+	// first is charged but not saved yet; a concurrent create must see it.
+	second := cpuWorkload("b", 2, 0)
+	second.Namespace = "team-a"
+	if err := s.admitToNamespace(&second, nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+	}
+	if _, ok := quotaLedger(t, s, "team-a").Charges["b"]; ok {
+		t.Fatalf("a rejected workload must not be charged")
+	}
+
+	// Growing past the quota is rejected, shrinking is not.
+	grown := first
+	grown.Resources.CPUUsage = 5
+	if err := s.admitToNamespace(&grown, &first); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected growth to be rejected, got %v", err)
+	}
+	shrunk := first
+	shrunk.Resources.CPUUsage = 1
+	if err := s.admitToNamespace(&shrunk, &first); err != nil {
+		t.Fatalf("shrink: %v", err)
+	}
+	if err := s.admitToNamespace(&second, nil); err != nil {
+		t.Fatalf("expected room after the shrink, got %v", err)
+	}
+
+	third := cpuWorkload("c", 0.5, 0)
+	third.Namespace = "team-a"
+	if err := s.admitToNamespace(&third, nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected the workload count to be enforced, got %v", err)
+	}
+	// Deleting a workload returns its charge.
+	shrunk.DesiredState = "Deleted"
+	if err := s.saveWorkload(shrunk); err != nil {
+		t.Fatalf("saveWorkload: %v", err)
+	}
+	if err := s.admitToNamespace(&third, nil); err != nil {
+		t.Fatalf("expected the deleted workload's charge to be released, got %v", err)
+	}
+}
+
+func TestReconcileNamespaceUsage(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	seedNamespace(t, s, models.Namespace{Name: "team-a", Quota: models.ResourceQuota{Workloads: 5}})
+
+	live := cpuWorkload("live", 1, 0)
+	live.Namespace = "team-a"
+	if err := s.saveWorkload(live); err != nil {
+		t.Fatalf("saveWorkload: %v", err)
+	}
+	old := time.Now().UTC().Add(-2 * quotaChargeGrace)
+	ledger := models.NamespaceUsageLedger{Namespace: "team-a", Charges: map[string]models.QuotaCharge{
+		"gone":     {ResourceQuota: models.ResourceQuota{CPU: 1, Workloads: 1}, ChargedAt: old},
+		"creating": {ResourceQuota: models.ResourceQuota{CPU: 1, Workloads: 1}, ChargedAt: time.Now().UTC()},
+	}}
+	payload, _ := json.Marshal(ledger)
+	if _, err := s.RetryableEtcdCompareAndPut(quotaUsageKey("team-a"), string(payload), 0); err != nil {
+		t.Fatalf("seed ledger: %v", err)
+	}
+	stale, _ := json.Marshal(models.NamespaceUsageLedger{Namespace: "no-quota"})
+	if _, err := s.RetryableEtcdCompareAndPut(quotaUsageKey("no-quota"), string(stale), 0); err != nil {
+		t.Fatalf("seed ledger: %v", err)
+	}
+
+	if err := s.ReconcileNamespaceUsage(); err != nil {
+		t.Fatalf("ReconcileNamespaceUsage: %v", err)
+	}
+	charges := quotaLedger(t, s, "team-a").Charges
+	if _, ok := charges["gone"]; ok {
+		t.Fatalf("expected the charge of a missing workload to be dropped")
+	}
+	if _, ok := charges["creating"]; !ok {
+		t.Fatalf("expected a fresh charge to be kept")
+	}
+	if got := charges["live"].ResourceQuota; got != quotaChargeOf(live) {
+		t.Fatalf("expected the live workload to be adopted, got %+v", got)
+	}
+	if resp, err := s.RetryableEtcdGet(quotaUsageKey("no-quota")); err != nil || len(resp.Kvs) != 0 {
+		t.Fatalf("expected the ledger of a namespace without quota to be removed (err %v)", err)
+	}
+}
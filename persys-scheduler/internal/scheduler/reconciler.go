@@ -3,6 +3,7 @@ package scheduler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -111,7 +112,29 @@ func (r *Reconciler) ReconcileWorkload(ctx context.Context, workload models.Work
 		return result, nil
 	}
 
-	if err := r.scheduler.EnsureWorkloadAssigned(&workload); err != nil && !strings.EqualFold(workload.DesiredState, "Deleted") {
+	if jobChildFinished(workload) && !strings.EqualFold(workload.DesiredState, "Deleted") {
+		// A finished job run is terminal; its record stays until the job goes.
+		result.ActualState = jobChildOutcome(workload)
+		result.Action = "Completed"
+		result.Success = true
+		return result, nil
+	}
+
+	if strings.TrimSpace(workload.NodeID) == "" && isMetadataTrue(workload.Metadata, workloadQueuedMetadataKey) && !strings.EqualFold(workload.DesiredState, "Deleted") {
+		// Queued workloads are placed by ProcessPendingQueue, in queue order.
+		result.ActualState = "Pending"
+		result.Action = "Queued"
+		result.Success = true
+		return result, nil
+	}
+
+	if err := r.scheduler.EnsureWorkloadAssigned(&workload); err != nil && errors.Is(err, errWorkloadQueued) {
+		result.ActualState = "Pending"
+		result.Action = "Queued"
+		result.Success = true
+		r.scheduler.writeReconciliationRecord(workload.ID, result.Action, true, err.Error())
+		return result, nil
+	} else if err != nil && !strings.EqualFold(workload.DesiredState, "Deleted") {
 		result.ActualState = "Unknown"
 		result.Action = "Assign"
 		result.Success = false
@@ -194,6 +217,30 @@ func (r *Reconciler) ReconcileWorkload(ctx context.Context, workload models.Work
 	}
 	result.ActualState = actualState
 
+	// A job run that stopped has finished rather than drifted, so it is
+	// recorded as Succeeded/Failed instead of being reapplied.
+	if strings.EqualFold(workload.DesiredState, "Running") && r.scheduler.completeJobChildFromState(workload, actualState) {
+		result.Action = "Completed"
+		result.Success = true
+		r.scheduler.writeReconciliationRecord(workload.ID, result.Action, true, "job run finished")
+		return result, nil
+	}
+
+	if strings.EqualFold(actualState, "Running") && workload.LivenessProbe != nil {
+		// Probe results were just recorded; re-read to see the verdict.
+		if current, getErr := r.scheduler.GetWorkloadByID(workload.ID); getErr == nil && livenessRestartDue(current) {
+			action, restartErr := r.restartUnhealthyWorkload(ctx, current)
+			result.Action = action
+			result.Success = restartErr == nil
+			if restartErr != nil {
+				result.ErrorMessage = restartErr.Error()
+			}
+			r.scheduler.writeReconciliationRecord(workload.ID, result.Action, result.Success, result.ErrorMessage)
+			r.updateWorkloadReconciliationStatus(workload.ID, result)
+			return result, nil
+		}
+	}
+
 	if r.needsReconciliation(workload, actualState) {
 		action, err := r.performReconciliation(ctx, workload, actualState)
 		result.Action = action
@@ -224,6 +271,40 @@ func (r *Reconciler) ReconcileWorkload(ctx context.Context, workload models.Work
 	return result, nil
 }
 
+// restartUnhealthyWorkload stops and starts a workload whose liveness probe
+// is failing. Stopping rather than deleting keeps managed volumes in place.
+func (r *Reconciler) restartUnhealthyWorkload(ctx context.Context, workload models.Workload) (string, error) {
+	const action = "RestartUnhealthy"
+	node, err := r.scheduler.GetNodeByID(workload.NodeID)
+	if err != nil {
+		return action, fmt.Errorf("failed to get node: %v", err)
+	}
+	reason := "liveness probe failed"
+	if live := workload.StatusInfo.Health.Liveness; live != nil && live.LastMessage != "" {
+		reason = fmt.Sprintf("liveness probe failed: %s", live.LastMessage)
+	}
+	reconcilerLogger.WithFields(logrus.Fields{
+		"workload_id": workload.ID,
+		"node_id":     workload.NodeID,
+		"restarts":    workload.StatusInfo.Health.Restarts,
+	}).Warn("restarting workload after liveness probe failure")
+
+	for _, desired := range []string{"Stopped", "Running"} {
+		step := workload
+		step.DesiredState = desired
+		resp, err := r.scheduler.applyWorkloadOnNode(ctx, node, step)
+		if err != nil {
+			return action, fmt.Errorf("failed to apply %s during restart: %v", strings.ToLower(desired), err)
+		}
+		if resp == nil || (!resp.GetApplied() && !resp.GetSkipped()) {
+			return action, fmt.Errorf("agent rejected %s during restart: %s", strings.ToLower(desired), strings.TrimSpace(resp.GetMessage()))
+		}
+	}
+	r.scheduler.recordLivenessRestart(workload.ID, reason)
+	_ = r.scheduler.UpdateWorkloadLogs(workload.ID, fmt.Sprintf("Restarted after %s", reason))
+	return action, nil
+}
+
 func (r *Reconciler) handleRuntimeUnavailable(workload *models.Workload, applyErr error) (bool, error) {
 	if workload == nil || strings.TrimSpace(workload.NodeID) == "" || applyErr == nil {
 		return false, nil
@@ -346,6 +427,12 @@ func (r *Reconciler) getActualWorkloadState(ctx context.Context, workload models
 	if len(statusResp.GetMetadata()) > 0 {
 		_ = r.scheduler.UpdateWorkloadMetadata(workload.ID, statusResp.GetMetadata())
 	}
+	if len(statusResp.GetProbeResults()) > 0 {
+		_ = r.scheduler.RecordProbeResults(workload.ID, probeResultsFromAgent(statusResp.GetProbeResults()))
+	}
+	if statusResp.GetExited() {
+		_ = r.scheduler.RecordWorkloadExit(workload.ID, int(statusResp.GetExitCode()))
+	}
 	if strings.EqualFold(mapActualStateToSchedulerStatus(statusResp.GetActualState()), "Failed") {
 		if msg := strings.TrimSpace(statusResp.GetMessage()); msg != "" {
 			_ = r.scheduler.UpdateWorkloadMetadata(workload.ID, map[string]string{"last_runtime_error": msg})
@@ -876,6 +963,33 @@ func (r *Reconciler) StartReconciliationLoop(ctx context.Context, interval time.
 			if !r.scheduler.isWritable() {
 				continue
 			}
+			if err := r.scheduler.ReconcileReplicaSets(); err != nil {
+				reconcilerLogger.WithError(err).Warn("replica set reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileStacks(); err != nil {
+				reconcilerLogger.WithError(err).Warn("stack reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileCronJobs(); err != nil {
+				reconcilerLogger.WithError(err).Warn("cron job reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileJobs(); err != nil {
+				reconcilerLogger.WithError(err).Warn("job reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileNodeDrains(); err != nil {
+				reconcilerLogger.WithError(err).Warn("node drain reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileDescheduler(); err != nil {
+				reconcilerLogger.WithError(err).Warn("descheduler pass failed")
+			}
+			if err := r.scheduler.ReconcileVolumes(); err != nil {
+				reconcilerLogger.WithError(err).Warn("volume reconciliation failed")
+			}
+			if err := r.scheduler.ReconcileNamespaceUsage(); err != nil {
+				reconcilerLogger.WithError(err).Warn("quota usage reconciliation failed")
+			}
+			if err := r.scheduler.ProcessPendingQueue(); err != nil {
+				reconcilerLogger.WithError(err).Warn("pending queue processing failed")
+			}
 			cycleStart := time.Now()
 			results, err := r.ReconcileAllWorkloads(ctx)
 			metricspkg.ObserveReconciliationCycle(time.Since(cycleStart), err)
@@ -892,7 +1006,7 @@ func (r *Reconciler) StartReconciliationLoop(ctx context.Context, interval time.
 				} else {
 					failureCount++
 				}
-				if result.Action != "NoAction" {
+				if result.Action != "NoAction" && result.Action != "Queued" {
 					actionCount++
 				}
 			}
@@ -904,6 +1018,9 @@ func (r *Reconciler) StartReconciliationLoop(ctx context.Context, interval time.
 					"failed":              failureCount,
 				}).Info("reconciliation cycle summary")
 			}
+			if err := r.scheduler.ReconcileServices(); err != nil {
+				reconcilerLogger.WithError(err).Warn("service record reconciliation failed")
+			}
 			if err := r.scheduler.RefreshStateMetrics(); err != nil {
 				reconcilerLogger.WithError(err).Warn("failed to refresh scheduler state metrics")
 			}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
 	"github.com/redis/go-redis/v9"
 	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
 )
 
 var redisLogger = logging.C("scheduler.redis")
@@ -30,6 +34,9 @@ func (s *Scheduler) initRedisStore() {
 		return
 	}
 	s.redisClient = client
+	// Events an earlier run wrote to etcd while Redis was down still need to
+	// reach Redis watchers.
+	s.markEventBacklog()
 	redisLogger.WithField("addr", s.cfg.RedisAddr).Info("redis telemetry store enabled")
 }
 
@@ -69,7 +76,26 @@ func (s *Scheduler) writeReconciliationTelemetry(workloadID, action string, succ
 	_ = s.RetryableEtcdPut(reconciliationKey(workloadID), string(payload))
 }
 
-func (s *Scheduler) writeEventTelemetry(payload []byte) bool {
+const (
+	redisEventRevisionKey = "events:revision"
+	redisEventStreamKey   = "events:stream"
+	redisEventField       = "event"
+)
+
+// redisAppendEvent assigns the next event revision and appends the event to
+// the stream under that revision in one step, so stream order is revision
+// order. The counter only moves once the entry is written.
+var redisAppendEvent = redis.NewScript(`
+local rev = tonumber(redis.call('GET', KEYS[1]) or '0') + 1
+redis.call('XADD', KEYS[2], 'MAXLEN', '~', ARGV[2], string.format('%d-0', rev), ARGV[3], ARGV[1])
+redis.call('SET', KEYS[1], rev)
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+return rev
+`)
+
+// writeEventTelemetry appends event to the Redis event stream, stamping it
+// with the revision the stream entry was written under.
+func (s *Scheduler) writeEventTelemetry(event *models.SchedulerEvent) bool {
 	if s.redisClient == nil {
 		return false
 	}
@@ -83,10 +109,84 @@ func (s *Scheduler) writeEventTelemetry(payload []byte) bool {
 			maxEntries = s.cfg.RedisEventMaxEntries
 		}
 	}
-	pipe := s.redisClient.TxPipeline()
-	pipe.LPush(context.Background(), "events:history", payload)
-	pipe.LTrim(context.Background(), "events:history", 0, maxEntries-1)
-	pipe.Expire(context.Background(), "events:history", ttl)
-	_, err := pipe.Exec(context.Background())
-	return err == nil
+	event.Revision = 0
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return false
+	}
+	revision, err := redisAppendEvent.Run(context.Background(), s.redisClient,
+		[]string{redisEventRevisionKey, redisEventStreamKey},
+		string(payload), maxEntries, redisEventField, ttl.Milliseconds()).Int64()
+	if err != nil {
+		redisLogger.WithError(err).WithField("event_id", event.ID).Warn("failed writing event to redis")
+		return false
+	}
+	event.Revision = revision
+	return true
+}
+
+// redisEventRevision parses the revision out of a stream entry ID, which the
+// append script writes as "<revision>-0".
+func redisEventRevision(id string) (int64, bool) {
+	ms, _, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, false
+	}
+	revision, err := strconv.ParseInt(ms, 10, 64)
+	return revision, err == nil && revision > 0
+}
+
+// markEventBacklog records that an event went to etcd while Redis was
+// configured, so republishEventBacklog has work to do.
+func (s *Scheduler) markEventBacklog() {
+	s.eventBacklogMu.Lock()
+	s.eventBacklog = true
+	s.eventBacklogMu.Unlock()
+}
+
+// republishEventBacklog moves events that were written to etcd because Redis
+// was unavailable into the Redis stream, oldest first, so Redis watchers see
+// them. Each event is claimed by deleting its etcd key with a compare on its
+// mod revision, so replicas draining together publish it once; an event Redis
+// still refuses is put back and the backlog kept for the next attempt.
+func (s *Scheduler) republishEventBacklog() {
+	if s.redisClient == nil {
+		return
+	}
+	s.eventBacklogMu.Lock()
+	defer s.eventBacklogMu.Unlock()
+	if !s.eventBacklog {
+		return
+	}
+	pingCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	err := s.redisClient.Ping(pingCtx).Err()
+	cancel()
+	if err != nil {
+		return
+	}
+	resp, err := s.RetryableEtcdGet(eventsPrefix, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByModRevision, clientv3.SortAscend))
+	if err != nil {
+		return
+	}
+	for _, kv := range resp.Kvs {
+		var event models.SchedulerEvent
+		if err := json.Unmarshal(kv.Value, &event); err != nil {
+			continue
+		}
+		claimed, err := s.RetryableEtcdCompareAndDelete(string(kv.Key), kv.ModRevision)
+		if err != nil {
+			return
+		}
+		if !claimed {
+			continue
+		}
+		if !s.writeEventTelemetry(&event) {
+			_ = s.RetryableEtcdPut(string(kv.Key), string(kv.Value))
+			return
+		}
+	}
+	if len(resp.Kvs) > 0 {
+		redisLogger.WithField("events", len(resp.Kvs)).Info("republished events written to etcd while redis was unavailable")
+	}
+	s.eventBacklog = false
 }
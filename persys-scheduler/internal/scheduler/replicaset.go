@@ -0,0 +1,575 @@
+package scheduler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	replicaSetIDMetadataKey       = "replicaset_id"
+	replicaSetOrdinalMetadataKey  = "replicaset_ordinal"
+	replicaSetRevisionMetadataKey = "replicaset_template_revision"
+	defaultMaxReplicas            = 100
+)
+
+var replicaSetLogger = logging.C("scheduler.replicaset")
+
+// replicaSetChildID is the workload ID of a replica set child. User IDs
+// cannot contain '.', so it never collides with a workload created directly.
+func replicaSetChildID(replicaSetID string, ordinal int) string {
+	return fmt.Sprintf("%s.%d", replicaSetID, ordinal)
+}
+
+func (s *Scheduler) maxReplicas() int {
+	if s.cfg != nil && s.cfg.SchedulerMaxReplicas > 0 {
+		return s.cfg.SchedulerMaxReplicas
+	}
+	return defaultMaxReplicas
+}
+
+func (s *Scheduler) validateReplicaCount(replicas int) error {
+	if replicas < 0 {
+		return fmt.Errorf("replicas must not be negative")
+	}
+	if max := s.maxReplicas(); replicas > max {
+		return fmt.Errorf("replicas %d exceeds limit %d", replicas, max)
+	}
+	return nil
+}
+
+// replicaSetTemplateRevision hashes the parts of the template that are copied
+// into every child, so a change is detected regardless of child identity.
+// The rollout strategy is left out: changing it must not start a rollout.
+func replicaSetTemplateRevision(template models.Workload) string {
+	clone := template
+	clone.ID = ""
+	clone.RevisionID = ""
+	clone.DesiredState = ""
+	clone.CreatedAt = time.Time{}
+	clone.Logs = ""
+	clone.Status = ""
+	clone.Metadata = nil
+	clone.Rollout = nil
+	payload, _ := json.Marshal(clone)
+	h := sha256.Sum256(payload)
+	return hex.EncodeToString(h[:12])
+}
+
+// ApplyReplicaSet creates or updates a replica set. Children are created,
+// removed and updated by the reconciliation loop.
+func (s *Scheduler) ApplyReplicaSet(rs models.ReplicaSet) (models.ReplicaSet, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	rs.ID = strings.TrimSpace(rs.ID)
+	if rs.ID == "" {
+		rs.ID = uuid.NewString()
+	}
+	if err := s.validateReplicaCount(rs.Replicas); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	if strings.TrimSpace(rs.Template.Type) == "" {
+		return models.ReplicaSet{}, fmt.Errorf("replica set template type is required")
+	}
+	rs.DesiredState = normalizeDesiredStateString(rs.DesiredState)
+	if rs.DesiredState == "Deleted" {
+		return models.ReplicaSet{}, fmt.Errorf("use DeleteReplicaSet to delete replica set %s", rs.ID)
+	}
+	rs.Template.ID = ""
+	rs.Template.RevisionID = ""
+	rs.Template.DesiredState = ""
+	// Folding the referenced secret and config versions into the template
+	// rolls the children when one of them changes.
+	refsRevision, err := s.workloadRefsRevision(rs.Template)
+	if err != nil {
+		return models.ReplicaSet{}, fmt.Errorf("replica set %s: %w", rs.ID, err)
+	}
+	rs.Template.RefsRevision = refsRevision
+	rs.TemplateRevision = replicaSetTemplateRevision(rs.Template)
+
+	existing, found, err := s.getReplicaSetRecord(rs.ID)
+	if err != nil {
+		return models.ReplicaSet{}, err
+	}
+	now := time.Now().UTC()
+	eventType := "ReplicaSetCreated"
+	if found {
+		if existing.DesiredState == "Deleted" {
+			return models.ReplicaSet{}, fmt.Errorf("replica set %s is being deleted", rs.ID)
+		}
+		rs.CreatedAt = existing.CreatedAt
+		rs.Status = existing.Status
+		if existing.Replicas != rs.Replicas {
+			rs.Status.LastScaledAt = now
+		}
+		eventType = "ReplicaSetUpdated"
+	} else {
+		if err := validateObjectID("replica set", rs.ID); err != nil {
+			return models.ReplicaSet{}, err
+		}
+		rs.CreatedAt = now
+		rs.Status = models.ReplicaSetStatus{LastScaledAt: now}
+	}
+	rs.UpdatedAt = now
+
+	if err := s.saveReplicaSetRecord(rs); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	s.emitEvent(eventType, "", "", "Replica set applied", map[string]interface{}{
+		"replicaset_id":     rs.ID,
+		"replicas":          rs.Replicas,
+		"template_revision": rs.TemplateRevision,
+	})
+	return rs, nil
+}
+
+// ScaleReplicaSet changes the desired replica count of an existing replica set.
+func (s *Scheduler) ScaleReplicaSet(replicaSetID string, replicas int) (models.ReplicaSet, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	if err := s.validateReplicaCount(replicas); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	rs, err := s.GetReplicaSet(replicaSetID)
+	if err != nil {
+		return models.ReplicaSet{}, err
+	}
+	if rs.DesiredState == "Deleted" {
+		return models.ReplicaSet{}, fmt.Errorf("replica set %s is being deleted", rs.ID)
+	}
+	if rs.Replicas == replicas {
+		return rs, nil
+	}
+	previous := rs.Replicas
+	now := time.Now().UTC()
+	rs.Replicas = replicas
+	rs.UpdatedAt = now
+	rs.Status.LastScaledAt = now
+	if err := s.saveReplicaSetRecord(rs); err != nil {
+		return models.ReplicaSet{}, err
+	}
+	s.emitEvent("ReplicaSetScaled", "", "", fmt.Sprintf("Scaled from %d to %d replicas", previous, replicas), map[string]interface{}{
+		"replicaset_id": rs.ID,
+		"from":          previous,
+		"to":            replicas,
+	})
+	return rs, nil
+}
+
+// DeleteReplicaSet marks a replica set for deletion. The record is removed once
+// the reconciler has deleted every child.
+func (s *Scheduler) DeleteReplicaSet(replicaSetID string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	rs, err := s.GetReplicaSet(replicaSetID)
+	if err != nil {
+		return err
+	}
+	if rs.DesiredState == "Deleted" {
+		return nil
+	}
+	rs.DesiredState = "Deleted"
+	rs.UpdatedAt = time.Now().UTC()
+	if err := s.saveReplicaSetRecord(rs); err != nil {
+		return err
+	}
+	s.emitEvent("ReplicaSetUpdated", "", "", "Marked for deletion", map[string]interface{}{"replicaset_id": rs.ID})
+	return nil
+}
+
+// GetReplicaSet retrieves a replica set by ID.
+func (s *Scheduler) GetReplicaSet(replicaSetID string) (models.ReplicaSet, error) {
+	rs, found, err := s.getReplicaSetRecord(replicaSetID)
+	if err != nil {
+		return models.ReplicaSet{}, err
+	}
+	if !found {
+		return models.ReplicaSet{}, fmt.Errorf("replica set %s not found", replicaSetID)
+	}
+	return rs, nil
+}
+
+// ListReplicaSets returns all replica sets.
+func (s *Scheduler) ListReplicaSets() ([]models.ReplicaSet, error) {
+	resp, err := s.RetryableEtcdGet(replicaSetsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get replica sets: %v", err)
+	}
+	out := make([]models.ReplicaSet, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var rs models.ReplicaSet
+		if err := json.Unmarshal(kv.Value, &rs); err != nil {
+			replicaSetLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal replica set")
+			continue
+		}
+		out = append(out, rs)
+	}
+	return out, nil
+}
+
+// ReplicaSetIDForTarget resolves target to a replica set ID. Target may be the
+// replica set itself or one of its child workloads.
+func (s *Scheduler) ReplicaSetIDForTarget(target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if _, found, err := s.getReplicaSetRecord(target); err != nil {
+		return "", err
+	} else if found {
+		return target, nil
+	}
+	workload, err := s.GetWorkloadByID(target)
+	if err != nil {
+		return "", fmt.Errorf("%s is neither a replica set nor a workload", target)
+	}
+	if id, ok := metadataString(workload.Metadata, replicaSetIDMetadataKey); ok && strings.TrimSpace(id) != "" {
+		return id, nil
+	}
+	return "", fmt.Errorf("workload %s is not managed by a replica set", target)
+}
+
+func (s *Scheduler) getReplicaSetRecord(replicaSetID string) (models.ReplicaSet, bool, error) {
+	resp, err := s.RetryableEtcdGet(replicaSetKey(replicaSetID))
+	if err != nil {
+		return models.ReplicaSet{}, false, fmt.Errorf("failed to get replica set %s: %v", replicaSetID, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.ReplicaSet{}, false, nil
+	}
+	var rs models.ReplicaSet
+	if err := json.Unmarshal(resp.Kvs[0].Value, &rs); err != nil {
+		return models.ReplicaSet{}, false, fmt.Errorf("failed to unmarshal replica set %s: %v", replicaSetID, err)
+	}
+	return rs, true, nil
+}
+
+func (s *Scheduler) saveReplicaSetRecord(rs models.ReplicaSet) error {
+	payload, err := json.Marshal(rs)
+	if err != nil {
+		return fmt.Errorf("marshal replica set %s: %w", rs.ID, err)
+	}
+	return s.RetryableEtcdPut(replicaSetKey(rs.ID), string(payload))
+}
+
+// replicaSetChild builds the desired child workload for ordinal.
+func replicaSetChild(rs models.ReplicaSet, ordinal int) models.Workload {
+	child := rs.Template
+	child.ID = replicaSetChildID(rs.ID, ordinal)
+	base := strings.TrimSpace(rs.Template.Name)
+	if base == "" {
+		base = rs.ID
+	}
+	child.Name = fmt.Sprintf("%s-%d", base, ordinal)
+	child.DesiredState = rs.DesiredState
+	child.EnvVars = copyStringMap(rs.Template.EnvVars)
+	child.Labels = copyStringMap(rs.Template.Labels)
+	child.Metadata = map[string]interface{}{}
+	for k, v := range rs.Template.Metadata {
+		child.Metadata[k] = v
+	}
+	child.Metadata[replicaSetIDMetadataKey] = rs.ID
+	child.Metadata[replicaSetOrdinalMetadataKey] = ordinal
+	child.Metadata[replicaSetRevisionMetadataKey] = rs.TemplateRevision
+	return child
+}
+
+func copyStringMap(in map[string]string) map[string]string {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// ReconcileReplicaSets converges the child workloads of every replica set.
+func (s *Scheduler) ReconcileReplicaSets() error {
+	if !s.isWritable() {
+		return errControlPlaneFrozen
+	}
+	sets, err := s.ListReplicaSets()
+	if err != nil {
+		return err
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	children := make(map[string][]models.Workload)
+	for _, w := range workloads {
+		if id, ok := metadataString(w.Metadata, replicaSetIDMetadataKey); ok && id != "" {
+			children[id] = append(children[id], w)
+		}
+	}
+	for _, rs := range sets {
+		if err := s.reconcileReplicaSet(rs, children[rs.ID]); err != nil {
+			replicaSetLogger.WithError(err).WithField("replicaset_id", rs.ID).Warn("failed to reconcile replica set")
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcileReplicaSet(rs models.ReplicaSet, children []models.Workload) error {
+	desired := rs.Replicas
+	if rs.DesiredState == "Deleted" {
+		desired = 0
+	}
+
+	taken := make(map[int]struct{}, len(children))
+	active := make([]models.Workload, 0, len(children))
+	for _, child := range children {
+		// By ordinal rather than ID: children created before child IDs
+		// used '.' still hold theirs.
+		if ordinal, ok := metadataInt(child.Metadata, replicaSetOrdinalMetadataKey); ok {
+			taken[ordinal] = struct{}{}
+		}
+		if strings.EqualFold(child.DesiredState, "Deleted") {
+			continue
+		}
+		active = append(active, child)
+	}
+	sort.Slice(active, func(i, j int) bool {
+		oi, _ := metadataInt(active[i].Metadata, replicaSetOrdinalMetadataKey)
+		oj, _ := metadataInt(active[j].Metadata, replicaSetOrdinalMetadataKey)
+		return oi < oj
+	})
+
+	strategy := rolloutStrategy(rs.Template)
+	rolling := strategy.Type == models.RolloutRollingUpdate && desired > 0 && countOutdatedReplicas(rs, active) > 0
+	limit := desired
+	if rolling {
+		limit += strategy.MaxSurge
+	}
+
+	// Scale down from the highest ordinal so names stay dense.
+	for len(active) > limit {
+		victim := active[len(active)-1]
+		if err := s.MarkWorkloadDeleted(victim.ID); err != nil {
+			return fmt.Errorf("scale down %s: %w", victim.ID, err)
+		}
+		active = active[:len(active)-1]
+		replicaSetLogger.WithFields(logrus.Fields{
+			"replicaset_id": rs.ID,
+			"workload_id":   victim.ID,
+		}).Info("removed replica")
+	}
+
+	for len(active) < desired {
+		child, err := s.createReplica(rs, taken)
+		if err != nil {
+			return err
+		}
+		active = append(active, child)
+	}
+
+	// Roll template and desired state changes into existing children.
+	if rolling {
+		var err error
+		if active, err = s.rollReplicaSet(rs, strategy, desired, active, taken); err != nil {
+			return err
+		}
+	} else {
+		for i, child := range active {
+			if replicaUpToDate(rs, child) && child.DesiredState == rs.DesiredState {
+				continue
+			}
+			updated, err := s.updateReplica(rs, child)
+			if err != nil {
+				return err
+			}
+			active[i] = updated
+		}
+	}
+
+	if rs.DesiredState == "Deleted" && len(children) == 0 {
+		if err := s.RetryableEtcdDelete(replicaSetKey(rs.ID)); err != nil {
+			return err
+		}
+		s.emitEvent("ReplicaSetDeleted", "", "", "All replicas removed", map[string]interface{}{"replicaset_id": rs.ID})
+		return nil
+	}
+
+	status := rs.Status
+	status.CurrentReplicas = len(active)
+	status.ReadyReplicas = 0
+	status.UpdatedReplicas = 0
+	status.WorkloadIDs = make([]string, 0, len(active))
+	for _, child := range active {
+		if strings.EqualFold(child.Status, "Running") {
+			status.ReadyReplicas++
+		}
+		if replicaUpToDate(rs, child) {
+			status.UpdatedReplicas++
+		}
+		status.WorkloadIDs = append(status.WorkloadIDs, child.ID)
+	}
+	if status.CurrentReplicas == rs.Status.CurrentReplicas &&
+		status.ReadyReplicas == rs.Status.ReadyReplicas &&
+		status.UpdatedReplicas == rs.Status.UpdatedReplicas &&
+		strings.Join(status.WorkloadIDs, ",") == strings.Join(rs.Status.WorkloadIDs, ",") {
+		return nil
+	}
+	status.LastReconciledAt = time.Now().UTC()
+
+	// Re-read so a scale or apply that landed during this pass is not lost.
+	current, found, err := s.getReplicaSetRecord(rs.ID)
+	if err != nil || !found {
+		return err
+	}
+	current.Status.CurrentReplicas = status.CurrentReplicas
+	current.Status.ReadyReplicas = status.ReadyReplicas
+	current.Status.UpdatedReplicas = status.UpdatedReplicas
+	current.Status.WorkloadIDs = status.WorkloadIDs
+	current.Status.LastReconciledAt = status.LastReconciledAt
+	return s.saveReplicaSetRecord(current)
+}
+
+func replicaUpToDate(rs models.ReplicaSet, child models.Workload) bool {
+	revision, _ := metadataString(child.Metadata, replicaSetRevisionMetadataKey)
+	return revision == rs.TemplateRevision
+}
+
+func countOutdatedReplicas(rs models.ReplicaSet, active []models.Workload) int {
+	n := 0
+	for _, child := range active {
+		if !replicaUpToDate(rs, child) {
+			n++
+		}
+	}
+	return n
+}
+
+// createReplica creates a child in the lowest ordinal not still held by a
+// deleting child.
+func (s *Scheduler) createReplica(rs models.ReplicaSet, taken map[int]struct{}) (models.Workload, error) {
+	ordinal := 0
+	for {
+		if _, ok := taken[ordinal]; !ok {
+			break
+		}
+		ordinal++
+	}
+	child, err := s.createWorkload(replicaSetChild(rs, ordinal))
+	if err != nil {
+		return models.Workload{}, fmt.Errorf("scale up %s: %w", replicaSetChildID(rs.ID, ordinal), err)
+	}
+	taken[ordinal] = struct{}{}
+	replicaSetLogger.WithFields(logrus.Fields{
+		"replicaset_id": rs.ID,
+		"workload_id":   child.ID,
+	}).Info("created replica")
+	return child, nil
+}
+
+// updateReplica rewrites a child in place from the current template.
+func (s *Scheduler) updateReplica(rs models.ReplicaSet, child models.Workload) (models.Workload, error) {
+	ordinal, _ := metadataInt(child.Metadata, replicaSetOrdinalMetadataKey)
+	updated, err := s.UpdateWorkloadSpec(child.ID, replicaSetChild(rs, ordinal))
+	if err != nil {
+		return models.Workload{}, fmt.Errorf("update %s: %w", child.ID, err)
+	}
+	updated.Metadata[replicaSetRevisionMetadataKey] = rs.TemplateRevision
+	if err := s.saveWorkload(updated); err != nil {
+		return models.Workload{}, fmt.Errorf("update %s: %w", child.ID, err)
+	}
+	return updated, nil
+}
+
+// rollReplicaSet moves outdated children to the current template while
+// keeping at least desired-MaxUnavailable replicas available. With MaxSurge,
+// replacements are started first and outdated children are retired as the
+// replacements become available; otherwise children are updated in place.
+// Unavailable outdated children are replaced first since that costs nothing.
+func (s *Scheduler) rollReplicaSet(rs models.ReplicaSet, strategy models.RolloutStrategy, desired int, active []models.Workload, taken map[int]struct{}) ([]models.Workload, error) {
+	updatedCount := 0
+	for _, child := range active {
+		if replicaUpToDate(rs, child) {
+			updatedCount++
+		}
+	}
+	for len(active) < desired+strategy.MaxSurge && updatedCount < desired {
+		child, err := s.createReplica(rs, taken)
+		if err != nil {
+			return active, err
+		}
+		active = append(active, child)
+		updatedCount++
+	}
+
+	available := 0
+	var outdated []int
+	for i, child := range active {
+		if replicaAvailable(child) {
+			available++
+		}
+		if !replicaUpToDate(rs, child) {
+			outdated = append(outdated, i)
+		} else if child.DesiredState != rs.DesiredState {
+			updated, err := s.updateReplica(rs, child)
+			if err != nil {
+				return active, err
+			}
+			active[i] = updated
+		}
+	}
+	sort.SliceStable(outdated, func(a, b int) bool {
+		return !replicaAvailable(active[outdated[a]]) && replicaAvailable(active[outdated[b]])
+	})
+
+	minAvailable := desired - strategy.MaxUnavailable
+	removed := make(map[int]struct{})
+	count := len(active)
+	for _, i := range outdated {
+		child := active[i]
+		if replicaAvailable(child) {
+			if available-1 < minAvailable {
+				break
+			}
+			available--
+		}
+		if count > desired {
+			if err := s.MarkWorkloadDeleted(child.ID); err != nil {
+				return active, fmt.Errorf("retire %s: %w", child.ID, err)
+			}
+			removed[i] = struct{}{}
+			count--
+			replicaSetLogger.WithFields(logrus.Fields{
+				"replicaset_id": rs.ID,
+				"workload_id":   child.ID,
+			}).Info("retired outdated replica")
+			continue
+		}
+		updated, err := s.updateReplica(rs, child)
+		if err != nil {
+			return active, err
+		}
+		active[i] = updated
+	}
+
+	if len(removed) == 0 {
+		return active, nil
+	}
+	kept := active[:0]
+	for i, child := range active {
+		if _, ok := removed[i]; !ok {
+			kept = append(kept, child)
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,255 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	defaultRevisionHistoryLimit = 10
+
+	// changeCauseMetadataKey lets callers (e.g. CI redeploys) annotate why a
+	// spec changed; it is recorded on the revision.
+	changeCauseMetadataKey = "persys.change_cause"
+)
+
+func (s *Scheduler) revisionHistoryLimit() int {
+	if s.cfg != nil && s.cfg.SchedulerRevisionHistoryLimit > 0 {
+		return s.cfg.SchedulerRevisionHistoryLimit
+	}
+	return defaultRevisionHistoryLimit
+}
+
+func revisionKey(workloadID, revisionID string) string {
+	return revisionPrefix(workloadID) + revisionID
+}
+
+// revisionSpec strips runtime state and scheduler-only settings from w so the
+// revision holds just what is sent to the agent.
+func revisionSpec(w models.Workload) models.Workload {
+	spec := w
+	spec.AssignedNode = ""
+	spec.NominatedNode = ""
+	spec.NodeID = ""
+	spec.Status = ""
+	spec.DesiredState = ""
+	spec.Logs = ""
+	spec.Metadata = nil
+	spec.Retry = models.RetryState{}
+	spec.StatusInfo = models.WorkloadStatusInfo{}
+	spec.HostPorts = nil
+	spec.Usage = nil
+	spec.Priority = 0
+	spec.PriorityClass = ""
+	spec.Rollout = nil
+	return spec
+}
+
+// restoreRevisionSpec returns current with its spec replaced by the revision,
+// keeping identity, placement, runtime state and scheduler-only settings.
+func restoreRevisionSpec(current models.Workload, rev models.WorkloadRevision) models.Workload {
+	out := rev.Spec
+	out.ID = current.ID
+	out.Namespace = current.Namespace
+	out.RevisionID = rev.RevisionID
+	out.CreatedAt = current.CreatedAt
+	out.AssignedNode = current.AssignedNode
+	out.NominatedNode = current.NominatedNode
+	out.NodeID = current.NodeID
+	out.Status = current.Status
+	out.DesiredState = current.DesiredState
+	out.Logs = current.Logs
+	out.Metadata = current.Metadata
+	out.Retry = current.Retry
+	out.StatusInfo = current.StatusInfo
+	out.HostPorts = current.HostPorts
+	out.Usage = current.Usage
+	out.Priority = current.Priority
+	out.PriorityClass = current.PriorityClass
+	out.Rollout = current.Rollout
+	return out
+}
+
+func changeCause(metadata map[string]interface{}, fallback string) string {
+	if cause, ok := metadataString(metadata, changeCauseMetadataKey); ok && strings.TrimSpace(cause) != "" {
+		return strings.TrimSpace(cause)
+	}
+	return fallback
+}
+
+// replicaSetOwner returns the replica set managing w, if any. Replica set
+// children follow the set's template, so they keep no history of their own.
+func replicaSetOwner(w models.Workload) (string, bool) {
+	id, ok := metadataString(w.Metadata, replicaSetIDMetadataKey)
+	id = strings.TrimSpace(id)
+	return id, ok && id != ""
+}
+
+// listWorkloadRevisions returns the stored revisions of a workload, oldest first.
+func (s *Scheduler) listWorkloadRevisions(workloadID string) ([]models.WorkloadRevision, error) {
+	resp, err := s.RetryableEtcdGet(revisionPrefix(workloadID), clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions for workload %s: %w", workloadID, err)
+	}
+	out := make([]models.WorkloadRevision, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var rev models.WorkloadRevision
+		if err := json.Unmarshal(kv.Value, &rev); err != nil {
+			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal workload revision")
+			continue
+		}
+		out = append(out, rev)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Revision < out[j].Revision })
+	return out, nil
+}
+
+// ListWorkloadRevisions returns the revision history of a workload, newest first.
+func (s *Scheduler) ListWorkloadRevisions(workloadID string) ([]models.WorkloadRevision, error) {
+	if _, err := s.GetWorkloadByID(workloadID); err != nil {
+		return nil, err
+	}
+	revisions, err := s.listWorkloadRevisions(workloadID)
+	if err != nil {
+		return nil, err
+	}
+	for i, j := 0, len(revisions)-1; i < j; i, j = i+1, j-1 {
+		revisions[i], revisions[j] = revisions[j], revisions[i]
+	}
+	return revisions, nil
+}
+
+// recordWorkloadRevision stores w's spec under its RevisionID with the next
+// sequence number and prunes history beyond the configured limit. A revision
+// that already exists keeps its spec and is only re-stamped, which is how a
+// rollback becomes the newest revision. It returns the sequence number, or 0
+// for replica set children.
+func (s *Scheduler) recordWorkloadRevision(w models.Workload, cause string) (int, error) {
+	if _, owned := replicaSetOwner(w); owned || strings.TrimSpace(w.RevisionID) == "" {
+		return 0, nil
+	}
+	revisions, err := s.listWorkloadRevisions(w.ID)
+	if err != nil {
+		return 0, err
+	}
+	entry := models.WorkloadRevision{
+		WorkloadID: w.ID,
+		RevisionID: w.RevisionID,
+		Spec:       revisionSpec(w),
+		CreatedAt:  time.Now().UTC(),
+	}
+	kept := revisions[:0]
+	for _, rev := range revisions {
+		if rev.Revision >= entry.Revision {
+			entry.Revision = rev.Revision
+		}
+		if rev.RevisionID == w.RevisionID {
+			entry.Spec = rev.Spec
+			entry.CreatedAt = rev.CreatedAt
+			continue
+		}
+		kept = append(kept, rev)
+	}
+	entry.Revision++
+	entry.ChangeCause = cause
+
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal revision %s of workload %s: %w", entry.RevisionID, w.ID, err)
+	}
+	if err := s.RetryableEtcdPut(revisionKey(w.ID, entry.RevisionID), string(payload)); err != nil {
+		return 0, fmt.Errorf("failed to record revision %s of workload %s: %w", entry.RevisionID, w.ID, err)
+	}
+
+	// kept is oldest first and excludes the revision just written.
+	for excess := len(kept) + 1 - s.revisionHistoryLimit(); excess > 0; excess-- {
+		old := kept[0]
+		kept = kept[1:]
+		if err := s.RetryableEtcdDelete(revisionKey(w.ID, old.RevisionID)); err != nil {
+			schedulerLogger.WithError(err).WithFields(logrus.Fields{
+				"workload_id": w.ID,
+				"revision_id": old.RevisionID,
+			}).Warn("failed to prune workload revision")
+		}
+	}
+	return entry.Revision, nil
+}
+
+// RollbackWorkload reapplies a stored revision of a workload. An empty
+// revisionID picks the newest revision other than the current one.
+func (s *Scheduler) RollbackWorkload(workloadID, revisionID string) (models.Workload, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Workload{}, err
+	}
+	current, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return models.Workload{}, err
+	}
+	if owner, owned := replicaSetOwner(current); owned {
+		return models.Workload{}, fmt.Errorf("workload %s is managed by replica set %s; apply the previous template to the replica set instead", workloadID, owner)
+	}
+	if strings.EqualFold(current.DesiredState, "Deleted") {
+		return models.Workload{}, fmt.Errorf("workload %s is being deleted", workloadID)
+	}
+	revisions, err := s.listWorkloadRevisions(workloadID)
+	if err != nil {
+		return models.Workload{}, err
+	}
+
+	revisionID = strings.TrimSpace(revisionID)
+	var target *models.WorkloadRevision
+	for i := len(revisions) - 1; i >= 0; i-- {
+		rev := revisions[i]
+		if (revisionID == "" && rev.RevisionID != current.RevisionID) || rev.RevisionID == revisionID {
+			target = &revisions[i]
+			break
+		}
+	}
+	switch {
+	case target == nil && revisionID == "":
+		return models.Workload{}, fmt.Errorf("workload %s has no previous revision", workloadID)
+	case target == nil:
+		return models.Workload{}, fmt.Errorf("revision %s not found for workload %s", revisionID, workloadID)
+	case target.RevisionID == current.RevisionID:
+		return models.Workload{}, fmt.Errorf("workload %s is already at revision %s", workloadID, revisionID)
+	}
+
+	restored := restoreRevisionSpec(current, *target)
+	if restored.Metadata == nil {
+		restored.Metadata = map[string]interface{}{}
+	}
+	if !reflect.DeepEqual(restored.Ports, current.Ports) {
+		if err := s.reserveHostPorts(&restored); err != nil {
+			return models.Workload{}, err
+		}
+	}
+	seq, err := s.recordWorkloadRevision(restored, fmt.Sprintf("Rollback to revision %d", target.Revision))
+	if err != nil {
+		return models.Workload{}, err
+	}
+	startRollout(&restored, seq, current.RevisionID, fmt.Sprintf("rolling back to revision %d", target.Revision))
+	restored.Status = "Updating"
+	restored.StatusInfo.ActualState = "Pending"
+	restored.StatusInfo.LastUpdated = time.Now().UTC()
+	restored.Metadata["last_action"] = "RolledBack"
+
+	if err := s.saveWorkload(restored); err != nil {
+		return models.Workload{}, err
+	}
+	if isMetadataTrue(restored.Metadata, workloadQueuedMetadataKey) {
+		s.activatePendingQueue("queued workload rolled back")
+	}
+	s.emitEvent("WorkloadRolledBack", restored.ID, restored.NodeID, fmt.Sprintf("Rolled back to revision %d", target.Revision), map[string]interface{}{
+		"revision_id":          restored.RevisionID,
+		"previous_revision_id": current.RevisionID,
+	})
+	return restored, nil
+}
@@ -0,0 +1,121 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+// rolloutStrategy resolves a workload's strategy, defaulting to Recreate. A
+// rolling update with neither budget set replaces one replica at a time.
+func rolloutStrategy(w models.Workload) models.RolloutStrategy {
+	if w.Rollout == nil || !strings.EqualFold(w.Rollout.Type, models.RolloutRollingUpdate) {
+		return models.RolloutStrategy{Type: models.RolloutRecreate}
+	}
+	out := models.RolloutStrategy{
+		Type:           models.RolloutRollingUpdate,
+		MaxUnavailable: w.Rollout.MaxUnavailable,
+		MaxSurge:       w.Rollout.MaxSurge,
+	}
+	if out.MaxUnavailable < 0 {
+		out.MaxUnavailable = 0
+	}
+	if out.MaxSurge < 0 {
+		out.MaxSurge = 0
+	}
+	if out.MaxUnavailable == 0 && out.MaxSurge == 0 {
+		out.MaxUnavailable = 1
+	}
+	return out
+}
+
+// startRollout marks w's current revision as progressing towards the agent.
+func startRollout(w *models.Workload, revision int, previousRevisionID, message string) {
+	w.StatusInfo.Rollout = &models.RolloutStatus{
+		Strategy:           rolloutStrategy(*w).Type,
+		Revision:           revision,
+		RevisionID:         w.RevisionID,
+		PreviousRevisionID: previousRevisionID,
+		Phase:              models.RolloutProgressing,
+		Message:            message,
+		StartedAt:          time.Now().UTC(),
+	}
+	// Probe verdicts belong to the previous revision.
+	resetProbeStates(w)
+}
+
+// advanceRollout completes or fails the rollout of w's current revision once
+// the agent is seen running observedRevision in the given state. A failed
+// rollout can still complete if the revision recovers. It reports whether the
+// rollout status changed.
+func advanceRollout(w *models.Workload, state, observedRevision string) bool {
+	ro := w.StatusInfo.Rollout
+	if ro == nil || ro.Phase == models.RolloutComplete || ro.RevisionID != w.RevisionID {
+		return false
+	}
+	if strings.TrimSpace(observedRevision) != w.RevisionID {
+		return false
+	}
+	now := time.Now().UTC()
+	switch {
+	case strings.EqualFold(state, "Running"):
+		ro.Phase = models.RolloutComplete
+		ro.Message = fmt.Sprintf("revision %s is running", w.RevisionID)
+		ro.CompletedAt = now
+		return true
+	case strings.EqualFold(state, "Failed") && ro.Phase != models.RolloutFailed:
+		ro.Phase = models.RolloutFailed
+		ro.Message = strings.TrimSpace(w.StatusInfo.FailureReason)
+		if ro.Message == "" {
+			ro.Message = fmt.Sprintf("revision %s failed", w.RevisionID)
+		}
+		ro.CompletedAt = now
+		return true
+	}
+	return false
+}
+
+func (s *Scheduler) emitRolloutEvent(w models.Workload) {
+	ro := w.StatusInfo.Rollout
+	if ro == nil {
+		return
+	}
+	eventType := "RolloutComplete"
+	if ro.Phase == models.RolloutFailed {
+		eventType = "RolloutFailed"
+	}
+	s.emitEvent(eventType, w.ID, w.NodeID, ro.Message, map[string]interface{}{
+		"revision_id": ro.RevisionID,
+		"revision":    ro.Revision,
+	})
+}
+
+// observeAgentRevision advances the rollout of a workload from what its agent
+// reports. Drift detection calls it with the agent's revision, which is the
+// only confirmation that an in-place update actually landed.
+func (s *Scheduler) observeAgentRevision(workloadID, state, agentRevision string) {
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return
+	}
+	if !advanceRollout(&workload, state, agentRevision) {
+		return
+	}
+	if err := s.saveWorkload(workload); err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workloadID).Warn("failed to persist rollout status")
+		return
+	}
+	s.emitRolloutEvent(workload)
+}
+
+// replicaAvailable reports whether a replica is ready and running its latest
+// revision. Workloads from before rollout tracking count as available when
+// ready.
+func replicaAvailable(w models.Workload) bool {
+	if !WorkloadReady(w) {
+		return false
+	}
+	return w.StatusInfo.Rollout == nil || w.StatusInfo.Rollout.Phase == models.RolloutComplete
+}
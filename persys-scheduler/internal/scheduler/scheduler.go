@@ -3,13 +3,14 @@ package scheduler
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/auth"
 	cfgpkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/config"
 	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
 	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
@@ -24,10 +25,16 @@ const (
 	etcdTimeout   = 5 * time.Second
 	maxRetries    = 5
 	retryWaitTime = 2 * time.Second
+
+	// scheduleReservationAttempts bounds re-placement when reservations race.
+	scheduleReservationAttempts = 3
 )
 
 var schedulerLogger = logging.C("scheduler.core")
 
+// ErrWorkloadNotFound is returned for workload IDs that have no record.
+var ErrWorkloadNotFound = errors.New("workload not found")
+
 // Scheduler holds the state and configuration for the cluster scheduler.
 type Scheduler struct {
 	cfg              *cfgpkg.Config
@@ -48,6 +55,29 @@ type Scheduler struct {
 	cacheNodes       map[string]models.Node
 	cacheWorkloads   map[string]models.Workload
 	cacheAssignments map[string]models.AssignmentRecord
+	cacheRecords     models.StateArchive // sections of a frozen snapshot the informer does not mirror
+	cacheRecordsAt   time.Time
+	informer         *stateInformer
+	usageMu          sync.Mutex
+	usageOpen        map[string]map[string]*models.WorkloadUsagePoint // workload ID -> rollup -> open point
+	usageLeases      map[string]usageLease                            // rollup/step -> lease of its stored points
+	agentStreams     *agentStreamRegistry
+	placement        *placementPipeline
+	secretsKEK       auth.KeyEncrypter
+
+	preemptionMu sync.Mutex
+
+	// eventBacklog is set while events written to etcd because Redis was
+	// unavailable may still wait to be republished to Redis watchers.
+	eventBacklogMu sync.Mutex
+	eventBacklog   bool
+
+	deschedulerMu      sync.Mutex
+	deschedulerLastRun time.Time
+
+	leaderMu sync.RWMutex
+	leading  bool
+	leader   LeaderInfo
 }
 
 // NewScheduler initializes the scheduler with an etcd client and configuration.
@@ -85,10 +115,15 @@ func NewScheduler(cfg *cfgpkg.Config) (*Scheduler, error) {
 		cacheNodes:       map[string]models.Node{},
 		cacheWorkloads:   map[string]models.Workload{},
 		cacheAssignments: map[string]models.AssignmentRecord{},
+		informer:         newStateInformer(),
+		usageOpen:        map[string]map[string]*models.WorkloadUsagePoint{},
+		agentStreams:     newAgentStreamRegistry(cfg.SchedulerAgentStreamResumeWindow),
+		placement:        newPlacementPipeline(cfg),
 	}
 
 	// Initialize monitor and reconciler
 	scheduler.initRedisStore()
+	scheduler.initSecretsKEK()
 	scheduler.monitor = NewMonitor(scheduler)
 	scheduler.reconciler = NewReconciler(scheduler, scheduler.monitor)
 
@@ -119,6 +154,16 @@ func (s *Scheduler) RegisterNode(node models.Node) error {
 
 	node.LastHeartbeat = time.Now()
 	node.DomainName = node.NodeID + "." + s.domain
+	node.AgentTaints = node.Taints
+	node.OperatorTaints = nil
+	if existing, err := s.GetNodeByID(node.NodeID); err == nil {
+		// Re-registration (e.g. after a reboot for patching) must not undo a
+		// cordon, a drain or the taints an operator set.
+		node.Unschedulable = existing.Unschedulable
+		node.Drain = existing.Drain
+		node.OperatorTaints = existing.OperatorTaints
+	}
+	node.Taints = mergeTaints(node.OperatorTaints, node.AgentTaints)
 	if node.Status == "" {
 		node.Status = "Ready"
 	}
@@ -165,6 +210,7 @@ func (s *Scheduler) RegisterNode(node models.Node) error {
 		schedulerLogger.WithField("node_id", node.NodeID).Info("updated CoreDNS record for node")
 	}
 	s.cacheNode(node)
+	s.activatePendingQueue("node registered")
 
 	schedulerLogger.WithField("node_id", node.NodeID).Info("registered node")
 
@@ -177,6 +223,9 @@ func matchesLabels(workloadLabels, nodeLabels map[string]string) bool {
 		return true // No labels to match, accept node
 	}
 	for k, v := range workloadLabels {
+		if k == doNotMoveLabel {
+			continue
+		}
 		if nodeVal, ok := nodeLabels[k]; !ok || nodeVal != v {
 			return false
 		}
@@ -309,100 +358,81 @@ func (s *Scheduler) selectNodeForWorkload(workload models.Workload) (models.Node
 	if !s.isWritable() {
 		return models.Node{}, "", errControlPlaneFrozen
 	}
-	resp, err := s.RetryableEtcdGet(nodesPrefix, clientv3.WithPrefix())
+	nodes, err := s.GetNodes()
 	if err != nil {
 		return models.Node{}, "", fmt.Errorf("failed to get nodes for scheduling: %v", err)
 	}
-	if len(resp.Kvs) == 0 {
-		return models.Node{}, "", fmt.Errorf("no nodes available")
+	if len(nodes) == 0 {
+		return models.Node{}, "", &unschedulableError{msg: "no nodes available"}
 	}
 
-	candidates := make([]models.Node, 0)
-	rejections := make([]string, 0)
-	neededStorageDrivers := requiredStorageDrivers(workload)
-	for _, kv := range resp.Kvs {
-		if isNodeStatusSubKey(string(kv.Key)) {
-			continue
-		}
-		var node models.Node
-		if err := json.Unmarshal(kv.Value, &node); err != nil {
-			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal node data")
-			continue
-		}
-		if !strings.EqualFold(node.Status, "active") && !strings.EqualFold(node.Status, "ready") {
-			reason := strings.TrimSpace(node.StatusReason)
-			if reason == "" {
-				reason = "unspecified"
-			}
-			rejections = append(rejections, fmt.Sprintf("%s: status=%s reason=%q by=%s at=%s", node.NodeID, node.Status, reason, node.StatusUpdatedBy, node.StatusUpdatedAt.UTC().Format(time.RFC3339)))
-			continue
-		}
-		if time.Since(node.LastHeartbeat) > 10*time.Minute {
-			rejections = append(rejections, fmt.Sprintf("%s: heartbeat_stale last=%s", node.NodeID, node.LastHeartbeat.UTC().Format(time.RFC3339)))
-			continue
-		}
-		if !matchesLabels(workload.Labels, node.Labels) {
-			rejections = append(rejections, fmt.Sprintf("%s: label_mismatch", node.NodeID))
-			continue
-		}
-		if !nodeSupportsWorkloadType(node, workload.Type) {
-			rejections = append(rejections, fmt.Sprintf("%s: workload_type_unsupported need=%s supports=%v", node.NodeID, canonicalWorkloadType(workload.Type), node.SupportedWorkloadTypes))
-			continue
+	decision := s.evaluatePlacement(workload, nodes)
+	if decision.Node == nil {
+		rejections := make([]string, 0, len(decision.Evaluations))
+		for _, eval := range decision.Evaluations {
+			rejections = append(rejections, fmt.Sprintf("%s: %s", eval.NodeID, eval.RejectionReason()))
 		}
-		if !nodeSupportsStorageDrivers(node, neededStorageDrivers) {
-			rejections = append(rejections, fmt.Sprintf("%s: storage_driver_unsupported need=%v supports=%v", node.NodeID, neededStorageDrivers, node.SupportedStorageDrivers))
-			continue
-		}
-		if workload.Resources.CPUUsage > 0 && node.AvailableCPU < workload.Resources.CPUUsage {
-			rejections = append(rejections, fmt.Sprintf("%s: cpu_insufficient need=%.3f have=%.3f", node.NodeID, workload.Resources.CPUUsage, node.AvailableCPU))
-			continue
-		}
-		if workload.Resources.MemoryUsage > 0 && float64(node.AvailableMemory) < workload.Resources.MemoryUsage {
-			rejections = append(rejections, fmt.Sprintf("%s: memory_insufficient need=%.0fMB have=%dMB", node.NodeID, workload.Resources.MemoryUsage, node.AvailableMemory))
-			continue
-		}
-		if workload.Resources.DiskUsage >= 0 {
-			availableDisk := int(node.TotalMemory - node.AvailableMemory) // placeholder until explicit disk tracking exists on node model
-			_ = availableDisk
-		}
-		candidates = append(candidates, node)
-	}
-	if len(candidates) == 0 {
 		if len(rejections) > 0 {
-			return models.Node{}, "", fmt.Errorf("no suitable node available (%s)", strings.Join(rejections, "; "))
+			return models.Node{}, "", &unschedulableError{decision: decision, msg: fmt.Sprintf("no suitable node available (%s)", strings.Join(rejections, "; "))}
 		}
-		return models.Node{}, "", fmt.Errorf("no suitable node available")
+		return models.Node{}, "", &unschedulableError{decision: decision, msg: "no suitable node available"}
 	}
-
-	sort.Slice(candidates, func(i, j int) bool {
-		return nodeUtilizationScore(candidates[i]) < nodeUtilizationScore(candidates[j])
-	})
-
-	reason := fmt.Sprintf("selected by lowest utilization score %.4f", nodeUtilizationScore(candidates[0]))
-	return candidates[0], reason, nil
+	return *decision.Node, decision.Reason, nil
 }
 
 func (s *Scheduler) assignWorkload(workload *models.Workload, node models.Node, reason string) error {
 	if err := s.requireWritable(); err != nil {
 		return err
 	}
+	hostPorts, err := s.reserveAllocation(node, *workload)
+	if err != nil {
+		return err
+	}
+	previousNode := workload.NodeID
+	workload.HostPorts = hostPorts
 	if workload.Metadata == nil {
 		workload.Metadata = map[string]interface{}{}
 	}
 	workload.NodeID = node.NodeID
 	workload.AssignedNode = node.NodeID
+	workload.NominatedNode = ""
 	workload.Status = "Scheduled"
 	workload.StatusInfo.ActualState = "Pending"
 	workload.StatusInfo.LastUpdated = time.Now().UTC()
 	workload.Metadata["last_action"] = "Assigned"
 	workload.Metadata["assignment_reason"] = reason
+	s.dequeuePending(workload)
 
+	// A failed write must not leave the new reservation behind. A workload
+	// re-placed on its own node keeps the reservation it already had.
+	releaseReservation := func(cause error) {
+		if previousNode == node.NodeID {
+			return
+		}
+		if err := s.releaseAllocation(node.NodeID, workload.ID); err != nil {
+			schedulerLogger.WithError(err).WithFields(logrus.Fields{
+				"workload_id": workload.ID,
+				"node_id":     node.NodeID,
+				"cause":       cause.Error(),
+			}).Warn("failed to release allocation after a failed assignment")
+		}
+	}
 	if err := s.saveWorkload(*workload); err != nil {
+		releaseReservation(err)
 		return err
 	}
 	if err := s.writeAssignment(workload.ID, node.NodeID, reason); err != nil {
+		releaseReservation(err)
 		return err
 	}
+	if previousNode != "" && previousNode != node.NodeID {
+		if err := s.releaseAllocation(previousNode, workload.ID); err != nil {
+			schedulerLogger.WithError(err).WithFields(logrus.Fields{
+				"workload_id": workload.ID,
+				"node_id":     previousNode,
+			}).Warn("failed to release allocation on previous node")
+		}
+	}
 	s.emitEvent("WorkloadScheduled", workload.ID, node.NodeID, reason, nil)
 	return nil
 }
@@ -419,14 +449,36 @@ func (s *Scheduler) ScheduleWorkload(workload models.Workload) (string, error) {
 	s.ensureWorkloadRevision(&workload)
 	s.initializeWorkloadDefaults(&workload)
 
-	selectedNode, reason, err := s.selectNodeForWorkload(workload)
-	if err != nil {
-		s.emitEvent("WorkloadFailed", workload.ID, "", err.Error(), nil)
-		return "", err
-	}
-
-	if err := s.assignWorkload(&workload, selectedNode, reason); err != nil {
-		return "", fmt.Errorf("failed assigning workload %s: %w", workload.ID, err)
+	// Placement reads a snapshot of the allocation ledger; if a concurrent
+	// schedule took the capacity first, the reservation fails and we pick again.
+	var selectedNode models.Node
+	for attempt := 1; ; attempt++ {
+		node, reason, err := s.selectNodeOrPreempt(workload)
+		var unschedulable *unschedulableError
+		if errors.As(err, &unschedulable) {
+			s.emitEvent("WorkloadUnschedulable", workload.ID, workload.NodeID, err.Error(), nil)
+			// Workloads being moved keep running where they are; new ones wait in the queue.
+			if workload.NodeID == "" {
+				if qErr := s.enqueuePending(&workload, unschedulable); qErr != nil {
+					return "", qErr
+				}
+				return "", fmt.Errorf("%w: %v", errWorkloadQueued, err)
+			}
+			return "", err
+		}
+		if err != nil {
+			s.emitEvent("WorkloadFailed", workload.ID, "", err.Error(), nil)
+			return "", err
+		}
+		err = s.assignWorkload(&workload, node, reason)
+		if err == nil {
+			selectedNode = node
+			break
+		}
+		if !errors.Is(err, errInsufficientCapacity) || attempt >= scheduleReservationAttempts {
+			return "", fmt.Errorf("failed assigning workload %s: %w", workload.ID, err)
+		}
+		schedulerLogger.WithError(err).WithField("workload_id", workload.ID).Info("node capacity taken concurrently; re-running placement")
 	}
 
 	applyResp, err := s.applyWorkloadOnNode(context.Background(), selectedNode, workload)
@@ -482,8 +534,12 @@ func (s *Scheduler) ScheduleWorkload(workload models.Workload) (string, error) {
 	return selectedNode.NodeID, nil
 }
 
-// GetNodes retrieves all nodes from etcd.
+// GetNodes retrieves all nodes, from the state informer once it is synced and
+// from etcd otherwise.
 func (s *Scheduler) GetNodes() ([]models.Node, error) {
+	if s.informerReady() {
+		return s.informer.listNodes(), nil
+	}
 	resp, err := s.RetryableEtcdGet("/nodes/", clientv3.WithPrefix())
 	if err != nil {
 		if s.currentMode() != ModeNormal {
@@ -520,6 +576,12 @@ func (s *Scheduler) GetNodes() ([]models.Node, error) {
 
 // GetNodeByID retrieves a specific node by ID.
 func (s *Scheduler) GetNodeByID(nodeID string) (models.Node, error) {
+	if s.informerReady() {
+		if node, ok := s.informer.node(nodeID); ok {
+			return node, nil
+		}
+		return models.Node{}, fmt.Errorf("node %s not found", nodeID)
+	}
 	resp, err := s.RetryableEtcdGet("/nodes/" + nodeID)
 	if err != nil {
 		if s.currentMode() != ModeNormal {
@@ -567,9 +629,13 @@ func (s *Scheduler) DeleteNode(nodeID string) error {
 	return nil
 }
 
-// GetWorkloads retrieves all workloads from etcd.
+// GetWorkloads retrieves all workloads, from the state informer once it is
+// synced and from etcd otherwise.
 func (s *Scheduler) GetWorkloads() ([]models.Workload, error) {
-	specResp, err := s.RetryableEtcdGet(workloadSpecPrefix, clientv3.WithPrefix())
+	if s.informerReady() {
+		return s.informer.listWorkloads(), nil
+	}
+	specResp, err := s.RetryableEtcdGet(workloadsPrefix, clientv3.WithPrefix())
 	if err != nil {
 		if s.currentMode() != ModeNormal {
 			return s.getCachedWorkloads(), nil
@@ -594,28 +660,19 @@ func (s *Scheduler) GetWorkloads() ([]models.Workload, error) {
 	}
 
 	for _, kv := range specResp.Kvs {
+		if _, ok := workloadIDFromKey(workloadsPrefix, string(kv.Key)); !ok {
+			continue
+		}
 		var spec workloadSpec
 		if err := json.Unmarshal(kv.Value, &spec); err != nil {
 			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal workload spec data")
 			continue
 		}
-		workload := models.Workload{
-			ID: spec.ID, Name: spec.Name, Type: spec.Type, RevisionID: spec.RevisionID, Image: spec.Image, Command: spec.Command,
-			CommandList: spec.CommandList, Compose: spec.Compose, ComposeYAML: spec.ComposeYAML, ProjectName: spec.ProjectName,
-			GitRepo: spec.GitRepo, GitBranch: spec.GitBranch, GitToken: spec.GitToken, EnvVars: spec.EnvVars, Resources: spec.Resources,
-			DesiredState: spec.DesiredState, Labels: spec.Labels, LocalPath: spec.LocalPath, Ports: spec.Ports, Volumes: spec.Volumes,
-			Network: spec.Network, RestartPolicy: spec.RestartPolicy, VM: spec.VM,
-		}
-		if st, ok := statusMap[workload.ID]; ok {
-			workload.AssignedNode = st.AssignedNode
-			workload.NodeID = st.NodeID
-			workload.Status = st.Status
-			workload.Logs = st.Logs
-			workload.Metadata = st.Metadata
-			workload.Retry = st.Retry
-			workload.StatusInfo = st.StatusInfo
+		var status *workloadStatus
+		if st, ok := statusMap[spec.ID]; ok {
+			status = &st
 		}
-		workloads = append(workloads, workload)
+		workloads = append(workloads, workloadFromProjections(spec, status))
 	}
 	if s.currentMode() != ModeNormal && len(workloads) == 0 {
 		// In recovery mode etcd may be reachable but state still empty.
@@ -632,9 +689,22 @@ func (s *Scheduler) GetWorkloads() ([]models.Workload, error) {
 	return workloads, nil
 }
 
-// GetWorkloadByID retrieves a specific workload by ID.
+// GetWorkloadByID retrieves a specific workload by ID. Workloads the informer
+// does not hold are looked up in etcd through their ID record.
 func (s *Scheduler) GetWorkloadByID(workloadID string) (models.Workload, error) {
-	specResp, err := s.RetryableEtcdGet(workloadSpecKey(workloadID))
+	if s.informerReady() {
+		if workload, ok := s.informer.workload(workloadID); ok {
+			return workload, nil
+		}
+	}
+	namespace, found, err := s.workloadNamespaceByID(workloadID)
+	if err == nil && !found {
+		return models.Workload{}, fmt.Errorf("%w: %s", ErrWorkloadNotFound, workloadID)
+	}
+	var specResp *clientv3.GetResponse
+	if err == nil {
+		specResp, err = s.RetryableEtcdGet(workloadSpecKey(namespace, workloadID))
+	}
 	if err != nil {
 		if s.currentMode() != ModeNormal {
 			if workload, ok := s.getCachedWorkload(workloadID); ok {
@@ -643,19 +713,8 @@ func (s *Scheduler) GetWorkloadByID(workloadID string) (models.Workload, error)
 		}
 		return models.Workload{}, fmt.Errorf("failed to get workload %s: %v", workloadID, err)
 	}
-
 	if specResp == nil || len(specResp.Kvs) == 0 {
-		// compatibility shim for legacy persisted full objects.
-		resp, legacyErr := s.RetryableEtcdGet("/workloads/" + workloadID)
-		if legacyErr != nil || len(resp.Kvs) == 0 {
-			return models.Workload{}, fmt.Errorf("workload %s not found", workloadID)
-		}
-		var legacy models.Workload
-		if err := json.Unmarshal(resp.Kvs[0].Value, &legacy); err != nil {
-			return models.Workload{}, fmt.Errorf("failed to unmarshal legacy workload %s: %v", workloadID, err)
-		}
-		s.cacheWorkload(legacy)
-		return legacy, nil
+		return models.Workload{}, fmt.Errorf("%w: %s", ErrWorkloadNotFound, workloadID)
 	}
 
 	var spec workloadSpec
@@ -663,33 +722,33 @@ func (s *Scheduler) GetWorkloadByID(workloadID string) (models.Workload, error)
 		return models.Workload{}, fmt.Errorf("failed to unmarshal workload spec %s: %v", workloadID, err)
 	}
 
-	statusResp, _ := s.RetryableEtcdGet(workloadStatusKey(workloadID))
-	var st workloadStatus
+	statusResp, _ := s.RetryableEtcdGet(workloadStatusKey(namespace, workloadID))
+	var status *workloadStatus
 	if statusResp != nil && len(statusResp.Kvs) > 0 {
-		_ = json.Unmarshal(statusResp.Kvs[0].Value, &st)
+		var st workloadStatus
+		if err := json.Unmarshal(statusResp.Kvs[0].Value, &st); err == nil && st.ID != "" {
+			status = &st
+		}
 	}
 
-	workload := models.Workload{
-		ID: spec.ID, Name: spec.Name, Type: spec.Type, RevisionID: spec.RevisionID, Image: spec.Image, Command: spec.Command,
-		CommandList: spec.CommandList, Compose: spec.Compose, ComposeYAML: spec.ComposeYAML, ProjectName: spec.ProjectName,
-		GitRepo: spec.GitRepo, GitBranch: spec.GitBranch, GitToken: spec.GitToken, EnvVars: spec.EnvVars, Resources: spec.Resources,
-		DesiredState: spec.DesiredState, Labels: spec.Labels, LocalPath: spec.LocalPath, Ports: spec.Ports, Volumes: spec.Volumes,
-		Network: spec.Network, RestartPolicy: spec.RestartPolicy, VM: spec.VM,
-	}
-	if st.ID != "" {
-		workload.AssignedNode = st.AssignedNode
-		workload.NodeID = st.NodeID
-		workload.Status = st.Status
-		workload.Logs = st.Logs
-		workload.Metadata = st.Metadata
-		workload.Retry = st.Retry
-		workload.StatusInfo = st.StatusInfo
-	}
+	workload := workloadFromProjections(spec, status)
 	s.cacheWorkload(workload)
 
 	return workload, nil
 }
 
+// workloadNamespaceByID reads the namespace recorded for workloadID.
+func (s *Scheduler) workloadNamespaceByID(workloadID string) (string, bool, error) {
+	resp, err := s.RetryableEtcdGet(workloadIDKey(workloadID))
+	if err != nil {
+		return "", false, err
+	}
+	if resp == nil || len(resp.Kvs) == 0 {
+		return "", false, nil
+	}
+	return string(resp.Kvs[0].Value), true, nil
+}
+
 // DeleteWorkload removes a workload from etcd.
 func (s *Scheduler) DeleteWorkloadWithContext(ctx context.Context, workloadID string) error {
 	if err := s.requireWritable(); err != nil {
@@ -736,14 +795,35 @@ func (s *Scheduler) DeleteWorkloadWithContext(ctx context.Context, workloadID st
 		}
 	}
 
-	if err := s.RetryableEtcdDelete(workloadSpecKey(workloadID)); err != nil {
-		return fmt.Errorf("failed to delete workload %s: %v", workloadID, err)
+	namespace, found := WorkloadNamespace(workload), err == nil
+	if !found {
+		var lookupErr error
+		if namespace, found, lookupErr = s.workloadNamespaceByID(workloadID); lookupErr != nil {
+			return fmt.Errorf("failed to delete workload %s: %v", workloadID, lookupErr)
+		}
+	}
+	if found {
+		if err := s.RetryableEtcdDelete(workloadSpecKey(namespace, workloadID)); err != nil {
+			return fmt.Errorf("failed to delete workload %s: %v", workloadID, err)
+		}
+		_ = s.RetryableEtcdDelete(workloadStatusKey(namespace, workloadID))
+	}
+	if err == nil {
+		if relErr := s.releaseAllocation(workload.NodeID, workloadID); relErr != nil {
+			schedulerLogger.WithError(relErr).WithField("workload_id", workloadID).Warn("failed to release workload allocation")
+		}
+		if relErr := s.releaseQuota(WorkloadNamespace(workload), workloadID); relErr != nil {
+			schedulerLogger.WithError(relErr).WithField("workload_id", workloadID).Warn("failed to release quota charge")
+		}
 	}
-	_ = s.RetryableEtcdDelete(workloadStatusKey(workloadID))
-	_ = s.RetryableEtcdDelete("/workloads/" + workloadID)
+	_ = s.RetryableEtcdDelete(workloadIDKey(workloadID))
 	_ = s.RetryableEtcdDelete(assignmentKey(workloadID))
 	_ = s.RetryableEtcdDelete(retryKey(workloadID))
 	_ = s.RetryableEtcdDelete(reconciliationKey(workloadID))
+	_ = s.RetryableEtcdDelete(pendingKey(workloadID))
+	_ = s.RetryableEtcdDelete(revisionPrefix(workloadID), clientv3.WithPrefix())
+	s.deleteUsageHistory(workloadID)
+	s.activatePendingQueue("workload deleted")
 	s.emitEvent("Rescheduled", workloadID, "", "Workload state removed", nil)
 	s.removeCachedWorkload(workloadID)
 	schedulerLogger.WithField("workload_id", workloadID).Info("deleted workload")
@@ -764,8 +844,15 @@ func (s *Scheduler) UpdateWorkloadStatus(workloadID, status string) error {
 	if err != nil {
 		return err
 	}
+	if jobChildFinished(workload) {
+		return nil
+	}
 
-	if strings.EqualFold(workload.Status, status) && strings.EqualFold(workload.StatusInfo.ActualState, status) {
+	// The last revision sent to the agent stands in for the running one here;
+	// drift detection confirms in-place updates from the agent's own revision.
+	appliedRevision, _ := metadataString(workload.Metadata, workloadReapplyRevisionKey)
+	rolloutChanged := advanceRollout(&workload, status, appliedRevision)
+	if !rolloutChanged && strings.EqualFold(workload.Status, status) && strings.EqualFold(workload.StatusInfo.ActualState, status) {
 		return nil
 	}
 
@@ -781,6 +868,9 @@ func (s *Scheduler) UpdateWorkloadStatus(workloadID, status string) error {
 	if err := s.saveWorkload(workload); err != nil {
 		return fmt.Errorf("failed to update workload %s status: %v", workloadID, err)
 	}
+	if rolloutChanged {
+		s.emitRolloutEvent(workload)
+	}
 
 	schedulerLogger.WithFields(logrus.Fields{
 		"workload_id": workloadID,
@@ -866,7 +956,8 @@ func (s *Scheduler) UpdateWorkloadMetadata(workloadID string, metadata map[strin
 	return nil
 }
 
-// UpdateWorkloadRuntimeDetails stores structured reason and latest usage for a workload.
+// UpdateWorkloadRuntimeDetails stores structured reason and latest usage for a
+// workload, and adds the usage to its history.
 func (s *Scheduler) UpdateWorkloadRuntimeDetails(workloadID string, reason *models.WorkloadReason, usage *models.WorkloadUsage) error {
 	if err := s.requireWritable(); err != nil {
 		return err
@@ -915,6 +1006,7 @@ func (s *Scheduler) UpdateWorkloadRuntimeDetails(workloadID string, reason *mode
 		if strings.TrimSpace(copied.Source) != "" {
 			workload.Metadata["usage_source"] = copied.Source
 		}
+		s.recordUsageSample(workloadID, copied)
 	}
 
 	workload.StatusInfo.LastUpdated = time.Now().UTC()
@@ -926,6 +1018,9 @@ func (s *Scheduler) UpdateWorkloadRuntimeDetails(workloadID string, reason *mode
 
 // GetWorkloadsByNode retrieves all workloads assigned to a specific node.
 func (s *Scheduler) GetWorkloadsByNode(nodeID string) ([]models.Workload, error) {
+	if s.informerReady() {
+		return s.informer.workloadsByIndex(byNodeIndex(nodeID)), nil
+	}
 	workloads, err := s.GetWorkloads()
 	if err != nil {
 		return nil, err
@@ -941,6 +1036,48 @@ func (s *Scheduler) GetWorkloadsByNode(nodeID string) ([]models.Workload, error)
 	return nodeWorkloads, nil
 }
 
+// GetWorkloadsByStatus retrieves all workloads whose status matches status,
+// ignoring case.
+func (s *Scheduler) GetWorkloadsByStatus(status string) ([]models.Workload, error) {
+	if s.informerReady() {
+		return s.informer.workloadsByIndex(byStatusIndex(status)), nil
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]models.Workload, 0)
+	for _, workload := range workloads {
+		if strings.EqualFold(strings.TrimSpace(workload.Status), strings.TrimSpace(status)) {
+			out = append(out, workload)
+		}
+	}
+	return out, nil
+}
+
+// GetWorkloadsByLabels retrieves all workloads carrying every label in
+// selector. An empty selector matches every workload.
+func (s *Scheduler) GetWorkloadsByLabels(selector map[string]string) ([]models.Workload, error) {
+	if len(selector) > 0 && s.informerReady() {
+		lookups := make([]func(*stateInformer) map[string]struct{}, 0, len(selector))
+		for k, v := range selector {
+			lookups = append(lookups, byLabelIndex(k, v))
+		}
+		return s.informer.workloadsByIndex(lookups...), nil
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]models.Workload, 0, len(workloads))
+	for _, workload := range workloads {
+		if selectorMatches(selector, workload.Labels) {
+			out = append(out, workload)
+		}
+	}
+	return out, nil
+}
+
 // MonitorNodes periodically checks node health and updates status.
 func (s *Scheduler) MonitorNodes(ctx context.Context) {
 	ticker := time.NewTicker(1 * time.Minute)
@@ -971,50 +1108,21 @@ func (s *Scheduler) MonitorNodes(ctx context.Context) {
 	}
 }
 
-// StartMonitoring starts both node monitoring and workload monitoring
+// StartMonitoring starts the mode supervisor and the state informer. They run
+// on every replica; the loops that act on cluster state are started by
+// StartLeaderElection.
 func (s *Scheduler) StartMonitoring(ctx context.Context) {
-	s.bgWG.Add(1)
+	s.bgWG.Add(2)
 	go func() {
 		defer s.bgWG.Done()
 		s.startModeSupervisor(ctx)
 	}()
-
-	// Start node monitoring
-	s.bgWG.Add(1)
-	go func() {
-		defer s.bgWG.Done()
-		s.MonitorNodes(ctx)
-	}()
-
-	// Start workload monitoring
-	if s.monitor != nil {
-		s.bgWG.Add(1)
-		go func() {
-			defer s.bgWG.Done()
-			s.monitor.MonitorWorkloads(ctx, 60*time.Second)
-		}()
-	}
-
-	// Start drift detection loop (agent state vs scheduler state)
-	s.bgWG.Add(1)
 	go func() {
 		defer s.bgWG.Done()
-		s.StartDriftDetection(ctx, s.driftDetectInterval())
+		s.runStateInformer(ctx)
 	}()
 }
 
-// StartReconciliation starts the reconciliation loop
-func (s *Scheduler) StartReconciliation(ctx context.Context) {
-	if s.reconciler != nil {
-		interval := s.cfg.SchedulerReconcileInterval
-		s.bgWG.Add(1)
-		go func() {
-			defer s.bgWG.Done()
-			s.reconciler.StartReconciliationLoop(ctx, interval)
-		}()
-	}
-}
-
 // WaitForBackground blocks until scheduler background workers stop or timeout elapses.
 func (s *Scheduler) WaitForBackground(timeout time.Duration) bool {
 	done := make(chan struct{})
@@ -0,0 +1,933 @@
+package scheduler
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/auth"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	refKindSecret = "secret"
+	refKindConfig = "config"
+
+	secretCryptoTimeout = 10 * time.Second
+	defaultSecretMode   = 0o400
+	defaultConfigMode   = 0o444
+	redactedValue       = "<redacted>"
+	// minRedactedLength keeps very short secret values from being
+	// replaced all over agent messages.
+	minRedactedLength = 4
+)
+
+var errSecretsDisabled = errors.New("secret encryption is not configured: set SCHEDULER_SECRETS_LOCAL_KEY or SCHEDULER_SECRETS_KEK_PROVIDER=vault-transit")
+
+var (
+	secretLogger       = logging.C("scheduler.secrets")
+	dataKeyPattern     = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+	templateVarPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+func (s *Scheduler) initSecretsKEK() {
+	if s.cfg == nil {
+		return
+	}
+	switch s.cfg.SchedulerSecretsKEKProvider {
+	case "vault-transit":
+		s.secretsKEK = auth.NewTransitKEK(auth.Config{
+			VaultAddr:        s.cfg.VaultAddr,
+			VaultAuthMethod:  s.cfg.VaultAuthMethod,
+			VaultToken:       s.cfg.VaultToken,
+			VaultAppRoleID:   s.cfg.VaultAppRoleID,
+			VaultAppSecretID: s.cfg.VaultAppSecretID,
+		}, s.cfg.SchedulerSecretsTransitMount, s.cfg.SchedulerSecretsTransitKey)
+	case "local":
+		if s.cfg.SchedulerSecretsLocalKey == "" {
+			secretLogger.Warn("no local secrets key configured; secrets are disabled")
+			return
+		}
+		key, err := base64.StdEncoding.DecodeString(s.cfg.SchedulerSecretsLocalKey)
+		if err != nil {
+			secretLogger.WithError(err).Warn("invalid local secrets key; secrets are disabled")
+			return
+		}
+		kek, err := auth.NewLocalKEK(key)
+		if err != nil {
+			secretLogger.WithError(err).Warn("invalid local secrets key; secrets are disabled")
+			return
+		}
+		s.secretsKEK = kek
+	default:
+		return
+	}
+	secretLogger.WithField("kek", s.secretsKEK.Name()).Info("secret encryption enabled")
+}
+
+// sealSecretData encrypts data under a fresh data key bound to the secret's
+// location and wraps the data key with the KEK.
+func (s *Scheduler) sealSecretData(namespace, name string, data map[string]string) (kek, wrappedKey, ciphertext string, err error) {
+	if s.secretsKEK == nil {
+		return "", "", "", errSecretsDisabled
+	}
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return "", "", "", err
+	}
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", "", "", err
+	}
+	aead, err := newDataKeyAEAD(dataKey)
+	if err != nil {
+		return "", "", "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", "", "", err
+	}
+	sealed := aead.Seal(nonce, nonce, plaintext, secretAAD(namespace, name))
+
+	ctx, cancel := context.WithTimeout(context.Background(), secretCryptoTimeout)
+	defer cancel()
+	wrappedKey, err = s.secretsKEK.WrapKey(ctx, dataKey)
+	if err != nil {
+		return "", "", "", fmt.Errorf("wrap data key of secret %s/%s: %w", namespace, name, err)
+	}
+	return s.secretsKEK.Name(), wrappedKey, base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (s *Scheduler) openSecret(sealed models.SealedSecret) (map[string]string, error) {
+	if s.secretsKEK == nil {
+		return nil, errSecretsDisabled
+	}
+	if sealed.KEK != s.secretsKEK.Name() {
+		return nil, fmt.Errorf("secret %s/%s is sealed with KEK %s, but this scheduler uses %s", sealed.Namespace, sealed.Name, sealed.KEK, s.secretsKEK.Name())
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), secretCryptoTimeout)
+	defer cancel()
+	dataKey, err := s.secretsKEK.UnwrapKey(ctx, sealed.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key of secret %s/%s: %w", sealed.Namespace, sealed.Name, err)
+	}
+	aead, err := newDataKeyAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := base64.StdEncoding.DecodeString(sealed.Ciphertext)
+	if err != nil || len(raw) < aead.NonceSize() {
+		return nil, fmt.Errorf("secret %s/%s has a malformed ciphertext", sealed.Namespace, sealed.Name)
+	}
+	plaintext, err := aead.Open(nil, raw[:aead.NonceSize()], raw[aead.NonceSize():], secretAAD(sealed.Namespace, sealed.Name))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt secret %s/%s: %w", sealed.Namespace, sealed.Name, err)
+	}
+	data := map[string]string{}
+	if err := json.Unmarshal(plaintext, &data); err != nil {
+		return nil, fmt.Errorf("decode secret %s/%s: %w", sealed.Namespace, sealed.Name, err)
+	}
+	return data, nil
+}
+
+func newDataKeyAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// secretAAD ties a ciphertext to its key, so a record copied to another
+// secret fails to decrypt.
+func secretAAD(namespace, name string) []byte {
+	return []byte(secretKey(namespace, name))
+}
+
+func validateObjectName(kind, name string) error {
+	if !namespaceNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid %s name %q: use lowercase letters, digits and '-', at most 63 characters", kind, name)
+	}
+	return nil
+}
+
+func validateDataKeys(kind, name string, data map[string]string) error {
+	for key := range data {
+		if !dataKeyPattern.MatchString(key) {
+			return fmt.Errorf("%s %s: invalid key %q: use letters, digits, '-', '_' and '.'", kind, name, key)
+		}
+	}
+	return nil
+}
+
+func sameStringMap(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if other, ok := b[k]; !ok || other != v {
+			return false
+		}
+	}
+	return true
+}
+
+func sortedKeys(data map[string]string) []string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// prepareObject normalizes the namespace and name of a secret or config and
+// checks that the namespace exists.
+func (s *Scheduler) prepareObject(kind, namespace, name string, data map[string]string) (string, string, error) {
+	namespace = normalizeNamespace(namespace)
+	name = strings.TrimSpace(name)
+	if err := validateObjectName(kind, name); err != nil {
+		return "", "", err
+	}
+	if err := validateDataKeys(kind, name, data); err != nil {
+		return "", "", err
+	}
+	if _, err := s.GetNamespace(namespace); err != nil {
+		return "", "", err
+	}
+	return namespace, name, nil
+}
+
+// ApplySecret creates or replaces a secret. Its data is sealed before it
+// reaches etcd. When the data changes the version is bumped and workloads
+// referencing the secret are rolled.
+func (s *Scheduler) ApplySecret(secret models.Secret) (models.Secret, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Secret{}, err
+	}
+	namespace, name, err := s.prepareObject(refKindSecret, secret.Namespace, secret.Name, secret.Data)
+	if err != nil {
+		return models.Secret{}, err
+	}
+	if s.secretsKEK == nil {
+		return models.Secret{}, errSecretsDisabled
+	}
+	current, found, err := s.getSealedSecret(namespace, name)
+	if err != nil {
+		return models.Secret{}, err
+	}
+	now := time.Now().UTC()
+	record := models.SealedSecret{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    copyStringMap(secret.Labels),
+		Keys:      sortedKeys(secret.Data),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	dataChanged := true
+	if found {
+		record.CreatedAt = current.CreatedAt
+		record.Version = current.Version
+		// A secret sealed under another KEK cannot be compared and is
+		// treated as changed.
+		if previous, err := s.openSecret(current); err == nil && sameStringMap(previous, secret.Data) {
+			dataChanged = false
+		}
+		if !dataChanged && sameStringMap(current.Labels, secret.Labels) {
+			return secretView(current), nil
+		}
+		if dataChanged {
+			record.Version++
+		}
+	}
+	record.KEK, record.WrappedKey, record.Ciphertext, err = s.sealSecretData(namespace, name, secret.Data)
+	if err != nil {
+		return models.Secret{}, err
+	}
+	if err := s.saveSealedSecret(record); err != nil {
+		return models.Secret{}, err
+	}
+	eventType := "SecretCreated"
+	if found {
+		eventType = "SecretUpdated"
+	}
+	s.emitEvent(eventType, "", "", "Secret applied", map[string]interface{}{
+		"namespace": namespace,
+		"secret":    name,
+		"version":   record.Version,
+		"keys":      strings.Join(record.Keys, ","),
+	})
+	if found && dataChanged {
+		s.rollRefConsumers(namespace, refKindSecret, name)
+	}
+	return secretView(record), nil
+}
+
+// DeleteSecret removes a secret that no workload references any more.
+func (s *Scheduler) DeleteSecret(namespace, name string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	namespace = normalizeNamespace(namespace)
+	name = strings.TrimSpace(name)
+	if _, found, err := s.getSealedSecret(namespace, name); err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("secret %s not found in namespace %s", name, namespace)
+	}
+	if err := s.ensureUnreferenced(namespace, refKindSecret, name); err != nil {
+		return err
+	}
+	if err := s.RetryableEtcdDelete(secretKey(namespace, name)); err != nil {
+		return err
+	}
+	s.emitEvent("SecretDeleted", "", "", "Secret deleted", map[string]interface{}{"namespace": namespace, "secret": name})
+	return nil
+}
+
+// GetSecret returns the metadata and key names of a secret. Values are
+// write-only and only ever sent to agents.
+func (s *Scheduler) GetSecret(namespace, name string) (models.Secret, error) {
+	namespace = normalizeNamespace(namespace)
+	record, found, err := s.getSealedSecret(namespace, strings.TrimSpace(name))
+	if err != nil {
+		return models.Secret{}, err
+	}
+	if !found {
+		return models.Secret{}, fmt.Errorf("secret %s not found in namespace %s", name, namespace)
+	}
+	return secretView(record), nil
+}
+
+// ListSecrets returns the secrets of a namespace, or of all namespaces when
+// namespace is empty, without their values.
+func (s *Scheduler) ListSecrets(namespace string) ([]models.Secret, error) {
+	records, err := s.listSealedSecrets(namespace)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]models.Secret, 0, len(records))
+	for _, record := range records {
+		out = append(out, secretView(record))
+	}
+	return out, nil
+}
+
+func secretView(record models.SealedSecret) models.Secret {
+	return models.Secret{
+		Name:      record.Name,
+		Namespace: record.Namespace,
+		Labels:    record.Labels,
+		Keys:      record.Keys,
+		Version:   record.Version,
+		CreatedAt: record.CreatedAt,
+		UpdatedAt: record.UpdatedAt,
+	}
+}
+
+func (s *Scheduler) listSealedSecrets(namespace string) ([]models.SealedSecret, error) {
+	prefix := secretsPrefix
+	if strings.TrimSpace(namespace) != "" {
+		prefix = secretsPrefix + normalizeNamespace(namespace) + "/"
+	}
+	resp, err := s.RetryableEtcdGet(prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secrets: %v", err)
+	}
+	out := make([]models.SealedSecret, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var record models.SealedSecret
+		if err := json.Unmarshal(kv.Value, &record); err != nil {
+			secretLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal secret")
+			continue
+		}
+		out = append(out, record)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) getSealedSecret(namespace, name string) (models.SealedSecret, bool, error) {
+	resp, err := s.RetryableEtcdGet(secretKey(namespace, name))
+	if err != nil {
+		return models.SealedSecret{}, false, fmt.Errorf("failed to get secret %s/%s: %v", namespace, name, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.SealedSecret{}, false, nil
+	}
+	var record models.SealedSecret
+	if err := json.Unmarshal(resp.Kvs[0].Value, &record); err != nil {
+		return models.SealedSecret{}, false, fmt.Errorf("failed to unmarshal secret %s/%s: %v", namespace, name, err)
+	}
+	return record, true, nil
+}
+
+func (s *Scheduler) saveSealedSecret(record models.SealedSecret) error {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal secret %s/%s: %w", record.Namespace, record.Name, err)
+	}
+	return s.RetryableEtcdPut(secretKey(record.Namespace, record.Name), string(payload))
+}
+
+// ApplyConfig creates or replaces a config. Like secrets, a data change
+// bumps the version and rolls the workloads referencing it.
+func (s *Scheduler) ApplyConfig(config models.Config) (models.Config, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Config{}, err
+	}
+	namespace, name, err := s.prepareObject(refKindConfig, config.Namespace, config.Name, config.Data)
+	if err != nil {
+		return models.Config{}, err
+	}
+	current, found, err := s.getConfigRecord(namespace, name)
+	if err != nil {
+		return models.Config{}, err
+	}
+	now := time.Now().UTC()
+	record := models.Config{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    copyStringMap(config.Labels),
+		Data:      copyStringMap(config.Data),
+		Version:   1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	dataChanged := true
+	if found {
+		record.CreatedAt = current.CreatedAt
+		record.Version = current.Version
+		dataChanged = !sameStringMap(current.Data, config.Data)
+		if !dataChanged && sameStringMap(current.Labels, config.Labels) {
+			return current, nil
+		}
+		if dataChanged {
+			record.Version++
+		}
+	}
+	if err := s.saveConfigRecord(record); err != nil {
+		return models.Config{}, err
+	}
+	eventType := "ConfigCreated"
+	if found {
+		eventType = "ConfigUpdated"
+	}
+	s.emitEvent(eventType, "", "", "Config applied", map[string]interface{}{
+		"namespace": namespace,
+		"config":    name,
+		"version":   record.Version,
+	})
+	if found && dataChanged {
+		s.rollRefConsumers(namespace, refKindConfig, name)
+	}
+	return record, nil
+}
+
+// DeleteConfig removes a config that no workload references any more.
+func (s *Scheduler) DeleteConfig(namespace, name string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	namespace = normalizeNamespace(namespace)
+	name = strings.TrimSpace(name)
+	if _, found, err := s.getConfigRecord(namespace, name); err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("config %s not found in namespace %s", name, namespace)
+	}
+	if err := s.ensureUnreferenced(namespace, refKindConfig, name); err != nil {
+		return err
+	}
+	if err := s.RetryableEtcdDelete(configKey(namespace, name)); err != nil {
+		return err
+	}
+	s.emitEvent("ConfigDeleted", "", "", "Config deleted", map[string]interface{}{"namespace": namespace, "config": name})
+	return nil
+}
+
+func (s *Scheduler) GetConfig(namespace, name string) (models.Config, error) {
+	namespace = normalizeNamespace(namespace)
+	record, found, err := s.getConfigRecord(namespace, strings.TrimSpace(name))
+	if err != nil {
+		return models.Config{}, err
+	}
+	if !found {
+		return models.Config{}, fmt.Errorf("config %s not found in namespace %s", name, namespace)
+	}
+	return record, nil
+}
+
+// ListConfigs returns the configs of a namespace, or of all namespaces when
+// namespace is empty.
+func (s *Scheduler) ListConfigs(namespace string) ([]models.Config, error) {
+	prefix := configsPrefix
+	if strings.TrimSpace(namespace) != "" {
+		prefix = configsPrefix + normalizeNamespace(namespace) + "/"
+	}
+	resp, err := s.RetryableEtcdGet(prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configs: %v", err)
+	}
+	out := make([]models.Config, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var record models.Config
+		if err := json.Unmarshal(kv.Value, &record); err != nil {
+			secretLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal config")
+			continue
+		}
+		out = append(out, record)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) getConfigRecord(namespace, name string) (models.Config, bool, error) {
+	resp, err := s.RetryableEtcdGet(configKey(namespace, name))
+	if err != nil {
+		return models.Config{}, false, fmt.Errorf("failed to get config %s/%s: %v", namespace, name, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.Config{}, false, nil
+	}
+	var record models.Config
+	if err := json.Unmarshal(resp.Kvs[0].Value, &record); err != nil {
+		return models.Config{}, false, fmt.Errorf("failed to unmarshal config %s/%s: %v", namespace, name, err)
+	}
+	return record, true, nil
+}
+
+func (s *Scheduler) saveConfigRecord(record models.Config) error {
+	payload, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("marshal config %s/%s: %w", record.Namespace, record.Name, err)
+	}
+	return s.RetryableEtcdPut(configKey(record.Namespace, record.Name), string(payload))
+}
+
+// objectRef names a secret or config within the namespace of a workload.
+type objectRef struct {
+	kind string
+	name string
+}
+
+func (r objectRef) String() string { return r.kind + " " + r.name }
+
+func refOf(secret, config string) (objectRef, error) {
+	secret, config = strings.TrimSpace(secret), strings.TrimSpace(config)
+	switch {
+	case secret != "" && config != "":
+		return objectRef{}, fmt.Errorf("reference sets both secret %s and config %s", secret, config)
+	case secret != "":
+		return objectRef{kind: refKindSecret, name: secret}, nil
+	case config != "":
+		return objectRef{kind: refKindConfig, name: config}, nil
+	}
+	return objectRef{}, fmt.Errorf("reference must set a secret or a config")
+}
+
+// workloadRefs validates the secret and config references of w and returns
+// the objects they name, without duplicates.
+func workloadRefs(w models.Workload) ([]objectRef, error) {
+	var templateVars []models.TemplateVar
+	if w.VM != nil {
+		templateVars = w.VM.TemplateVars
+	}
+	if canonicalWorkloadType(w.Type) == "vm" && (len(w.EnvFrom) > 0 || len(w.Files) > 0) {
+		return nil, fmt.Errorf("env_from and files are not supported for vm workloads; use template_vars")
+	}
+	seen := map[objectRef]struct{}{}
+	var refs []objectRef
+	add := func(ref objectRef) {
+		if _, ok := seen[ref]; !ok {
+			seen[ref] = struct{}{}
+			refs = append(refs, ref)
+		}
+	}
+	for i, src := range w.EnvFrom {
+		ref, err := refOf(src.Secret, src.Config)
+		if err != nil {
+			return nil, fmt.Errorf("env_from[%d]: %w", i, err)
+		}
+		add(ref)
+	}
+	for i, file := range w.Files {
+		ref, err := refOf(file.Secret, file.Config)
+		if err != nil {
+			return nil, fmt.Errorf("files[%d]: %w", i, err)
+		}
+		p := strings.TrimSpace(file.Path)
+		if p == "" || strings.Contains(p, "..") {
+			return nil, fmt.Errorf("files[%d]: path %q must be set and must not contain '..'", i, file.Path)
+		}
+		if file.Mode < 0 || file.Mode > 0o777 {
+			return nil, fmt.Errorf("files[%d]: invalid mode %o", i, file.Mode)
+		}
+		add(ref)
+	}
+	if git := w.GitTokenRef; git != nil {
+		if strings.TrimSpace(git.Secret) == "" || strings.TrimSpace(git.Key) == "" {
+			return nil, fmt.Errorf("git_token: secret and key are required")
+		}
+		add(objectRef{kind: refKindSecret, name: strings.TrimSpace(git.Secret)})
+	}
+	for i, v := range templateVars {
+		ref, err := refOf(v.Secret, v.Config)
+		if err != nil {
+			return nil, fmt.Errorf("template_vars[%d]: %w", i, err)
+		}
+		if !templateVarPattern.MatchString(v.Name) {
+			return nil, fmt.Errorf("template_vars[%d]: invalid name %q", i, v.Name)
+		}
+		if strings.TrimSpace(v.Key) == "" {
+			return nil, fmt.Errorf("template_vars[%d]: key is required", i)
+		}
+		add(ref)
+	}
+	return refs, nil
+}
+
+func referencesObject(w models.Workload, kind, name string) bool {
+	refs, err := workloadRefs(w)
+	if err != nil {
+		return false
+	}
+	for _, ref := range refs {
+		if ref.kind == kind && ref.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// workloadRefsRevision fingerprints the versions of the secrets and configs
+// w references. It is part of the workload spec, so a new version of a
+// referenced object yields a new workload revision.
+func (s *Scheduler) workloadRefsRevision(w models.Workload) (string, error) {
+	refs, err := workloadRefs(w)
+	if err != nil || len(refs) == 0 {
+		return "", err
+	}
+	namespace := WorkloadNamespace(w)
+	parts := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		var version int
+		switch ref.kind {
+		case refKindSecret:
+			record, found, err := s.getSealedSecret(namespace, ref.name)
+			if err != nil {
+				return "", err
+			}
+			if !found {
+				return "", fmt.Errorf("secret %s not found in namespace %s", ref.name, namespace)
+			}
+			version = record.Version
+		case refKindConfig:
+			record, found, err := s.getConfigRecord(namespace, ref.name)
+			if err != nil {
+				return "", err
+			}
+			if !found {
+				return "", fmt.Errorf("config %s not found in namespace %s", ref.name, namespace)
+			}
+			version = record.Version
+		}
+		parts = append(parts, fmt.Sprintf("%s/%s@%d", ref.kind, ref.name, version))
+	}
+	sort.Strings(parts)
+	h := sha256.Sum256([]byte(strings.Join(parts, ",")))
+	return hex.EncodeToString(h[:8]), nil
+}
+
+// ensureUnreferenced fails when a live workload or controller template still
+// references the object.
+func (s *Scheduler) ensureUnreferenced(namespace, kind, name string) error {
+	var users []string
+	uses := func(w models.Workload) bool {
+		return WorkloadNamespace(w) == namespace && referencesObject(w, kind, name)
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	for _, w := range workloads {
+		if !strings.EqualFold(w.DesiredState, "Deleted") && !jobChildFinished(w) && uses(w) {
+			users = append(users, "workload "+w.ID)
+		}
+	}
+	replicaSets, err := s.ListReplicaSets()
+	if err != nil {
+		return err
+	}
+	for _, rs := range replicaSets {
+		if rs.DesiredState != "Deleted" && uses(rs.Template) {
+			users = append(users, "replica set "+rs.ID)
+		}
+	}
+	jobs, err := s.ListJobs()
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		finished := job.Status.Phase == models.JobSucceeded || job.Status.Phase == models.JobFailed
+		if job.DesiredState != "Deleted" && !finished && uses(job.Template) {
+			users = append(users, "job "+job.ID)
+		}
+	}
+	cronJobs, err := s.ListCronJobs()
+	if err != nil {
+		return err
+	}
+	for _, cj := range cronJobs {
+		if cj.DesiredState != "Deleted" && uses(cj.JobTemplate.Template) {
+			users = append(users, "cron job "+cj.ID)
+		}
+	}
+	if len(users) == 0 {
+		return nil
+	}
+	if len(users) > 5 {
+		users = append(users[:5], fmt.Sprintf("%d more", len(users)-5))
+	}
+	return fmt.Errorf("%s %s is still referenced by %s", kind, name, strings.Join(users, ", "))
+}
+
+// rollRefConsumers moves the consumers of a changed secret or config to its
+// new version. Replica sets roll their children following their rollout
+// strategy; standalone workloads are updated in place. Job runs keep what
+// they started with, later runs get the new version.
+func (s *Scheduler) rollRefConsumers(namespace, kind, name string) {
+	logger := secretLogger.WithFields(logrus.Fields{"namespace": namespace, "kind": kind, "name": name})
+	cause := fmt.Sprintf("%s %s updated", kind, name)
+
+	replicaSets, err := s.ListReplicaSets()
+	if err != nil {
+		logger.WithError(err).Warn("failed to list replica sets to roll")
+	}
+	for _, rs := range replicaSets {
+		if rs.DesiredState == "Deleted" || WorkloadNamespace(rs.Template) != namespace || !referencesObject(rs.Template, kind, name) {
+			continue
+		}
+		if _, err := s.ApplyReplicaSet(rs); err != nil {
+			logger.WithError(err).WithField("replicaset_id", rs.ID).Warn("failed to roll replica set")
+		}
+	}
+
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		logger.WithError(err).Warn("failed to list workloads to roll")
+		return
+	}
+	for _, w := range workloads {
+		if strings.EqualFold(w.DesiredState, "Deleted") || WorkloadNamespace(w) != namespace || !referencesObject(w, kind, name) {
+			continue
+		}
+		if _, owned := replicaSetOwner(w); owned || jobIDOf(w) != "" {
+			continue
+		}
+		update := models.Workload{Metadata: map[string]interface{}{changeCauseMetadataKey: cause}}
+		if _, err := s.UpdateWorkloadSpec(w.ID, update); err != nil {
+			logger.WithError(err).WithField("workload_id", w.ID).Warn("failed to reapply workload")
+		}
+	}
+}
+
+// resolvedRefs holds the values a workload's references resolve to. It only
+// lives while an apply request is built.
+type resolvedRefs struct {
+	env      map[string]string
+	files    []*agentpb.FileMount
+	vars     map[string]string
+	gitToken string
+	// secrets holds every value of the secrets loaded, for redaction.
+	secrets []string
+}
+
+// redact replaces the secret values resolved for a request in text, so agent
+// messages echoing them stay out of workload logs and events.
+func (r resolvedRefs) redact(text string) string {
+	for _, v := range r.secrets {
+		if len(v) >= minRedactedLength {
+			text = strings.ReplaceAll(text, v, redactedValue)
+		}
+	}
+	return text
+}
+
+// withEnv returns the injected variables overlaid with the workload's own.
+func (r resolvedRefs) withEnv(env map[string]string) map[string]string {
+	if len(r.env) == 0 {
+		return env
+	}
+	out := make(map[string]string, len(r.env)+len(env))
+	for k, v := range r.env {
+		out[k] = v
+	}
+	for k, v := range env {
+		out[k] = v
+	}
+	return out
+}
+
+// resolveWorkloadRefs loads the secrets and configs w references. Secret
+// values are decrypted here and never stored.
+func (s *Scheduler) resolveWorkloadRefs(w models.Workload) (resolvedRefs, error) {
+	var out resolvedRefs
+	if _, err := workloadRefs(w); err != nil {
+		return out, err
+	}
+	namespace := WorkloadNamespace(w)
+	loaded := map[objectRef]map[string]string{}
+	load := func(ref objectRef) (map[string]string, error) {
+		if data, ok := loaded[ref]; ok {
+			return data, nil
+		}
+		var data map[string]string
+		switch ref.kind {
+		case refKindSecret:
+			record, found, err := s.getSealedSecret(namespace, ref.name)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				return nil, fmt.Errorf("secret %s not found in namespace %s", ref.name, namespace)
+			}
+			if data, err = s.openSecret(record); err != nil {
+				return nil, err
+			}
+			for _, v := range data {
+				out.secrets = append(out.secrets, v)
+			}
+		case refKindConfig:
+			record, found, err := s.getConfigRecord(namespace, ref.name)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				return nil, fmt.Errorf("config %s not found in namespace %s", ref.name, namespace)
+			}
+			data = record.Data
+		}
+		loaded[ref] = data
+		return data, nil
+	}
+	value := func(ref objectRef, key string) (string, error) {
+		data, err := load(ref)
+		if err != nil {
+			return "", err
+		}
+		v, ok := data[key]
+		if !ok {
+			return "", fmt.Errorf("%s has no key %q", ref, key)
+		}
+		return v, nil
+	}
+
+	for _, src := range w.EnvFrom {
+		ref, _ := refOf(src.Secret, src.Config)
+		data, err := load(ref)
+		if err != nil {
+			return out, err
+		}
+		if out.env == nil {
+			out.env = map[string]string{}
+		}
+		for k, v := range data {
+			out.env[src.Prefix+k] = v
+		}
+	}
+	for _, file := range w.Files {
+		ref, _ := refOf(file.Secret, file.Config)
+		mode := file.Mode
+		if mode == 0 {
+			mode = defaultConfigMode
+			if ref.kind == refKindSecret {
+				mode = defaultSecretMode
+			}
+		}
+		if key := strings.TrimSpace(file.Key); key != "" {
+			v, err := value(ref, key)
+			if err != nil {
+				return out, err
+			}
+			out.files = append(out.files, &agentpb.FileMount{Path: file.Path, Content: []byte(v), Mode: int32(mode)})
+			continue
+		}
+		data, err := load(ref)
+		if err != nil {
+			return out, err
+		}
+		for _, key := range sortedKeys(data) {
+			out.files = append(out.files, &agentpb.FileMount{Path: path.Join(file.Path, key), Content: []byte(data[key]), Mode: int32(mode)})
+		}
+	}
+	if git := w.GitTokenRef; git != nil {
+		token, err := value(objectRef{kind: refKindSecret, name: strings.TrimSpace(git.Secret)}, strings.TrimSpace(git.Key))
+		if err != nil {
+			return out, err
+		}
+		out.gitToken = token
+	}
+	if w.VM != nil {
+		for _, v := range w.VM.TemplateVars {
+			ref, _ := refOf(v.Secret, v.Config)
+			resolved, err := value(ref, strings.TrimSpace(v.Key))
+			if err != nil {
+				return out, err
+			}
+			if out.vars == nil {
+				out.vars = map[string]string{}
+			}
+			out.vars[v.Name] = resolved
+		}
+	}
+	return out, nil
+}
+
+// renderCloudInit executes a cloud-init document as a text/template over the
+// VM's template variables. Documents of VMs without variables are sent as
+// they are, so Jinja templates keep working.
+func renderCloudInit(name, text string, vars map[string]string) (string, error) {
+	if len(vars) == 0 || strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("parse %s template: %w", name, err)
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, vars); err != nil {
+		return "", fmt.Errorf("render %s template: %w", name, err)
+	}
+	return b.String(), nil
+}
+
+// redactWorkloadRecord blanks env values and credentials in a JSON-decoded
+// workload record before it leaves the scheduler.
+func redactWorkloadRecord(record map[string]interface{}) {
+	if env, ok := record["envVars"].(map[string]interface{}); ok {
+		for k := range env {
+			env[k] = redactedValue
+		}
+	}
+	if token, ok := record["gitToken"].(string); ok && token != "" {
+		record["gitToken"] = redactedValue
+	}
+}
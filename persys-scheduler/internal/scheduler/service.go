@@ -0,0 +1,392 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// serviceRecordTTL is short because endpoints move with their workloads.
+const serviceRecordTTL = 30
+
+var serviceLogger = logging.C("scheduler.service")
+
+// skydnsRecord is a CoreDNS etcd plugin record. Records with a port also
+// answer SRV queries.
+type skydnsRecord struct {
+	Host string `json:"host"`
+	Port int    `json:"port,omitempty"`
+	TTL  int    `json:"ttl"`
+}
+
+// ServiceFQDN returns the DNS name a service is published under:
+// <service>.<namespace>.svc.<domain>.
+func (s *Scheduler) ServiceFQDN(svc models.Service) string {
+	return fmt.Sprintf("%s.%s.svc.%s", svc.Name, normalizeNamespace(svc.Namespace), s.domain)
+}
+
+// serviceRecordsPrefix holds every record published for services; the
+// scheduler owns the whole subtree and deletes anything it did not write.
+func (s *Scheduler) serviceRecordsPrefix() string {
+	return fmt.Sprintf("/skydns/%s/svc/", reverseDomain(s.domain))
+}
+
+func (s *Scheduler) serviceRecordKey(svc models.Service, ep models.ServiceEndpoint) string {
+	label := ep.WorkloadID
+	switch {
+	case ep.PortName != "":
+		label += "-" + ep.PortName
+	case ep.Port > 0:
+		label += "-" + strconv.Itoa(ep.Port)
+	}
+	return s.serviceRecordsPrefix() + normalizeNamespace(svc.Namespace) + "/" + svc.Name + "/" + label
+}
+
+func validateService(svc models.Service) error {
+	if err := validateObjectName("service", svc.Name); err != nil {
+		return err
+	}
+	if len(svc.Selector) == 0 {
+		return fmt.Errorf("service %s needs a selector", svc.Name)
+	}
+	seen := map[string]bool{}
+	for i, port := range svc.Ports {
+		if port.Port < 1 || port.Port > 65535 {
+			return fmt.Errorf("service %s: port %d must be between 1 and 65535", svc.Name, port.Port)
+		}
+		if port.HostPort < 0 || port.HostPort > 65535 {
+			return fmt.Errorf("service %s: host port %d must be between 0 and 65535", svc.Name, port.HostPort)
+		}
+		switch port.Protocol {
+		case "tcp", "udp":
+		default:
+			return fmt.Errorf("service %s: unsupported protocol %q", svc.Name, port.Protocol)
+		}
+		if len(svc.Ports) > 1 && port.Name == "" {
+			return fmt.Errorf("service %s: port %d needs a name when a service has several ports", svc.Name, i)
+		}
+		if port.Name != "" {
+			if err := validateObjectName("port", port.Name); err != nil {
+				return fmt.Errorf("service %s: %w", svc.Name, err)
+			}
+			if seen[port.Name] {
+				return fmt.Errorf("service %s: port name %s is used twice", svc.Name, port.Name)
+			}
+			seen[port.Name] = true
+		}
+	}
+	return nil
+}
+
+// ApplyService creates or replaces a service and publishes its records.
+func (s *Scheduler) ApplyService(svc models.Service) (models.Service, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Service{}, err
+	}
+	svc.Namespace = normalizeNamespace(svc.Namespace)
+	svc.Name = strings.TrimSpace(svc.Name)
+	ports := make([]models.ServicePort, 0, len(svc.Ports))
+	for _, port := range svc.Ports {
+		port.Name = strings.ToLower(strings.TrimSpace(port.Name))
+		port.Protocol = strings.ToLower(strings.TrimSpace(port.Protocol))
+		if port.Protocol == "" {
+			port.Protocol = "tcp"
+		}
+		ports = append(ports, port)
+	}
+	svc.Ports = ports
+	if err := validateService(svc); err != nil {
+		return models.Service{}, err
+	}
+	if _, err := s.GetNamespace(svc.Namespace); err != nil {
+		return models.Service{}, err
+	}
+	current, found, err := s.getServiceRecord(svc.Namespace, svc.Name)
+	if err != nil {
+		return models.Service{}, err
+	}
+	now := time.Now().UTC()
+	svc.Selector = copyStringMap(svc.Selector)
+	svc.CreatedAt = now
+	if found {
+		svc.CreatedAt = current.CreatedAt
+	}
+	svc.UpdatedAt = now
+	if err := s.saveServiceRecord(svc); err != nil {
+		return models.Service{}, err
+	}
+	eventType := "ServiceCreated"
+	if found {
+		eventType = "ServiceUpdated"
+	}
+	s.emitEvent(eventType, "", "", "Service applied", map[string]interface{}{
+		"namespace": svc.Namespace,
+		"service":   svc.Name,
+		"fqdn":      s.ServiceFQDN(svc),
+	})
+	// Followers only store the service; the leader publishes its records on
+	// the next reconcile pass.
+	if s.IsLeader() {
+		if err := s.ReconcileServices(); err != nil {
+			serviceLogger.WithError(err).WithField("service", svc.Name).Warn("failed to publish service records")
+		}
+	}
+	return svc, nil
+}
+
+// DeleteService removes a service and its records.
+func (s *Scheduler) DeleteService(namespace, name string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	namespace = normalizeNamespace(namespace)
+	name = strings.TrimSpace(name)
+	if _, found, err := s.getServiceRecord(namespace, name); err != nil {
+		return err
+	} else if !found {
+		return fmt.Errorf("service %s not found in namespace %s", name, namespace)
+	}
+	if err := s.RetryableEtcdDelete(serviceKey(namespace, name)); err != nil {
+		return err
+	}
+	s.emitEvent("ServiceDeleted", "", "", "Service deleted", map[string]interface{}{"namespace": namespace, "service": name})
+	if s.IsLeader() {
+		if err := s.ReconcileServices(); err != nil {
+			serviceLogger.WithError(err).WithField("service", name).Warn("failed to remove service records")
+		}
+	}
+	return nil
+}
+
+// GetService returns a service.
+func (s *Scheduler) GetService(namespace, name string) (models.Service, error) {
+	namespace = normalizeNamespace(namespace)
+	svc, found, err := s.getServiceRecord(namespace, strings.TrimSpace(name))
+	if err != nil {
+		return models.Service{}, err
+	}
+	if !found {
+		return models.Service{}, fmt.Errorf("service %s not found in namespace %s", name, namespace)
+	}
+	return svc, nil
+}
+
+// ListServices returns the services of a namespace, or of all namespaces
+// when namespace is empty.
+func (s *Scheduler) ListServices(namespace string) ([]models.Service, error) {
+	prefix := servicesPrefix
+	if strings.TrimSpace(namespace) != "" {
+		prefix = servicesPrefix + normalizeNamespace(namespace) + "/"
+	}
+	resp, err := s.RetryableEtcdGet(prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get services: %v", err)
+	}
+	out := make([]models.Service, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var svc models.Service
+		if err := json.Unmarshal(kv.Value, &svc); err != nil {
+			serviceLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal service")
+			continue
+		}
+		out = append(out, svc)
+	}
+	return out, nil
+}
+
+// ServiceEndpoints returns the addresses a service currently publishes.
+func (s *Scheduler) ServiceEndpoints(svc models.Service) ([]models.ServiceEndpoint, error) {
+	workloads, err := s.GetWorkloadsByLabels(svc.Selector)
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := s.nodesByID()
+	if err != nil {
+		return nil, err
+	}
+	return serviceEndpoints(svc, workloads, nodes), nil
+}
+
+func (s *Scheduler) nodesByID() (map[string]models.Node, error) {
+	nodes, err := s.GetNodes()
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]models.Node, len(nodes))
+	for _, node := range nodes {
+		out[node.NodeID] = node
+	}
+	return out, nil
+}
+
+// serviceEndpoints lists one endpoint per selected workload and port. Only
+// ready workloads on a node with a known address are published; a port the
+// workload does not publish is left out for that workload.
+func serviceEndpoints(svc models.Service, workloads []models.Workload, nodes map[string]models.Node) []models.ServiceEndpoint {
+	namespace := normalizeNamespace(svc.Namespace)
+	var out []models.ServiceEndpoint
+	for _, w := range workloads {
+		if WorkloadNamespace(w) != namespace || !selectorMatches(svc.Selector, w.Labels) {
+			continue
+		}
+		if strings.EqualFold(w.DesiredState, "Deleted") || !WorkloadReady(w) {
+			continue
+		}
+		node, ok := nodes[w.NodeID]
+		if !ok || strings.TrimSpace(node.IPAddress) == "" {
+			continue
+		}
+		base := models.ServiceEndpoint{WorkloadID: w.ID, NodeID: node.NodeID, Host: node.IPAddress}
+		if len(svc.Ports) == 0 {
+			// Without ports the service answers A queries, plus SRV with
+			// the first published port if there is one.
+			for _, raw := range workloadPorts(w) {
+				if mapping, err := parsePortMapping(raw); err == nil {
+					base.Port = int(mapping.GetHostPort())
+					base.Protocol = mapping.GetProtocol()
+					break
+				}
+			}
+			out = append(out, base)
+			continue
+		}
+		for _, port := range svc.Ports {
+			hostPort := publishedHostPort(w, port)
+			if hostPort == 0 {
+				continue
+			}
+			ep := base
+			ep.Port = hostPort
+			ep.PortName = port.Name
+			ep.Protocol = port.Protocol
+			out = append(out, ep)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].WorkloadID != out[j].WorkloadID {
+			return out[i].WorkloadID < out[j].WorkloadID
+		}
+		return out[i].PortName < out[j].PortName
+	})
+	return out
+}
+
+func selectorMatches(selector, labels map[string]string) bool {
+	for k, v := range selector {
+		if got, ok := labels[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+func publishedHostPort(w models.Workload, port models.ServicePort) int {
+	for _, raw := range workloadPorts(w) {
+		mapping, err := parsePortMapping(raw)
+		if err != nil {
+			continue
+		}
+		if int(mapping.GetContainerPort()) == port.Port && mapping.GetProtocol() == port.Protocol {
+			return int(mapping.GetHostPort())
+		}
+	}
+	return port.HostPort
+}
+
+// ReconcileServices brings the published service records in line with the
+// services and the workloads they select. It runs every reconcile cycle, so
+// records follow reschedules, drift remediation and deletions, and records
+// left behind by deleted services or workloads are removed.
+func (s *Scheduler) ReconcileServices() error {
+	services, err := s.ListServices("")
+	if err != nil {
+		return err
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	nodes, err := s.nodesByID()
+	if err != nil {
+		return err
+	}
+
+	desired := map[string]string{}
+	for _, svc := range services {
+		for _, ep := range serviceEndpoints(svc, workloads, nodes) {
+			payload, err := json.Marshal(skydnsRecord{Host: ep.Host, Port: ep.Port, TTL: serviceRecordTTL})
+			if err != nil {
+				return fmt.Errorf("marshal record of service %s: %w", svc.Name, err)
+			}
+			desired[s.serviceRecordKey(svc, ep)] = string(payload)
+		}
+	}
+
+	resp, err := s.RetryableEtcdGet(s.serviceRecordsPrefix(), clientv3.WithPrefix())
+	if err != nil {
+		return fmt.Errorf("failed to get service records: %v", err)
+	}
+	existing := make(map[string]string, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		existing[string(kv.Key)] = string(kv.Value)
+	}
+
+	written, removed := 0, 0
+	for key, value := range desired {
+		if existing[key] == value {
+			continue
+		}
+		if err := s.RetryableEtcdPut(key, value); err != nil {
+			return err
+		}
+		written++
+	}
+	for key := range existing {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+		if err := s.RetryableEtcdDelete(key); err != nil {
+			return err
+		}
+		removed++
+	}
+	if written > 0 || removed > 0 {
+		serviceLogger.WithFields(logrus.Fields{
+			"written": written,
+			"removed": removed,
+			"records": len(desired),
+		}).Info("updated service DNS records")
+	}
+	return nil
+}
+
+func (s *Scheduler) getServiceRecord(namespace, name string) (models.Service, bool, error) {
+	resp, err := s.RetryableEtcdGet(serviceKey(namespace, name))
+	if err != nil {
+		return models.Service{}, false, fmt.Errorf("failed to get service %s: %v", name, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.Service{}, false, nil
+	}
+	var svc models.Service
+	if err := json.Unmarshal(resp.Kvs[0].Value, &svc); err != nil {
+		return models.Service{}, false, fmt.Errorf("failed to unmarshal service %s: %v", name, err)
+	}
+	return svc, true, nil
+}
+
+func (s *Scheduler) saveServiceRecord(svc models.Service) error {
+	payload, err := json.Marshal(svc)
+	if err != nil {
+		return fmt.Errorf("marshal service %s: %w", svc.Name, err)
+	}
+	return s.RetryableEtcdPut(serviceKey(svc.Namespace, svc.Name), string(payload))
+}
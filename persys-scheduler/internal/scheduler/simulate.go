@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+// ErrInvalidSimulation is wrapped by SimulatePlacement errors caused by the
+// workload being simulated rather than by reading cluster state.
+var ErrInvalidSimulation = errors.New("invalid placement simulation")
+
+// PlacementSimulation is the outcome of a placement dry run.
+type PlacementSimulation struct {
+	Decision PlacementDecision
+	// PreemptionNode and PreemptionVictims are set when no node fits but
+	// preempting lower-priority workloads would make room.
+	PreemptionNode    string
+	PreemptionVictims []string
+}
+
+// SimulatePlacement runs the placement pipeline for workload without
+// reserving, assigning or persisting anything. It reads the same nodes,
+// allocation ledgers and workloads as real placement, so the answer holds
+// until the cluster changes. A workload that already exists keeps its ID, so
+// its own reservations do not count against it.
+func (s *Scheduler) SimulatePlacement(workload models.Workload) (PlacementSimulation, error) {
+	if strings.TrimSpace(workload.Type) == "" {
+		return PlacementSimulation{}, fmt.Errorf("%w: workload type is required", ErrInvalidSimulation)
+	}
+	workload.Namespace = normalizeNamespace(workload.Namespace)
+	if err := s.resolveWorkloadPriority(&workload); err != nil {
+		return PlacementSimulation{}, fmt.Errorf("%w: %v", ErrInvalidSimulation, err)
+	}
+	if err := validateWorkloadPorts(workload.Ports); err != nil {
+		return PlacementSimulation{}, fmt.Errorf("%w: %v", ErrInvalidSimulation, err)
+	}
+	nodes, err := s.GetNodes()
+	if err != nil {
+		return PlacementSimulation{}, fmt.Errorf("list nodes: %w", err)
+	}
+
+	sim := PlacementSimulation{Decision: s.evaluatePlacement(workload, nodes)}
+	if sim.Decision.Node == nil && len(nodes) > 0 && s.preemptionEnabled() {
+		if plan, ok := s.planPreemption(workload); ok {
+			sim.PreemptionNode = plan.node.NodeID
+			sim.PreemptionVictims = plan.victimIDs()
+		}
+	}
+	return sim, nil
+}
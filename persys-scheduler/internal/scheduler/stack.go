@@ -0,0 +1,594 @@
+package scheduler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	stackNamespaceMetadataKey = "stack_namespace"
+	stackNameMetadataKey      = "stack_name"
+	stackMemberMetadataKey    = "stack_member"
+	stackRevisionMetadataKey  = "stack_template_revision"
+)
+
+var stackLogger = logging.C("scheduler.stack")
+
+// stackMemberID is the workload ID of a stack member. Object names cannot
+// contain '_', so IDs of different stacks and members never collide.
+func stackMemberID(namespace, stack, member string) string {
+	return namespace + "_" + stack + "_" + member
+}
+
+// stackAffinityGroup is the affinity group members of a colocated stack are
+// placed with.
+func stackAffinityGroup(namespace, stack string) string {
+	return "stack:" + namespace + "/" + stack
+}
+
+// stackOrder returns the members in dependency order, keeping the declared
+// order among members that do not depend on each other. It fails on unknown
+// dependencies and on cycles.
+func stackOrder(members []models.StackMember) ([]models.StackMember, error) {
+	index := make(map[string]int, len(members))
+	for i, m := range members {
+		index[m.Name] = i
+	}
+	pending := make([]int, len(members))
+	dependents := make([][]int, len(members))
+	for i, m := range members {
+		for _, dep := range m.DependsOn {
+			j, ok := index[dep]
+			if !ok {
+				return nil, fmt.Errorf("member %s depends on unknown member %s", m.Name, dep)
+			}
+			if j == i {
+				return nil, fmt.Errorf("member %s depends on itself", m.Name)
+			}
+			pending[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+	done := make([]bool, len(members))
+	ordered := make([]models.StackMember, 0, len(members))
+	for len(ordered) < len(members) {
+		next := -1
+		for i := range members {
+			if !done[i] && pending[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []string
+			for i, m := range members {
+				if !done[i] {
+					cycle = append(cycle, m.Name)
+				}
+			}
+			return nil, fmt.Errorf("dependency cycle among members %s", strings.Join(cycle, ", "))
+		}
+		done[next] = true
+		ordered = append(ordered, members[next])
+		for _, i := range dependents[next] {
+			pending[i]--
+		}
+	}
+	return ordered, nil
+}
+
+func validateStack(st models.Stack) error {
+	if err := validateObjectName("stack", st.Name); err != nil {
+		return err
+	}
+	if len(st.Members) == 0 {
+		return fmt.Errorf("stack %s needs at least one member", st.Name)
+	}
+	seen := map[string]bool{}
+	for _, m := range st.Members {
+		if err := validateObjectName("stack member", m.Name); err != nil {
+			return fmt.Errorf("stack %s: %w", st.Name, err)
+		}
+		if seen[m.Name] {
+			return fmt.Errorf("stack %s: member %s is declared twice", st.Name, m.Name)
+		}
+		seen[m.Name] = true
+		if strings.TrimSpace(m.Template.Type) == "" {
+			return fmt.Errorf("stack %s: member %s template type is required", st.Name, m.Name)
+		}
+		if ns := strings.TrimSpace(m.Template.Namespace); ns != "" && normalizeNamespace(ns) != st.Namespace {
+			return fmt.Errorf("stack %s: member %s is in namespace %s, not %s", st.Name, m.Name, ns, st.Namespace)
+		}
+		if err := validateWorkloadPorts(m.Template.Ports); err != nil {
+			return fmt.Errorf("stack %s: member %s: %w", st.Name, m.Name, err)
+		}
+	}
+	if _, err := stackOrder(st.Members); err != nil {
+		return fmt.Errorf("stack %s: %w", st.Name, err)
+	}
+	return nil
+}
+
+// ApplyStack creates or updates a stack. Member workloads are created,
+// started, stopped and removed by the reconciliation loop.
+func (s *Scheduler) ApplyStack(st models.Stack) (models.Stack, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Stack{}, err
+	}
+	st.Namespace = normalizeNamespace(st.Namespace)
+	st.Name = strings.TrimSpace(st.Name)
+	st.DesiredState = normalizeDesiredStateString(st.DesiredState)
+	if st.DesiredState == "Deleted" {
+		return models.Stack{}, fmt.Errorf("use DeleteStack to delete stack %s", st.Name)
+	}
+	members := make([]models.StackMember, 0, len(st.Members))
+	for _, m := range st.Members {
+		m.Name = strings.TrimSpace(m.Name)
+		deps := make([]string, 0, len(m.DependsOn))
+		for _, dep := range m.DependsOn {
+			if dep = strings.TrimSpace(dep); dep != "" {
+				deps = append(deps, dep)
+			}
+		}
+		m.DependsOn = deps
+		members = append(members, m)
+	}
+	st.Members = members
+	if err := validateStack(st); err != nil {
+		return models.Stack{}, err
+	}
+	if _, err := s.GetNamespace(st.Namespace); err != nil {
+		return models.Stack{}, err
+	}
+
+	for i := range st.Members {
+		m := &st.Members[i]
+		m.Template.ID = ""
+		m.Template.RevisionID = ""
+		m.Template.DesiredState = ""
+		m.Template.Namespace = st.Namespace
+		if st.Colocate {
+			placement := models.PlacementPolicy{}
+			if m.Template.Placement != nil {
+				placement = *m.Template.Placement
+			}
+			if strings.TrimSpace(placement.AffinityGroup) == "" {
+				placement.AffinityGroup = stackAffinityGroup(st.Namespace, st.Name)
+			}
+			m.Template.Placement = &placement
+		}
+		refsRevision, err := s.workloadRefsRevision(m.Template)
+		if err != nil {
+			return models.Stack{}, fmt.Errorf("stack %s: member %s: %w", st.Name, m.Name, err)
+		}
+		m.Template.RefsRevision = refsRevision
+		m.TemplateRevision = replicaSetTemplateRevision(m.Template)
+	}
+
+	existing, found, err := s.getStackRecord(st.Namespace, st.Name)
+	if err != nil {
+		return models.Stack{}, err
+	}
+	now := time.Now().UTC()
+	eventType := "StackCreated"
+	if found {
+		if existing.DesiredState == "Deleted" {
+			return models.Stack{}, fmt.Errorf("stack %s is being deleted", st.Name)
+		}
+		st.CreatedAt = existing.CreatedAt
+		st.Status = existing.Status
+		eventType = "StackUpdated"
+	} else {
+		st.CreatedAt = now
+		st.Status = models.StackStatus{Phase: models.StackProgressing}
+	}
+	st.UpdatedAt = now
+
+	if err := s.saveStackRecord(st); err != nil {
+		return models.Stack{}, err
+	}
+	s.emitEvent(eventType, "", "", "Stack applied", map[string]interface{}{
+		"namespace":     st.Namespace,
+		"stack":         st.Name,
+		"members":       len(st.Members),
+		"desired_state": st.DesiredState,
+	})
+	return st, nil
+}
+
+// SetStackState starts or stops every member of a stack. Members start in
+// dependency order and stop in reverse order.
+func (s *Scheduler) SetStackState(namespace, name, desiredState string) (models.Stack, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.Stack{}, err
+	}
+	st, err := s.GetStack(namespace, name)
+	if err != nil {
+		return models.Stack{}, err
+	}
+	if st.DesiredState == "Deleted" {
+		return models.Stack{}, fmt.Errorf("stack %s is being deleted", st.Name)
+	}
+	desiredState = normalizeDesiredStateString(desiredState)
+	if desiredState == "Deleted" {
+		return models.Stack{}, fmt.Errorf("use DeleteStack to delete stack %s", st.Name)
+	}
+	if st.DesiredState == desiredState {
+		return st, nil
+	}
+	st.DesiredState = desiredState
+	st.UpdatedAt = time.Now().UTC()
+	if err := s.saveStackRecord(st); err != nil {
+		return models.Stack{}, err
+	}
+	s.emitEvent("StackUpdated", "", "", "Desired state updated", map[string]interface{}{
+		"namespace":     st.Namespace,
+		"stack":         st.Name,
+		"desired_state": desiredState,
+	})
+	return st, nil
+}
+
+// DeleteStack marks a stack for deletion. Members are deleted in reverse
+// dependency order and the record is removed once all of them are gone.
+func (s *Scheduler) DeleteStack(namespace, name string) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	st, err := s.GetStack(namespace, name)
+	if err != nil {
+		return err
+	}
+	if st.DesiredState == "Deleted" {
+		return nil
+	}
+	st.DesiredState = "Deleted"
+	st.UpdatedAt = time.Now().UTC()
+	if err := s.saveStackRecord(st); err != nil {
+		return err
+	}
+	s.emitEvent("StackUpdated", "", "", "Marked for deletion", map[string]interface{}{"namespace": st.Namespace, "stack": st.Name})
+	return nil
+}
+
+// GetStack returns a stack.
+func (s *Scheduler) GetStack(namespace, name string) (models.Stack, error) {
+	namespace = normalizeNamespace(namespace)
+	st, found, err := s.getStackRecord(namespace, strings.TrimSpace(name))
+	if err != nil {
+		return models.Stack{}, err
+	}
+	if !found {
+		return models.Stack{}, fmt.Errorf("stack %s not found in namespace %s", name, namespace)
+	}
+	return st, nil
+}
+
+// ListStacks returns the stacks of a namespace, or of all namespaces when
+// namespace is empty.
+func (s *Scheduler) ListStacks(namespace string) ([]models.Stack, error) {
+	prefix := stacksPrefix
+	if strings.TrimSpace(namespace) != "" {
+		prefix = stacksPrefix + normalizeNamespace(namespace) + "/"
+	}
+	resp, err := s.RetryableEtcdGet(prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stacks: %v", err)
+	}
+	out := make([]models.Stack, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var st models.Stack
+		if err := json.Unmarshal(kv.Value, &st); err != nil {
+			stackLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal stack")
+			continue
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) getStackRecord(namespace, name string) (models.Stack, bool, error) {
+	resp, err := s.RetryableEtcdGet(stackKey(namespace, name))
+	if err != nil {
+		return models.Stack{}, false, fmt.Errorf("failed to get stack %s: %v", name, err)
+	}
+	if len(resp.Kvs) == 0 {
+		return models.Stack{}, false, nil
+	}
+	var st models.Stack
+	if err := json.Unmarshal(resp.Kvs[0].Value, &st); err != nil {
+		return models.Stack{}, false, fmt.Errorf("failed to unmarshal stack %s: %v", name, err)
+	}
+	return st, true, nil
+}
+
+func (s *Scheduler) saveStackRecord(st models.Stack) error {
+	payload, err := json.Marshal(st)
+	if err != nil {
+		return fmt.Errorf("marshal stack %s: %w", st.Name, err)
+	}
+	return s.RetryableEtcdPut(stackKey(st.Namespace, st.Name), string(payload))
+}
+
+// stackMemberWorkload builds the desired workload of a member.
+func stackMemberWorkload(st models.Stack, m models.StackMember, desiredState string) models.Workload {
+	w := m.Template
+	w.ID = stackMemberID(st.Namespace, st.Name, m.Name)
+	w.Name = st.Name + "-" + m.Name
+	w.Namespace = st.Namespace
+	w.DesiredState = desiredState
+	w.EnvVars = copyStringMap(m.Template.EnvVars)
+	w.Labels = copyStringMap(m.Template.Labels)
+	w.Metadata = map[string]interface{}{}
+	for k, v := range m.Template.Metadata {
+		w.Metadata[k] = v
+	}
+	w.Metadata[stackNamespaceMetadataKey] = st.Namespace
+	w.Metadata[stackNameMetadataKey] = st.Name
+	w.Metadata[stackMemberMetadataKey] = m.Name
+	w.Metadata[stackRevisionMetadataKey] = m.TemplateRevision
+	return w
+}
+
+func stackMemberUpToDate(m models.StackMember, w models.Workload) bool {
+	revision, _ := metadataString(w.Metadata, stackRevisionMetadataKey)
+	return revision == m.TemplateRevision
+}
+
+// ReconcileStacks converges the member workloads of every stack.
+func (s *Scheduler) ReconcileStacks() error {
+	if !s.isWritable() {
+		return errControlPlaneFrozen
+	}
+	stacks, err := s.ListStacks("")
+	if err != nil {
+		return err
+	}
+	if len(stacks) == 0 {
+		return nil
+	}
+	workloads, err := s.GetWorkloads()
+	if err != nil {
+		return err
+	}
+	members := make(map[string][]models.Workload)
+	for _, w := range workloads {
+		name, ok := metadataString(w.Metadata, stackNameMetadataKey)
+		if !ok || name == "" {
+			continue
+		}
+		namespace, _ := metadataString(w.Metadata, stackNamespaceMetadataKey)
+		key := stackKey(namespace, name)
+		members[key] = append(members[key], w)
+	}
+	for _, st := range stacks {
+		if err := s.reconcileStack(st, members[stackKey(st.Namespace, st.Name)]); err != nil {
+			stackLogger.WithError(err).WithFields(logrus.Fields{
+				"namespace": st.Namespace,
+				"stack":     st.Name,
+			}).Warn("failed to reconcile stack")
+		}
+	}
+	return nil
+}
+
+func (s *Scheduler) reconcileStack(st models.Stack, workloads []models.Workload) error {
+	ordered, err := stackOrder(st.Members)
+	if err != nil {
+		return err
+	}
+	byMember := make(map[string]models.Workload, len(workloads))
+	for _, w := range workloads {
+		name, _ := metadataString(w.Metadata, stackMemberMetadataKey)
+		byMember[name] = w
+	}
+	dependents := make(map[string][]string)
+	declared := make(map[string]bool, len(st.Members))
+	for _, m := range st.Members {
+		declared[m.Name] = true
+		for _, dep := range m.DependsOn {
+			dependents[dep] = append(dependents[dep], m.Name)
+		}
+	}
+	// live reports whether a member still has a workload that is not being
+	// deleted.
+	live := func(name string) (models.Workload, bool) {
+		w, ok := byMember[name]
+		return w, ok && !strings.EqualFold(w.DesiredState, "Deleted")
+	}
+
+	// Members dropped from the stack are removed right away.
+	for name, w := range byMember {
+		if declared[name] || strings.EqualFold(w.DesiredState, "Deleted") {
+			continue
+		}
+		if err := s.MarkWorkloadDeleted(w.ID); err != nil {
+			return fmt.Errorf("remove member %s: %w", name, err)
+		}
+		w.DesiredState = "Deleted"
+		byMember[name] = w
+		stackLogger.WithFields(logrus.Fields{"stack": st.Name, "member": name, "workload_id": w.ID}).Info("removed stack member")
+	}
+
+	waiting := make(map[string][]string, len(st.Members))
+	switch st.DesiredState {
+	case "Deleted":
+		// Reverse order: a member goes once every member depending on it
+		// is gone.
+		for i := len(ordered) - 1; i >= 0; i-- {
+			m := ordered[i]
+			for _, dep := range dependents[m.Name] {
+				if _, ok := byMember[dep]; ok {
+					waiting[m.Name] = append(waiting[m.Name], dep)
+				}
+			}
+			w, ok := live(m.Name)
+			if !ok || len(waiting[m.Name]) > 0 {
+				continue
+			}
+			if err := s.MarkWorkloadDeleted(w.ID); err != nil {
+				return fmt.Errorf("delete member %s: %w", m.Name, err)
+			}
+			w.DesiredState = "Deleted"
+			byMember[m.Name] = w
+		}
+		if len(workloads) == 0 {
+			if err := s.RetryableEtcdDelete(stackKey(st.Namespace, st.Name)); err != nil {
+				return err
+			}
+			s.emitEvent("StackDeleted", "", "", "All members removed", map[string]interface{}{"namespace": st.Namespace, "stack": st.Name})
+			return nil
+		}
+	case "Stopped":
+		// Reverse order: a member stops once every member depending on it
+		// has stopped.
+		for i := len(ordered) - 1; i >= 0; i-- {
+			m := ordered[i]
+			for _, dep := range dependents[m.Name] {
+				if w, ok := live(dep); ok && strings.EqualFold(w.Status, "Running") {
+					waiting[m.Name] = append(waiting[m.Name], dep)
+				}
+			}
+			w, ok := live(m.Name)
+			if !ok {
+				continue
+			}
+			desired := "Stopped"
+			if len(waiting[m.Name]) > 0 {
+				desired = w.DesiredState
+			}
+			if stackMemberUpToDate(m, w) && w.DesiredState == desired {
+				continue
+			}
+			updated, err := s.updateStackMember(st, m, w, desired)
+			if err != nil {
+				return err
+			}
+			byMember[m.Name] = updated
+		}
+	default:
+		// Dependency order: a member starts once every member it depends
+		// on is ready.
+		for _, m := range ordered {
+			for _, dep := range m.DependsOn {
+				if w, ok := live(dep); !ok || !WorkloadReady(w) {
+					waiting[m.Name] = append(waiting[m.Name], dep)
+				}
+			}
+			w, found := byMember[m.Name]
+			if !found {
+				if len(waiting[m.Name]) > 0 {
+					continue
+				}
+				created, err := s.createWorkload(stackMemberWorkload(st, m, "Running"))
+				if err != nil {
+					return fmt.Errorf("create member %s: %w", m.Name, err)
+				}
+				byMember[m.Name] = created
+				stackLogger.WithFields(logrus.Fields{"stack": st.Name, "member": m.Name, "workload_id": created.ID}).Info("created stack member")
+				continue
+			}
+			if strings.EqualFold(w.DesiredState, "Deleted") {
+				// Recreated once the old workload is gone.
+				continue
+			}
+			desired := "Running"
+			if len(waiting[m.Name]) > 0 {
+				desired = w.DesiredState
+			}
+			if stackMemberUpToDate(m, w) && w.DesiredState == desired {
+				continue
+			}
+			updated, err := s.updateStackMember(st, m, w, desired)
+			if err != nil {
+				return err
+			}
+			byMember[m.Name] = updated
+		}
+	}
+
+	status := stackStatus(st, byMember, waiting)
+	if status.Phase == st.Status.Phase &&
+		status.ReadyMembers == st.Status.ReadyMembers &&
+		reflect.DeepEqual(status.Members, st.Status.Members) {
+		return nil
+	}
+	status.LastReconciledAt = time.Now().UTC()
+
+	// Re-read so an apply or state change that landed during this pass is
+	// not lost.
+	current, found, err := s.getStackRecord(st.Namespace, st.Name)
+	if err != nil || !found {
+		return err
+	}
+	if current.Status.Phase != status.Phase {
+		s.emitEvent("StackPhaseChanged", "", "", fmt.Sprintf("Stack is %s", status.Phase), map[string]interface{}{
+			"namespace": st.Namespace,
+			"stack":     st.Name,
+			"from":      current.Status.Phase,
+			"to":        status.Phase,
+		})
+	}
+	current.Status = status
+	return s.saveStackRecord(current)
+}
+
+// updateStackMember rewrites a member workload from its template.
+func (s *Scheduler) updateStackMember(st models.Stack, m models.StackMember, w models.Workload, desiredState string) (models.Workload, error) {
+	updated, err := s.UpdateWorkloadSpec(w.ID, stackMemberWorkload(st, m, desiredState))
+	if err != nil {
+		return models.Workload{}, fmt.Errorf("update member %s: %w", m.Name, err)
+	}
+	updated.Metadata[stackRevisionMetadataKey] = m.TemplateRevision
+	if err := s.saveWorkload(updated); err != nil {
+		return models.Workload{}, fmt.Errorf("update member %s: %w", m.Name, err)
+	}
+	return updated, nil
+}
+
+// stackStatus aggregates the state of the member workloads.
+func stackStatus(st models.Stack, byMember map[string]models.Workload, waiting map[string][]string) models.StackStatus {
+	status := models.StackStatus{Members: make([]models.StackMemberStatus, 0, len(st.Members))}
+	failed, running := false, false
+	for _, m := range st.Members {
+		ms := models.StackMemberStatus{Name: m.Name}
+		if deps := waiting[m.Name]; len(deps) > 0 {
+			ms.WaitingFor = append([]string(nil), deps...)
+			sort.Strings(ms.WaitingFor)
+		}
+		if w, ok := byMember[m.Name]; ok {
+			ms.WorkloadID = w.ID
+			ms.NodeID = w.NodeID
+			ms.Status = w.Status
+			ms.Ready = WorkloadReady(w)
+			failed = failed || strings.EqualFold(w.Status, "Failed")
+			running = running || strings.EqualFold(w.Status, "Running")
+		}
+		if ms.Ready {
+			status.ReadyMembers++
+		}
+		status.Members = append(status.Members, ms)
+	}
+	switch {
+	case st.DesiredState == "Deleted":
+		status.Phase = models.StackDeleting
+	case failed:
+		status.Phase = models.StackDegraded
+	case st.DesiredState == "Running" && status.ReadyMembers == len(st.Members):
+		status.Phase = models.StackRunning
+	case st.DesiredState == "Stopped" && !running:
+		status.Phase = models.StackStopped
+	default:
+		status.Phase = models.StackProgressing
+	}
+	return status
+}
@@ -0,0 +1,452 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// ExportState returns a copy of the control-plane state. In normal mode it is
+// read from etcd; while degraded or recovering it is the frozen snapshot taken
+// when the mode changed, which is all that is left once etcd is gone.
+func (s *Scheduler) ExportState() (models.StateArchive, error) {
+	if !s.isWritable() {
+		s.modeMu.RLock()
+		frozen := s.frozen
+		s.modeMu.RUnlock()
+		if frozen == nil {
+			return models.StateArchive{}, fmt.Errorf("scheduler is %s and has no frozen snapshot", s.currentMode())
+		}
+		return archiveFromFrozen(frozen), nil
+	}
+
+	archive := models.StateArchive{
+		Version:   models.StateArchiveVersion,
+		CreatedAt: time.Now().UTC(),
+		Source:    models.StateSourceEtcd,
+	}
+	var err error
+	if archive.Nodes, err = s.GetNodes(); err != nil {
+		return models.StateArchive{}, err
+	}
+	if archive.Workloads, err = s.GetWorkloads(); err != nil {
+		return models.StateArchive{}, err
+	}
+	if archive.Assignments, err = s.listAssignments(); err != nil {
+		return models.StateArchive{}, err
+	}
+	if err := s.exportRecords(&archive); err != nil {
+		return models.StateArchive{}, err
+	}
+	return archive, nil
+}
+
+// exportRecords fills the archive sections that only etcd holds: everything
+// but nodes, workloads and assignments.
+func (s *Scheduler) exportRecords(archive *models.StateArchive) error {
+	var err error
+	if archive.Namespaces, err = s.listNamespaceRecords(); err != nil {
+		return err
+	}
+	if archive.Secrets, err = s.listSealedSecrets(""); err != nil {
+		return err
+	}
+	if archive.Configs, err = s.ListConfigs(""); err != nil {
+		return err
+	}
+	if archive.Services, err = s.ListServices(""); err != nil {
+		return err
+	}
+	if archive.Stacks, err = s.ListStacks(""); err != nil {
+		return err
+	}
+	if archive.ReplicaSets, err = s.ListReplicaSets(); err != nil {
+		return err
+	}
+	if archive.Jobs, err = s.ListJobs(); err != nil {
+		return err
+	}
+	if archive.CronJobs, err = s.ListCronJobs(); err != nil {
+		return err
+	}
+	if archive.Revisions, err = s.listAllRevisions(); err != nil {
+		return err
+	}
+	if archive.Volumes, err = s.listManagedVolumeRecords(); err != nil {
+		return fmt.Errorf("failed to list managed volumes: %w", err)
+	}
+	if archive.Attachments, err = s.listVolumeAttachmentsByWorkload(""); err != nil {
+		return fmt.Errorf("failed to list volume attachments: %w", err)
+	}
+	return nil
+}
+
+func archiveFromFrozen(frozen *FrozenState) models.StateArchive {
+	archive := frozen.Records
+	archive.Version = models.StateArchiveVersion
+	archive.CreatedAt = frozen.CreatedAt
+	archive.Source = models.StateSourceFrozen
+	archive.Reason = frozen.Reason
+	archive.Nodes = cacheSnapshot(frozen.Nodes)
+	archive.Workloads = cacheSnapshot(frozen.Workloads)
+	archive.Assignments = cacheSnapshot(frozen.Assignments)
+	return archive
+}
+
+func (s *Scheduler) listAssignments() ([]models.AssignmentRecord, error) {
+	resp, err := s.RetryableEtcdGet(assignmentsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	out := make([]models.AssignmentRecord, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var rec models.AssignmentRecord
+		if err := json.Unmarshal(kv.Value, &rec); err != nil {
+			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal assignment")
+			continue
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
+
+func (s *Scheduler) listAllRevisions() ([]models.WorkloadRevision, error) {
+	resp, err := s.RetryableEtcdGet(revisionsPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	out := make([]models.WorkloadRevision, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		var rev models.WorkloadRevision
+		if err := json.Unmarshal(kv.Value, &rev); err != nil {
+			schedulerLogger.WithError(err).WithField("key", string(kv.Key)).Warn("failed to unmarshal workload revision")
+			continue
+		}
+		out = append(out, rev)
+	}
+	return out, nil
+}
+
+// ValidateStateArchive checks that an archive can be imported: a known
+// version, unique IDs, and records that only reference workloads and volumes
+// present in the archive.
+func ValidateStateArchive(archive models.StateArchive) error {
+	if archive.Version < 1 || archive.Version > models.StateArchiveVersion {
+		return fmt.Errorf("unsupported state archive version %d (supported: 1-%d)", archive.Version, models.StateArchiveVersion)
+	}
+	nodes := make(map[string]struct{}, len(archive.Nodes))
+	for _, node := range archive.Nodes {
+		if strings.TrimSpace(node.NodeID) == "" {
+			return fmt.Errorf("archive contains a node without an ID")
+		}
+		if _, dup := nodes[node.NodeID]; dup {
+			return fmt.Errorf("archive contains node %s twice", node.NodeID)
+		}
+		nodes[node.NodeID] = struct{}{}
+	}
+	workloads := make(map[string]struct{}, len(archive.Workloads))
+	for _, w := range archive.Workloads {
+		if strings.TrimSpace(w.ID) == "" {
+			return fmt.Errorf("archive contains a workload without an ID")
+		}
+		if strings.TrimSpace(w.Type) == "" {
+			return fmt.Errorf("workload %s has no type", w.ID)
+		}
+		if _, dup := workloads[w.ID]; dup {
+			return fmt.Errorf("archive contains workload %s twice", w.ID)
+		}
+		workloads[w.ID] = struct{}{}
+	}
+	for _, rec := range archive.Assignments {
+		if _, ok := workloads[rec.WorkloadID]; !ok {
+			return fmt.Errorf("assignment references unknown workload %q", rec.WorkloadID)
+		}
+		if strings.TrimSpace(rec.NodeID) == "" {
+			return fmt.Errorf("assignment of workload %s has no node", rec.WorkloadID)
+		}
+	}
+	for _, ns := range archive.Namespaces {
+		if err := validateNamespaceName(ns.Name); err != nil {
+			return err
+		}
+	}
+	for _, secret := range archive.Secrets {
+		if err := validateObjectName("secret", secret.Name); err != nil {
+			return err
+		}
+		if strings.TrimSpace(secret.KEK) == "" || strings.TrimSpace(secret.WrappedKey) == "" {
+			return fmt.Errorf("secret %s/%s is not sealed", secret.Namespace, secret.Name)
+		}
+	}
+	for _, config := range archive.Configs {
+		if err := validateObjectName("config", config.Name); err != nil {
+			return err
+		}
+	}
+	for _, svc := range archive.Services {
+		if err := validateService(svc); err != nil {
+			return err
+		}
+	}
+	for _, st := range archive.Stacks {
+		if err := validateStack(st); err != nil {
+			return err
+		}
+	}
+	for _, rs := range archive.ReplicaSets {
+		if strings.TrimSpace(rs.ID) == "" {
+			return fmt.Errorf("archive contains a replica set without an ID")
+		}
+	}
+	for _, job := range archive.Jobs {
+		if strings.TrimSpace(job.ID) == "" {
+			return fmt.Errorf("archive contains a job without an ID")
+		}
+	}
+	for _, cj := range archive.CronJobs {
+		if strings.TrimSpace(cj.ID) == "" {
+			return fmt.Errorf("archive contains a cron job without an ID")
+		}
+	}
+	for _, rev := range archive.Revisions {
+		if _, ok := workloads[rev.WorkloadID]; !ok {
+			return fmt.Errorf("revision %s references unknown workload %q", rev.RevisionID, rev.WorkloadID)
+		}
+		if strings.TrimSpace(rev.RevisionID) == "" {
+			return fmt.Errorf("revision of workload %s has no ID", rev.WorkloadID)
+		}
+	}
+	volumes := make(map[string]struct{}, len(archive.Volumes))
+	for _, vol := range archive.Volumes {
+		if strings.TrimSpace(vol.ID) == "" {
+			return fmt.Errorf("archive contains a managed volume without an ID")
+		}
+		volumes[vol.ID] = struct{}{}
+	}
+	for _, att := range archive.Attachments {
+		if _, ok := volumes[att.VolumeID]; !ok {
+			return fmt.Errorf("attachment references unknown volume %q", att.VolumeID)
+		}
+		if _, ok := workloads[att.WorkloadID]; !ok {
+			return fmt.Errorf("attachment references unknown workload %q", att.WorkloadID)
+		}
+	}
+	return nil
+}
+
+// ImportState restores an archive into etcd and returns the scheduler to
+// normal mode. It refuses to write over existing state unless force is set;
+// with force, archived keys overwrite stored ones and other keys are kept.
+// Node allocation ledgers are not archived and are rebuilt from heartbeats.
+// Only the leader imports: the writes bypass the frozen-mode guard, and
+// followers leave recovery on their own once etcd holds state again.
+func (s *Scheduler) ImportState(archive models.StateArchive, force bool) error {
+	if err := s.RequireLeader(); err != nil {
+		return err
+	}
+	if err := ValidateStateArchive(archive); err != nil {
+		return err
+	}
+	if err := s.pingEtcd(); err != nil {
+		return fmt.Errorf("etcd is unreachable: %w", err)
+	}
+	hasState, err := s.etcdHasState()
+	if err != nil {
+		return fmt.Errorf("failed to check existing state: %w", err)
+	}
+	if hasState && !force {
+		return fmt.Errorf("etcd already holds scheduler state; import with force to overwrite")
+	}
+
+	for _, node := range archive.Nodes {
+		if err := s.restorePut(nodesPrefix+node.NodeID, node); err != nil {
+			return err
+		}
+	}
+	for _, w := range archive.Workloads {
+		if w.Metadata == nil {
+			w.Metadata = map[string]interface{}{}
+		}
+		// The pending queue is not archived; the reconciler re-queues
+		// unplaced workloads on its next pass.
+		delete(w.Metadata, workloadQueuedMetadataKey)
+		namespace := WorkloadNamespace(w)
+		if err := s.restorePut(workloadIDKey(w.ID), namespace); err != nil {
+			return err
+		}
+		if err := s.restorePut(workloadSpecKey(namespace, w.ID), workloadSpecFromWorkload(w)); err != nil {
+			return err
+		}
+		if err := s.restorePut(workloadStatusKey(namespace, w.ID), workloadStatusFromWorkload(w)); err != nil {
+			return err
+		}
+		if err := s.restorePut(retryKey(w.ID), w.Retry); err != nil {
+			return err
+		}
+		s.cacheWorkload(w)
+	}
+	for _, rec := range archive.Assignments {
+		if err := s.restorePut(assignmentKey(rec.WorkloadID), rec); err != nil {
+			return err
+		}
+		s.cacheAssignment(rec)
+	}
+	for _, ns := range archive.Namespaces {
+		if err := s.restorePut(namespaceKey(ns.Name), ns); err != nil {
+			return err
+		}
+	}
+	for _, secret := range archive.Secrets {
+		if err := s.restorePut(secretKey(secret.Namespace, secret.Name), secret); err != nil {
+			return err
+		}
+	}
+	for _, config := range archive.Configs {
+		if err := s.restorePut(configKey(config.Namespace, config.Name), config); err != nil {
+			return err
+		}
+	}
+	for _, svc := range archive.Services {
+		if err := s.restorePut(serviceKey(svc.Namespace, svc.Name), svc); err != nil {
+			return err
+		}
+	}
+	for _, st := range archive.Stacks {
+		if err := s.restorePut(stackKey(st.Namespace, st.Name), st); err != nil {
+			return err
+		}
+	}
+	for _, rs := range archive.ReplicaSets {
+		if err := s.restorePut(replicaSetKey(rs.ID), rs); err != nil {
+			return err
+		}
+	}
+	for _, job := range archive.Jobs {
+		if err := s.restorePut(jobKey(job.ID), job); err != nil {
+			return err
+		}
+	}
+	for _, cj := range archive.CronJobs {
+		if err := s.restorePut(cronJobKey(cj.ID), cj); err != nil {
+			return err
+		}
+	}
+	for _, rev := range archive.Revisions {
+		if err := s.restorePut(revisionKey(rev.WorkloadID, rev.RevisionID), rev); err != nil {
+			return err
+		}
+	}
+	for _, vol := range archive.Volumes {
+		if err := s.restorePut(managedVolumeKey(vol.ID), vol); err != nil {
+			return err
+		}
+	}
+	for _, att := range archive.Attachments {
+		key := att.ID
+		if strings.TrimSpace(key) == "" {
+			key = volumeAttachmentKey(att.NodeID, att.WorkloadID, att.VolumeID)
+			att.ID = key
+		}
+		if err := s.restorePut(key, att); err != nil {
+			return err
+		}
+	}
+	for _, node := range archive.Nodes {
+		s.cacheNode(node)
+	}
+
+	schedulerLogger.WithFields(logrus.Fields{
+		"version":     archive.Version,
+		"source":      archive.Source,
+		"created_at":  archive.CreatedAt.Format(time.RFC3339),
+		"nodes":       len(archive.Nodes),
+		"workloads":   len(archive.Workloads),
+		"assignments": len(archive.Assignments),
+	}).Info("imported scheduler state")
+	s.enterNormal(fmt.Sprintf("state imported from %s archive created %s", archive.Source, archive.CreatedAt.Format(time.RFC3339)))
+	s.activatePendingQueue("state imported")
+	s.emitEvent("StateImported", "", "", fmt.Sprintf("Imported %d workloads and %d nodes", len(archive.Workloads), len(archive.Nodes)), map[string]interface{}{
+		"source":  archive.Source,
+		"version": archive.Version,
+	})
+	return nil
+}
+
+// restorePut writes a record while the control plane may still be frozen, so
+// it bypasses the writable check RetryableEtcdPut enforces.
+func (s *Scheduler) restorePut(key string, record interface{}) error {
+	// Strings are stored as they are, not as JSON.
+	payload, ok := record.(string)
+	if !ok {
+		raw, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s: %w", key, err)
+		}
+		payload = string(raw)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	if _, err := s.etcdClient.Put(ctx, key, payload); err != nil {
+		return fmt.Errorf("failed to restore %s: %w", key, err)
+	}
+	return nil
+}
+
+// persistFrozenSnapshot writes the frozen snapshot as a state archive to
+// SCHEDULER_STATE_SNAPSHOT_PATH, replacing the previous file atomically. An
+// empty snapshot never replaces an existing one, so a restart against an empty
+// etcd does not clobber the last good copy.
+func (s *Scheduler) persistFrozenSnapshot(frozen *FrozenState) {
+	path := ""
+	if s.cfg != nil {
+		path = strings.TrimSpace(s.cfg.SchedulerStateSnapshotPath)
+	}
+	if path == "" || frozen == nil {
+		return
+	}
+	if len(frozen.Nodes) == 0 && len(frozen.Workloads) == 0 {
+		return
+	}
+	payload, err := json.MarshalIndent(archiveFromFrozen(frozen), "", "  ")
+	if err != nil {
+		schedulerLogger.WithError(err).Warn("failed to encode frozen state snapshot")
+		return
+	}
+	if err := writeFileAtomic(path, payload); err != nil {
+		schedulerLogger.WithError(err).WithField("path", path).Warn("failed to persist frozen state snapshot")
+		return
+	}
+	schedulerLogger.WithFields(logrus.Fields{
+		"path":      path,
+		"workloads": len(frozen.Workloads),
+		"nodes":     len(frozen.Nodes),
+	}).Info("persisted frozen state snapshot")
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,77 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func putTestRecord(t *testing.T, kv *fakeKV, key string, record interface{}) {
+	t.Helper()
+	payload, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("marshal %s: %v", key, err)
+	}
+	if _, err := kv.Put(context.Background(), key, string(payload)); err != nil {
+		t.Fatalf("put %s: %v", key, err)
+	}
+}
+
+func TestDegradedExportRoundTripsRecords(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	seedNamespace(t, s, models.Namespace{Name: "team-a"})
+	seedTestSecret(t, kv, models.SealedSecret{Name: "db", Namespace: "team-a", Version: 1, KEK: "local", WrappedKey: "wrapped", Ciphertext: "sealed"})
+	seedVolumeRecord(t, kv, models.ManagedVolumeRecord{ID: "vol-1", Name: "data", NodeID: "n1"})
+	attachment := models.VolumeAttachmentRecord{ID: volumeAttachmentKey("n1", "w1", "vol-1"), VolumeID: "vol-1", WorkloadID: "w1", NodeID: "n1", Phase: "Attached"}
+	putTestRecord(t, kv, attachment.ID, attachment)
+	putTestRecord(t, kv, revisionKey("w1", "rev-1"), models.WorkloadRevision{WorkloadID: "w1", RevisionID: "rev-1", Revision: 1})
+
+	workload := models.Workload{ID: "w1", Type: "container", Namespace: "team-a"}
+	s.cacheWorkload(workload)
+	s.refreshRecordCache()
+	s.enterDegraded("etcd unreachable")
+
+	archive, err := s.ExportState()
+	if err != nil {
+		t.Fatalf("ExportState: %v", err)
+	}
+	if archive.Source != models.StateSourceFrozen {
+		t.Fatalf("expected a frozen archive, got %q", archive.Source)
+	}
+	if len(archive.Namespaces) != 1 || len(archive.Secrets) != 1 || len(archive.Revisions) != 1 || len(archive.Volumes) != 1 || len(archive.Attachments) != 1 {
+		t.Fatalf("frozen archive misses records: %d namespaces, %d secrets, %d revisions, %d volumes, %d attachments",
+			len(archive.Namespaces), len(archive.Secrets), len(archive.Revisions), len(archive.Volumes), len(archive.Attachments))
+	}
+
+	// The archive goes through JSON on its way to the operator and back.
+	raw, err := json.Marshal(archive)
+	if err != nil {
+		t.Fatalf("marshal archive: %v", err)
+	}
+	var decoded models.StateArchive
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal archive: %v", err)
+	}
+
+	restoredKV := newFakeKV()
+	restored := newLedgerTestScheduler(restoredKV)
+	restored.setLeading(true)
+	if err := restored.ImportState(decoded, false); err != nil {
+		t.Fatalf("ImportState: %v", err)
+	}
+	for _, key := range []string{
+		namespaceKey("team-a"),
+		secretKey("team-a", "db"),
+		revisionKey("w1", "rev-1"),
+		managedVolumeKey("vol-1"),
+		attachment.ID,
+	} {
+		resp, err := restoredKV.Get(context.Background(), key)
+		if err != nil || len(resp.Kvs) == 0 {
+			t.Fatalf("expected %s to be restored", key)
+		}
+	}
+}
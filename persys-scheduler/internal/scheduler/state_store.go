@@ -13,29 +13,90 @@ import (
 )
 
 const (
-	nodesPrefix            = "/nodes/"
-	workloadsPrefix        = "/workloads/"
-	workloadSpecPrefix     = "/workloads-spec/"
-	workloadStatusPrefix   = "/workloads-status/"
-	volumesPrefix          = "/volumes/"
-	attachmentsPrefix      = "/attachments/"
-	assignmentsPrefix      = "/assignments/"
-	reconciliationPrefix   = "/reconciliation/"
-	retriesPrefix          = "/retries/"
-	driftsPrefix           = "/drifts/"
-	eventsPrefix           = "/events/"
-	managedStorageStateKey = "managed_storage_state"
+	nodesPrefix              = "/nodes/"
+	workloadsPrefix          = "/workloads/"
+	workloadStatusPrefix     = "/workloads-status/"
+	workloadIDsPrefix        = "/workload-ids/"
+	legacyWorkloadSpecPrefix = "/workloads-spec/"
+	replicaSetsPrefix        = "/replicasets/"
+	jobsPrefix               = "/jobs/"
+	cronJobsPrefix           = "/cronjobs/"
+	namespacesPrefix         = "/namespaces/"
+	secretsPrefix            = "/secrets/"
+	configsPrefix            = "/configs/"
+	servicesPrefix           = "/services/"
+	stacksPrefix             = "/stacks/"
+	allocationsPrefix        = "/allocations/"
+	pendingPrefix            = "/pending/"
+	pendingActivationKey     = "/pending-activation"
+	revisionsPrefix          = "/revisions/"
+	volumesPrefix            = "/volumes/"
+	usagePrefix              = "/usage/"
+	attachmentsPrefix        = "/attachments/"
+	assignmentsPrefix        = "/assignments/"
+	reconciliationPrefix     = "/reconciliation/"
+	retriesPrefix            = "/retries/"
+	driftsPrefix             = "/drifts/"
+	eventsPrefix             = "/events/"
+	leaderElectionPrefix     = "/leader/scheduler/"
+	migrationsPrefix         = "/migrations/"
+	quotaUsagePrefix         = "/quota-usage/"
+	managedStorageStateKey   = "managed_storage_state"
 )
 
-func workloadKey(workloadID string) string       { return workloadsPrefix + workloadID }
-func workloadSpecKey(workloadID string) string   { return workloadSpecPrefix + workloadID }
-func workloadStatusKey(workloadID string) string { return workloadStatusPrefix + workloadID }
+func workloadIDKey(workloadID string) string     { return workloadIDsPrefix + workloadID }
+func replicaSetKey(replicaSetID string) string   { return replicaSetsPrefix + replicaSetID }
+func jobKey(jobID string) string                 { return jobsPrefix + jobID }
+func cronJobKey(cronJobID string) string         { return cronJobsPrefix + cronJobID }
+func namespaceKey(name string) string            { return namespacesPrefix + name }
+func secretKey(namespace, name string) string    { return secretsPrefix + namespace + "/" + name }
+func migrationKey(name string) string            { return migrationsPrefix + name }
+func quotaUsageKey(namespace string) string      { return quotaUsagePrefix + namespace }
+func configKey(namespace, name string) string    { return configsPrefix + namespace + "/" + name }
+func serviceKey(namespace, name string) string   { return servicesPrefix + namespace + "/" + name }
+func stackKey(namespace, name string) string     { return stacksPrefix + namespace + "/" + name }
+func allocationKey(nodeID string) string         { return allocationsPrefix + sanitizeKeySegment(nodeID) }
+func pendingKey(workloadID string) string        { return pendingPrefix + workloadID }
+func revisionPrefix(workloadID string) string    { return revisionsPrefix + workloadID + "/" }
 func managedVolumeKey(volumeID string) string    { return volumesPrefix + volumeID }
 func attachmentPrefix() string                   { return attachmentsPrefix }
 func assignmentKey(workloadID string) string     { return assignmentsPrefix + workloadID }
 func reconciliationKey(workloadID string) string { return reconciliationPrefix + workloadID }
 func retryKey(workloadID string) string          { return retriesPrefix + workloadID }
 func eventKey(eventID string) string             { return eventsPrefix + eventID }
+func usageHistoryKey(workloadID, rollup string) string {
+	return usagePrefix + workloadID + "/" + rollup
+}
+
+// usagePointKey is the key of one stored usage point: the rollup's prefix,
+// the zero-padded unix time of the step, so keys sort by time, and the
+// replica that aggregated it.
+func usagePointKey(workloadID, rollup string, at time.Time, instanceID string) string {
+	return fmt.Sprintf("%s/%020d/%s", usageHistoryKey(workloadID, rollup), at.Unix(), instanceID)
+}
+
+// Workload specs live under /workloads/<namespace>/<id> and statuses under
+// /workloads-status/<namespace>/<id>. IDs stay unique across namespaces;
+// /workload-ids/<id> holds the namespace of each, for lookups by ID.
+func workloadSpecKey(namespace, workloadID string) string {
+	return workloadsPrefix + namespace + "/" + workloadID
+}
+
+func workloadStatusKey(namespace, workloadID string) string {
+	return workloadStatusPrefix + namespace + "/" + workloadID
+}
+
+// workloadIDFromKey returns the workload ID of a spec or status key under
+// prefix. Keys of the layout before namespaces (<prefix><id>) are not
+// matched.
+func workloadIDFromKey(prefix, key string) (string, bool) {
+	parts := strings.Split(strings.TrimPrefix(key, prefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
 func volumeAttachmentKey(nodeID, workloadID, volumeID string) string {
 	return attachmentsPrefix + sanitizeKeySegment(nodeID) + "/" + sanitizeKeySegment(workloadID) + "/" + sanitizeKeySegment(volumeID)
 }
@@ -106,6 +167,16 @@ func (s *Scheduler) initializeWorkloadDefaults(workload *models.Workload) {
 }
 
 func (s *Scheduler) saveWorkload(workload models.Workload) error {
+	return s.putWorkload(workload, false)
+}
+
+// insertWorkload saves a new workload, failing with ErrWorkloadExists if its
+// ID was taken in the meantime.
+func (s *Scheduler) insertWorkload(workload models.Workload) error {
+	return s.putWorkload(workload, true)
+}
+
+func (s *Scheduler) putWorkload(workload models.Workload, create bool) error {
 	if workload.Metadata == nil {
 		workload.Metadata = map[string]interface{}{}
 	}
@@ -121,15 +192,52 @@ func (s *Scheduler) saveWorkload(workload models.Workload) error {
 	if err != nil {
 		return fmt.Errorf("marshal workload status %s: %w", workload.ID, err)
 	}
-	if err := s.RetryableEtcdPut(workloadSpecKey(workload.ID), string(specPayload)); err != nil {
-		return err
-	}
-	metricspkg.IncStateStoreWrite("spec")
-	if err := s.RetryableEtcdPut(workloadStatusKey(workload.ID), string(statusPayload)); err != nil {
-		return err
+	// Callers save a copy they just read, so a status differing from the
+	// stored one is a transition made by this write.
+	previous, known := s.informer.workload(workload.ID)
+	if !known {
+		previous, known = s.getCachedWorkload(workload.ID)
+	}
+	namespace := WorkloadNamespace(workload)
+	specKey := workloadSpecKey(namespace, workload.ID)
+	statusKey := workloadStatusKey(namespace, workload.ID)
+	if create {
+		// Claiming the ID and writing both projections in one transaction
+		// leaves no half-created workload behind.
+		ok, err := s.RetryableEtcdCompareAndPutAll(workloadIDKey(workload.ID), 0, map[string]string{
+			workloadIDKey(workload.ID): namespace,
+			specKey:                    string(specPayload),
+			statusKey:                  string(statusPayload),
+		})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("workload %s: %w", workload.ID, ErrWorkloadExists)
+		}
+		metricspkg.IncStateStoreWrite("spec")
+	} else {
+		if err := s.RetryableEtcdPut(specKey, string(specPayload)); err != nil {
+			return err
+		}
+		metricspkg.IncStateStoreWrite("spec")
+		if err := s.RetryableEtcdPut(statusKey, string(statusPayload)); err != nil {
+			return err
+		}
 	}
 	metricspkg.IncStateStoreWrite("status")
 	s.cacheWorkload(workload)
+	if !countsTowardQuota(workload) && (!known || countsTowardQuota(previous)) {
+		if err := s.releaseQuota(WorkloadNamespace(workload), workload.ID); err != nil {
+			namespaceLogger.WithError(err).WithField("workload_id", workload.ID).Warn("failed to release quota charge")
+		}
+	}
+	if known && previous.Status != workload.Status {
+		s.emitEvent("WorkloadStatusChanged", workload.ID, workload.NodeID, fmt.Sprintf("%s -> %s", previous.Status, workload.Status), map[string]interface{}{
+			"from": previous.Status,
+			"to":   workload.Status,
+		})
+	}
 	retryPayload, err := json.Marshal(workload.Retry)
 	if err == nil {
 		_ = s.RetryableEtcdPut(retryKey(workload.ID), string(retryPayload))
@@ -177,15 +285,25 @@ func (s *Scheduler) emitEvent(eventType, workloadID, nodeID, reason string, deta
 		Timestamp:  time.Now().UTC(),
 		Details:    details,
 	}
+	// Earlier events waiting in etcd go out first, so they keep their order
+	// relative to this one.
+	s.republishEventBacklog()
+	if s.writeEventTelemetry(&event) {
+		metricspkg.IncStateStoreWrite("event")
+		return
+	}
 	payload, err := json.Marshal(event)
 	if err != nil {
 		return
 	}
-	if s.writeEventTelemetry(payload) {
-		metricspkg.IncStateStoreWrite("event")
+	if err := s.RetryableEtcdPut(eventKey(event.ID), string(payload)); err != nil {
 		return
 	}
-	_ = s.RetryableEtcdPut(eventKey(event.ID), string(payload))
+	if s.redisClient != nil {
+		// Redis watchers only read the stream; the event reaches them once
+		// Redis accepts writes again.
+		s.markEventBacklog()
+	}
 	metricspkg.IncStateStoreWrite("event")
 }
 
@@ -216,6 +334,7 @@ func (s *Scheduler) ListSchedulerEvents(limit int64) ([]models.SchedulerEvent, e
 		if err := json.Unmarshal(kv.Value, &event); err != nil {
 			continue
 		}
+		event.Revision = kv.ModRevision
 		events = append(events, event)
 	}
 	return events, nil
@@ -268,6 +387,9 @@ func canonicalStorageDriver(in string) string {
 }
 
 func managedVolumeID(spec models.ManagedVolumeSpec) string {
+	if id := strings.TrimSpace(spec.VolumeID); id != "" {
+		return id
+	}
 	driver := canonicalStorageDriver(spec.Driver)
 	name := strings.TrimSpace(spec.Name)
 	return driver + ":" + name
@@ -374,6 +496,11 @@ func (s *Scheduler) saveManagedVolumeRecord(record models.ManagedVolumeRecord) e
 		record.CreatedAt = now
 	}
 	record.UpdatedAt = now
+	if record.Phase != "Released" {
+		record.ReleasedAt = time.Time{}
+	} else if record.ReleasedAt.IsZero() {
+		record.ReleasedAt = now
+	}
 	payload, err := json.Marshal(record)
 	if err != nil {
 		return err
@@ -499,11 +626,7 @@ func (s *Scheduler) syncWorkloadManagedStorage(workload models.Workload) error {
 				if len(record.WorkloadRefs) == 0 && normalizeRetainPolicy(record.RetainPolicy) == "Delete" {
 					_ = s.deleteManagedVolumeRecord(record.ID)
 				} else {
-					if normalizeRetainPolicy(record.RetainPolicy) == "Retain" && deleting {
-						record.Phase = "Retained"
-					} else {
-						record.Phase = "Released"
-					}
+					record.Phase = releasedVolumePhase(*record)
 					_ = s.saveManagedVolumeRecord(*record)
 				}
 			}
@@ -530,7 +653,10 @@ func (s *Scheduler) syncWorkloadManagedStorage(workload models.Workload) error {
 
 		record.Name = strings.TrimSpace(spec.Name)
 		record.Driver = canonicalStorageDriver(spec.Driver)
-		record.SizeGB = spec.SizeGB
+		// Volumes only grow; a smaller spec size is left to ResizeVolume.
+		if spec.SizeGB > record.SizeGB {
+			record.SizeGB = spec.SizeGB
+		}
 		record.AccessMode = strings.TrimSpace(spec.AccessMode)
 		record.FSType = strings.TrimSpace(spec.FSType)
 		record.RetainPolicy = normalizeRetainPolicy(spec.RetainPolicy)
@@ -559,6 +685,9 @@ func (s *Scheduler) syncWorkloadManagedStorage(workload models.Workload) error {
 		record.WorkloadRefs = appendUniqueString(record.WorkloadRefs, workloadID)
 		if assignedNode != "" {
 			record.AttachedNodes = appendUniqueString(record.AttachedNodes, assignedNode)
+			if record.Driver == "local" {
+				record.NodeID = assignedNode
+			}
 		}
 		record.Phase = workloadStatusToVolumePhase(workload, assignedNode, false)
 		if strings.EqualFold(record.Phase, "Error") {
@@ -609,11 +738,7 @@ func (s *Scheduler) syncWorkloadManagedStorage(workload models.Workload) error {
 			}
 			continue
 		}
-		if deleting && normalizeRetainPolicy(record.RetainPolicy) == "Retain" {
-			record.Phase = "Retained"
-		} else {
-			record.Phase = "Released"
-		}
+		record.Phase = releasedVolumePhase(record)
 		if err := s.saveManagedVolumeRecord(record); err != nil {
 			return err
 		}
@@ -621,6 +746,16 @@ func (s *Scheduler) syncWorkloadManagedStorage(workload models.Workload) error {
 	return nil
 }
 
+// releasedVolumePhase is the phase of a volume a workload stopped using. A
+// Retain volume nothing uses any more is Retained and stays until
+// DeleteVolume; only Released volumes are collected by ReconcileVolumes.
+func releasedVolumePhase(record models.ManagedVolumeRecord) string {
+	if len(record.WorkloadRefs) == 0 && normalizeRetainPolicy(record.RetainPolicy) == "Retain" {
+		return "Retained"
+	}
+	return "Released"
+}
+
 func (s *Scheduler) cleanupManagedStorageForWorkload(workloadID string) error {
 	workloadID = strings.TrimSpace(workloadID)
 	if workloadID == "" {
@@ -661,10 +796,8 @@ func (s *Scheduler) cleanupManagedStorageForWorkload(workloadID string) error {
 			}
 			continue
 		}
-		if len(volume.WorkloadRefs) == 0 && normalizeRetainPolicy(volume.RetainPolicy) == "Retain" {
-			volume.Phase = "Retained"
-		} else if len(volume.WorkloadRefs) == 0 {
-			volume.Phase = "Released"
+		if len(volume.WorkloadRefs) == 0 {
+			volume.Phase = releasedVolumePhase(volume)
 		}
 		if err := s.saveManagedVolumeRecord(volume); err != nil {
 			return err
@@ -0,0 +1,103 @@
+package scheduler
+
+import (
+	"strings"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+// canonicalTaintEffect maps user input onto the effect constants. Unknown
+// values come back empty.
+func canonicalTaintEffect(effect string) string {
+	switch strings.ToLower(strings.TrimSpace(effect)) {
+	case "noschedule":
+		return models.TaintEffectNoSchedule
+	case "prefernoschedule":
+		return models.TaintEffectPreferNoSchedule
+	case "noexecute":
+		return models.TaintEffectNoExecute
+	default:
+		return ""
+	}
+}
+
+func toleratesTaint(tol models.Toleration, taint models.Taint) bool {
+	if effect := strings.TrimSpace(tol.Effect); effect != "" && canonicalTaintEffect(effect) != canonicalTaintEffect(taint.Effect) {
+		return false
+	}
+	key := strings.TrimSpace(tol.Key)
+	switch strings.ToLower(strings.TrimSpace(tol.Operator)) {
+	case "exists":
+		return key == "" || key == taint.Key
+	case "", "equal":
+		return key == taint.Key && tol.Value == taint.Value
+	default:
+		return false
+	}
+}
+
+// untoleratedTaints returns the taints with one of effects that none of
+// tolerations match.
+func untoleratedTaints(taints []models.Taint, tolerations []models.Toleration, effects ...string) []models.Taint {
+	var out []models.Taint
+	for _, taint := range taints {
+		if !containsString(effects, canonicalTaintEffect(taint.Effect)) {
+			continue
+		}
+		tolerated := false
+		for _, tol := range tolerations {
+			if toleratesTaint(tol, taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			out = append(out, taint)
+		}
+	}
+	return out
+}
+
+func hasTaintEffect(taints []models.Taint, effect string) bool {
+	for _, taint := range taints {
+		if canonicalTaintEffect(taint.Effect) == effect {
+			return true
+		}
+	}
+	return false
+}
+
+func formatTaints(taints []models.Taint) string {
+	parts := make([]string, 0, len(taints))
+	for _, taint := range taints {
+		if taint.Value != "" {
+			parts = append(parts, taint.Key+"="+taint.Value+":"+taint.Effect)
+			continue
+		}
+		parts = append(parts, taint.Key+":"+taint.Effect)
+	}
+	return strings.Join(parts, ",")
+}
+
+// mergeTaints returns the operator taints followed by the agent taints they
+// do not override. An operator taint overrides an agent taint with the same
+// key and effect.
+func mergeTaints(operator, agent []models.Taint) []models.Taint {
+	if len(operator) == 0 && len(agent) == 0 {
+		return nil
+	}
+	out := append([]models.Taint(nil), operator...)
+	for _, taint := range agent {
+		overridden := false
+		for _, op := range operator {
+			if op.Key == taint.Key && canonicalTaintEffect(op.Effect) == canonicalTaintEffect(taint.Effect) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			out = append(out, taint)
+		}
+	}
+	return out
+}
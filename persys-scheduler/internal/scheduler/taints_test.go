@@ -0,0 +1,86 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestUntoleratedTaints(t *testing.T) {
+	gpu := models.Taint{Key: "gpu", Value: "a100", Effect: models.TaintEffectNoSchedule}
+	maint := models.Taint{Key: "maintenance", Effect: models.TaintEffectNoExecute}
+	tests := []struct {
+		name        string
+		tolerations []models.Toleration
+		effects     []string
+		want        int
+	}{
+		{name: "no tolerations", effects: []string{models.TaintEffectNoSchedule, models.TaintEffectNoExecute}, want: 2},
+		{name: "only the asked effect counts", effects: []string{models.TaintEffectNoExecute}, want: 1},
+		{
+			name:        "equal needs the value",
+			tolerations: []models.Toleration{{Key: "gpu", Operator: "Equal", Value: "h100"}},
+			effects:     []string{models.TaintEffectNoSchedule},
+			want:        1,
+		},
+		{
+			name:        "equal with the value",
+			tolerations: []models.Toleration{{Key: "gpu", Operator: "Equal", Value: "a100"}},
+			effects:     []string{models.TaintEffectNoSchedule},
+			want:        0,
+		},
+		{
+			name:        "exists with an effect",
+			tolerations: []models.Toleration{{Key: "maintenance", Operator: "Exists", Effect: "noexecute"}},
+			effects:     []string{models.TaintEffectNoSchedule, models.TaintEffectNoExecute},
+			want:        1,
+		},
+		{
+			name:        "exists without a key tolerates everything",
+			tolerations: []models.Toleration{{Operator: "Exists"}},
+			effects:     []string{models.TaintEffectNoSchedule, models.TaintEffectNoExecute},
+			want:        0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := untoleratedTaints([]models.Taint{gpu, maint}, tt.tolerations, tt.effects...)
+			if len(got) != tt.want {
+				t.Fatalf("expected %d untolerated taints, got %s", tt.want, formatTaints(got))
+			}
+		})
+	}
+}
+
+func TestMergeTaints(t *testing.T) {
+	agentGPU := models.Taint{Key: "gpu", Value: "agent", Effect: models.TaintEffectNoSchedule}
+	agentZone := models.Taint{Key: "zone", Value: "edge", Effect: models.TaintEffectPreferNoSchedule}
+	tests := []struct {
+		name     string
+		operator []models.Taint
+		agent    []models.Taint
+		want     string
+	}{
+		{name: "none", want: ""},
+		{name: "agent only", agent: []models.Taint{agentGPU}, want: "gpu=agent:NoSchedule"},
+		{
+			name:     "operator overrides the same key and effect",
+			operator: []models.Taint{{Key: "gpu", Value: "operator", Effect: models.TaintEffectNoSchedule}},
+			agent:    []models.Taint{agentGPU, agentZone},
+			want:     "gpu=operator:NoSchedule,zone=edge:PreferNoSchedule",
+		},
+		{
+			name:     "same key with another effect is kept",
+			operator: []models.Taint{{Key: "gpu", Effect: models.TaintEffectNoExecute}},
+			agent:    []models.Taint{agentGPU},
+			want:     "gpu:NoExecute,gpu=agent:NoSchedule",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatTaints(mergeTaints(tt.operator, tt.agent)); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,435 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// usageRollup is one resolution of the workload usage history.
+type usageRollup struct {
+	name string
+	step time.Duration
+}
+
+var usageRollups = []usageRollup{
+	{name: "1m", step: time.Minute},
+	{name: "5m", step: 5 * time.Minute},
+	{name: "1h", step: time.Hour},
+}
+
+// usageTrendThreshold is how many CPU percentage points the fitted line has
+// to move over a range before the trend counts as increasing or decreasing.
+const usageTrendThreshold = 5.0
+
+func usageRedisKey(workloadID, rollup string) string {
+	return fmt.Sprintf("usage:%s:%s", workloadID, rollup)
+}
+
+func (s *Scheduler) usageRetention(r usageRollup) time.Duration {
+	if s.cfg != nil {
+		switch r.name {
+		case "1m":
+			if s.cfg.SchedulerUsageRetention1m > 0 {
+				return s.cfg.SchedulerUsageRetention1m
+			}
+		case "5m":
+			if s.cfg.SchedulerUsageRetention5m > 0 {
+				return s.cfg.SchedulerUsageRetention5m
+			}
+		case "1h":
+			if s.cfg.SchedulerUsageRetention1h > 0 {
+				return s.cfg.SchedulerUsageRetention1h
+			}
+		}
+	}
+	switch r.name {
+	case "1m":
+		return 6 * time.Hour
+	case "5m":
+		return 48 * time.Hour
+	default:
+		return 30 * 24 * time.Hour
+	}
+}
+
+// usageCapacity is how many points of r the ring buffer keeps.
+func (s *Scheduler) usageCapacity(r usageRollup) int {
+	return int(s.usageRetention(r) / r.step)
+}
+
+// storedUsageRollups are the rollups written to the store. Redis keeps all of
+// them; etcd only keeps the coarser ones so it is written at most once every
+// five minutes per workload.
+func (s *Scheduler) storedUsageRollups() []usageRollup {
+	if s.redisClient != nil {
+		return usageRollups
+	}
+	return usageRollups[1:]
+}
+
+// recordUsageSample folds a usage sample into the open point of every rollup.
+// Points are stored once a later sample starts the next step; the open points
+// live in the memory of the replica that received the samples and are merged
+// into its own queries.
+func (s *Scheduler) recordUsageSample(workloadID string, usage models.WorkloadUsage) {
+	at := usage.CollectedAt.UTC()
+	if usage.CollectedAt.IsZero() {
+		at = time.Now().UTC()
+	}
+	type closedPoint struct {
+		rollup usageRollup
+		point  models.WorkloadUsagePoint
+	}
+	var closed []closedPoint
+
+	s.usageMu.Lock()
+	open := s.usageOpen[workloadID]
+	if open == nil {
+		open = map[string]*models.WorkloadUsagePoint{}
+		s.usageOpen[workloadID] = open
+	}
+	for _, r := range usageRollups {
+		bucket := at.Truncate(r.step)
+		p := open[r.name]
+		if p != nil && bucket.Before(p.Timestamp) {
+			// Late sample for a step that is already stored.
+			continue
+		}
+		if p != nil && bucket.After(p.Timestamp) {
+			closed = append(closed, closedPoint{rollup: r, point: *p})
+			p = nil
+		}
+		if p == nil {
+			p = &models.WorkloadUsagePoint{Timestamp: bucket}
+			open[r.name] = p
+		}
+		addUsageSample(p, usage)
+	}
+	s.usageMu.Unlock()
+
+	for _, c := range closed {
+		s.storeUsagePoint(workloadID, c.rollup, c.point)
+	}
+}
+
+func addUsageSample(p *models.WorkloadUsagePoint, usage models.WorkloadUsage) {
+	mergeUsagePoint(p, models.WorkloadUsagePoint{
+		Samples:        1,
+		CPUAvgPercent:  usage.CPUPercent,
+		CPUMaxPercent:  usage.CPUPercent,
+		MemoryAvgBytes: usage.MemoryBytes,
+		MemoryMaxBytes: usage.MemoryBytes,
+		DiskReadBytes:  usage.DiskReadBytes,
+		DiskWriteBytes: usage.DiskWriteBytes,
+		NetRXBytes:     usage.NetRXBytes,
+		NetTXBytes:     usage.NetTXBytes,
+	})
+}
+
+// mergeUsagePoint folds src, which is not older than dst, into dst. Averages
+// are weighted by sample count; counters are taken from src.
+func mergeUsagePoint(dst *models.WorkloadUsagePoint, src models.WorkloadUsagePoint) {
+	n, m := float64(dst.Samples), float64(src.Samples)
+	if n+m == 0 {
+		return
+	}
+	dst.CPUAvgPercent = (dst.CPUAvgPercent*n + src.CPUAvgPercent*m) / (n + m)
+	dst.MemoryAvgBytes = int64((float64(dst.MemoryAvgBytes)*n + float64(src.MemoryAvgBytes)*m) / (n + m))
+	if dst.Samples == 0 || src.CPUMaxPercent > dst.CPUMaxPercent {
+		dst.CPUMaxPercent = src.CPUMaxPercent
+	}
+	if dst.Samples == 0 || src.MemoryMaxBytes > dst.MemoryMaxBytes {
+		dst.MemoryMaxBytes = src.MemoryMaxBytes
+	}
+	dst.DiskReadBytes = src.DiskReadBytes
+	dst.DiskWriteBytes = src.DiskWriteBytes
+	dst.NetRXBytes = src.NetRXBytes
+	dst.NetTXBytes = src.NetTXBytes
+	dst.Samples += src.Samples
+}
+
+// storeUsagePoint stores a finished point of its rollup: in a capped Redis
+// list when Redis is configured, otherwise under its own etcd key, leased so it
+// expires with the rollup's retention. Every replica aggregates the samples it
+// receives and stores its own part of a step; queries merge the parts.
+func (s *Scheduler) storeUsagePoint(workloadID string, r usageRollup, point models.WorkloadUsagePoint) {
+	payload, err := json.Marshal(point)
+	if err != nil {
+		return
+	}
+	if s.redisClient != nil {
+		key := usageRedisKey(workloadID, r.name)
+		pipe := s.redisClient.TxPipeline()
+		pipe.RPush(context.Background(), key, payload)
+		pipe.LTrim(context.Background(), key, int64(-s.usageCapacity(r)), -1)
+		pipe.Expire(context.Background(), key, s.usageRetention(r))
+		if _, err = pipe.Exec(context.Background()); err == nil {
+			return
+		}
+		redisLogger.WithError(err).WithFields(logrus.Fields{"key": key}).Warn("failed writing usage history to redis")
+	}
+	if r.name == usageRollups[0].name {
+		return
+	}
+	if err := s.requireWritable(); err != nil {
+		return
+	}
+	lease, err := s.usagePointLease(r, point.Timestamp)
+	if err != nil {
+		schedulerLogger.WithError(err).WithField("workload_id", workloadID).Warn("failed to lease usage history point")
+		return
+	}
+	_ = s.RetryableEtcdPut(usagePointKey(workloadID, r.name, point.Timestamp, s.usageInstanceID()), string(payload), clientv3.WithLease(lease))
+}
+
+// usageLease is a lease shared by the stored points of one rollup step.
+type usageLease struct {
+	id      clientv3.LeaseID
+	expires time.Time
+}
+
+// usagePointLease returns the lease for points of r at bucket, granting one
+// that runs until the step falls out of retention the first time it is
+// needed.
+func (s *Scheduler) usagePointLease(r usageRollup, bucket time.Time) (clientv3.LeaseID, error) {
+	key := fmt.Sprintf("%s/%d", r.name, bucket.Unix())
+	s.usageMu.Lock()
+	lease, ok := s.usageLeases[key]
+	s.usageMu.Unlock()
+	if ok {
+		return lease.id, nil
+	}
+
+	expires := bucket.Add(s.usageRetention(r) + r.step)
+	ttl := time.Until(expires)
+	if ttl < r.step {
+		ttl = r.step
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), etcdTimeout)
+	defer cancel()
+	resp, err := s.etcdClient.Grant(ctx, int64(ttl/time.Second))
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	s.usageMu.Lock()
+	defer s.usageMu.Unlock()
+	if s.usageLeases == nil {
+		s.usageLeases = map[string]usageLease{}
+	}
+	for k, l := range s.usageLeases {
+		if now.After(l.expires) {
+			delete(s.usageLeases, k)
+		}
+	}
+	s.usageLeases[key] = usageLease{id: resp.ID, expires: now.Add(ttl)}
+	return resp.ID, nil
+}
+
+func (s *Scheduler) usageInstanceID() string {
+	if s.cfg != nil && strings.TrimSpace(s.cfg.SchedulerInstanceID) != "" {
+		return s.cfg.SchedulerInstanceID
+	}
+	return "local"
+}
+
+// etcdUsagePoints reads the stored points of r, oldest first. Points from
+// releases that kept the whole ring in one key are read too.
+func (s *Scheduler) etcdUsagePoints(workloadID string, r usageRollup) ([]models.WorkloadUsagePoint, error) {
+	legacyKey := usageHistoryKey(workloadID, r.name)
+	resp, err := s.RetryableEtcdGet(legacyKey, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	var points []models.WorkloadUsagePoint
+	for _, kv := range resp.Kvs {
+		key := string(kv.Key)
+		switch {
+		case key == legacyKey:
+			var ring []models.WorkloadUsagePoint
+			if err := json.Unmarshal(kv.Value, &ring); err != nil {
+				return nil, fmt.Errorf("unmarshal usage history %s/%s: %w", workloadID, r.name, err)
+			}
+			points = append(points, ring...)
+		case strings.HasPrefix(key, legacyKey+"/"):
+			var p models.WorkloadUsagePoint
+			if err := json.Unmarshal(kv.Value, &p); err != nil {
+				continue
+			}
+			points = append(points, p)
+		}
+	}
+	cutoff := time.Now().UTC().Add(-s.usageRetention(r))
+	kept := points[:0]
+	for _, p := range points {
+		if !p.Timestamp.Before(cutoff) {
+			kept = append(kept, p)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].Timestamp.Before(kept[j].Timestamp) })
+	return kept, nil
+}
+
+// storedUsagePoints reads the stored points of r, oldest first.
+func (s *Scheduler) storedUsagePoints(workloadID string, r usageRollup) ([]models.WorkloadUsagePoint, error) {
+	if s.redisClient != nil {
+		raw, err := s.redisClient.LRange(context.Background(), usageRedisKey(workloadID, r.name), 0, -1).Result()
+		if err == nil {
+			points := make([]models.WorkloadUsagePoint, 0, len(raw))
+			for _, item := range raw {
+				var p models.WorkloadUsagePoint
+				if err := json.Unmarshal([]byte(item), &p); err == nil {
+					points = append(points, p)
+				}
+			}
+			return points, nil
+		}
+		redisLogger.WithError(err).WithField("workload_id", workloadID).Warn("failed reading usage history from redis")
+	}
+	if r.name == usageRollups[0].name {
+		return nil, nil
+	}
+	return s.etcdUsagePoints(workloadID, r)
+}
+
+// deleteUsageHistory drops the usage history of a deleted workload.
+func (s *Scheduler) deleteUsageHistory(workloadID string) {
+	s.usageMu.Lock()
+	delete(s.usageOpen, workloadID)
+	s.usageMu.Unlock()
+	if s.redisClient != nil {
+		keys := make([]string, 0, len(usageRollups))
+		for _, r := range usageRollups {
+			keys = append(keys, usageRedisKey(workloadID, r.name))
+		}
+		_ = s.redisClient.Del(context.Background(), keys...).Err()
+	}
+	_ = s.RetryableEtcdDelete(usagePrefix+workloadID+"/", clientv3.WithPrefix())
+}
+
+// pickUsageRollup returns the coarsest stored rollup no coarser than step
+// whose retention still reaches back to from, or the finest one that does.
+func (s *Scheduler) pickUsageRollup(from time.Time, step time.Duration) usageRollup {
+	stored := s.storedUsageRollups()
+	age := time.Since(from)
+	picked := stored[len(stored)-1]
+	for i := len(stored) - 1; i >= 0; i-- {
+		r := stored[i]
+		if s.usageRetention(r) < age {
+			break
+		}
+		picked = r
+		if r.step <= step {
+			break
+		}
+	}
+	return picked
+}
+
+// GetWorkloadUsageHistory returns the usage of a workload between from and to
+// with one point per step. A zero to means now, a zero from one hour before
+// to, and a zero step the step of the rollup the points are read from.
+func (s *Scheduler) GetWorkloadUsageHistory(workloadID string, from, to time.Time, step time.Duration) (models.WorkloadUsageHistory, error) {
+	if _, err := s.GetWorkloadByID(workloadID); err != nil {
+		return models.WorkloadUsageHistory{}, err
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-time.Hour)
+	}
+	from, to = from.UTC(), to.UTC()
+	if !from.Before(to) {
+		return models.WorkloadUsageHistory{}, fmt.Errorf("from must be before to")
+	}
+	if step < 0 {
+		return models.WorkloadUsageHistory{}, fmt.Errorf("step must not be negative")
+	}
+
+	r := s.pickUsageRollup(from, step)
+	if step < r.step {
+		step = r.step
+	}
+	points, err := s.storedUsagePoints(workloadID, r)
+	if err != nil {
+		return models.WorkloadUsageHistory{}, err
+	}
+	s.usageMu.Lock()
+	if p := s.usageOpen[workloadID][r.name]; p != nil {
+		points = append(points, *p)
+	}
+	s.usageMu.Unlock()
+
+	start := from.Truncate(step)
+	buckets := map[time.Time]*models.WorkloadUsagePoint{}
+	for _, p := range points {
+		if p.Timestamp.Before(start) || p.Timestamp.After(to) {
+			continue
+		}
+		bucket := p.Timestamp.Truncate(step)
+		agg, ok := buckets[bucket]
+		if !ok {
+			agg = &models.WorkloadUsagePoint{Timestamp: bucket}
+			buckets[bucket] = agg
+		}
+		mergeUsagePoint(agg, p)
+	}
+	history := models.WorkloadUsageHistory{
+		WorkloadID: workloadID,
+		Resolution: r.name,
+		Step:       step,
+		From:       from,
+		To:         to,
+		Points:     make([]models.WorkloadUsagePoint, 0, len(buckets)),
+	}
+	for _, p := range buckets {
+		history.Points = append(history.Points, *p)
+	}
+	sort.Slice(history.Points, func(i, j int) bool { return history.Points[i].Timestamp.Before(history.Points[j].Timestamp) })
+	history.CPUTrend = usageCPUTrend(history.Points)
+	return history, nil
+}
+
+// usageCPUTrend fits a line through the average CPU of points and reports
+// whether it moves by more than usageTrendThreshold over their range. It is
+// empty with fewer than two points.
+func usageCPUTrend(points []models.WorkloadUsagePoint) string {
+	if len(points) < 2 {
+		return ""
+	}
+	origin := points[0].Timestamp
+	var sumX, sumY, sumXY, sumXX float64
+	for _, p := range points {
+		x := p.Timestamp.Sub(origin).Seconds()
+		sumX += x
+		sumY += p.CPUAvgPercent
+		sumXY += x * p.CPUAvgPercent
+		sumXX += x * x
+	}
+	n := float64(len(points))
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return "stable"
+	}
+	slope := (n*sumXY - sumX*sumY) / denom
+	change := slope * points[len(points)-1].Timestamp.Sub(origin).Seconds()
+	switch {
+	case math.IsNaN(change) || math.Abs(change) < usageTrendThreshold:
+		return "stable"
+	case change > 0:
+		return "increasing"
+	default:
+		return "decreasing"
+	}
+}
@@ -0,0 +1,80 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	cfgpkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/config"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// fakeLease grants leases without expiring anything; fakeKV ignores them.
+type fakeLease struct {
+	clientv3.Lease
+	grants int
+}
+
+func (f *fakeLease) Grant(_ context.Context, ttl int64) (*clientv3.LeaseGrantResponse, error) {
+	f.grants++
+	return &clientv3.LeaseGrantResponse{ID: clientv3.LeaseID(f.grants), TTL: ttl}, nil
+}
+
+func TestUsageHistoryMergesPointsStoredByEachReplica(t *testing.T) {
+	kv := newFakeKV()
+	lease := &fakeLease{}
+	replicas := make([]*Scheduler, 0, 2)
+	for _, id := range []string{"scheduler-a", "scheduler-b"} {
+		s := newLedgerTestScheduler(kv)
+		s.etcdClient.Lease = lease
+		s.cfg = &cfgpkg.Config{SchedulerInstanceID: id}
+		s.usageOpen = map[string]map[string]*models.WorkloadUsagePoint{}
+		replicas = append(replicas, s)
+	}
+	if err := replicas[0].insertWorkload(models.Workload{ID: "w1", Type: "container"}); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+
+	base := time.Now().UTC().Add(-30 * time.Minute).Truncate(5 * time.Minute)
+	// An older release kept the whole ring in one key.
+	legacy, err := json.Marshal([]models.WorkloadUsagePoint{{Timestamp: base.Add(-5 * time.Minute), Samples: 1, CPUAvgPercent: 50}})
+	if err != nil {
+		t.Fatalf("marshal legacy ring: %v", err)
+	}
+	if _, err := kv.Put(context.Background(), usageHistoryKey("w1", "5m"), string(legacy)); err != nil {
+		t.Fatalf("put legacy ring: %v", err)
+	}
+
+	// Each replica receives one sample of the step, then one of the next
+	// step, which closes and stores its part.
+	for i, cpu := range []float64{10, 30} {
+		s := replicas[i]
+		s.recordUsageSample("w1", models.WorkloadUsage{CPUPercent: cpu, CollectedAt: base.Add(time.Duration(i+1) * time.Minute)})
+		s.recordUsageSample("w1", models.WorkloadUsage{CPUPercent: cpu, CollectedAt: base.Add(6 * time.Minute)})
+	}
+
+	resp, err := kv.Get(context.Background(), usageHistoryKey("w1", "5m")+"/", clientv3.WithPrefix())
+	if err != nil {
+		t.Fatalf("list usage points: %v", err)
+	}
+	if len(resp.Kvs) != 2 {
+		t.Fatalf("expected one stored point per replica, got %d", len(resp.Kvs))
+	}
+
+	history, err := replicas[0].GetWorkloadUsageHistory("w1", base.Add(-5*time.Minute), base.Add(4*time.Minute), 5*time.Minute)
+	if err != nil {
+		t.Fatalf("GetWorkloadUsageHistory: %v", err)
+	}
+	if history.Resolution != "5m" || len(history.Points) != 2 {
+		t.Fatalf("expected the legacy point and the merged point at 5m, got %s with %+v", history.Resolution, history.Points)
+	}
+	merged := history.Points[1]
+	if !merged.Timestamp.Equal(base) || merged.Samples != 2 || merged.CPUAvgPercent != 20 || merged.CPUMaxPercent != 30 {
+		t.Fatalf("expected both replicas' parts merged, got %+v", merged)
+	}
+	if lease.grants == 0 {
+		t.Fatalf("expected stored points to be leased")
+	}
+}
@@ -0,0 +1,642 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	agentpb "github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	metricspkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/metrics"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// Snapshots and clones copy the whole volume, so agent calls get longer
+	// than the usual RPC timeout.
+	defaultVolumeRPCTimeout    = 5 * time.Minute
+	defaultVolumeReleaseGrace  = 24 * time.Hour
+	defaultVolumeRetainPolicy  = "Retain"
+	volumeSnapshotNameTemplate = "snap-20060102-150405"
+)
+
+// ErrVolumeNotFound is returned for volume IDs that have no record.
+var ErrVolumeNotFound = errors.New("managed volume not found")
+
+// ErrVolumeInUse is returned when a volume is attached to workloads and the
+// operation needs it free.
+var ErrVolumeInUse = errors.New("managed volume is in use")
+
+var volumeLogger = logging.C("scheduler.volumes")
+
+// VolumeFilter selects volumes in ListVolumes. Empty fields match all.
+type VolumeFilter struct {
+	Phase      string
+	Driver     string
+	NodeID     string
+	WorkloadID string
+}
+
+// CloneVolumeOptions describes the volume CloneVolume creates.
+type CloneVolumeOptions struct {
+	SourceSnapshot string // empty clones the live volume
+	Name           string
+	SizeGB         int64 // 0 keeps the source size
+	RetainPolicy   string
+}
+
+func (s *Scheduler) volumeReleaseGrace() time.Duration {
+	if s.cfg != nil {
+		return s.cfg.SchedulerVolumeReleaseGrace
+	}
+	return defaultVolumeReleaseGrace
+}
+
+// sharedAccessMode reports whether several workloads may use a volume at once.
+func sharedAccessMode(mode string) bool {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "readwritemany", "readonlymany", "rwx", "rox":
+		return true
+	default:
+		return false
+	}
+}
+
+func volumeNodeReady(node models.Node) bool {
+	return strings.EqualFold(node.Status, "active") || strings.EqualFold(node.Status, "ready")
+}
+
+// volumeHomeNode returns the node holding the data of a local volume.
+func volumeHomeNode(record models.ManagedVolumeRecord) string {
+	if nodeID := strings.TrimSpace(record.NodeID); nodeID != "" {
+		return nodeID
+	}
+	if len(record.AttachedNodes) > 0 {
+		return record.AttachedNodes[0]
+	}
+	return ""
+}
+
+// sharedVolumeNode picks a ready node that supports driver to run operations
+// on a volume that is not tied to a node.
+func (s *Scheduler) sharedVolumeNode(driver string) (models.Node, error) {
+	nodes, err := s.GetNodes()
+	if err != nil {
+		return models.Node{}, err
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].NodeID < nodes[j].NodeID })
+	for _, node := range nodes {
+		if volumeNodeReady(node) && nodeSupportsStorageDrivers(node, []string{driver}) {
+			return node, nil
+		}
+	}
+	return models.Node{}, fmt.Errorf("no ready node supports storage driver %s", driver)
+}
+
+// volumeNode returns the node that runs operations on an existing volume:
+// the home node of a local volume, otherwise a node it is attached to or any
+// ready node with its driver.
+func (s *Scheduler) volumeNode(record models.ManagedVolumeRecord) (models.Node, error) {
+	nodeID := volumeHomeNode(record)
+	if record.Driver == "local" {
+		if nodeID == "" {
+			return models.Node{}, fmt.Errorf("local volume %s has no node", record.ID)
+		}
+		return s.GetNodeByID(nodeID)
+	}
+	if nodeID != "" {
+		if node, err := s.GetNodeByID(nodeID); err == nil && volumeNodeReady(node) {
+			return node, nil
+		}
+	}
+	return s.sharedVolumeNode(record.Driver)
+}
+
+func volumeRef(record models.ManagedVolumeRecord) *agentpb.VolumeRef {
+	return &agentpb.VolumeRef{Name: record.Name, Driver: record.Driver}
+}
+
+// callVolumeOnNode runs one volume call against the agent of node. Volume
+// calls are rare and may be long, so they always dial the agent directly.
+func (s *Scheduler) callVolumeOnNode(node models.Node, method string, call func(context.Context, agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error)) (resp *agentpb.VolumeOperationResponse, err error) {
+	start := time.Now()
+	defer func() {
+		metricspkg.ObserveAgentRPC(method, err, time.Since(start))
+	}()
+
+	client, conn, err := s.newAgentClient(node)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), defaultVolumeRPCTimeout)
+	defer cancel()
+	resp, err = call(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetSuccess() {
+		err = fmt.Errorf("agent %s on node %s: %s", method, node.NodeID, resp.GetMessage())
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateVolume provisions a volume outside any workload. Local volumes are
+// created on nodeID; shared drivers use nodeID or any node with the driver.
+// Standalone volumes are retained by default.
+func (s *Scheduler) CreateVolume(spec models.ManagedVolumeSpec, nodeID string) (models.ManagedVolumeRecord, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	spec.Name = strings.TrimSpace(spec.Name)
+	spec.Driver = canonicalStorageDriver(spec.Driver)
+	spec.VolumeID = ""
+	if err := validateObjectName("volume", spec.Name); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	if spec.SizeGB <= 0 {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("volume size must be positive")
+	}
+	if strings.TrimSpace(spec.RetainPolicy) == "" {
+		spec.RetainPolicy = defaultVolumeRetainPolicy
+	}
+	spec.RetainPolicy = normalizeRetainPolicy(spec.RetainPolicy)
+
+	volumeID := managedVolumeID(spec)
+	if _, exists, err := s.getManagedVolumeRecord(volumeID); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	} else if exists {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("managed volume %s already exists", volumeID)
+	}
+
+	var node models.Node
+	nodeID = strings.TrimSpace(nodeID)
+	switch {
+	case nodeID != "":
+		var err error
+		node, err = s.GetNodeByID(nodeID)
+		if err != nil {
+			return models.ManagedVolumeRecord{}, err
+		}
+		if !nodeSupportsStorageDrivers(node, []string{spec.Driver}) {
+			return models.ManagedVolumeRecord{}, fmt.Errorf("node %s does not support storage driver %s", nodeID, spec.Driver)
+		}
+	case spec.Driver == "local":
+		return models.ManagedVolumeRecord{}, fmt.Errorf("node_id is required for local volumes")
+	default:
+		var err error
+		node, err = s.sharedVolumeNode(spec.Driver)
+		if err != nil {
+			return models.ManagedVolumeRecord{}, err
+		}
+	}
+
+	resp, err := s.callVolumeOnNode(node, "CreateVolume", func(ctx context.Context, client agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error) {
+		return client.CreateVolume(ctx, &agentpb.CreateVolumeRequest{Volume: toAgentManagedVolumes([]models.ManagedVolumeSpec{spec})[0]})
+	})
+	if err != nil {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("create volume %s: %w", volumeID, err)
+	}
+
+	record := models.ManagedVolumeRecord{
+		ID:           volumeID,
+		Name:         spec.Name,
+		Driver:       spec.Driver,
+		SizeGB:       spec.SizeGB,
+		AccessMode:   strings.TrimSpace(spec.AccessMode),
+		FSType:       strings.TrimSpace(spec.FSType),
+		RetainPolicy: spec.RetainPolicy,
+		Phase:        "Provisioned",
+	}
+	if resp.GetSizeGb() > record.SizeGB {
+		record.SizeGB = resp.GetSizeGb()
+	}
+	if spec.Driver == "local" {
+		record.NodeID = node.NodeID
+	}
+	if err := s.saveManagedVolumeRecord(record); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	s.emitEvent("VolumeCreated", "", node.NodeID, "", map[string]interface{}{"volume_id": volumeID, "size_gb": record.SizeGB})
+	volumeLogger.WithFields(logrus.Fields{"volume_id": volumeID, "node_id": node.NodeID, "size_gb": record.SizeGB}).Info("created managed volume")
+	return s.GetVolume(volumeID)
+}
+
+// GetVolume returns the record of a managed volume.
+func (s *Scheduler) GetVolume(volumeID string) (models.ManagedVolumeRecord, error) {
+	volumeID = strings.TrimSpace(volumeID)
+	record, exists, err := s.getManagedVolumeRecord(volumeID)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	if !exists {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("%w: %s", ErrVolumeNotFound, volumeID)
+	}
+	return *record, nil
+}
+
+// ListVolumes returns managed volumes matching filter, sorted by ID.
+func (s *Scheduler) ListVolumes(filter VolumeFilter) ([]models.ManagedVolumeRecord, error) {
+	records, err := s.listManagedVolumeRecords()
+	if err != nil {
+		return nil, err
+	}
+	driver := ""
+	if strings.TrimSpace(filter.Driver) != "" {
+		driver = canonicalStorageDriver(filter.Driver)
+	}
+	out := make([]models.ManagedVolumeRecord, 0, len(records))
+	for _, record := range records {
+		if filter.Phase != "" && !strings.EqualFold(record.Phase, strings.TrimSpace(filter.Phase)) {
+			continue
+		}
+		if driver != "" && record.Driver != driver {
+			continue
+		}
+		if nodeID := strings.TrimSpace(filter.NodeID); nodeID != "" && record.NodeID != nodeID && !containsString(record.AttachedNodes, nodeID) {
+			continue
+		}
+		if workloadID := strings.TrimSpace(filter.WorkloadID); workloadID != "" && !containsString(record.WorkloadRefs, workloadID) {
+			continue
+		}
+		out = append(out, record)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+// DeleteVolume deletes a volume no workload uses, data included. With force
+// the record is dropped even when the agent cannot delete the data, e.g.
+// because the node of a local volume is gone.
+func (s *Scheduler) DeleteVolume(volumeID string, force bool) error {
+	if err := s.requireWritable(); err != nil {
+		return err
+	}
+	record, err := s.GetVolume(volumeID)
+	if err != nil {
+		return err
+	}
+	if len(record.WorkloadRefs) > 0 {
+		return fmt.Errorf("%w: %s is used by %s", ErrVolumeInUse, record.ID, strings.Join(record.WorkloadRefs, ", "))
+	}
+	return s.destroyVolume(record, force, "VolumeDeleted", "")
+}
+
+// destroyVolume deletes the data of a volume on its agent and then its record.
+// A failed agent call is kept in LastError and leaves the record in place.
+func (s *Scheduler) destroyVolume(record models.ManagedVolumeRecord, force bool, eventType, reason string) error {
+	node, agentErr := s.volumeNode(record)
+	if agentErr == nil {
+		_, agentErr = s.callVolumeOnNode(node, "DeleteVolume", func(ctx context.Context, client agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error) {
+			return client.DeleteVolume(ctx, &agentpb.DeleteVolumeRequest{Volume: volumeRef(record)})
+		})
+	}
+	if agentErr != nil && !force {
+		record.LastError = agentErr.Error()
+		if err := s.saveManagedVolumeRecord(record); err != nil {
+			volumeLogger.WithError(err).WithField("volume_id", record.ID).Warn("failed to record volume delete error")
+		}
+		return fmt.Errorf("delete volume %s: %w", record.ID, agentErr)
+	}
+	if agentErr != nil {
+		volumeLogger.WithError(agentErr).WithField("volume_id", record.ID).Warn("agent could not delete volume data; dropping the record anyway")
+	}
+	if err := s.deleteManagedVolumeRecord(record.ID); err != nil {
+		return err
+	}
+	s.emitEvent(eventType, "", node.NodeID, reason, map[string]interface{}{"volume_id": record.ID, "forced": agentErr != nil})
+	volumeLogger.WithFields(logrus.Fields{"volume_id": record.ID, "node_id": node.NodeID}).Info("deleted managed volume")
+	return nil
+}
+
+// ResizeVolume grows a volume to sizeGB. Volumes never shrink.
+func (s *Scheduler) ResizeVolume(volumeID string, sizeGB int64) (models.ManagedVolumeRecord, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	record, err := s.GetVolume(volumeID)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	if sizeGB <= record.SizeGB {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("volume %s is %d GB; volumes can only grow", record.ID, record.SizeGB)
+	}
+	node, err := s.volumeNode(record)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	resp, err := s.callVolumeOnNode(node, "ResizeVolume", func(ctx context.Context, client agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error) {
+		return client.ResizeVolume(ctx, &agentpb.ResizeVolumeRequest{Volume: volumeRef(record), SizeGb: sizeGB})
+	})
+	if err != nil {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("resize volume %s: %w", record.ID, err)
+	}
+
+	previousSize := record.SizeGB
+	record.SizeGB = sizeGB
+	if resp.GetSizeGb() > sizeGB {
+		record.SizeGB = resp.GetSizeGb()
+	}
+	record.LastError = ""
+	if err := s.saveManagedVolumeRecord(record); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	s.emitEvent("VolumeResized", "", node.NodeID, "", map[string]interface{}{"volume_id": record.ID, "from_gb": previousSize, "size_gb": record.SizeGB})
+	return s.GetVolume(record.ID)
+}
+
+// SnapshotVolume takes a snapshot of a volume on its node and returns the
+// updated record and the snapshot name.
+func (s *Scheduler) SnapshotVolume(volumeID, snapshotName string) (models.ManagedVolumeRecord, string, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ManagedVolumeRecord{}, "", err
+	}
+	record, err := s.GetVolume(volumeID)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, "", err
+	}
+	snapshotName = strings.TrimSpace(snapshotName)
+	if snapshotName == "" {
+		snapshotName = time.Now().UTC().Format(volumeSnapshotNameTemplate)
+	}
+	if err := validateObjectName("snapshot", snapshotName); err != nil {
+		return models.ManagedVolumeRecord{}, "", err
+	}
+	for _, snap := range record.Snapshots {
+		if snap.Name == snapshotName {
+			return models.ManagedVolumeRecord{}, "", fmt.Errorf("volume %s already has a snapshot %s", record.ID, snapshotName)
+		}
+	}
+	node, err := s.volumeNode(record)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, "", err
+	}
+	resp, err := s.callVolumeOnNode(node, "SnapshotVolume", func(ctx context.Context, client agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error) {
+		return client.SnapshotVolume(ctx, &agentpb.SnapshotVolumeRequest{Volume: volumeRef(record), SnapshotName: snapshotName})
+	})
+	if err != nil {
+		return models.ManagedVolumeRecord{}, "", fmt.Errorf("snapshot volume %s: %w", record.ID, err)
+	}
+
+	size := resp.GetSizeGb()
+	if size <= 0 {
+		size = record.SizeGB
+	}
+	record.Snapshots = append(record.Snapshots, models.ManagedVolumeSnapshot{Name: snapshotName, SizeGB: size, CreatedAt: time.Now().UTC()})
+	if err := s.saveManagedVolumeRecord(record); err != nil {
+		return models.ManagedVolumeRecord{}, "", err
+	}
+	s.emitEvent("VolumeSnapshotted", "", node.NodeID, "", map[string]interface{}{"volume_id": record.ID, "snapshot": snapshotName})
+	record, err = s.GetVolume(record.ID)
+	return record, snapshotName, err
+}
+
+// CloneVolume copies a volume, or one of its snapshots, into a new volume
+// with the same driver on the node of the source.
+func (s *Scheduler) CloneVolume(sourceVolumeID string, opts CloneVolumeOptions) (models.ManagedVolumeRecord, error) {
+	if err := s.requireWritable(); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	source, err := s.GetVolume(sourceVolumeID)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	snapshot := strings.TrimSpace(opts.SourceSnapshot)
+	if snapshot != "" {
+		found := false
+		for _, snap := range source.Snapshots {
+			if snap.Name == snapshot {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return models.ManagedVolumeRecord{}, fmt.Errorf("volume %s has no snapshot %s", source.ID, snapshot)
+		}
+	}
+	target := models.ManagedVolumeSpec{
+		Name:         strings.TrimSpace(opts.Name),
+		Driver:       source.Driver,
+		SizeGB:       opts.SizeGB,
+		AccessMode:   source.AccessMode,
+		FSType:       source.FSType,
+		RetainPolicy: opts.RetainPolicy,
+	}
+	if err := validateObjectName("volume", target.Name); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	if target.SizeGB == 0 {
+		target.SizeGB = source.SizeGB
+	}
+	if target.SizeGB < source.SizeGB {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("clone size %d GB is smaller than volume %s (%d GB)", target.SizeGB, source.ID, source.SizeGB)
+	}
+	if strings.TrimSpace(target.RetainPolicy) == "" {
+		target.RetainPolicy = defaultVolumeRetainPolicy
+	}
+	target.RetainPolicy = normalizeRetainPolicy(target.RetainPolicy)
+	volumeID := managedVolumeID(target)
+	if _, exists, err := s.getManagedVolumeRecord(volumeID); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	} else if exists {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("managed volume %s already exists", volumeID)
+	}
+
+	node, err := s.volumeNode(source)
+	if err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	resp, err := s.callVolumeOnNode(node, "CloneVolume", func(ctx context.Context, client agentpb.AgentServiceClient) (*agentpb.VolumeOperationResponse, error) {
+		return client.CloneVolume(ctx, &agentpb.CloneVolumeRequest{
+			Source:         volumeRef(source),
+			SourceSnapshot: snapshot,
+			Target:         toAgentManagedVolumes([]models.ManagedVolumeSpec{target})[0],
+		})
+	})
+	if err != nil {
+		return models.ManagedVolumeRecord{}, fmt.Errorf("clone volume %s: %w", source.ID, err)
+	}
+
+	record := models.ManagedVolumeRecord{
+		ID:             volumeID,
+		Name:           target.Name,
+		Driver:         target.Driver,
+		SizeGB:         target.SizeGB,
+		AccessMode:     target.AccessMode,
+		FSType:         target.FSType,
+		RetainPolicy:   target.RetainPolicy,
+		Phase:          "Provisioned",
+		SourceVolumeID: source.ID,
+		SourceSnapshot: snapshot,
+	}
+	if resp.GetSizeGb() > record.SizeGB {
+		record.SizeGB = resp.GetSizeGb()
+	}
+	if record.Driver == "local" {
+		record.NodeID = node.NodeID
+	}
+	if err := s.saveManagedVolumeRecord(record); err != nil {
+		return models.ManagedVolumeRecord{}, err
+	}
+	s.emitEvent("VolumeCloned", "", node.NodeID, "", map[string]interface{}{"volume_id": volumeID, "source_volume_id": source.ID, "source_snapshot": snapshot})
+	volumeLogger.WithFields(logrus.Fields{"volume_id": volumeID, "source_volume_id": source.ID, "node_id": node.NodeID}).Info("cloned managed volume")
+	return s.GetVolume(volumeID)
+}
+
+func hasVolumeReference(specs []models.ManagedVolumeSpec) bool {
+	for _, spec := range specs {
+		if strings.TrimSpace(spec.VolumeID) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// bindManagedVolumes fills managed volumes referenced by ID from their
+// records, so the agent mounts the existing volume. A volume used by another
+// workload is only shared when its access mode allows it. The spec slices are
+// copied, as they may be shared with a template.
+func (s *Scheduler) bindManagedVolumes(workloadID string, workload *models.Workload) error {
+	bind := func(specs []models.ManagedVolumeSpec) ([]models.ManagedVolumeSpec, error) {
+		out := append([]models.ManagedVolumeSpec(nil), specs...)
+		for i := range out {
+			spec := &out[i]
+			volumeID := strings.TrimSpace(spec.VolumeID)
+			if volumeID == "" {
+				continue
+			}
+			record, err := s.GetVolume(volumeID)
+			if err != nil {
+				return nil, err
+			}
+			if name := strings.TrimSpace(spec.Name); name != "" && name != record.Name {
+				return nil, fmt.Errorf("managed volume %s is named %s, not %s", volumeID, record.Name, name)
+			}
+			if record.Phase == "Deleting" || record.Phase == "Deleted" {
+				return nil, fmt.Errorf("managed volume %s is being deleted", volumeID)
+			}
+			if !sharedAccessMode(record.AccessMode) {
+				for _, ref := range record.WorkloadRefs {
+					if ref != workloadID {
+						return nil, fmt.Errorf("%w: %s is used by workload %s", ErrVolumeInUse, volumeID, ref)
+					}
+				}
+			}
+			spec.VolumeID = volumeID
+			spec.Name = record.Name
+			spec.Driver = record.Driver
+			spec.SizeGB = record.SizeGB
+			spec.AccessMode = record.AccessMode
+			spec.FSType = record.FSType
+			if strings.TrimSpace(spec.RetainPolicy) == "" {
+				spec.RetainPolicy = record.RetainPolicy
+			}
+		}
+		return out, nil
+	}
+
+	if hasVolumeReference(workload.ManagedVolumes) {
+		specs, err := bind(workload.ManagedVolumes)
+		if err != nil {
+			return err
+		}
+		workload.ManagedVolumes = specs
+	}
+	if workload.VM != nil && hasVolumeReference(workload.VM.ManagedVolumes) {
+		specs, err := bind(workload.VM.ManagedVolumes)
+		if err != nil {
+			return err
+		}
+		vm := *workload.VM
+		vm.ManagedVolumes = specs
+		workload.VM = &vm
+	}
+	return nil
+}
+
+// localVolumeNodes maps the local volumes a workload attaches by ID to the
+// nodes holding their data.
+func (s *Scheduler) localVolumeNodes(workload models.Workload) map[string]string {
+	var out map[string]string
+	for _, spec := range workloadManagedVolumeSpecs(workload) {
+		volumeID := strings.TrimSpace(spec.VolumeID)
+		if volumeID == "" {
+			continue
+		}
+		record, exists, err := s.getManagedVolumeRecord(volumeID)
+		if err != nil {
+			schedulerLogger.WithError(err).WithFields(logrus.Fields{"workload_id": workload.ID, "volume_id": volumeID}).Warn("failed to load managed volume for placement")
+			continue
+		}
+		if !exists || record.Driver != "local" {
+			continue
+		}
+		if nodeID := volumeHomeNode(*record); nodeID != "" {
+			if out == nil {
+				out = map[string]string{}
+			}
+			out[volumeID] = nodeID
+		}
+	}
+	return out
+}
+
+func filterVolumeLocality(pc *placementContext, node models.Node) (bool, string) {
+	volumeIDs := make([]string, 0, len(pc.volumeNodes))
+	for volumeID := range pc.volumeNodes {
+		volumeIDs = append(volumeIDs, volumeID)
+	}
+	sort.Strings(volumeIDs)
+	for _, volumeID := range volumeIDs {
+		if nodeID := pc.volumeNodes[volumeID]; nodeID != node.NodeID {
+			return false, fmt.Sprintf("local_volume_elsewhere volume=%s node=%s", volumeID, nodeID)
+		}
+	}
+	return true, ""
+}
+
+// ReconcileVolumes deletes Released Delete-policy volumes that no workload
+// has used for SCHEDULER_VOLUME_RELEASE_GRACE. Retain-policy volumes stay
+// until DeleteVolume, whatever their phase.
+func (s *Scheduler) ReconcileVolumes() error {
+	grace := s.volumeReleaseGrace()
+	if grace <= 0 {
+		return nil
+	}
+	records, err := s.listManagedVolumeRecords()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, record := range records {
+		if record.Phase != "Released" || len(record.WorkloadRefs) > 0 {
+			continue
+		}
+		if normalizeRetainPolicy(record.RetainPolicy) == "Retain" {
+			// Released by an older release; it keeps its data.
+			record.Phase = "Retained"
+			if err := s.saveManagedVolumeRecord(record); err != nil {
+				volumeLogger.WithError(err).WithField("volume_id", record.ID).Warn("failed to mark released volume retained")
+			}
+			continue
+		}
+		releasedAt := record.ReleasedAt
+		if releasedAt.IsZero() {
+			releasedAt = record.UpdatedAt
+		}
+		if now.Sub(releasedAt) < grace {
+			continue
+		}
+		reason := fmt.Sprintf("released for more than %s", grace)
+		if err := s.destroyVolume(record, false, "VolumeCollected", reason); err != nil {
+			volumeLogger.WithError(err).WithField("volume_id", record.ID).Warn("failed to collect released volume")
+		}
+	}
+	return nil
+}
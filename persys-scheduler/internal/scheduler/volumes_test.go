@@ -0,0 +1,90 @@
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func seedVolumeRecord(t *testing.T, kv *fakeKV, record models.ManagedVolumeRecord) {
+	t.Helper()
+	payload, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("marshal volume: %v", err)
+	}
+	if _, err := kv.Put(context.Background(), managedVolumeKey(record.ID), string(payload)); err != nil {
+		t.Fatalf("put volume: %v", err)
+	}
+}
+
+func TestSyncWorkloadManagedStorageRetainsVolumes(t *testing.T) {
+	tests := []struct {
+		name      string
+		policy    string
+		refs      []string
+		wantPhase string // empty when the record is deleted
+	}{
+		{name: "retain volume left by its last workload", policy: "Retain", refs: []string{"w1"}, wantPhase: "Retained"},
+		{name: "retain volume still used elsewhere", policy: "Retain", refs: []string{"w1", "w2"}, wantPhase: "Released"},
+		{name: "delete volume left by its last workload", policy: "Delete", refs: []string{"w1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newFakeKV()
+			s := newLedgerTestScheduler(kv)
+			seedVolumeRecord(t, kv, models.ManagedVolumeRecord{ID: "data", RetainPolicy: tt.policy, Phase: "Bound", WorkloadRefs: tt.refs})
+
+			// The spec edit dropped the volume; the workload keeps running.
+			if err := s.syncWorkloadManagedStorage(models.Workload{ID: "w1", NodeID: "n1", DesiredState: "Running"}); err != nil {
+				t.Fatalf("syncWorkloadManagedStorage: %v", err)
+			}
+			record, found, err := s.getManagedVolumeRecord("data")
+			if err != nil {
+				t.Fatalf("getManagedVolumeRecord: %v", err)
+			}
+			if tt.wantPhase == "" {
+				if found {
+					t.Fatalf("expected the record to be deleted, got %+v", record)
+				}
+				return
+			}
+			if !found || record.Phase != tt.wantPhase {
+				t.Fatalf("expected phase %s, got %+v", tt.wantPhase, record)
+			}
+		})
+	}
+}
+
+func TestReconcileVolumesKeepsRetainedData(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	longAgo := time.Now().Add(-48 * time.Hour)
+	seedVolumeRecord(t, kv, models.ManagedVolumeRecord{ID: "kept", RetainPolicy: "Retain", Phase: "Released", ReleasedAt: longAgo})
+	seedVolumeRecord(t, kv, models.ManagedVolumeRecord{ID: "retained", RetainPolicy: "Retain", Phase: "Retained", UpdatedAt: longAgo})
+	seedVolumeRecord(t, kv, models.ManagedVolumeRecord{ID: "scratch", RetainPolicy: "Delete", Phase: "Released", ReleasedAt: longAgo})
+
+	if err := s.ReconcileVolumes(); err != nil {
+		t.Fatalf("ReconcileVolumes: %v", err)
+	}
+	for id, phase := range map[string]string{"kept": "Retained", "retained": "Retained"} {
+		record, found, err := s.getManagedVolumeRecord(id)
+		if err != nil || !found {
+			t.Fatalf("expected %s to survive collection: found=%v err=%v", id, found, err)
+		}
+		if record.Phase != phase {
+			t.Fatalf("expected %s to be %s, got %s", id, phase, record.Phase)
+		}
+	}
+	// No node serves the Delete volume here, so collection is attempted and
+	// the failure kept on the record.
+	scratch, found, err := s.getManagedVolumeRecord("scratch")
+	if err != nil || !found {
+		t.Fatalf("expected the failed collection to keep the record: found=%v err=%v", found, err)
+	}
+	if scratch.LastError == "" {
+		t.Fatalf("expected the Delete volume to be collected")
+	}
+}
@@ -2,8 +2,10 @@ package scheduler
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
@@ -18,21 +20,94 @@ const (
 	minAttemptsBeforeBackoff     = 3
 )
 
+// ErrWorkloadExists is wrapped by errors from CreateWorkload when the ID is
+// already taken.
+var ErrWorkloadExists = errors.New("workload already exists")
+
+// ErrInvalidObjectID is wrapped by errors for a workload, replica set, job or
+// cron job ID that does not match objectIDPattern.
+var ErrInvalidObjectID = errors.New("invalid object id")
+
+// objectIDPattern matches the IDs users may pick. It leaves out '.' and '_'
+// so the IDs controllers derive (replicaSetChildID, jobChildID,
+// cronJobRunID, stackMemberID) never collide with one of them.
+var objectIDPattern = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9]{0,126}[A-Za-z0-9])?$`)
+
+func validateObjectID(kind, id string) error {
+	if !objectIDPattern.MatchString(id) {
+		return fmt.Errorf("%w: %s id %q: use letters, digits and '-', at most 128 characters", ErrInvalidObjectID, kind, id)
+	}
+	return nil
+}
+
+// CreateWorkload creates a workload with a user-chosen ID. It fails with
+// ErrWorkloadExists instead of overwriting an existing workload.
 func (s *Scheduler) CreateWorkload(workload models.Workload) (models.Workload, error) {
+	workload.ID = strings.TrimSpace(workload.ID)
+	if workload.ID == "" {
+		workload.ID = uuid.NewString()
+	}
+	if err := validateObjectID("workload", workload.ID); err != nil {
+		return models.Workload{}, err
+	}
+	return s.createWorkload(workload)
+}
+
+// createWorkload creates a workload whose ID is already set, such as one
+// derived by a controller.
+func (s *Scheduler) createWorkload(workload models.Workload) (models.Workload, error) {
 	if err := s.requireWritable(); err != nil {
 		return models.Workload{}, err
 	}
-	if strings.TrimSpace(workload.ID) == "" {
-		workload.ID = uuid.NewString()
+	// Checked before volumes are bound and quota is taken; insertWorkload
+	// settles a concurrent create.
+	if _, found, err := s.workloadNamespaceByID(workload.ID); err != nil {
+		return models.Workload{}, err
+	} else if found {
+		return models.Workload{}, fmt.Errorf("workload %s: %w", workload.ID, ErrWorkloadExists)
+	}
+	if err := s.resolveWorkloadPriority(&workload); err != nil {
+		return models.Workload{}, err
+	}
+	if err := validateWorkloadPorts(workload.Ports); err != nil {
+		return models.Workload{}, err
 	}
+	if err := s.bindManagedVolumes(workload.ID, &workload); err != nil {
+		return models.Workload{}, err
+	}
+	if err := s.admitToNamespace(&workload, nil); err != nil {
+		return models.Workload{}, err
+	}
+	// The quota charge is returned if the workload is not stored after all.
+	// Charges are keyed by ID, so one lost to a concurrent create of the same
+	// ID belongs to the winner.
+	abort := func(err error) (models.Workload, error) {
+		if errors.Is(err, ErrWorkloadExists) {
+			return models.Workload{}, err
+		}
+		if relErr := s.releaseQuota(workload.Namespace, workload.ID); relErr != nil {
+			namespaceLogger.WithError(relErr).WithField("workload_id", workload.ID).Warn("failed to release quota charge")
+		}
+		return models.Workload{}, err
+	}
+	refsRevision, err := s.workloadRefsRevision(workload)
+	if err != nil {
+		return abort(err)
+	}
+	workload.RefsRevision = refsRevision
 	s.ensureWorkloadRevision(&workload)
 	s.initializeWorkloadDefaults(&workload)
+	revision, err := s.recordWorkloadRevision(workload, changeCause(workload.Metadata, "Created"))
+	if err != nil {
+		return abort(err)
+	}
+	startRollout(&workload, revision, "", "")
 	workload.Status = "Pending"
 	workload.StatusInfo.ActualState = "Pending"
 	workload.Metadata["last_action"] = "Created"
 
-	if err := s.saveWorkload(workload); err != nil {
-		return models.Workload{}, err
+	if err := s.insertWorkload(workload); err != nil {
+		return abort(err)
 	}
 	s.emitEvent("WorkloadScheduled", workload.ID, "", "Workload created", map[string]interface{}{"desired_state": workload.DesiredState})
 	return workload, nil
@@ -49,8 +124,19 @@ func (s *Scheduler) UpdateWorkloadSpec(workloadID string, update models.Workload
 	if current.Metadata == nil {
 		current.Metadata = map[string]interface{}{}
 	}
+	previous := current
 	specChanged := false
 	desiredChanged := false
+	priorityChanged := false
+	strategyChanged := false
+	portsChanged := false
+
+	if strings.TrimSpace(update.Namespace) != "" && normalizeNamespace(update.Namespace) != WorkloadNamespace(current) {
+		return models.Workload{}, fmt.Errorf("workload %s belongs to namespace %s; namespaces cannot be changed", current.ID, WorkloadNamespace(current))
+	}
+	if err := s.bindManagedVolumes(current.ID, &update); err != nil {
+		return models.Workload{}, err
+	}
 
 	if strings.TrimSpace(update.Name) != "" {
 		if current.Name != update.Name {
@@ -120,8 +206,12 @@ func (s *Scheduler) UpdateWorkloadSpec(workloadID string, update models.Workload
 		current.Labels = update.Labels
 	}
 	if len(update.Ports) > 0 {
+		if err := validateWorkloadPorts(update.Ports); err != nil {
+			return models.Workload{}, err
+		}
 		if !reflect.DeepEqual(current.Ports, update.Ports) {
 			specChanged = true
+			portsChanged = true
 		}
 		current.Ports = update.Ports
 	}
@@ -143,19 +233,109 @@ func (s *Scheduler) UpdateWorkloadSpec(workloadID string, update models.Workload
 		}
 		current.VM = update.VM
 	}
+	if len(update.EnvFrom) > 0 {
+		if !reflect.DeepEqual(current.EnvFrom, update.EnvFrom) {
+			specChanged = true
+		}
+		current.EnvFrom = update.EnvFrom
+	}
+	if len(update.Files) > 0 {
+		if !reflect.DeepEqual(current.Files, update.Files) {
+			specChanged = true
+		}
+		current.Files = update.Files
+	}
+	if update.Placement != nil {
+		if !reflect.DeepEqual(current.Placement, update.Placement) {
+			specChanged = true
+		}
+		current.Placement = update.Placement
+	}
+	if update.Tolerations != nil {
+		if !reflect.DeepEqual(current.Tolerations, update.Tolerations) {
+			specChanged = true
+		}
+		current.Tolerations = update.Tolerations
+	}
+	if update.LivenessProbe != nil {
+		if !reflect.DeepEqual(current.LivenessProbe, update.LivenessProbe) {
+			specChanged = true
+		}
+		current.LivenessProbe = update.LivenessProbe
+	}
+	if update.ReadinessProbe != nil {
+		if !reflect.DeepEqual(current.ReadinessProbe, update.ReadinessProbe) {
+			specChanged = true
+		}
+		current.ReadinessProbe = update.ReadinessProbe
+	}
+
+	if strings.TrimSpace(update.PriorityClass) != "" || update.Priority != 0 {
+		next := current
+		next.PriorityClass = update.PriorityClass
+		next.Priority = update.Priority
+		if err := s.resolveWorkloadPriority(&next); err != nil {
+			return models.Workload{}, err
+		}
+		// Priority only matters to the scheduler, so it does not bump the revision.
+		if next.Priority != current.Priority || next.PriorityClass != current.PriorityClass {
+			priorityChanged = true
+		}
+		current.Priority = next.Priority
+		current.PriorityClass = next.PriorityClass
+	}
+	if update.Rollout != nil {
+		// Like priority, the strategy only shapes how revisions roll out.
+		if !reflect.DeepEqual(current.Rollout, update.Rollout) {
+			strategyChanged = true
+		}
+		current.Rollout = update.Rollout
+	}
+
+	if !strings.EqualFold(current.DesiredState, "Deleted") {
+		// A new version of a referenced secret or config is a spec change.
+		refsRevision, err := s.workloadRefsRevision(current)
+		if err != nil {
+			return models.Workload{}, err
+		}
+		if refsRevision != current.RefsRevision {
+			current.RefsRevision = refsRevision
+			specChanged = true
+		}
+	}
+	if specChanged && !strings.EqualFold(current.DesiredState, "Deleted") {
+		if err := s.admitToNamespace(&current, &previous); err != nil {
+			return models.Workload{}, err
+		}
+	}
+	if portsChanged && !strings.EqualFold(current.DesiredState, "Deleted") {
+		if err := s.reserveHostPorts(&current); err != nil {
+			return models.Workload{}, err
+		}
+	}
 
 	now := time.Now().UTC()
 	current.StatusInfo.LastUpdated = now
 	switch {
 	case specChanged:
+		previousRevision := current.RevisionID
 		current.RevisionID = ""
 		s.ensureWorkloadRevision(&current)
+		revision, err := s.recordWorkloadRevision(current, changeCause(update.Metadata, "Updated"))
+		if err != nil {
+			return models.Workload{}, err
+		}
+		startRollout(&current, revision, previousRevision, "")
 		current.Status = "Updating"
 		current.StatusInfo.ActualState = "Pending"
 		current.Metadata["last_action"] = "Updated"
 	case desiredChanged:
 		clearReapplyMetadata(&current)
 		current.Metadata["last_action"] = "DesiredStateUpdated"
+	case priorityChanged:
+		current.Metadata["last_action"] = "PriorityUpdated"
+	case strategyChanged:
+		current.Metadata["last_action"] = "RolloutStrategyUpdated"
 	default:
 		current.Metadata["last_action"] = "NoopUpdate"
 	}
@@ -163,6 +343,9 @@ func (s *Scheduler) UpdateWorkloadSpec(workloadID string, update models.Workload
 	if err := s.saveWorkload(current); err != nil {
 		return models.Workload{}, err
 	}
+	if (specChanged || priorityChanged) && isMetadataTrue(current.Metadata, workloadQueuedMetadataKey) {
+		s.activatePendingQueue("queued workload updated")
+	}
 	switch {
 	case specChanged:
 		s.emitEvent("WorkloadScheduled", current.ID, current.NodeID, "Workload updated", map[string]interface{}{"revision_id": current.RevisionID})
@@ -382,6 +565,7 @@ func (s *Scheduler) DumpWorkloadRecord(workloadID string) (map[string]interface{
 	if err := json.Unmarshal(raw, &result); err != nil {
 		return nil, err
 	}
+	redactWorkloadRecord(result)
 	return result, nil
 }
 
@@ -407,7 +591,14 @@ func (s *Scheduler) EnsureWorkloadAssigned(workload *models.Workload) error {
 	if workload.NodeID != "" {
 		return nil
 	}
-	node, reason, err := s.selectNodeForWorkload(*workload)
+	node, reason, err := s.selectNodeOrPreempt(*workload)
+	var unschedulable *unschedulableError
+	if errors.As(err, &unschedulable) {
+		if qErr := s.enqueuePending(workload, unschedulable); qErr != nil {
+			return qErr
+		}
+		return fmt.Errorf("%w: %v", errWorkloadQueued, err)
+	}
 	if err != nil {
 		return err
 	}
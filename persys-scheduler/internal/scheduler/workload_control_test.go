@@ -0,0 +1,108 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+)
+
+func TestDerivedIDsAreNotObjectIDs(t *testing.T) {
+	for _, id := range []string{"web", "web-0", "Web-1", "3f0c2a7e-9b1d-4c55-8e2f-0a6b1c9d7e44"} {
+		if err := validateObjectID("workload", id); err != nil {
+			t.Fatalf("expected %q to be accepted, got %v", id, err)
+		}
+	}
+	derived := []string{
+		replicaSetChildID("web", 0),
+		jobChildID("web", 0),
+		cronJobRunID("web", time.Unix(1700000000, 0)),
+		stackMemberID("default", "shop", "web"),
+		"",
+		"-web",
+		"web/0",
+	}
+	for _, id := range derived {
+		if err := validateObjectID("workload", id); !errors.Is(err, ErrInvalidObjectID) {
+			t.Fatalf("expected %q to be rejected, got %v", id, err)
+		}
+	}
+	if jobChildID(cronJobRunID("web", time.Unix(1700000000, 0)), 0) == jobChildID("web", 1700000000) {
+		t.Fatalf("cron run children collide with job children")
+	}
+	if jobChildID("web", 0) == replicaSetChildID("web", 0) {
+		t.Fatalf("job runs collide with replica set children")
+	}
+}
+
+func TestCreateWorkloadRejectsExistingID(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+
+	w := models.Workload{ID: "web", Type: "container", Image: "nginx", DesiredState: "Running"}
+	if _, err := s.CreateWorkload(w); err != nil {
+		t.Fatalf("CreateWorkload: %v", err)
+	}
+	w.Image = "redis"
+	if _, err := s.CreateWorkload(w); !errors.Is(err, ErrWorkloadExists) {
+		t.Fatalf("expected ErrWorkloadExists, got %v", err)
+	}
+	stored, err := s.GetWorkloadByID("web")
+	if err != nil {
+		t.Fatalf("GetWorkloadByID: %v", err)
+	}
+	if stored.Image != "nginx" {
+		t.Fatalf("expected the first workload to be kept, got image %q", stored.Image)
+	}
+
+	if _, err := s.CreateWorkload(models.Workload{ID: jobChildID("batch", 0), Type: "container", Image: "busybox"}); !errors.Is(err, ErrInvalidObjectID) {
+		t.Fatalf("expected a derived ID to be rejected, got %v", err)
+	}
+}
+
+func TestInsertWorkloadLosesToConcurrentCreate(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	if err := s.insertWorkload(models.Workload{ID: "web", Type: "container", Image: "nginx"}); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+	if err := s.insertWorkload(models.Workload{ID: "web", Type: "container", Image: "redis"}); !errors.Is(err, ErrWorkloadExists) {
+		t.Fatalf("expected ErrWorkloadExists, got %v", err)
+	}
+}
+
+func TestCreateReplicaNeverOverwrites(t *testing.T) {
+	kv := newFakeKV()
+	s := newLedgerTestScheduler(kv)
+	rs := models.ReplicaSet{ID: "web", Replicas: 2, Template: models.Workload{Type: "container", Image: "nginx"}}
+
+	// A child from before IDs used '.' still holds ordinal 0.
+	legacy := replicaSetChild(rs, 0)
+	legacy.ID = "web-0"
+	if err := s.insertWorkload(legacy); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+	taken := map[int]struct{}{0: {}}
+	child, err := s.createReplica(rs, taken)
+	if err != nil {
+		t.Fatalf("createReplica: %v", err)
+	}
+	if child.ID != replicaSetChildID("web", 1) {
+		t.Fatalf("expected the next free ordinal, got %s", child.ID)
+	}
+
+	if err := s.insertWorkload(models.Workload{ID: replicaSetChildID("web", 2), Type: "container", Image: "redis"}); err != nil {
+		t.Fatalf("insertWorkload: %v", err)
+	}
+	if _, err := s.createReplica(rs, taken); !errors.Is(err, ErrWorkloadExists) {
+		t.Fatalf("expected ErrWorkloadExists, got %v", err)
+	}
+	stored, err := s.GetWorkloadByID(replicaSetChildID("web", 2))
+	if err != nil {
+		t.Fatalf("GetWorkloadByID: %v", err)
+	}
+	if stored.Image != "redis" {
+		t.Fatalf("expected the existing workload to be kept, got image %q", stored.Image)
+	}
+}
@@ -0,0 +1,148 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/agentpb"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/logging"
+	metricspkg "github.com/persys-dev/persys-cloud/persys-scheduler/internal/metrics"
+	"github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrWorkloadNotPlaced is returned by StreamWorkloadLogs and ExecWorkload for
+// workloads that have no node to talk to.
+var ErrWorkloadNotPlaced = errors.New("workload is not placed on a node")
+
+// ErrInvalidExec is wrapped by ExecWorkload errors caused by the request
+// rather than by the workload or its node.
+var ErrInvalidExec = errors.New("invalid exec request")
+
+var workloadIOLogger = logging.C("scheduler.workload_io")
+
+// workloadTarget returns the workload and the node it runs on.
+func (s *Scheduler) workloadTarget(workloadID string) (models.Workload, models.Node, error) {
+	workload, err := s.GetWorkloadByID(workloadID)
+	if err != nil {
+		return models.Workload{}, models.Node{}, err
+	}
+	if strings.EqualFold(workload.DesiredState, "Deleted") || strings.TrimSpace(workload.NodeID) == "" {
+		return workload, models.Node{}, fmt.Errorf("%w: %s", ErrWorkloadNotPlaced, workloadID)
+	}
+	node, err := s.GetNodeByID(workload.NodeID)
+	if err != nil {
+		return workload, models.Node{}, fmt.Errorf("node %s of workload %s: %w", workload.NodeID, workloadID, err)
+	}
+	return workload, node, nil
+}
+
+// StreamWorkloadLogs streams the output of a workload from its agent and
+// passes every chunk to send. It returns when the agent ends the stream,
+// send fails or ctx is cancelled. Agents are dialed directly; the control
+// stream is not used for bulk output.
+func (s *Scheduler) StreamWorkloadLogs(ctx context.Context, workloadID string, req *agentpb.StreamWorkloadLogsRequest, send func(*agentpb.WorkloadLogChunk) error) (err error) {
+	_, node, err := s.workloadTarget(workloadID)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	defer func() {
+		metricspkg.ObserveAgentRPC("StreamWorkloadLogs", err, time.Since(start))
+	}()
+
+	client, conn, err := s.newAgentClient(node)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	req.Id = workloadID
+	stream, err := client.StreamWorkloadLogs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if err := send(chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// ExecSession is an exec stream opened on the agent running a workload.
+// Close releases the agent connection.
+type ExecSession struct {
+	agentpb.AgentService_ExecWorkloadClient
+	NodeID string
+	close  func() error
+}
+
+func (e *ExecSession) Close() error { return e.close() }
+
+// ExecWorkload opens an exec session for start.Command in a workload and
+// sends start to the agent. requestedBy is recorded in the WorkloadExec
+// event; the command arguments are not, as they may carry credentials.
+func (s *Scheduler) ExecWorkload(ctx context.Context, workloadID string, start *agentpb.ExecStart, requestedBy string) (*ExecSession, error) {
+	if len(start.GetCommand()) == 0 || strings.TrimSpace(start.GetCommand()[0]) == "" {
+		return nil, fmt.Errorf("%w: command is required", ErrInvalidExec)
+	}
+	workload, node, err := s.workloadTarget(workloadID)
+	if err != nil {
+		return nil, err
+	}
+	switch canonicalWorkloadType(workload.Type) {
+	case "vm":
+		return nil, fmt.Errorf("%w: exec is not supported for vm workloads", ErrInvalidExec)
+	case "compose":
+		if strings.TrimSpace(start.GetContainer()) == "" {
+			return nil, fmt.Errorf("%w: container is required to exec into a compose workload", ErrInvalidExec)
+		}
+	}
+
+	// Only opening the session is measured; its length is up to the user.
+	opened := time.Now()
+	client, conn, err := s.newAgentClient(node)
+	if err != nil {
+		metricspkg.ObserveAgentRPC("ExecWorkload", err, time.Since(opened))
+		return nil, err
+	}
+	stream, err := client.ExecWorkload(ctx)
+	if err == nil {
+		start.Id = workloadID
+		err = stream.Send(&agentpb.ExecWorkloadRequest{Payload: &agentpb.ExecWorkloadRequest_Start{Start: start}})
+	}
+	metricspkg.ObserveAgentRPC("ExecWorkload", err, time.Since(opened))
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	workloadIOLogger.WithFields(logrus.Fields{
+		"workload_id":  workloadID,
+		"node_id":      node.NodeID,
+		"command":      start.GetCommand()[0],
+		"tty":          start.GetTty(),
+		"requested_by": requestedBy,
+	}).Info("exec session started")
+	s.emitEvent("WorkloadExec", workloadID, node.NodeID, "Exec session started", map[string]interface{}{
+		"command":      start.GetCommand()[0],
+		"container":    start.GetContainer(),
+		"tty":          start.GetTty(),
+		"requested_by": requestedBy,
+	})
+	return &ExecSession{AgentService_ExecWorkloadClient: stream, NodeID: node.NodeID, close: conn.Close}, nil
+}
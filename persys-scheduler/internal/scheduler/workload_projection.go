@@ -3,81 +3,134 @@ package scheduler
 import "github.com/persys-dev/persys-cloud/persys-scheduler/internal/models"
 
 type workloadSpec struct {
-	ID            string            `json:"id,omitempty"`
-	Name          string            `json:"name,omitempty"`
-	Type          string            `json:"type,omitempty"`
-	RevisionID    string            `json:"revisionId,omitempty"`
-	Image         string            `json:"image,omitempty"`
-	Command       string            `json:"command,omitempty"`
-	CommandList   []string          `json:"commandList,omitempty"`
-	Compose       string            `json:"compose,omitempty"`
-	ComposeYAML   string            `json:"composeYaml,omitempty"`
-	ProjectName   string            `json:"projectName,omitempty"`
-	GitRepo       string            `json:"gitRepo,omitempty"`
-	GitBranch     string            `json:"gitBranch,omitempty"`
-	GitToken      string            `json:"gitToken,omitempty"`
-	EnvVars       map[string]string `json:"envVars,omitempty"`
-	Resources     models.Resources  `json:"resources"`
-	DesiredState  string            `json:"desiredState,omitempty"`
-	Labels        map[string]string `json:"labels,omitempty"`
-	CreatedAt     interface{}       `json:"createdAt,omitempty"`
-	LocalPath     string            `json:"localPath,omitempty"`
-	Ports         []string          `json:"ports,omitempty"`
-	Volumes       []string          `json:"volumes,omitempty"`
-	Network       string            `json:"network,omitempty"`
-	RestartPolicy string            `json:"restartPolicy,omitempty"`
-	VM            *models.VMSpec    `json:"vm,omitempty"`
+	ID             string                  `json:"id,omitempty"`
+	Namespace      string                  `json:"namespace,omitempty"`
+	Name           string                  `json:"name,omitempty"`
+	Type           string                  `json:"type,omitempty"`
+	RevisionID     string                  `json:"revisionId,omitempty"`
+	Image          string                  `json:"image,omitempty"`
+	Command        string                  `json:"command,omitempty"`
+	CommandList    []string                `json:"commandList,omitempty"`
+	Compose        string                  `json:"compose,omitempty"`
+	ComposeYAML    string                  `json:"composeYaml,omitempty"`
+	ProjectName    string                  `json:"projectName,omitempty"`
+	GitRepo        string                  `json:"gitRepo,omitempty"`
+	GitBranch      string                  `json:"gitBranch,omitempty"`
+	GitTokenRef    *models.SecretKeyRef    `json:"gitTokenRef,omitempty"`
+	EnvVars        map[string]string       `json:"envVars,omitempty"`
+	Resources      models.Resources        `json:"resources"`
+	DesiredState   string                  `json:"desiredState,omitempty"`
+	Labels         map[string]string       `json:"labels,omitempty"`
+	CreatedAt      interface{}             `json:"createdAt,omitempty"`
+	LocalPath      string                  `json:"localPath,omitempty"`
+	Ports          []string                `json:"ports,omitempty"`
+	Volumes        []string                `json:"volumes,omitempty"`
+	Network        string                  `json:"network,omitempty"`
+	RestartPolicy  string                  `json:"restartPolicy,omitempty"`
+	VM             *models.VMSpec          `json:"vm,omitempty"`
+	Placement      *models.PlacementPolicy `json:"placement,omitempty"`
+	Tolerations    []models.Toleration     `json:"tolerations,omitempty"`
+	Priority       int                     `json:"priority,omitempty"`
+	PriorityClass  string                  `json:"priorityClass,omitempty"`
+	Rollout        *models.RolloutStrategy `json:"rollout,omitempty"`
+	LivenessProbe  *models.Probe           `json:"livenessProbe,omitempty"`
+	ReadinessProbe *models.Probe           `json:"readinessProbe,omitempty"`
+	EnvFrom        []models.EnvFromSource  `json:"envFrom,omitempty"`
+	Files          []models.FileMount      `json:"files,omitempty"`
+	RefsRevision   string                  `json:"refsRevision,omitempty"`
 }
 
 type workloadStatus struct {
-	ID           string                    `json:"id,omitempty"`
-	AssignedNode string                    `json:"assignedNode,omitempty"`
-	NodeID       string                    `json:"nodeId,omitempty"`
-	Status       string                    `json:"status,omitempty"`
-	Logs         string                    `json:"logs,omitempty"`
-	Metadata     map[string]interface{}    `json:"metadata,omitempty"`
-	Retry        models.RetryState         `json:"retry"`
-	StatusInfo   models.WorkloadStatusInfo `json:"statusInfo"`
+	ID            string                    `json:"id,omitempty"`
+	AssignedNode  string                    `json:"assignedNode,omitempty"`
+	NominatedNode string                    `json:"nominatedNode,omitempty"`
+	NodeID        string                    `json:"nodeId,omitempty"`
+	Status        string                    `json:"status,omitempty"`
+	Logs          string                    `json:"logs,omitempty"`
+	Metadata      map[string]interface{}    `json:"metadata,omitempty"`
+	Retry         models.RetryState         `json:"retry"`
+	StatusInfo    models.WorkloadStatusInfo `json:"statusInfo"`
+	HostPorts     []string                  `json:"hostPorts,omitempty"`
 }
 
 func workloadSpecFromWorkload(w models.Workload) workloadSpec {
 	return workloadSpec{
-		ID:            w.ID,
-		Name:          w.Name,
-		Type:          w.Type,
-		RevisionID:    w.RevisionID,
-		Image:         w.Image,
-		Command:       w.Command,
-		CommandList:   w.CommandList,
-		Compose:       w.Compose,
-		ComposeYAML:   w.ComposeYAML,
-		ProjectName:   w.ProjectName,
-		GitRepo:       w.GitRepo,
-		GitBranch:     w.GitBranch,
-		GitToken:      w.GitToken,
-		EnvVars:       w.EnvVars,
-		Resources:     w.Resources,
-		DesiredState:  w.DesiredState,
-		Labels:        w.Labels,
-		CreatedAt:     w.CreatedAt,
-		LocalPath:     w.LocalPath,
-		Ports:         w.Ports,
-		Volumes:       w.Volumes,
-		Network:       w.Network,
-		RestartPolicy: w.RestartPolicy,
-		VM:            w.VM,
+		ID:             w.ID,
+		Namespace:      w.Namespace,
+		Name:           w.Name,
+		Type:           w.Type,
+		RevisionID:     w.RevisionID,
+		Image:          w.Image,
+		Command:        w.Command,
+		CommandList:    w.CommandList,
+		Compose:        w.Compose,
+		ComposeYAML:    w.ComposeYAML,
+		ProjectName:    w.ProjectName,
+		GitRepo:        w.GitRepo,
+		GitBranch:      w.GitBranch,
+		GitTokenRef:    w.GitTokenRef,
+		EnvVars:        w.EnvVars,
+		Resources:      w.Resources,
+		DesiredState:   w.DesiredState,
+		Labels:         w.Labels,
+		CreatedAt:      w.CreatedAt,
+		LocalPath:      w.LocalPath,
+		Ports:          w.Ports,
+		Volumes:        w.Volumes,
+		Network:        w.Network,
+		RestartPolicy:  w.RestartPolicy,
+		VM:             w.VM,
+		Placement:      w.Placement,
+		Tolerations:    w.Tolerations,
+		Priority:       w.Priority,
+		PriorityClass:  w.PriorityClass,
+		Rollout:        w.Rollout,
+		LivenessProbe:  w.LivenessProbe,
+		ReadinessProbe: w.ReadinessProbe,
+		EnvFrom:        w.EnvFrom,
+		Files:          w.Files,
+		RefsRevision:   w.RefsRevision,
 	}
 }
 
 func workloadStatusFromWorkload(w models.Workload) workloadStatus {
 	return workloadStatus{
-		ID:           w.ID,
-		AssignedNode: w.AssignedNode,
-		NodeID:       w.NodeID,
-		Status:       w.Status,
-		Logs:         w.Logs,
-		Metadata:     w.Metadata,
-		Retry:        w.Retry,
-		StatusInfo:   w.StatusInfo,
+		ID:            w.ID,
+		AssignedNode:  w.AssignedNode,
+		NominatedNode: w.NominatedNode,
+		NodeID:        w.NodeID,
+		Status:        w.Status,
+		Logs:          w.Logs,
+		Metadata:      w.Metadata,
+		Retry:         w.Retry,
+		StatusInfo:    w.StatusInfo,
+		HostPorts:     w.HostPorts,
+	}
+}
+
+// workloadFromProjections merges a stored spec with its status, when there is
+// one, back into a workload.
+func workloadFromProjections(spec workloadSpec, st *workloadStatus) models.Workload {
+	workload := models.Workload{
+		ID: spec.ID, Namespace: spec.Namespace, Name: spec.Name, Type: spec.Type, RevisionID: spec.RevisionID, Image: spec.Image, Command: spec.Command,
+		CommandList: spec.CommandList, Compose: spec.Compose, ComposeYAML: spec.ComposeYAML, ProjectName: spec.ProjectName,
+		GitRepo: spec.GitRepo, GitBranch: spec.GitBranch, GitTokenRef: spec.GitTokenRef, EnvVars: spec.EnvVars, Resources: spec.Resources,
+		DesiredState: spec.DesiredState, Labels: spec.Labels, LocalPath: spec.LocalPath, Ports: spec.Ports, Volumes: spec.Volumes,
+		Network: spec.Network, RestartPolicy: spec.RestartPolicy, VM: spec.VM, Placement: spec.Placement,
+		Tolerations: spec.Tolerations, Priority: spec.Priority, PriorityClass: spec.PriorityClass, Rollout: spec.Rollout,
+		LivenessProbe: spec.LivenessProbe, ReadinessProbe: spec.ReadinessProbe,
+		EnvFrom: spec.EnvFrom, Files: spec.Files, RefsRevision: spec.RefsRevision,
+	}
+	if st != nil {
+		workload.AssignedNode = st.AssignedNode
+		workload.NominatedNode = st.NominatedNode
+		workload.NodeID = st.NodeID
+		workload.Status = st.Status
+		workload.Logs = st.Logs
+		workload.Metadata = st.Metadata
+		workload.Retry = st.Retry
+		workload.StatusInfo = st.StatusInfo
+		workload.HostPorts = st.HostPorts
 	}
+	return workload
 }
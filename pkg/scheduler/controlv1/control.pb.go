@@ -89,6 +89,7 @@ const (
 	FailureReason_NETWORK_ERROR              FailureReason = 6
 	FailureReason_STORAGE_ERROR              FailureReason = 7
 	FailureReason_VM_BOOT_FAILED             FailureReason = 8
+	FailureReason_QUOTA_EXCEEDED             FailureReason = 9
 )
 
 // Enum value maps for FailureReason.
@@ -103,6 +104,7 @@ var (
 		6: "NETWORK_ERROR",
 		7: "STORAGE_ERROR",
 		8: "VM_BOOT_FAILED",
+		9: "QUOTA_EXCEEDED",
 	}
 	FailureReason_value = map[string]int32{
 		"FAILURE_REASON_UNSPECIFIED": 0,
@@ -114,6 +116,7 @@ var (
 		"NETWORK_ERROR":              6,
 		"STORAGE_ERROR":              7,
 		"VM_BOOT_FAILED":             8,
+		"QUOTA_EXCEEDED":             9,
 	}
 )
 
@@ -144,6 +147,55 @@ func (FailureReason) EnumDescriptor() ([]byte, []int) {
 	return file_control_proto_rawDescGZIP(), []int{1}
 }
 
+type LogStream int32
+
+const (
+	LogStream_LOG_STREAM_UNSPECIFIED LogStream = 0
+	LogStream_LOG_STREAM_STDOUT      LogStream = 1
+	LogStream_LOG_STREAM_STDERR      LogStream = 2
+)
+
+// Enum value maps for LogStream.
+var (
+	LogStream_name = map[int32]string{
+		0: "LOG_STREAM_UNSPECIFIED",
+		1: "LOG_STREAM_STDOUT",
+		2: "LOG_STREAM_STDERR",
+	}
+	LogStream_value = map[string]int32{
+		"LOG_STREAM_UNSPECIFIED": 0,
+		"LOG_STREAM_STDOUT":      1,
+		"LOG_STREAM_STDERR":      2,
+	}
+)
+
+func (x LogStream) Enum() *LogStream {
+	p := new(LogStream)
+	*p = x
+	return p
+}
+
+func (x LogStream) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogStream) Descriptor() protoreflect.EnumDescriptor {
+	return file_control_proto_enumTypes[2].Descriptor()
+}
+
+func (LogStream) Type() protoreflect.EnumType {
+	return &file_control_proto_enumTypes[2]
+}
+
+func (x LogStream) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogStream.Descriptor instead.
+func (LogStream) EnumDescriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{2}
+}
+
 type AutomationSuggestion struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	SuggestionId    string                 `protobuf:"bytes,1,opt,name=suggestion_id,json=suggestionId,proto3" json:"suggestion_id,omitempty"`
@@ -389,6 +441,7 @@ type RegisterNodeRequest struct {
 	GrpcEndpoint  string                 `protobuf:"bytes,5,opt,name=grpc_endpoint,json=grpcEndpoint,proto3" json:"grpc_endpoint,omitempty"`
 	ClusterId     string                 `protobuf:"bytes,6,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
 	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Taints        []*Taint               `protobuf:"bytes,8,rep,name=taints,proto3" json:"taints,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -472,6 +525,141 @@ func (x *RegisterNodeRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *RegisterNodeRequest) GetTaints() []*Taint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+type Taint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect,omitempty"` // NoSchedule | PreferNoSchedule | NoExecute
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Taint) Reset() {
+	*x = Taint{}
+	mi := &file_control_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Taint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Taint) ProtoMessage() {}
+
+func (x *Taint) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Taint.ProtoReflect.Descriptor instead.
+func (*Taint) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Taint) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Taint) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Taint) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+type Toleration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`           // empty with operator Exists matches every taint
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // Equal (default) | Exists
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"` // empty matches every effect
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	mi := &file_control_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
 type NodeCapabilities struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	CpuTotalMillicores      int64                  `protobuf:"varint,1,opt,name=cpu_total_millicores,json=cpuTotalMillicores,proto3" json:"cpu_total_millicores,omitempty"`
@@ -485,7 +673,7 @@ type NodeCapabilities struct {
 
 func (x *NodeCapabilities) Reset() {
 	*x = NodeCapabilities{}
-	mi := &file_control_proto_msgTypes[4]
+	mi := &file_control_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -497,7 +685,7 @@ func (x *NodeCapabilities) String() string {
 func (*NodeCapabilities) ProtoMessage() {}
 
 func (x *NodeCapabilities) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[4]
+	mi := &file_control_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -510,7 +698,7 @@ func (x *NodeCapabilities) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeCapabilities.ProtoReflect.Descriptor instead.
 func (*NodeCapabilities) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{4}
+	return file_control_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *NodeCapabilities) GetCpuTotalMillicores() int64 {
@@ -559,7 +747,7 @@ type StoragePool struct {
 
 func (x *StoragePool) Reset() {
 	*x = StoragePool{}
-	mi := &file_control_proto_msgTypes[5]
+	mi := &file_control_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -571,7 +759,7 @@ func (x *StoragePool) String() string {
 func (*StoragePool) ProtoMessage() {}
 
 func (x *StoragePool) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[5]
+	mi := &file_control_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +772,7 @@ func (x *StoragePool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StoragePool.ProtoReflect.Descriptor instead.
 func (*StoragePool) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{5}
+	return file_control_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StoragePool) GetName() string {
@@ -620,7 +808,7 @@ type RegisterNodeResponse struct {
 
 func (x *RegisterNodeResponse) Reset() {
 	*x = RegisterNodeResponse{}
-	mi := &file_control_proto_msgTypes[6]
+	mi := &file_control_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -632,7 +820,7 @@ func (x *RegisterNodeResponse) String() string {
 func (*RegisterNodeResponse) ProtoMessage() {}
 
 func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[6]
+	mi := &file_control_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -645,7 +833,7 @@ func (x *RegisterNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeResponse.ProtoReflect.Descriptor instead.
 func (*RegisterNodeResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{6}
+	return file_control_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RegisterNodeResponse) GetAccepted() bool {
@@ -689,7 +877,7 @@ type HeartbeatRequest struct {
 
 func (x *HeartbeatRequest) Reset() {
 	*x = HeartbeatRequest{}
-	mi := &file_control_proto_msgTypes[7]
+	mi := &file_control_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -701,7 +889,7 @@ func (x *HeartbeatRequest) String() string {
 func (*HeartbeatRequest) ProtoMessage() {}
 
 func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[7]
+	mi := &file_control_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -714,7 +902,7 @@ func (x *HeartbeatRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatRequest.ProtoReflect.Descriptor instead.
 func (*HeartbeatRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{7}
+	return file_control_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HeartbeatRequest) GetNodeId() string {
@@ -760,13 +948,14 @@ type NodeUsage struct {
 	MemoryUsedMb           int64                  `protobuf:"varint,4,opt,name=memory_used_mb,json=memoryUsedMb,proto3" json:"memory_used_mb,omitempty"`
 	DiskAllocatedGb        int64                  `protobuf:"varint,5,opt,name=disk_allocated_gb,json=diskAllocatedGb,proto3" json:"disk_allocated_gb,omitempty"`
 	DiskUsedGb             int64                  `protobuf:"varint,6,opt,name=disk_used_gb,json=diskUsedGb,proto3" json:"disk_used_gb,omitempty"`
+	StoragePools           []*StoragePoolUsage    `protobuf:"bytes,7,rep,name=storage_pools,json=storagePools,proto3" json:"storage_pools,omitempty"` // per-pool breakdown of disk usage
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
 func (x *NodeUsage) Reset() {
 	*x = NodeUsage{}
-	mi := &file_control_proto_msgTypes[8]
+	mi := &file_control_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -778,7 +967,7 @@ func (x *NodeUsage) String() string {
 func (*NodeUsage) ProtoMessage() {}
 
 func (x *NodeUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[8]
+	mi := &file_control_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +980,7 @@ func (x *NodeUsage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeUsage.ProtoReflect.Descriptor instead.
 func (*NodeUsage) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{8}
+	return file_control_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NodeUsage) GetCpuAllocatedMillicores() int64 {
@@ -836,6 +1025,73 @@ func (x *NodeUsage) GetDiskUsedGb() int64 {
 	return 0
 }
 
+func (x *NodeUsage) GetStoragePools() []*StoragePoolUsage {
+	if x != nil {
+		return x.StoragePools
+	}
+	return nil
+}
+
+type StoragePoolUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AllocatedGb   int64                  `protobuf:"varint,2,opt,name=allocated_gb,json=allocatedGb,proto3" json:"allocated_gb,omitempty"`
+	UsedGb        int64                  `protobuf:"varint,3,opt,name=used_gb,json=usedGb,proto3" json:"used_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoragePoolUsage) Reset() {
+	*x = StoragePoolUsage{}
+	mi := &file_control_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePoolUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePoolUsage) ProtoMessage() {}
+
+func (x *StoragePoolUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePoolUsage.ProtoReflect.Descriptor instead.
+func (*StoragePoolUsage) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StoragePoolUsage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoragePoolUsage) GetAllocatedGb() int64 {
+	if x != nil {
+		return x.AllocatedGb
+	}
+	return 0
+}
+
+func (x *StoragePoolUsage) GetUsedGb() int64 {
+	if x != nil {
+		return x.UsedGb
+	}
+	return 0
+}
+
 type HeartbeatResponse struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Acknowledged   bool                   `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
@@ -847,7 +1103,7 @@ type HeartbeatResponse struct {
 
 func (x *HeartbeatResponse) Reset() {
 	*x = HeartbeatResponse{}
-	mi := &file_control_proto_msgTypes[9]
+	mi := &file_control_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -859,7 +1115,7 @@ func (x *HeartbeatResponse) String() string {
 func (*HeartbeatResponse) ProtoMessage() {}
 
 func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[9]
+	mi := &file_control_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -872,7 +1128,7 @@ func (x *HeartbeatResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeartbeatResponse.ProtoReflect.Descriptor instead.
 func (*HeartbeatResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{9}
+	return file_control_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *HeartbeatResponse) GetAcknowledged() bool {
@@ -909,7 +1165,7 @@ type ApplyWorkloadRequest struct {
 
 func (x *ApplyWorkloadRequest) Reset() {
 	*x = ApplyWorkloadRequest{}
-	mi := &file_control_proto_msgTypes[10]
+	mi := &file_control_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -921,7 +1177,7 @@ func (x *ApplyWorkloadRequest) String() string {
 func (*ApplyWorkloadRequest) ProtoMessage() {}
 
 func (x *ApplyWorkloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[10]
+	mi := &file_control_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1190,7 @@ func (x *ApplyWorkloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyWorkloadRequest.ProtoReflect.Descriptor instead.
 func (*ApplyWorkloadRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{10}
+	return file_control_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ApplyWorkloadRequest) GetWorkloadId() string {
@@ -976,7 +1232,7 @@ type ApplyWorkloadResponse struct {
 
 func (x *ApplyWorkloadResponse) Reset() {
 	*x = ApplyWorkloadResponse{}
-	mi := &file_control_proto_msgTypes[11]
+	mi := &file_control_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -988,7 +1244,7 @@ func (x *ApplyWorkloadResponse) String() string {
 func (*ApplyWorkloadResponse) ProtoMessage() {}
 
 func (x *ApplyWorkloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[11]
+	mi := &file_control_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1001,7 +1257,7 @@ func (x *ApplyWorkloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyWorkloadResponse.ProtoReflect.Descriptor instead.
 func (*ApplyWorkloadResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{11}
+	return file_control_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ApplyWorkloadResponse) GetSuccess() bool {
@@ -1028,13 +1284,14 @@ func (x *ApplyWorkloadResponse) GetErrorMessage() string {
 type DeleteWorkloadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // optional; the workload must be in it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteWorkloadRequest) Reset() {
 	*x = DeleteWorkloadRequest{}
-	mi := &file_control_proto_msgTypes[12]
+	mi := &file_control_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1303,7 @@ func (x *DeleteWorkloadRequest) String() string {
 func (*DeleteWorkloadRequest) ProtoMessage() {}
 
 func (x *DeleteWorkloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[12]
+	mi := &file_control_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1316,7 @@ func (x *DeleteWorkloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkloadRequest.ProtoReflect.Descriptor instead.
 func (*DeleteWorkloadRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{12}
+	return file_control_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteWorkloadRequest) GetWorkloadId() string {
@@ -1069,6 +1326,13 @@ func (x *DeleteWorkloadRequest) GetWorkloadId() string {
 	return ""
 }
 
+func (x *DeleteWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
 type DeleteWorkloadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1079,7 +1343,7 @@ type DeleteWorkloadResponse struct {
 
 func (x *DeleteWorkloadResponse) Reset() {
 	*x = DeleteWorkloadResponse{}
-	mi := &file_control_proto_msgTypes[13]
+	mi := &file_control_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1091,7 +1355,7 @@ func (x *DeleteWorkloadResponse) String() string {
 func (*DeleteWorkloadResponse) ProtoMessage() {}
 
 func (x *DeleteWorkloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[13]
+	mi := &file_control_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1104,7 +1368,7 @@ func (x *DeleteWorkloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteWorkloadResponse.ProtoReflect.Descriptor instead.
 func (*DeleteWorkloadResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{13}
+	return file_control_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteWorkloadResponse) GetSuccess() bool {
@@ -1130,15 +1394,25 @@ type WorkloadSpec struct {
 	//	*WorkloadSpec_Container
 	//	*WorkloadSpec_Compose
 	//	*WorkloadSpec_Vm
-	Workload      isWorkloadSpec_Workload `protobuf_oneof:"workload"`
-	Metadata      map[string]string       `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Workload    isWorkloadSpec_Workload `protobuf_oneof:"workload"`
+	Metadata    map[string]string       `protobuf:"bytes,20,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Placement   *PlacementPolicy        `protobuf:"bytes,21,opt,name=placement,proto3" json:"placement,omitempty"`
+	Tolerations []*Toleration           `protobuf:"bytes,22,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// Higher priority workloads may preempt lower ones when no node fits.
+	// priority_class (see SCHEDULER_PRIORITY_CLASSES) overrides priority.
+	Priority      int32  `protobuf:"varint,23,opt,name=priority,proto3" json:"priority,omitempty"`
+	PriorityClass string `protobuf:"bytes,24,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	// How spec changes reach replicas. Unset means Recreate.
+	Rollout *RolloutStrategy `protobuf:"bytes,25,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// Empty means "default". Fixed once the workload exists.
+	Namespace     string `protobuf:"bytes,26,opt,name=namespace,proto3" json:"namespace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *WorkloadSpec) Reset() {
 	*x = WorkloadSpec{}
-	mi := &file_control_proto_msgTypes[14]
+	mi := &file_control_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1150,7 +1424,7 @@ func (x *WorkloadSpec) String() string {
 func (*WorkloadSpec) ProtoMessage() {}
 
 func (x *WorkloadSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[14]
+	mi := &file_control_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1163,7 +1437,7 @@ func (x *WorkloadSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkloadSpec.ProtoReflect.Descriptor instead.
 func (*WorkloadSpec) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{14}
+	return file_control_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WorkloadSpec) GetType() string {
@@ -1221,16 +1495,58 @@ func (x *WorkloadSpec) GetMetadata() map[string]string {
 	return nil
 }
 
-type isWorkloadSpec_Workload interface {
-	isWorkloadSpec_Workload()
+func (x *WorkloadSpec) GetPlacement() *PlacementPolicy {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
 }
 
-type WorkloadSpec_Container struct {
-	Container *ContainerSpec `protobuf:"bytes,10,opt,name=container,proto3,oneof"`
+func (x *WorkloadSpec) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
 }
 
-type WorkloadSpec_Compose struct {
-	Compose *ComposeSpec `protobuf:"bytes,11,opt,name=compose,proto3,oneof"`
+func (x *WorkloadSpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WorkloadSpec) GetPriorityClass() string {
+	if x != nil {
+		return x.PriorityClass
+	}
+	return ""
+}
+
+func (x *WorkloadSpec) GetRollout() *RolloutStrategy {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *WorkloadSpec) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type isWorkloadSpec_Workload interface {
+	isWorkloadSpec_Workload()
+}
+
+type WorkloadSpec_Container struct {
+	Container *ContainerSpec `protobuf:"bytes,10,opt,name=container,proto3,oneof"`
+}
+
+type WorkloadSpec_Compose struct {
+	Compose *ComposeSpec `protobuf:"bytes,11,opt,name=compose,proto3,oneof"`
 }
 
 type WorkloadSpec_Vm struct {
@@ -1243,30 +1559,31 @@ func (*WorkloadSpec_Compose) isWorkloadSpec_Workload() {}
 
 func (*WorkloadSpec_Vm) isWorkloadSpec_Workload() {}
 
-type ResourceRequirements struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CpuMillicores int64                  `protobuf:"varint,1,opt,name=cpu_millicores,json=cpuMillicores,proto3" json:"cpu_millicores,omitempty"`
-	MemoryMb      int64                  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
-	DiskGb        int64                  `protobuf:"varint,3,opt,name=disk_gb,json=diskGb,proto3" json:"disk_gb,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type RolloutStrategy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // Recreate | RollingUpdate
+	// RollingUpdate only. Both zero means max_unavailable = 1.
+	MaxUnavailable int32 `protobuf:"varint,2,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	MaxSurge       int32 `protobuf:"varint,3,opt,name=max_surge,json=maxSurge,proto3" json:"max_surge,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ResourceRequirements) Reset() {
-	*x = ResourceRequirements{}
-	mi := &file_control_proto_msgTypes[15]
+func (x *RolloutStrategy) Reset() {
+	*x = RolloutStrategy{}
+	mi := &file_control_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResourceRequirements) String() string {
+func (x *RolloutStrategy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResourceRequirements) ProtoMessage() {}
+func (*RolloutStrategy) ProtoMessage() {}
 
-func (x *ResourceRequirements) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[15]
+func (x *RolloutStrategy) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1277,61 +1594,62 @@ func (x *ResourceRequirements) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResourceRequirements.ProtoReflect.Descriptor instead.
-func (*ResourceRequirements) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use RolloutStrategy.ProtoReflect.Descriptor instead.
+func (*RolloutStrategy) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ResourceRequirements) GetCpuMillicores() int64 {
+func (x *RolloutStrategy) GetType() string {
 	if x != nil {
-		return x.CpuMillicores
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *ResourceRequirements) GetMemoryMb() int64 {
+func (x *RolloutStrategy) GetMaxUnavailable() int32 {
 	if x != nil {
-		return x.MemoryMb
+		return x.MaxUnavailable
 	}
 	return 0
 }
 
-func (x *ResourceRequirements) GetDiskGb() int64 {
+func (x *RolloutStrategy) GetMaxSurge() int32 {
 	if x != nil {
-		return x.DiskGb
+		return x.MaxSurge
 	}
 	return 0
 }
 
-type ContainerSpec struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Image          string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	Command        []string               `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
-	Env            map[string]string      `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Volumes        []*VolumeMount         `protobuf:"bytes,4,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	Ports          []*Port                `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
-	RestartPolicy  string                 `protobuf:"bytes,6,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
-	Privileged     bool                   `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged,omitempty"`
-	ManagedVolumes []*ManagedVolumeSpec   `protobuf:"bytes,8,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type PlacementPolicy struct {
+	state                 protoimpl.MessageState     `protogen:"open.v1"`
+	Strategy              string                     `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"` // spread | binpack; empty uses scheduler default
+	RequiredNodeAffinity  []*NodeSelectorRequirement `protobuf:"bytes,2,rep,name=required_node_affinity,json=requiredNodeAffinity,proto3" json:"required_node_affinity,omitempty"`
+	PreferredNodeAffinity []*PreferredNodeAffinity   `protobuf:"bytes,3,rep,name=preferred_node_affinity,json=preferredNodeAffinity,proto3" json:"preferred_node_affinity,omitempty"`
+	AntiAffinityGroup     string                     `protobuf:"bytes,4,opt,name=anti_affinity_group,json=antiAffinityGroup,proto3" json:"anti_affinity_group,omitempty"` // replica set children default to their replica set
+	AntiAffinityRequired  bool                       `protobuf:"varint,5,opt,name=anti_affinity_required,json=antiAffinityRequired,proto3" json:"anti_affinity_required,omitempty"`
+	SpreadTopologyKey     string                     `protobuf:"bytes,6,opt,name=spread_topology_key,json=spreadTopologyKey,proto3" json:"spread_topology_key,omitempty"` // node label, e.g. zone or rack
+	// Members of an affinity group are placed on the node of the members
+	// already running; stacks with colocate set use their own group.
+	AffinityGroup string `protobuf:"bytes,7,opt,name=affinity_group,json=affinityGroup,proto3" json:"affinity_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerSpec) Reset() {
-	*x = ContainerSpec{}
-	mi := &file_control_proto_msgTypes[16]
+func (x *PlacementPolicy) Reset() {
+	*x = PlacementPolicy{}
+	mi := &file_control_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerSpec) String() string {
+func (x *PlacementPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerSpec) ProtoMessage() {}
+func (*PlacementPolicy) ProtoMessage() {}
 
-func (x *ContainerSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[16]
+func (x *PlacementPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1342,91 +1660,84 @@ func (x *ContainerSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerSpec.ProtoReflect.Descriptor instead.
-func (*ContainerSpec) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use PlacementPolicy.ProtoReflect.Descriptor instead.
+func (*PlacementPolicy) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ContainerSpec) GetImage() string {
+func (x *PlacementPolicy) GetStrategy() string {
 	if x != nil {
-		return x.Image
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *ContainerSpec) GetCommand() []string {
+func (x *PlacementPolicy) GetRequiredNodeAffinity() []*NodeSelectorRequirement {
 	if x != nil {
-		return x.Command
+		return x.RequiredNodeAffinity
 	}
 	return nil
 }
 
-func (x *ContainerSpec) GetEnv() map[string]string {
+func (x *PlacementPolicy) GetPreferredNodeAffinity() []*PreferredNodeAffinity {
 	if x != nil {
-		return x.Env
+		return x.PreferredNodeAffinity
 	}
 	return nil
 }
 
-func (x *ContainerSpec) GetVolumes() []*VolumeMount {
+func (x *PlacementPolicy) GetAntiAffinityGroup() string {
 	if x != nil {
-		return x.Volumes
+		return x.AntiAffinityGroup
 	}
-	return nil
+	return ""
 }
 
-func (x *ContainerSpec) GetPorts() []*Port {
+func (x *PlacementPolicy) GetAntiAffinityRequired() bool {
 	if x != nil {
-		return x.Ports
+		return x.AntiAffinityRequired
 	}
-	return nil
+	return false
 }
 
-func (x *ContainerSpec) GetRestartPolicy() string {
+func (x *PlacementPolicy) GetSpreadTopologyKey() string {
 	if x != nil {
-		return x.RestartPolicy
+		return x.SpreadTopologyKey
 	}
 	return ""
 }
 
-func (x *ContainerSpec) GetPrivileged() bool {
-	if x != nil {
-		return x.Privileged
-	}
-	return false
-}
-
-func (x *ContainerSpec) GetManagedVolumes() []*ManagedVolumeSpec {
+func (x *PlacementPolicy) GetAffinityGroup() string {
 	if x != nil {
-		return x.ManagedVolumes
+		return x.AffinityGroup
 	}
-	return nil
+	return ""
 }
 
-type VolumeMount struct {
+type NodeSelectorRequirement struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostPath      string                 `protobuf:"bytes,1,opt,name=host_path,json=hostPath,proto3" json:"host_path,omitempty"`
-	ContainerPath string                 `protobuf:"bytes,2,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // In | NotIn | Exists | DoesNotExist
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VolumeMount) Reset() {
-	*x = VolumeMount{}
-	mi := &file_control_proto_msgTypes[17]
+func (x *NodeSelectorRequirement) Reset() {
+	*x = NodeSelectorRequirement{}
+	mi := &file_control_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VolumeMount) String() string {
+func (x *NodeSelectorRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VolumeMount) ProtoMessage() {}
+func (*NodeSelectorRequirement) ProtoMessage() {}
 
-func (x *VolumeMount) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[17]
+func (x *NodeSelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,56 +1748,55 @@ func (x *VolumeMount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
-func (*VolumeMount) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use NodeSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*NodeSelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *VolumeMount) GetHostPath() string {
+func (x *NodeSelectorRequirement) GetKey() string {
 	if x != nil {
-		return x.HostPath
+		return x.Key
 	}
 	return ""
 }
 
-func (x *VolumeMount) GetContainerPath() string {
+func (x *NodeSelectorRequirement) GetOperator() string {
 	if x != nil {
-		return x.ContainerPath
+		return x.Operator
 	}
 	return ""
 }
 
-func (x *VolumeMount) GetReadOnly() bool {
+func (x *NodeSelectorRequirement) GetValues() []string {
 	if x != nil {
-		return x.ReadOnly
+		return x.Values
 	}
-	return false
+	return nil
 }
 
-type Port struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	HostPort      int32                  `protobuf:"varint,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
-	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
-	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp or udp
+type PreferredNodeAffinity struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Weight        int32                    `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Requirement   *NodeSelectorRequirement `protobuf:"bytes,2,opt,name=requirement,proto3" json:"requirement,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Port) Reset() {
-	*x = Port{}
-	mi := &file_control_proto_msgTypes[18]
+func (x *PreferredNodeAffinity) Reset() {
+	*x = PreferredNodeAffinity{}
+	mi := &file_control_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Port) String() string {
+func (x *PreferredNodeAffinity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Port) ProtoMessage() {}
+func (*PreferredNodeAffinity) ProtoMessage() {}
 
-func (x *Port) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[18]
+func (x *PreferredNodeAffinity) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,58 +1807,49 @@ func (x *Port) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Port.ProtoReflect.Descriptor instead.
-func (*Port) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *Port) GetHostPort() int32 {
-	if x != nil {
-		return x.HostPort
-	}
-	return 0
+// Deprecated: Use PreferredNodeAffinity.ProtoReflect.Descriptor instead.
+func (*PreferredNodeAffinity) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Port) GetContainerPort() int32 {
+func (x *PreferredNodeAffinity) GetWeight() int32 {
 	if x != nil {
-		return x.ContainerPort
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *Port) GetProtocol() string {
+func (x *PreferredNodeAffinity) GetRequirement() *NodeSelectorRequirement {
 	if x != nil {
-		return x.Protocol
+		return x.Requirement
 	}
-	return ""
+	return nil
 }
 
-type ComposeSpec struct {
+type ResourceRequirements struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SourceType    string                 `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // git or inline
-	GitRepo       string                 `protobuf:"bytes,2,opt,name=git_repo,json=gitRepo,proto3" json:"git_repo,omitempty"`
-	GitRef        string                 `protobuf:"bytes,3,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
-	InlineYaml    string                 `protobuf:"bytes,4,opt,name=inline_yaml,json=inlineYaml,proto3" json:"inline_yaml,omitempty"`
-	Env           map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CpuMillicores int64                  `protobuf:"varint,1,opt,name=cpu_millicores,json=cpuMillicores,proto3" json:"cpu_millicores,omitempty"`
+	MemoryMb      int64                  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	DiskGb        int64                  `protobuf:"varint,3,opt,name=disk_gb,json=diskGb,proto3" json:"disk_gb,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ComposeSpec) Reset() {
-	*x = ComposeSpec{}
-	mi := &file_control_proto_msgTypes[19]
+func (x *ResourceRequirements) Reset() {
+	*x = ResourceRequirements{}
+	mi := &file_control_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ComposeSpec) String() string {
+func (x *ResourceRequirements) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ComposeSpec) ProtoMessage() {}
+func (*ResourceRequirements) ProtoMessage() {}
 
-func (x *ComposeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[19]
+func (x *ResourceRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,74 +1860,67 @@ func (x *ComposeSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ComposeSpec.ProtoReflect.Descriptor instead.
-func (*ComposeSpec) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *ComposeSpec) GetSourceType() string {
-	if x != nil {
-		return x.SourceType
-	}
-	return ""
-}
-
-func (x *ComposeSpec) GetGitRepo() string {
-	if x != nil {
-		return x.GitRepo
-	}
-	return ""
+// Deprecated: Use ResourceRequirements.ProtoReflect.Descriptor instead.
+func (*ResourceRequirements) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ComposeSpec) GetGitRef() string {
+func (x *ResourceRequirements) GetCpuMillicores() int64 {
 	if x != nil {
-		return x.GitRef
+		return x.CpuMillicores
 	}
-	return ""
+	return 0
 }
 
-func (x *ComposeSpec) GetInlineYaml() string {
+func (x *ResourceRequirements) GetMemoryMb() int64 {
 	if x != nil {
-		return x.InlineYaml
+		return x.MemoryMb
 	}
-	return ""
+	return 0
 }
 
-func (x *ComposeSpec) GetEnv() map[string]string {
+func (x *ResourceRequirements) GetDiskGb() int64 {
 	if x != nil {
-		return x.Env
+		return x.DiskGb
 	}
-	return nil
+	return 0
 }
 
-type VMSpec struct {
+type ContainerSpec struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Vcpus          int32                  `protobuf:"varint,1,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
-	MemoryMb       int64                  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
-	Disks          []*DiskConfig          `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
-	Networks       []*NetworkConfig       `protobuf:"bytes,4,rep,name=networks,proto3" json:"networks,omitempty"`
-	CloudInit      *CloudInitConfig       `protobuf:"bytes,5,opt,name=cloud_init,json=cloudInit,proto3" json:"cloud_init,omitempty"`
-	OsImage        string                 `protobuf:"bytes,6,opt,name=os_image,json=osImage,proto3" json:"os_image,omitempty"`
-	ManagedVolumes []*ManagedVolumeSpec   `protobuf:"bytes,7,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Image          string                 `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Command        []string               `protobuf:"bytes,2,rep,name=command,proto3" json:"command,omitempty"`
+	Env            map[string]string      `protobuf:"bytes,3,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Volumes        []*VolumeMount         `protobuf:"bytes,4,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Ports          []*Port                `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	RestartPolicy  string                 `protobuf:"bytes,6,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	Privileged     bool                   `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	ManagedVolumes []*ManagedVolumeSpec   `protobuf:"bytes,8,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
+	LivenessProbe  *Probe                 `protobuf:"bytes,9,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	ReadinessProbe *Probe                 `protobuf:"bytes,10,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
+	EnvFrom        []*EnvFromSource       `protobuf:"bytes,11,rep,name=env_from,json=envFrom,proto3" json:"env_from,omitempty"`
+	Files          []*FileMount           `protobuf:"bytes,12,rep,name=files,proto3" json:"files,omitempty"`
+	// Arguments passed to the entrypoint; command overrides the entrypoint.
+	Args          []string `protobuf:"bytes,13,rep,name=args,proto3" json:"args,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VMSpec) Reset() {
-	*x = VMSpec{}
-	mi := &file_control_proto_msgTypes[20]
+func (x *ContainerSpec) Reset() {
+	*x = ContainerSpec{}
+	mi := &file_control_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VMSpec) String() string {
+func (x *ContainerSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VMSpec) ProtoMessage() {}
+func (*ContainerSpec) ProtoMessage() {}
 
-func (x *VMSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[20]
+func (x *ContainerSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,84 +1931,129 @@ func (x *VMSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VMSpec.ProtoReflect.Descriptor instead.
-func (*VMSpec) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ContainerSpec.ProtoReflect.Descriptor instead.
+func (*ContainerSpec) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VMSpec) GetVcpus() int32 {
+func (x *ContainerSpec) GetImage() string {
 	if x != nil {
-		return x.Vcpus
+		return x.Image
 	}
-	return 0
+	return ""
 }
 
-func (x *VMSpec) GetMemoryMb() int64 {
+func (x *ContainerSpec) GetCommand() []string {
 	if x != nil {
-		return x.MemoryMb
+		return x.Command
 	}
-	return 0
+	return nil
 }
 
-func (x *VMSpec) GetDisks() []*DiskConfig {
+func (x *ContainerSpec) GetEnv() map[string]string {
 	if x != nil {
-		return x.Disks
+		return x.Env
 	}
 	return nil
 }
 
-func (x *VMSpec) GetNetworks() []*NetworkConfig {
+func (x *ContainerSpec) GetVolumes() []*VolumeMount {
 	if x != nil {
-		return x.Networks
+		return x.Volumes
 	}
 	return nil
 }
 
-func (x *VMSpec) GetCloudInit() *CloudInitConfig {
+func (x *ContainerSpec) GetPorts() []*Port {
 	if x != nil {
-		return x.CloudInit
+		return x.Ports
 	}
 	return nil
 }
 
-func (x *VMSpec) GetOsImage() string {
+func (x *ContainerSpec) GetRestartPolicy() string {
 	if x != nil {
-		return x.OsImage
+		return x.RestartPolicy
 	}
 	return ""
 }
 
-func (x *VMSpec) GetManagedVolumes() []*ManagedVolumeSpec {
+func (x *ContainerSpec) GetPrivileged() bool {
+	if x != nil {
+		return x.Privileged
+	}
+	return false
+}
+
+func (x *ContainerSpec) GetManagedVolumes() []*ManagedVolumeSpec {
 	if x != nil {
 		return x.ManagedVolumes
 	}
 	return nil
 }
 
-type DiskConfig struct {
+func (x *ContainerSpec) GetLivenessProbe() *Probe {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetReadinessProbe() *Probe {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetFiles() []*FileMount {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ContainerSpec) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+// EnvFromSource injects every key of a secret or config in the workload's
+// namespace as an env var. Set exactly one of secret and config. Explicit
+// env entries win.
+type EnvFromSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PoolName      string                 `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
-	SizeGb        int64                  `protobuf:"varint,2,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Config        string                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DiskConfig) Reset() {
-	*x = DiskConfig{}
-	mi := &file_control_proto_msgTypes[21]
+func (x *EnvFromSource) Reset() {
+	*x = EnvFromSource{}
+	mi := &file_control_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DiskConfig) String() string {
+func (x *EnvFromSource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiskConfig) ProtoMessage() {}
+func (*EnvFromSource) ProtoMessage() {}
 
-func (x *DiskConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[21]
+func (x *EnvFromSource) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1725,56 +2064,62 @@ func (x *DiskConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
-func (*DiskConfig) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use EnvFromSource.ProtoReflect.Descriptor instead.
+func (*EnvFromSource) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DiskConfig) GetPoolName() string {
+func (x *EnvFromSource) GetSecret() string {
 	if x != nil {
-		return x.PoolName
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *DiskConfig) GetSizeGb() int64 {
+func (x *EnvFromSource) GetConfig() string {
 	if x != nil {
-		return x.SizeGb
+		return x.Config
 	}
-	return 0
+	return ""
 }
 
-func (x *DiskConfig) GetMountPoint() string {
+func (x *EnvFromSource) GetPrefix() string {
 	if x != nil {
-		return x.MountPoint
+		return x.Prefix
 	}
 	return ""
 }
 
-type NetworkConfig struct {
+// FileMount writes a secret or config key to path, or every key as a file
+// under the directory path when key is empty. Mounts sent to agents carry
+// the resolved content instead of a reference.
+type FileMount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bridge        string                 `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
-	Dhcp          bool                   `protobuf:"varint,2,opt,name=dhcp,proto3" json:"dhcp,omitempty"`
-	StaticIp      string                 `protobuf:"bytes,3,opt,name=static_ip,json=staticIp,proto3" json:"static_ip,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Config        string                 `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Mode          int32                  `protobuf:"varint,5,opt,name=mode,proto3" json:"mode,omitempty"` // default 0400 for secrets, 0444 for configs
+	Content       []byte                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NetworkConfig) Reset() {
-	*x = NetworkConfig{}
-	mi := &file_control_proto_msgTypes[22]
+func (x *FileMount) Reset() {
+	*x = FileMount{}
+	mi := &file_control_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NetworkConfig) String() string {
+func (x *FileMount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NetworkConfig) ProtoMessage() {}
+func (*FileMount) ProtoMessage() {}
 
-func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[22]
+func (x *FileMount) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1785,57 +2130,80 @@ func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
-func (*NetworkConfig) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use FileMount.ProtoReflect.Descriptor instead.
+func (*FileMount) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *NetworkConfig) GetBridge() string {
+func (x *FileMount) GetSecret() string {
 	if x != nil {
-		return x.Bridge
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *NetworkConfig) GetDhcp() bool {
+func (x *FileMount) GetConfig() string {
 	if x != nil {
-		return x.Dhcp
+		return x.Config
 	}
-	return false
+	return ""
 }
 
-func (x *NetworkConfig) GetStaticIp() string {
+func (x *FileMount) GetKey() string {
 	if x != nil {
-		return x.StaticIp
+		return x.Key
 	}
 	return ""
 }
 
-type CloudInitConfig struct {
+func (x *FileMount) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileMount) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+func (x *FileMount) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// TemplateVar exposes a secret or config key to a VM's cloud-init documents
+// as {{ .name }}. Documents are only rendered when variables are set.
+type TemplateVar struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserData      string                 `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
-	MetaData      string                 `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
-	NetworkConfig string                 `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"`
-	VendorData    string                 `protobuf:"bytes,4,opt,name=vendor_data,json=vendorData,proto3" json:"vendor_data,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	Config        string                 `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Key           string                 `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CloudInitConfig) Reset() {
-	*x = CloudInitConfig{}
-	mi := &file_control_proto_msgTypes[23]
+func (x *TemplateVar) Reset() {
+	*x = TemplateVar{}
+	mi := &file_control_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloudInitConfig) String() string {
+func (x *TemplateVar) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudInitConfig) ProtoMessage() {}
+func (*TemplateVar) ProtoMessage() {}
 
-func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[23]
+func (x *TemplateVar) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,68 +2214,73 @@ func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudInitConfig.ProtoReflect.Descriptor instead.
-func (*CloudInitConfig) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use TemplateVar.ProtoReflect.Descriptor instead.
+func (*TemplateVar) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CloudInitConfig) GetUserData() string {
+func (x *TemplateVar) GetName() string {
 	if x != nil {
-		return x.UserData
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CloudInitConfig) GetMetaData() string {
+func (x *TemplateVar) GetSecret() string {
 	if x != nil {
-		return x.MetaData
+		return x.Secret
 	}
 	return ""
 }
 
-func (x *CloudInitConfig) GetNetworkConfig() string {
+func (x *TemplateVar) GetConfig() string {
 	if x != nil {
-		return x.NetworkConfig
+		return x.Config
 	}
 	return ""
 }
 
-func (x *CloudInitConfig) GetVendorData() string {
+func (x *TemplateVar) GetKey() string {
 	if x != nil {
-		return x.VendorData
+		return x.Key
 	}
 	return ""
 }
 
-type ManagedVolumeSpec struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd
-	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
-	AccessMode    string                 `protobuf:"bytes,4,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
-	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
-	MountPath     string                 `protobuf:"bytes,6,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
-	ReadOnly      bool                   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
-	RetainPolicy  string                 `protobuf:"bytes,8,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ManagedVolumeSpec) Reset() {
-	*x = ManagedVolumeSpec{}
-	mi := &file_control_proto_msgTypes[24]
+// Probes run on the agent; the scheduler applies the thresholds, restarts
+// workloads whose liveness probe fails and reports readiness.
+type Probe struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Handler:
+	//
+	//	*Probe_HttpGet
+	//	*Probe_TcpSocket
+	//	*Probe_Exec
+	Handler             isProbe_Handler `protobuf_oneof:"handler"`
+	InitialDelaySeconds int32           `protobuf:"varint,4,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
+	PeriodSeconds       int32           `protobuf:"varint,5,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	TimeoutSeconds      int32           `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	SuccessThreshold    int32           `protobuf:"varint,7,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"` // default 1
+	FailureThreshold    int32           `protobuf:"varint,8,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"` // default 3
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Probe) Reset() {
+	*x = Probe{}
+	mi := &file_control_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ManagedVolumeSpec) String() string {
+func (x *Probe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ManagedVolumeSpec) ProtoMessage() {}
+func (*Probe) ProtoMessage() {}
 
-func (x *ManagedVolumeSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[24]
+func (x *Probe) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,98 +2291,10405 @@ func (x *ManagedVolumeSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ManagedVolumeSpec.ProtoReflect.Descriptor instead.
-func (*ManagedVolumeSpec) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use Probe.ProtoReflect.Descriptor instead.
+func (*Probe) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ManagedVolumeSpec) GetName() string {
+func (x *Probe) GetHandler() isProbe_Handler {
 	if x != nil {
-		return x.Name
+		return x.Handler
 	}
-	return ""
+	return nil
 }
 
-func (x *ManagedVolumeSpec) GetDriver() string {
+func (x *Probe) GetHttpGet() *HTTPGetAction {
 	if x != nil {
-		return x.Driver
+		if x, ok := x.Handler.(*Probe_HttpGet); ok {
+			return x.HttpGet
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *ManagedVolumeSpec) GetSizeGb() int64 {
+func (x *Probe) GetTcpSocket() *TCPSocketAction {
 	if x != nil {
-		return x.SizeGb
+		if x, ok := x.Handler.(*Probe_TcpSocket); ok {
+			return x.TcpSocket
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *ManagedVolumeSpec) GetAccessMode() string {
+func (x *Probe) GetExec() *ExecAction {
 	if x != nil {
-		return x.AccessMode
+		if x, ok := x.Handler.(*Probe_Exec); ok {
+			return x.Exec
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *ManagedVolumeSpec) GetFsType() string {
+func (x *Probe) GetInitialDelaySeconds() int32 {
 	if x != nil {
-		return x.FsType
+		return x.InitialDelaySeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetMountPath() string {
+func (x *Probe) GetPeriodSeconds() int32 {
 	if x != nil {
-		return x.MountPath
+		return x.PeriodSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetReadOnly() bool {
+func (x *Probe) GetTimeoutSeconds() int32 {
 	if x != nil {
-		return x.ReadOnly
+		return x.TimeoutSeconds
 	}
-	return false
+	return 0
 }
 
-func (x *ManagedVolumeSpec) GetRetainPolicy() string {
+func (x *Probe) GetSuccessThreshold() int32 {
 	if x != nil {
-		return x.RetainPolicy
+		return x.SuccessThreshold
 	}
-	return ""
+	return 0
 }
 
-type WorkloadUsageSnapshot struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
-	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	CpuPercent     float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
-	MemoryBytes    int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
-	DiskReadBytes  int64                  `protobuf:"varint,5,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
-	DiskWriteBytes int64                  `protobuf:"varint,6,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
-	NetRxBytes     int64                  `protobuf:"varint,7,opt,name=net_rx_bytes,json=netRxBytes,proto3" json:"net_rx_bytes,omitempty"`
-	NetTxBytes     int64                  `protobuf:"varint,8,opt,name=net_tx_bytes,json=netTxBytes,proto3" json:"net_tx_bytes,omitempty"`
-	CollectedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
-	Source         string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Probe) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
 }
 
-func (x *WorkloadUsageSnapshot) Reset() {
-	*x = WorkloadUsageSnapshot{}
-	mi := &file_control_proto_msgTypes[25]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+type isProbe_Handler interface {
+	isProbe_Handler()
 }
 
-func (x *WorkloadUsageSnapshot) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type Probe_HttpGet struct {
+	HttpGet *HTTPGetAction `protobuf:"bytes,1,opt,name=http_get,json=httpGet,proto3,oneof"`
+}
+
+type Probe_TcpSocket struct {
+	TcpSocket *TCPSocketAction `protobuf:"bytes,2,opt,name=tcp_socket,json=tcpSocket,proto3,oneof"`
+}
+
+type Probe_Exec struct {
+	Exec *ExecAction `protobuf:"bytes,3,opt,name=exec,proto3,oneof"`
+}
+
+func (*Probe_HttpGet) isProbe_Handler() {}
+
+func (*Probe_TcpSocket) isProbe_Handler() {}
+
+func (*Probe_Exec) isProbe_Handler() {}
+
+type HTTPGetAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Scheme        string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"` // http | https
+	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTTPGetAction) Reset() {
+	*x = HTTPGetAction{}
+	mi := &file_control_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTTPGetAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPGetAction) ProtoMessage() {}
+
+func (x *HTTPGetAction) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPGetAction.ProtoReflect.Descriptor instead.
+func (*HTTPGetAction) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HTTPGetAction) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HTTPGetAction) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HTTPGetAction) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *HTTPGetAction) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type TCPSocketAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Port          int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TCPSocketAction) Reset() {
+	*x = TCPSocketAction{}
+	mi := &file_control_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TCPSocketAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPSocketAction) ProtoMessage() {}
+
+func (x *TCPSocketAction) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TCPSocketAction.ProtoReflect.Descriptor instead.
+func (*TCPSocketAction) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TCPSocketAction) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type ExecAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       []string               `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecAction) Reset() {
+	*x = ExecAction{}
+	mi := &file_control_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecAction) ProtoMessage() {}
+
+func (x *ExecAction) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecAction.ProtoReflect.Descriptor instead.
+func (*ExecAction) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ExecAction) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type ProbeResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Probe         string                 `protobuf:"bytes,1,opt,name=probe,proto3" json:"probe,omitempty"` // liveness | readiness
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	CheckedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProbeResult) Reset() {
+	*x = ProbeResult{}
+	mi := &file_control_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResult) ProtoMessage() {}
+
+func (x *ProbeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResult.ProtoReflect.Descriptor instead.
+func (*ProbeResult) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ProbeResult) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+func (x *ProbeResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProbeResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ProbeResult) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+type VolumeMount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostPath      string                 `protobuf:"bytes,1,opt,name=host_path,json=hostPath,proto3" json:"host_path,omitempty"`
+	ContainerPath string                 `protobuf:"bytes,2,opt,name=container_path,json=containerPath,proto3" json:"container_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeMount) Reset() {
+	*x = VolumeMount{}
+	mi := &file_control_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeMount) ProtoMessage() {}
+
+func (x *VolumeMount) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
+func (*VolumeMount) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VolumeMount) GetHostPath() string {
+	if x != nil {
+		return x.HostPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetContainerPath() string {
+	if x != nil {
+		return x.ContainerPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type Port struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HostPort      int32                  `protobuf:"varint,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"` // 0 allocates a port from SCHEDULER_HOST_PORT_RANGE
+	ContainerPort int32                  `protobuf:"varint,2,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,3,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp or udp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Port) Reset() {
+	*x = Port{}
+	mi := &file_control_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Port) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Port) ProtoMessage() {}
+
+func (x *Port) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Port.ProtoReflect.Descriptor instead.
+func (*Port) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Port) GetHostPort() int32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+func (x *Port) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *Port) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type ComposeSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceType     string                 `protobuf:"bytes,1,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"` // git or inline
+	GitRepo        string                 `protobuf:"bytes,2,opt,name=git_repo,json=gitRepo,proto3" json:"git_repo,omitempty"`
+	GitRef         string                 `protobuf:"bytes,3,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"`
+	InlineYaml     string                 `protobuf:"bytes,4,opt,name=inline_yaml,json=inlineYaml,proto3" json:"inline_yaml,omitempty"`
+	Env            map[string]string      `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvFrom        []*EnvFromSource       `protobuf:"bytes,6,rep,name=env_from,json=envFrom,proto3" json:"env_from,omitempty"`
+	Files          []*FileMount           `protobuf:"bytes,7,rep,name=files,proto3" json:"files,omitempty"`                                           // paths relative to the project directory
+	GitTokenSecret string                 `protobuf:"bytes,8,opt,name=git_token_secret,json=gitTokenSecret,proto3" json:"git_token_secret,omitempty"` // secret holding the git auth token
+	GitTokenKey    string                 `protobuf:"bytes,9,opt,name=git_token_key,json=gitTokenKey,proto3" json:"git_token_key,omitempty"`
+	// git_token is the resolved token. Only the scheduler sets it, on applies
+	// sent to agents; clients reference a secret instead.
+	GitToken      string `protobuf:"bytes,10,opt,name=git_token,json=gitToken,proto3" json:"git_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ComposeSpec) Reset() {
+	*x = ComposeSpec{}
+	mi := &file_control_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComposeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComposeSpec) ProtoMessage() {}
+
+func (x *ComposeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComposeSpec.ProtoReflect.Descriptor instead.
+func (*ComposeSpec) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ComposeSpec) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitRepo() string {
+	if x != nil {
+		return x.GitRepo
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetInlineYaml() string {
+	if x != nil {
+		return x.InlineYaml
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *ComposeSpec) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *ComposeSpec) GetFiles() []*FileMount {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *ComposeSpec) GetGitTokenSecret() string {
+	if x != nil {
+		return x.GitTokenSecret
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitTokenKey() string {
+	if x != nil {
+		return x.GitTokenKey
+	}
+	return ""
+}
+
+func (x *ComposeSpec) GetGitToken() string {
+	if x != nil {
+		return x.GitToken
+	}
+	return ""
+}
+
+type VMSpec struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Vcpus          int32                  `protobuf:"varint,1,opt,name=vcpus,proto3" json:"vcpus,omitempty"`
+	MemoryMb       int64                  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	Disks          []*DiskConfig          `protobuf:"bytes,3,rep,name=disks,proto3" json:"disks,omitempty"`
+	Networks       []*NetworkConfig       `protobuf:"bytes,4,rep,name=networks,proto3" json:"networks,omitempty"`
+	CloudInit      *CloudInitConfig       `protobuf:"bytes,5,opt,name=cloud_init,json=cloudInit,proto3" json:"cloud_init,omitempty"`
+	OsImage        string                 `protobuf:"bytes,6,opt,name=os_image,json=osImage,proto3" json:"os_image,omitempty"`
+	ManagedVolumes []*ManagedVolumeSpec   `protobuf:"bytes,7,rep,name=managed_volumes,json=managedVolumes,proto3" json:"managed_volumes,omitempty"`
+	LivenessProbe  *Probe                 `protobuf:"bytes,8,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	ReadinessProbe *Probe                 `protobuf:"bytes,9,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
+	TemplateVars   []*TemplateVar         `protobuf:"bytes,10,rep,name=template_vars,json=templateVars,proto3" json:"template_vars,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VMSpec) Reset() {
+	*x = VMSpec{}
+	mi := &file_control_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VMSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VMSpec) ProtoMessage() {}
+
+func (x *VMSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VMSpec.ProtoReflect.Descriptor instead.
+func (*VMSpec) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VMSpec) GetVcpus() int32 {
+	if x != nil {
+		return x.Vcpus
+	}
+	return 0
+}
+
+func (x *VMSpec) GetMemoryMb() int64 {
+	if x != nil {
+		return x.MemoryMb
+	}
+	return 0
+}
+
+func (x *VMSpec) GetDisks() []*DiskConfig {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *VMSpec) GetNetworks() []*NetworkConfig {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *VMSpec) GetCloudInit() *CloudInitConfig {
+	if x != nil {
+		return x.CloudInit
+	}
+	return nil
+}
+
+func (x *VMSpec) GetOsImage() string {
+	if x != nil {
+		return x.OsImage
+	}
+	return ""
+}
+
+func (x *VMSpec) GetManagedVolumes() []*ManagedVolumeSpec {
+	if x != nil {
+		return x.ManagedVolumes
+	}
+	return nil
+}
+
+func (x *VMSpec) GetLivenessProbe() *Probe {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return nil
+}
+
+func (x *VMSpec) GetReadinessProbe() *Probe {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return nil
+}
+
+func (x *VMSpec) GetTemplateVars() []*TemplateVar {
+	if x != nil {
+		return x.TemplateVars
+	}
+	return nil
+}
+
+type DiskConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PoolName      string                 `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	SizeGb        int64                  `protobuf:"varint,2,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	MountPoint    string                 `protobuf:"bytes,3,opt,name=mount_point,json=mountPoint,proto3" json:"mount_point,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskConfig) Reset() {
+	*x = DiskConfig{}
+	mi := &file_control_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskConfig) ProtoMessage() {}
+
+func (x *DiskConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskConfig.ProtoReflect.Descriptor instead.
+func (*DiskConfig) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiskConfig) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *DiskConfig) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *DiskConfig) GetMountPoint() string {
+	if x != nil {
+		return x.MountPoint
+	}
+	return ""
+}
+
+type NetworkConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bridge        string                 `protobuf:"bytes,1,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	Dhcp          bool                   `protobuf:"varint,2,opt,name=dhcp,proto3" json:"dhcp,omitempty"`
+	StaticIp      string                 `protobuf:"bytes,3,opt,name=static_ip,json=staticIp,proto3" json:"static_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NetworkConfig) Reset() {
+	*x = NetworkConfig{}
+	mi := &file_control_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NetworkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkConfig) ProtoMessage() {}
+
+func (x *NetworkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkConfig.ProtoReflect.Descriptor instead.
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NetworkConfig) GetBridge() string {
+	if x != nil {
+		return x.Bridge
+	}
+	return ""
+}
+
+func (x *NetworkConfig) GetDhcp() bool {
+	if x != nil {
+		return x.Dhcp
+	}
+	return false
+}
+
+func (x *NetworkConfig) GetStaticIp() string {
+	if x != nil {
+		return x.StaticIp
+	}
+	return ""
+}
+
+type CloudInitConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserData      string                 `protobuf:"bytes,1,opt,name=user_data,json=userData,proto3" json:"user_data,omitempty"`
+	MetaData      string                 `protobuf:"bytes,2,opt,name=meta_data,json=metaData,proto3" json:"meta_data,omitempty"`
+	NetworkConfig string                 `protobuf:"bytes,3,opt,name=network_config,json=networkConfig,proto3" json:"network_config,omitempty"`
+	VendorData    string                 `protobuf:"bytes,4,opt,name=vendor_data,json=vendorData,proto3" json:"vendor_data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloudInitConfig) Reset() {
+	*x = CloudInitConfig{}
+	mi := &file_control_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloudInitConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudInitConfig) ProtoMessage() {}
+
+func (x *CloudInitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudInitConfig.ProtoReflect.Descriptor instead.
+func (*CloudInitConfig) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CloudInitConfig) GetUserData() string {
+	if x != nil {
+		return x.UserData
+	}
+	return ""
+}
+
+func (x *CloudInitConfig) GetMetaData() string {
+	if x != nil {
+		return x.MetaData
+	}
+	return ""
+}
+
+func (x *CloudInitConfig) GetNetworkConfig() string {
+	if x != nil {
+		return x.NetworkConfig
+	}
+	return ""
+}
+
+func (x *CloudInitConfig) GetVendorData() string {
+	if x != nil {
+		return x.VendorData
+	}
+	return ""
+}
+
+type ManagedVolumeSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd
+	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	AccessMode    string                 `protobuf:"bytes,4,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	MountPath     string                 `protobuf:"bytes,6,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,7,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	RetainPolicy  string                 `protobuf:"bytes,8,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain
+	VolumeId      string                 `protobuf:"bytes,9,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`             // attach an existing volume (driver:name); its name, driver and size are used
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManagedVolumeSpec) Reset() {
+	*x = ManagedVolumeSpec{}
+	mi := &file_control_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManagedVolumeSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManagedVolumeSpec) ProtoMessage() {}
+
+func (x *ManagedVolumeSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManagedVolumeSpec.ProtoReflect.Descriptor instead.
+func (*ManagedVolumeSpec) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ManagedVolumeSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *ManagedVolumeSpec) GetAccessMode() string {
+	if x != nil {
+		return x.AccessMode
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ManagedVolumeSpec) GetRetainPolicy() string {
+	if x != nil {
+		return x.RetainPolicy
+	}
+	return ""
+}
+
+func (x *ManagedVolumeSpec) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type WorkloadUsageSnapshot struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	CpuPercent     float64                `protobuf:"fixed64,3,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryBytes    int64                  `protobuf:"varint,4,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	DiskReadBytes  int64                  `protobuf:"varint,5,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes int64                  `protobuf:"varint,6,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	NetRxBytes     int64                  `protobuf:"varint,7,opt,name=net_rx_bytes,json=netRxBytes,proto3" json:"net_rx_bytes,omitempty"`
+	NetTxBytes     int64                  `protobuf:"varint,8,opt,name=net_tx_bytes,json=netTxBytes,proto3" json:"net_tx_bytes,omitempty"`
+	CollectedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Source         string                 `protobuf:"bytes,10,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkloadUsageSnapshot) Reset() {
+	*x = WorkloadUsageSnapshot{}
+	mi := &file_control_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadUsageSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*WorkloadUsageSnapshot) ProtoMessage() {}
 
-func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[25]
+func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadUsageSnapshot.ProtoReflect.Descriptor instead.
+func (*WorkloadUsageSnapshot) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *WorkloadUsageSnapshot) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *WorkloadUsageSnapshot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkloadUsageSnapshot) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetDiskReadBytes() int64 {
+	if x != nil {
+		return x.DiskReadBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetDiskWriteBytes() int64 {
+	if x != nil {
+		return x.DiskWriteBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetNetRxBytes() int64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetNetTxBytes() int64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsageSnapshot) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *WorkloadUsageSnapshot) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ReasonDetail struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	LastTransition *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
+	NextRetryAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`
+	Retryable      bool                   `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReasonDetail) Reset() {
+	*x = ReasonDetail{}
+	mi := &file_control_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReasonDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReasonDetail) ProtoMessage() {}
+
+func (x *ReasonDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReasonDetail.ProtoReflect.Descriptor instead.
+func (*ReasonDetail) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReasonDetail) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReasonDetail) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReasonDetail) GetLastTransition() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransition
+	}
+	return nil
+}
+
+func (x *ReasonDetail) GetNextRetryAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return nil
+}
+
+func (x *ReasonDetail) GetRetryable() bool {
+	if x != nil {
+		return x.Retryable
+	}
+	return false
+}
+
+type WorkloadStatus struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	State          string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // Running, Stopped, Failed
+	FailureReason  FailureReason          `protobuf:"varint,3,opt,name=failure_reason,json=failureReason,proto3,enum=persys.control.v1.FailureReason" json:"failure_reason,omitempty"`
+	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	LastTransition *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
+	Reason         *ReasonDetail          `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Usage          *WorkloadUsageSnapshot `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
+	ProbeResults   []*ProbeResult         `protobuf:"bytes,8,rep,name=probe_results,json=probeResults,proto3" json:"probe_results,omitempty"`
+	// Set once the workload's process has ended on its own; exit_code is the
+	// code it ended with.
+	Exited        bool   `protobuf:"varint,9,opt,name=exited,proto3" json:"exited,omitempty"`
+	ExitCode      int32  `protobuf:"varint,10,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	RevisionId    string `protobuf:"bytes,11,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"` // revision the agent has applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadStatus) Reset() {
+	*x = WorkloadStatus{}
+	mi := &file_control_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadStatus) ProtoMessage() {}
+
+func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
+func (*WorkloadStatus) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WorkloadStatus) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetFailureReason() FailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return FailureReason_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *WorkloadStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *WorkloadStatus) GetLastTransition() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransition
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetReason() *ReasonDetail {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetUsage() *WorkloadUsageSnapshot {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetProbeResults() []*ProbeResult {
+	if x != nil {
+		return x.ProbeResults
+	}
+	return nil
+}
+
+func (x *WorkloadStatus) GetExited() bool {
+	if x != nil {
+		return x.Exited
+	}
+	return false
+}
+
+func (x *WorkloadStatus) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *WorkloadStatus) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+type RetryWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWorkloadRequest) Reset() {
+	*x = RetryWorkloadRequest{}
+	mi := &file_control_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWorkloadRequest) ProtoMessage() {}
+
+func (x *RetryWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*RetryWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RetryWorkloadRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+type RetryWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryWorkloadResponse) Reset() {
+	*x = RetryWorkloadResponse{}
+	mi := &file_control_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryWorkloadResponse) ProtoMessage() {}
+
+func (x *RetryWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*RetryWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RetryWorkloadResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+type ListNodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional filter: Ready | NotReady | Draining
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesRequest) Reset() {
+	*x = ListNodesRequest{}
+	mi := &file_control_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesRequest) ProtoMessage() {}
+
+func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
+func (*ListNodesRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListNodesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeRequest) Reset() {
+	*x = GetNodeRequest{}
+	mi := &file_control_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeRequest) ProtoMessage() {}
+
+func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetNodeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type ListNodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Nodes         []*NodeView            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNodesResponse) Reset() {
+	*x = ListNodesResponse{}
+	mi := &file_control_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNodesResponse) ProtoMessage() {}
+
+func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
+func (*ListNodesResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListNodesResponse) GetNodes() []*NodeView {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+type GetNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Node          *NodeView              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNodeResponse) Reset() {
+	*x = GetNodeResponse{}
+	mi := &file_control_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeResponse) ProtoMessage() {}
+
+func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetNodeResponse) GetNode() *NodeView {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type NodeView struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	NodeId                 string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status                 string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StatusReason           string                 `protobuf:"bytes,3,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
+	StatusUpdatedBy        string                 `protobuf:"bytes,4,opt,name=status_updated_by,json=statusUpdatedBy,proto3" json:"status_updated_by,omitempty"`
+	StatusUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
+	LastHeartbeat          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	GrpcEndpoint           string                 `protobuf:"bytes,7,opt,name=grpc_endpoint,json=grpcEndpoint,proto3" json:"grpc_endpoint,omitempty"`
+	TotalCpuCores          float64                `protobuf:"fixed64,8,opt,name=total_cpu_cores,json=totalCpuCores,proto3" json:"total_cpu_cores,omitempty"`
+	AvailableCpuCores      float64                `protobuf:"fixed64,9,opt,name=available_cpu_cores,json=availableCpuCores,proto3" json:"available_cpu_cores,omitempty"`
+	TotalMemoryMb          int64                  `protobuf:"varint,10,opt,name=total_memory_mb,json=totalMemoryMb,proto3" json:"total_memory_mb,omitempty"`
+	AvailableMemoryMb      int64                  `protobuf:"varint,11,opt,name=available_memory_mb,json=availableMemoryMb,proto3" json:"available_memory_mb,omitempty"`
+	SupportedWorkloadTypes []string               `protobuf:"bytes,12,rep,name=supported_workload_types,json=supportedWorkloadTypes,proto3" json:"supported_workload_types,omitempty"`
+	Labels                 map[string]string      `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	StoragePools           []*StoragePoolStatus   `protobuf:"bytes,14,rep,name=storage_pools,json=storagePools,proto3" json:"storage_pools,omitempty"`
+	Unschedulable          bool                   `protobuf:"varint,15,opt,name=unschedulable,proto3" json:"unschedulable,omitempty"`
+	Taints                 []*Taint               `protobuf:"bytes,16,rep,name=taints,proto3" json:"taints,omitempty"`
+	Drain                  *NodeDrainStatus       `protobuf:"bytes,17,opt,name=drain,proto3" json:"drain,omitempty"`
+	// Reserved by the scheduler's allocation ledger for assigned workloads.
+	AllocatedCpuCores  float64 `protobuf:"fixed64,18,opt,name=allocated_cpu_cores,json=allocatedCpuCores,proto3" json:"allocated_cpu_cores,omitempty"`
+	AllocatedMemoryMb  int64   `protobuf:"varint,19,opt,name=allocated_memory_mb,json=allocatedMemoryMb,proto3" json:"allocated_memory_mb,omitempty"`
+	AllocatedDiskGb    int64   `protobuf:"varint,20,opt,name=allocated_disk_gb,json=allocatedDiskGb,proto3" json:"allocated_disk_gb,omitempty"`
+	AllocatedWorkloads int32   `protobuf:"varint,21,opt,name=allocated_workloads,json=allocatedWorkloads,proto3" json:"allocated_workloads,omitempty"`
+	// Actually in use, as reported by the agent heartbeat.
+	UsedCpuCores float64 `protobuf:"fixed64,22,opt,name=used_cpu_cores,json=usedCpuCores,proto3" json:"used_cpu_cores,omitempty"`
+	UsedMemoryMb int64   `protobuf:"varint,23,opt,name=used_memory_mb,json=usedMemoryMb,proto3" json:"used_memory_mb,omitempty"`
+	UsedDiskGb   int64   `protobuf:"varint,24,opt,name=used_disk_gb,json=usedDiskGb,proto3" json:"used_disk_gb,omitempty"`
+	// Set with SetNodeTaints; taints holds these merged with the agent's own.
+	OperatorTaints []*Taint `protobuf:"bytes,25,rep,name=operator_taints,json=operatorTaints,proto3" json:"operator_taints,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeView) Reset() {
+	*x = NodeView{}
+	mi := &file_control_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeView) ProtoMessage() {}
+
+func (x *NodeView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeView.ProtoReflect.Descriptor instead.
+func (*NodeView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NodeView) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *NodeView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NodeView) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *NodeView) GetStatusUpdatedBy() string {
+	if x != nil {
+		return x.StatusUpdatedBy
+	}
+	return ""
+}
+
+func (x *NodeView) GetStatusUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusUpdatedAt
+	}
+	return nil
+}
+
+func (x *NodeView) GetLastHeartbeat() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHeartbeat
+	}
+	return nil
+}
+
+func (x *NodeView) GetGrpcEndpoint() string {
+	if x != nil {
+		return x.GrpcEndpoint
+	}
+	return ""
+}
+
+func (x *NodeView) GetTotalCpuCores() float64 {
+	if x != nil {
+		return x.TotalCpuCores
+	}
+	return 0
+}
+
+func (x *NodeView) GetAvailableCpuCores() float64 {
+	if x != nil {
+		return x.AvailableCpuCores
+	}
+	return 0
+}
+
+func (x *NodeView) GetTotalMemoryMb() int64 {
+	if x != nil {
+		return x.TotalMemoryMb
+	}
+	return 0
+}
+
+func (x *NodeView) GetAvailableMemoryMb() int64 {
+	if x != nil {
+		return x.AvailableMemoryMb
+	}
+	return 0
+}
+
+func (x *NodeView) GetSupportedWorkloadTypes() []string {
+	if x != nil {
+		return x.SupportedWorkloadTypes
+	}
+	return nil
+}
+
+func (x *NodeView) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodeView) GetStoragePools() []*StoragePoolStatus {
+	if x != nil {
+		return x.StoragePools
+	}
+	return nil
+}
+
+func (x *NodeView) GetUnschedulable() bool {
+	if x != nil {
+		return x.Unschedulable
+	}
+	return false
+}
+
+func (x *NodeView) GetTaints() []*Taint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *NodeView) GetDrain() *NodeDrainStatus {
+	if x != nil {
+		return x.Drain
+	}
+	return nil
+}
+
+func (x *NodeView) GetAllocatedCpuCores() float64 {
+	if x != nil {
+		return x.AllocatedCpuCores
+	}
+	return 0
+}
+
+func (x *NodeView) GetAllocatedMemoryMb() int64 {
+	if x != nil {
+		return x.AllocatedMemoryMb
+	}
+	return 0
+}
+
+func (x *NodeView) GetAllocatedDiskGb() int64 {
+	if x != nil {
+		return x.AllocatedDiskGb
+	}
+	return 0
+}
+
+func (x *NodeView) GetAllocatedWorkloads() int32 {
+	if x != nil {
+		return x.AllocatedWorkloads
+	}
+	return 0
+}
+
+func (x *NodeView) GetUsedCpuCores() float64 {
+	if x != nil {
+		return x.UsedCpuCores
+	}
+	return 0
+}
+
+func (x *NodeView) GetUsedMemoryMb() int64 {
+	if x != nil {
+		return x.UsedMemoryMb
+	}
+	return 0
+}
+
+func (x *NodeView) GetUsedDiskGb() int64 {
+	if x != nil {
+		return x.UsedDiskGb
+	}
+	return 0
+}
+
+func (x *NodeView) GetOperatorTaints() []*Taint {
+	if x != nil {
+		return x.OperatorTaints
+	}
+	return nil
+}
+
+type NodeDrainStatus struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	State               string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"` // Draining | Drained
+	Reason              string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxUnavailable      int32                  `protobuf:"varint,3,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	StartedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	Total               int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Migrated            int32                  `protobuf:"varint,7,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	Failed              int32                  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	Remaining           int32                  `protobuf:"varint,9,opt,name=remaining,proto3" json:"remaining,omitempty"`                                                    // still on the node
+	InFlightWorkloadIds []string               `protobuf:"bytes,10,rep,name=in_flight_workload_ids,json=inFlightWorkloadIds,proto3" json:"in_flight_workload_ids,omitempty"` // moved, not Running elsewhere yet
+	LastError           string                 `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *NodeDrainStatus) Reset() {
+	*x = NodeDrainStatus{}
+	mi := &file_control_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeDrainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeDrainStatus) ProtoMessage() {}
+
+func (x *NodeDrainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeDrainStatus.ProtoReflect.Descriptor instead.
+func (*NodeDrainStatus) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NodeDrainStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *NodeDrainStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *NodeDrainStatus) GetMaxUnavailable() int32 {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *NodeDrainStatus) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *NodeDrainStatus) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetMigrated() int32 {
+	if x != nil {
+		return x.Migrated
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *NodeDrainStatus) GetInFlightWorkloadIds() []string {
+	if x != nil {
+		return x.InFlightWorkloadIds
+	}
+	return nil
+}
+
+func (x *NodeDrainStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type StoragePoolStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	TotalGb       int64                  `protobuf:"varint,3,opt,name=total_gb,json=totalGb,proto3" json:"total_gb,omitempty"`
+	AllocatedGb   int64                  `protobuf:"varint,4,opt,name=allocated_gb,json=allocatedGb,proto3" json:"allocated_gb,omitempty"`
+	UsedGb        int64                  `protobuf:"varint,5,opt,name=used_gb,json=usedGb,proto3" json:"used_gb,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoragePoolStatus) Reset() {
+	*x = StoragePoolStatus{}
+	mi := &file_control_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoragePoolStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePoolStatus) ProtoMessage() {}
+
+func (x *StoragePoolStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePoolStatus.ProtoReflect.Descriptor instead.
+func (*StoragePoolStatus) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StoragePoolStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoragePoolStatus) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StoragePoolStatus) GetTotalGb() int64 {
+	if x != nil {
+		return x.TotalGb
+	}
+	return 0
+}
+
+func (x *StoragePoolStatus) GetAllocatedGb() int64 {
+	if x != nil {
+		return x.AllocatedGb
+	}
+	return 0
+}
+
+func (x *StoragePoolStatus) GetUsedGb() int64 {
+	if x != nil {
+		return x.UsedGb
+	}
+	return 0
+}
+
+type ListWorkloadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // optional filter
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // optional filter
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`         // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkloadsRequest) Reset() {
+	*x = ListWorkloadsRequest{}
+	mi := &file_control_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkloadsRequest) ProtoMessage() {}
+
+func (x *ListWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListWorkloadsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListWorkloadsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListWorkloadsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type GetWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // optional; the workload must be in it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadRequest) Reset() {
+	*x = GetWorkloadRequest{}
+	mi := &file_control_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadRequest) ProtoMessage() {}
+
+func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetWorkloadRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *GetWorkloadRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListWorkloadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     []*WorkloadView        `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWorkloadsResponse) Reset() {
+	*x = ListWorkloadsResponse{}
+	mi := &file_control_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWorkloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkloadsResponse) ProtoMessage() {}
+
+func (x *ListWorkloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkloadsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListWorkloadsResponse) GetWorkloads() []*WorkloadView {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+type GetWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workload      *WorkloadView          `protobuf:"bytes,1,opt,name=workload,proto3" json:"workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadResponse) Reset() {
+	*x = GetWorkloadResponse{}
+	mi := &file_control_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadResponse) ProtoMessage() {}
+
+func (x *GetWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetWorkloadResponse) GetWorkload() *WorkloadView {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+type WorkloadView struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId       string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DesiredState     string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedNodeId   string                 `protobuf:"bytes,5,opt,name=assigned_node_id,json=assignedNodeId,proto3" json:"assigned_node_id,omitempty"`
+	RevisionId       string                 `protobuf:"bytes,6,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	RetryAttempts    int32                  `protobuf:"varint,7,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
+	RetryMaxAttempts int32                  `protobuf:"varint,8,opt,name=retry_max_attempts,json=retryMaxAttempts,proto3" json:"retry_max_attempts,omitempty"`
+	RetryNextAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=retry_next_at,json=retryNextAt,proto3" json:"retry_next_at,omitempty"`
+	FailureReason    string                 `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	LastUpdated      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Reason           *ReasonDetail          `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Usage            *WorkloadUsageSnapshot `protobuf:"bytes,13,opt,name=usage,proto3" json:"usage,omitempty"`
+	Priority         int32                  `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	PriorityClass    string                 `protobuf:"bytes,15,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	Rollout          *RolloutStatusView     `protobuf:"bytes,16,opt,name=rollout,proto3" json:"rollout,omitempty"`
+	// Running, not failing liveness and, with a readiness probe, passing it.
+	Ready     bool                `protobuf:"varint,17,opt,name=ready,proto3" json:"ready,omitempty"`
+	Health    *WorkloadHealthView `protobuf:"bytes,18,opt,name=health,proto3" json:"health,omitempty"`
+	Namespace string              `protobuf:"bytes,19,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Port mappings with the host ports reserved on the assigned node.
+	Ports         []*Port `protobuf:"bytes,20,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkloadView) Reset() {
+	*x = WorkloadView{}
+	mi := &file_control_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadView) ProtoMessage() {}
+
+func (x *WorkloadView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadView.ProtoReflect.Descriptor instead.
+func (*WorkloadView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WorkloadView) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetAssignedNodeId() string {
+	if x != nil {
+		return x.AssignedNodeId
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetRetryAttempts() int32 {
+	if x != nil {
+		return x.RetryAttempts
+	}
+	return 0
+}
+
+func (x *WorkloadView) GetRetryMaxAttempts() int32 {
+	if x != nil {
+		return x.RetryMaxAttempts
+	}
+	return 0
+}
+
+func (x *WorkloadView) GetRetryNextAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetryNextAt
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetLastUpdated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetReason() *ReasonDetail {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetUsage() *WorkloadUsageSnapshot {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *WorkloadView) GetPriorityClass() string {
+	if x != nil {
+		return x.PriorityClass
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetRollout() *RolloutStatusView {
+	if x != nil {
+		return x.Rollout
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *WorkloadView) GetHealth() *WorkloadHealthView {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+func (x *WorkloadView) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *WorkloadView) GetPorts() []*Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type WorkloadHealthView struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Liveness          *ProbeStateView        `protobuf:"bytes,1,opt,name=liveness,proto3" json:"liveness,omitempty"`
+	Readiness         *ProbeStateView        `protobuf:"bytes,2,opt,name=readiness,proto3" json:"readiness,omitempty"`
+	Restarts          int32                  `protobuf:"varint,3,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastRestartAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_restart_at,json=lastRestartAt,proto3" json:"last_restart_at,omitempty"`
+	LastRestartReason string                 `protobuf:"bytes,5,opt,name=last_restart_reason,json=lastRestartReason,proto3" json:"last_restart_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WorkloadHealthView) Reset() {
+	*x = WorkloadHealthView{}
+	mi := &file_control_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkloadHealthView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkloadHealthView) ProtoMessage() {}
+
+func (x *WorkloadHealthView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkloadHealthView.ProtoReflect.Descriptor instead.
+func (*WorkloadHealthView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WorkloadHealthView) GetLiveness() *ProbeStateView {
+	if x != nil {
+		return x.Liveness
+	}
+	return nil
+}
+
+func (x *WorkloadHealthView) GetReadiness() *ProbeStateView {
+	if x != nil {
+		return x.Readiness
+	}
+	return nil
+}
+
+func (x *WorkloadHealthView) GetRestarts() int32 {
+	if x != nil {
+		return x.Restarts
+	}
+	return 0
+}
+
+func (x *WorkloadHealthView) GetLastRestartAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRestartAt
+	}
+	return nil
+}
+
+func (x *WorkloadHealthView) GetLastRestartReason() string {
+	if x != nil {
+		return x.LastRestartReason
+	}
+	return ""
+}
+
+type ProbeStateView struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Status               string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Unknown | Passing | Failing
+	ConsecutiveSuccesses int32                  `protobuf:"varint,2,opt,name=consecutive_successes,json=consecutiveSuccesses,proto3" json:"consecutive_successes,omitempty"`
+	ConsecutiveFailures  int32                  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastMessage          string                 `protobuf:"bytes,4,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	LastCheckedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_checked_at,json=lastCheckedAt,proto3" json:"last_checked_at,omitempty"`
+	LastTransitionAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_transition_at,json=lastTransitionAt,proto3" json:"last_transition_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ProbeStateView) Reset() {
+	*x = ProbeStateView{}
+	mi := &file_control_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProbeStateView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeStateView) ProtoMessage() {}
+
+func (x *ProbeStateView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeStateView.ProtoReflect.Descriptor instead.
+func (*ProbeStateView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ProbeStateView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProbeStateView) GetConsecutiveSuccesses() int32 {
+	if x != nil {
+		return x.ConsecutiveSuccesses
+	}
+	return 0
+}
+
+func (x *ProbeStateView) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *ProbeStateView) GetLastMessage() string {
+	if x != nil {
+		return x.LastMessage
+	}
+	return ""
+}
+
+func (x *ProbeStateView) GetLastCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastCheckedAt
+	}
+	return nil
+}
+
+func (x *ProbeStateView) GetLastTransitionAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionAt
+	}
+	return nil
+}
+
+type RolloutStatusView struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Strategy           string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Revision           int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	RevisionId         string                 `protobuf:"bytes,3,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	PreviousRevisionId string                 `protobuf:"bytes,4,opt,name=previous_revision_id,json=previousRevisionId,proto3" json:"previous_revision_id,omitempty"`
+	Phase              string                 `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"` // Progressing | Complete | Failed
+	Message            string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	StartedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RolloutStatusView) Reset() {
+	*x = RolloutStatusView{}
+	mi := &file_control_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RolloutStatusView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RolloutStatusView) ProtoMessage() {}
+
+func (x *RolloutStatusView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RolloutStatusView.ProtoReflect.Descriptor instead.
+func (*RolloutStatusView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RolloutStatusView) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *RolloutStatusView) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RolloutStatusView) GetRevisionId() string {
+	if x != nil {
+		return x.RevisionId
+	}
+	return ""
+}
+
+func (x *RolloutStatusView) GetPreviousRevisionId() string {
+	if x != nil {
+		return x.PreviousRevisionId
+	}
+	return ""
+}
+
+func (x *RolloutStatusView) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *RolloutStatusView) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RolloutStatusView) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *RolloutStatusView) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type GetClusterSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterSummaryRequest) Reset() {
+	*x = GetClusterSummaryRequest{}
+	mi := &file_control_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterSummaryRequest) ProtoMessage() {}
+
+func (x *GetClusterSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{60}
+}
+
+type GetClusterSummaryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TotalNodes         int32                  `protobuf:"varint,1,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
+	ReadyNodes         int32                  `protobuf:"varint,2,opt,name=ready_nodes,json=readyNodes,proto3" json:"ready_nodes,omitempty"`
+	NotReadyNodes      int32                  `protobuf:"varint,3,opt,name=not_ready_nodes,json=notReadyNodes,proto3" json:"not_ready_nodes,omitempty"`
+	TotalWorkloads     int32                  `protobuf:"varint,4,opt,name=total_workloads,json=totalWorkloads,proto3" json:"total_workloads,omitempty"`
+	RunningWorkloads   int32                  `protobuf:"varint,5,opt,name=running_workloads,json=runningWorkloads,proto3" json:"running_workloads,omitempty"`
+	PendingWorkloads   int32                  `protobuf:"varint,6,opt,name=pending_workloads,json=pendingWorkloads,proto3" json:"pending_workloads,omitempty"`
+	FailedWorkloads    int32                  `protobuf:"varint,7,opt,name=failed_workloads,json=failedWorkloads,proto3" json:"failed_workloads,omitempty"`
+	DeletedWorkloads   int32                  `protobuf:"varint,8,opt,name=deleted_workloads,json=deletedWorkloads,proto3" json:"deleted_workloads,omitempty"`
+	GeneratedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	ReadyWorkloads     int32                  `protobuf:"varint,10,opt,name=ready_workloads,json=readyWorkloads,proto3" json:"ready_workloads,omitempty"`
+	UnhealthyWorkloads int32                  `protobuf:"varint,11,opt,name=unhealthy_workloads,json=unhealthyWorkloads,proto3" json:"unhealthy_workloads,omitempty"` // running but not ready
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetClusterSummaryResponse) Reset() {
+	*x = GetClusterSummaryResponse{}
+	mi := &file_control_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterSummaryResponse) ProtoMessage() {}
+
+func (x *GetClusterSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetClusterSummaryResponse) GetTotalNodes() int32 {
+	if x != nil {
+		return x.TotalNodes
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetReadyNodes() int32 {
+	if x != nil {
+		return x.ReadyNodes
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetNotReadyNodes() int32 {
+	if x != nil {
+		return x.NotReadyNodes
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetTotalWorkloads() int32 {
+	if x != nil {
+		return x.TotalWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetRunningWorkloads() int32 {
+	if x != nil {
+		return x.RunningWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetPendingWorkloads() int32 {
+	if x != nil {
+		return x.PendingWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetFailedWorkloads() int32 {
+	if x != nil {
+		return x.FailedWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetDeletedWorkloads() int32 {
+	if x != nil {
+		return x.DeletedWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *GetClusterSummaryResponse) GetReadyWorkloads() int32 {
+	if x != nil {
+		return x.ReadyWorkloads
+	}
+	return 0
+}
+
+func (x *GetClusterSummaryResponse) GetUnhealthyWorkloads() int32 {
+	if x != nil {
+		return x.UnhealthyWorkloads
+	}
+	return 0
+}
+
+type ApplyReplicaSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSetId  string                 `protobuf:"bytes,1,opt,name=replica_set_id,json=replicaSetId,proto3" json:"replica_set_id,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Template      *WorkloadSpec          `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,4,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"` // Running | Stopped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyReplicaSetRequest) Reset() {
+	*x = ApplyReplicaSetRequest{}
+	mi := &file_control_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyReplicaSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyReplicaSetRequest) ProtoMessage() {}
+
+func (x *ApplyReplicaSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyReplicaSetRequest.ProtoReflect.Descriptor instead.
+func (*ApplyReplicaSetRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ApplyReplicaSetRequest) GetReplicaSetId() string {
+	if x != nil {
+		return x.ReplicaSetId
+	}
+	return ""
+}
+
+func (x *ApplyReplicaSetRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *ApplyReplicaSetRequest) GetTemplate() *WorkloadSpec {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *ApplyReplicaSetRequest) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+type ApplyReplicaSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ReplicaSet    *ReplicaSetView        `protobuf:"bytes,3,opt,name=replica_set,json=replicaSet,proto3" json:"replica_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyReplicaSetResponse) Reset() {
+	*x = ApplyReplicaSetResponse{}
+	mi := &file_control_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyReplicaSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyReplicaSetResponse) ProtoMessage() {}
+
+func (x *ApplyReplicaSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyReplicaSetResponse.ProtoReflect.Descriptor instead.
+func (*ApplyReplicaSetResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApplyReplicaSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyReplicaSetResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyReplicaSetResponse) GetReplicaSet() *ReplicaSetView {
+	if x != nil {
+		return x.ReplicaSet
+	}
+	return nil
+}
+
+type ScaleReplicaSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSetId  string                 `protobuf:"bytes,1,opt,name=replica_set_id,json=replicaSetId,proto3" json:"replica_set_id,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleReplicaSetRequest) Reset() {
+	*x = ScaleReplicaSetRequest{}
+	mi := &file_control_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleReplicaSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleReplicaSetRequest) ProtoMessage() {}
+
+func (x *ScaleReplicaSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleReplicaSetRequest.ProtoReflect.Descriptor instead.
+func (*ScaleReplicaSetRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ScaleReplicaSetRequest) GetReplicaSetId() string {
+	if x != nil {
+		return x.ReplicaSetId
+	}
+	return ""
+}
+
+func (x *ScaleReplicaSetRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type ScaleReplicaSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ReplicaSet    *ReplicaSetView        `protobuf:"bytes,3,opt,name=replica_set,json=replicaSet,proto3" json:"replica_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleReplicaSetResponse) Reset() {
+	*x = ScaleReplicaSetResponse{}
+	mi := &file_control_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleReplicaSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleReplicaSetResponse) ProtoMessage() {}
+
+func (x *ScaleReplicaSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleReplicaSetResponse.ProtoReflect.Descriptor instead.
+func (*ScaleReplicaSetResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ScaleReplicaSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ScaleReplicaSetResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ScaleReplicaSetResponse) GetReplicaSet() *ReplicaSetView {
+	if x != nil {
+		return x.ReplicaSet
+	}
+	return nil
+}
+
+type DeleteReplicaSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSetId  string                 `protobuf:"bytes,1,opt,name=replica_set_id,json=replicaSetId,proto3" json:"replica_set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplicaSetRequest) Reset() {
+	*x = DeleteReplicaSetRequest{}
+	mi := &file_control_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplicaSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplicaSetRequest) ProtoMessage() {}
+
+func (x *DeleteReplicaSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplicaSetRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReplicaSetRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteReplicaSetRequest) GetReplicaSetId() string {
+	if x != nil {
+		return x.ReplicaSetId
+	}
+	return ""
+}
+
+type DeleteReplicaSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplicaSetResponse) Reset() {
+	*x = DeleteReplicaSetResponse{}
+	mi := &file_control_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplicaSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplicaSetResponse) ProtoMessage() {}
+
+func (x *DeleteReplicaSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplicaSetResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReplicaSetResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteReplicaSetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteReplicaSetResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetReplicaSetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSetId  string                 `protobuf:"bytes,1,opt,name=replica_set_id,json=replicaSetId,proto3" json:"replica_set_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicaSetRequest) Reset() {
+	*x = GetReplicaSetRequest{}
+	mi := &file_control_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicaSetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicaSetRequest) ProtoMessage() {}
+
+func (x *GetReplicaSetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicaSetRequest.ProtoReflect.Descriptor instead.
+func (*GetReplicaSetRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetReplicaSetRequest) GetReplicaSetId() string {
+	if x != nil {
+		return x.ReplicaSetId
+	}
+	return ""
+}
+
+type GetReplicaSetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSet    *ReplicaSetView        `protobuf:"bytes,1,opt,name=replica_set,json=replicaSet,proto3" json:"replica_set,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReplicaSetResponse) Reset() {
+	*x = GetReplicaSetResponse{}
+	mi := &file_control_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReplicaSetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReplicaSetResponse) ProtoMessage() {}
+
+func (x *GetReplicaSetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReplicaSetResponse.ProtoReflect.Descriptor instead.
+func (*GetReplicaSetResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetReplicaSetResponse) GetReplicaSet() *ReplicaSetView {
+	if x != nil {
+		return x.ReplicaSet
+	}
+	return nil
+}
+
+type ListReplicaSetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplicaSetsRequest) Reset() {
+	*x = ListReplicaSetsRequest{}
+	mi := &file_control_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplicaSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicaSetsRequest) ProtoMessage() {}
+
+func (x *ListReplicaSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplicaSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListReplicaSetsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{70}
+}
+
+type ListReplicaSetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSets   []*ReplicaSetView      `protobuf:"bytes,1,rep,name=replica_sets,json=replicaSets,proto3" json:"replica_sets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplicaSetsResponse) Reset() {
+	*x = ListReplicaSetsResponse{}
+	mi := &file_control_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplicaSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplicaSetsResponse) ProtoMessage() {}
+
+func (x *ListReplicaSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplicaSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListReplicaSetsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListReplicaSetsResponse) GetReplicaSets() []*ReplicaSetView {
+	if x != nil {
+		return x.ReplicaSets
+	}
+	return nil
+}
+
+type ReplicaSetView struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReplicaSetId     string                 `protobuf:"bytes,1,opt,name=replica_set_id,json=replicaSetId,proto3" json:"replica_set_id,omitempty"`
+	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DesiredState     string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	Replicas         int32                  `protobuf:"varint,4,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	CurrentReplicas  int32                  `protobuf:"varint,5,opt,name=current_replicas,json=currentReplicas,proto3" json:"current_replicas,omitempty"`
+	ReadyReplicas    int32                  `protobuf:"varint,6,opt,name=ready_replicas,json=readyReplicas,proto3" json:"ready_replicas,omitempty"`
+	TemplateRevision string                 `protobuf:"bytes,7,opt,name=template_revision,json=templateRevision,proto3" json:"template_revision,omitempty"`
+	WorkloadIds      []string               `protobuf:"bytes,8,rep,name=workload_ids,json=workloadIds,proto3" json:"workload_ids,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastScaledAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_scaled_at,json=lastScaledAt,proto3" json:"last_scaled_at,omitempty"`
+	UpdatedReplicas  int32                  `protobuf:"varint,12,opt,name=updated_replicas,json=updatedReplicas,proto3" json:"updated_replicas,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReplicaSetView) Reset() {
+	*x = ReplicaSetView{}
+	mi := &file_control_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplicaSetView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicaSetView) ProtoMessage() {}
+
+func (x *ReplicaSetView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicaSetView.ProtoReflect.Descriptor instead.
+func (*ReplicaSetView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ReplicaSetView) GetReplicaSetId() string {
+	if x != nil {
+		return x.ReplicaSetId
+	}
+	return ""
+}
+
+func (x *ReplicaSetView) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReplicaSetView) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *ReplicaSetView) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *ReplicaSetView) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+func (x *ReplicaSetView) GetReadyReplicas() int32 {
+	if x != nil {
+		return x.ReadyReplicas
+	}
+	return 0
+}
+
+func (x *ReplicaSetView) GetTemplateRevision() string {
+	if x != nil {
+		return x.TemplateRevision
+	}
+	return ""
+}
+
+func (x *ReplicaSetView) GetWorkloadIds() []string {
+	if x != nil {
+		return x.WorkloadIds
+	}
+	return nil
+}
+
+func (x *ReplicaSetView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReplicaSetView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ReplicaSetView) GetLastScaledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScaledAt
+	}
+	return nil
+}
+
+func (x *ReplicaSetView) GetUpdatedReplicas() int32 {
+	if x != nil {
+		return x.UpdatedReplicas
+	}
+	return 0
+}
+
+// Zero fields are unlimited.
+type ResourceQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CpuCores      float64                `protobuf:"fixed64,1,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryMb      int64                  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	DiskGb        int64                  `protobuf:"varint,3,opt,name=disk_gb,json=diskGb,proto3" json:"disk_gb,omitempty"`
+	Workloads     int32                  `protobuf:"varint,4,opt,name=workloads,proto3" json:"workloads,omitempty"`
+	Vms           int32                  `protobuf:"varint,5,opt,name=vms,proto3" json:"vms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceQuota) Reset() {
+	*x = ResourceQuota{}
+	mi := &file_control_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceQuota) ProtoMessage() {}
+
+func (x *ResourceQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceQuota.ProtoReflect.Descriptor instead.
+func (*ResourceQuota) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ResourceQuota) GetCpuCores() float64 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *ResourceQuota) GetMemoryMb() int64 {
+	if x != nil {
+		return x.MemoryMb
+	}
+	return 0
+}
+
+func (x *ResourceQuota) GetDiskGb() int64 {
+	if x != nil {
+		return x.DiskGb
+	}
+	return 0
+}
+
+func (x *ResourceQuota) GetWorkloads() int32 {
+	if x != nil {
+		return x.Workloads
+	}
+	return 0
+}
+
+func (x *ResourceQuota) GetVms() int32 {
+	if x != nil {
+		return x.Vms
+	}
+	return 0
+}
+
+type ApplyNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Quota         *ResourceQuota         `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyNamespaceRequest) Reset() {
+	*x = ApplyNamespaceRequest{}
+	mi := &file_control_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNamespaceRequest) ProtoMessage() {}
+
+func (x *ApplyNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*ApplyNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ApplyNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyNamespaceRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ApplyNamespaceRequest) GetQuota() *ResourceQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type ApplyNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Namespace     *NamespaceView         `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyNamespaceResponse) Reset() {
+	*x = ApplyNamespaceResponse{}
+	mi := &file_control_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyNamespaceResponse) ProtoMessage() {}
+
+func (x *ApplyNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*ApplyNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ApplyNamespaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyNamespaceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyNamespaceResponse) GetNamespace() *NamespaceView {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type DeleteNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceRequest) Reset() {
+	*x = DeleteNamespaceRequest{}
+	mi := &file_control_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceRequest) ProtoMessage() {}
+
+func (x *DeleteNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteNamespaceResponse) Reset() {
+	*x = DeleteNamespaceResponse{}
+	mi := &file_control_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteNamespaceResponse) ProtoMessage() {}
+
+func (x *DeleteNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeleteNamespaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteNamespaceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceRequest) Reset() {
+	*x = GetNamespaceRequest{}
+	mi := &file_control_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceRequest) ProtoMessage() {}
+
+func (x *GetNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     *NamespaceView         `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceResponse) Reset() {
+	*x = GetNamespaceResponse{}
+	mi := &file_control_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceResponse) ProtoMessage() {}
+
+func (x *GetNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetNamespaceResponse) GetNamespace() *NamespaceView {
+	if x != nil {
+		return x.Namespace
+	}
+	return nil
+}
+
+type ListNamespacesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesRequest) Reset() {
+	*x = ListNamespacesRequest{}
+	mi := &file_control_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesRequest) ProtoMessage() {}
+
+func (x *ListNamespacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesRequest.ProtoReflect.Descriptor instead.
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{80}
+}
+
+type ListNamespacesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []*NamespaceView       `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNamespacesResponse) Reset() {
+	*x = ListNamespacesResponse{}
+	mi := &file_control_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNamespacesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNamespacesResponse) ProtoMessage() {}
+
+func (x *ListNamespacesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNamespacesResponse.ProtoReflect.Descriptor instead.
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListNamespacesResponse) GetNamespaces() []*NamespaceView {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type NamespaceView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Quota         *ResourceQuota         `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	Used          *ResourceQuota         `protobuf:"bytes,4,opt,name=used,proto3" json:"used,omitempty"` // requested by live workloads
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespaceView) Reset() {
+	*x = NamespaceView{}
+	mi := &file_control_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespaceView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespaceView) ProtoMessage() {}
+
+func (x *NamespaceView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespaceView.ProtoReflect.Descriptor instead.
+func (*NamespaceView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *NamespaceView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NamespaceView) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NamespaceView) GetQuota() *ResourceQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *NamespaceView) GetUsed() *ResourceQuota {
+	if x != nil {
+		return x.Used
+	}
+	return nil
+}
+
+func (x *NamespaceView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *NamespaceView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ApplySecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty means "default"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySecretRequest) Reset() {
+	*x = ApplySecretRequest{}
+	mi := &file_control_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySecretRequest) ProtoMessage() {}
+
+func (x *ApplySecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySecretRequest.ProtoReflect.Descriptor instead.
+func (*ApplySecretRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ApplySecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApplySecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplySecretRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ApplySecretRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApplySecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Secret        *SecretView            `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplySecretResponse) Reset() {
+	*x = ApplySecretResponse{}
+	mi := &file_control_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplySecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplySecretResponse) ProtoMessage() {}
+
+func (x *ApplySecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplySecretResponse.ProtoReflect.Descriptor instead.
+func (*ApplySecretResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ApplySecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplySecretResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplySecretResponse) GetSecret() *SecretView {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretRequest) Reset() {
+	*x = DeleteSecretRequest{}
+	mi := &file_control_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretRequest) ProtoMessage() {}
+
+func (x *DeleteSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *DeleteSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSecretResponse) Reset() {
+	*x = DeleteSecretResponse{}
+	mi := &file_control_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSecretResponse) ProtoMessage() {}
+
+func (x *DeleteSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSecretResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DeleteSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteSecretResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretRequest) Reset() {
+	*x = GetSecretRequest{}
+	mi := &file_control_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretRequest) ProtoMessage() {}
+
+func (x *GetSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretRequest.ProtoReflect.Descriptor instead.
+func (*GetSecretRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetSecretRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetSecretRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        *SecretView            `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSecretResponse) Reset() {
+	*x = GetSecretResponse{}
+	mi := &file_control_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretResponse) ProtoMessage() {}
+
+func (x *GetSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretResponse.ProtoReflect.Descriptor instead.
+func (*GetSecretResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetSecretResponse) GetSecret() *SecretView {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty lists all namespaces
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsRequest) Reset() {
+	*x = ListSecretsRequest{}
+	mi := &file_control_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsRequest) ProtoMessage() {}
+
+func (x *ListSecretsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsRequest.ProtoReflect.Descriptor instead.
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListSecretsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secrets       []*SecretView          `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSecretsResponse) Reset() {
+	*x = ListSecretsResponse{}
+	mi := &file_control_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSecretsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretsResponse) ProtoMessage() {}
+
+func (x *ListSecretsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretsResponse.ProtoReflect.Descriptor instead.
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListSecretsResponse) GetSecrets() []*SecretView {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
+// SecretView never carries values.
+type SecretView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Keys          []string               `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // bumped on every data change
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SecretView) Reset() {
+	*x = SecretView{}
+	mi := &file_control_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretView) ProtoMessage() {}
+
+func (x *SecretView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretView.ProtoReflect.Descriptor instead.
+func (*SecretView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SecretView) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SecretView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretView) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SecretView) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *SecretView) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SecretView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SecretView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ApplyConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty means "default"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigRequest) Reset() {
+	*x = ApplyConfigRequest{}
+	mi := &file_control_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigRequest) ProtoMessage() {}
+
+func (x *ApplyConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigRequest.ProtoReflect.Descriptor instead.
+func (*ApplyConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ApplyConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyConfigRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ApplyConfigRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ApplyConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Config        *ConfigView            `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfigResponse) Reset() {
+	*x = ApplyConfigResponse{}
+	mi := &file_control_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfigResponse) ProtoMessage() {}
+
+func (x *ApplyConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfigResponse.ProtoReflect.Descriptor instead.
+func (*ApplyConfigResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ApplyConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyConfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyConfigResponse) GetConfig() *ConfigView {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type DeleteConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConfigRequest) Reset() {
+	*x = DeleteConfigRequest{}
+	mi := &file_control_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigRequest) ProtoMessage() {}
+
+func (x *DeleteConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigRequest.ProtoReflect.Descriptor instead.
+func (*DeleteConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *DeleteConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteConfigResponse) Reset() {
+	*x = DeleteConfigResponse{}
+	mi := &file_control_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteConfigResponse) ProtoMessage() {}
+
+func (x *DeleteConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteConfigResponse.ProtoReflect.Descriptor instead.
+func (*DeleteConfigResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteConfigResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	mi := &file_control_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetConfigRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *ConfigView            `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigResponse) Reset() {
+	*x = GetConfigResponse{}
+	mi := &file_control_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigResponse) ProtoMessage() {}
+
+func (x *GetConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetConfigResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetConfigResponse) GetConfig() *ConfigView {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ListConfigsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty lists all namespaces
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsRequest) Reset() {
+	*x = ListConfigsRequest{}
+	mi := &file_control_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsRequest) ProtoMessage() {}
+
+func (x *ListConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListConfigsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListConfigsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Configs       []*ConfigView          `protobuf:"bytes,1,rep,name=configs,proto3" json:"configs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConfigsResponse) Reset() {
+	*x = ListConfigsResponse{}
+	mi := &file_control_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigsResponse) ProtoMessage() {}
+
+func (x *ListConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *ListConfigsResponse) GetConfigs() []*ConfigView {
+	if x != nil {
+		return x.Configs
+	}
+	return nil
+}
+
+type ConfigView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Data          map[string]string      `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigView) Reset() {
+	*x = ConfigView{}
+	mi := &file_control_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigView) ProtoMessage() {}
+
+func (x *ConfigView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigView.ProtoReflect.Descriptor instead.
+func (*ConfigView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ConfigView) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ConfigView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigView) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ConfigView) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ConfigView) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ConfigView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ConfigView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A service port resolves to the host port a selected workload publishes for
+// `port` (its container port); host_port is used for workloads that do not
+// publish it. Names are required when a service has several ports.
+type ServicePort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"` // tcp (default) or udp
+	Port          int32                  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	HostPort      int32                  `protobuf:"varint,4,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	mi := &file_control_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePort) ProtoMessage() {}
+
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ServicePort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServicePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ServicePort) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServicePort) GetHostPort() int32 {
+	if x != nil {
+		return x.HostPort
+	}
+	return 0
+}
+
+type ApplyServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty means "default"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Selector      map[string]string      `protobuf:"bytes,3,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ports         []*ServicePort         `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyServiceRequest) Reset() {
+	*x = ApplyServiceRequest{}
+	mi := &file_control_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyServiceRequest) ProtoMessage() {}
+
+func (x *ApplyServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyServiceRequest.ProtoReflect.Descriptor instead.
+func (*ApplyServiceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ApplyServiceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApplyServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyServiceRequest) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ApplyServiceRequest) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type ApplyServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Service       *ServiceView           `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyServiceResponse) Reset() {
+	*x = ApplyServiceResponse{}
+	mi := &file_control_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyServiceResponse) ProtoMessage() {}
+
+func (x *ApplyServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyServiceResponse.ProtoReflect.Descriptor instead.
+func (*ApplyServiceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ApplyServiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyServiceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyServiceResponse) GetService() *ServiceView {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type DeleteServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceRequest) Reset() {
+	*x = DeleteServiceRequest{}
+	mi := &file_control_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceRequest) ProtoMessage() {}
+
+func (x *DeleteServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServiceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeleteServiceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServiceResponse) Reset() {
+	*x = DeleteServiceResponse{}
+	mi := &file_control_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServiceResponse) ProtoMessage() {}
+
+func (x *DeleteServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServiceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServiceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DeleteServiceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteServiceResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetServiceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceRequest) Reset() {
+	*x = GetServiceRequest{}
+	mi := &file_control_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceRequest) ProtoMessage() {}
+
+func (x *GetServiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetServiceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetServiceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetServiceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       *ServiceView           `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceResponse) Reset() {
+	*x = GetServiceResponse{}
+	mi := &file_control_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceResponse) ProtoMessage() {}
+
+func (x *GetServiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetServiceResponse) GetService() *ServiceView {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+type ListServicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty lists all namespaces
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesRequest) Reset() {
+	*x = ListServicesRequest{}
+	mi := &file_control_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesRequest) ProtoMessage() {}
+
+func (x *ListServicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesRequest.ProtoReflect.Descriptor instead.
+func (*ListServicesRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ListServicesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListServicesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*ServiceView         `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListServicesResponse) Reset() {
+	*x = ListServicesResponse{}
+	mi := &file_control_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListServicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListServicesResponse) ProtoMessage() {}
+
+func (x *ListServicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListServicesResponse.ProtoReflect.Descriptor instead.
+func (*ListServicesResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ListServicesResponse) GetServices() []*ServiceView {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+type ServiceEndpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Host          string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32                  `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	PortName      string                 `protobuf:"bytes,5,opt,name=port_name,json=portName,proto3" json:"port_name,omitempty"`
+	Protocol      string                 `protobuf:"bytes,6,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceEndpoint) Reset() {
+	*x = ServiceEndpoint{}
+	mi := &file_control_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceEndpoint) ProtoMessage() {}
+
+func (x *ServiceEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceEndpoint.ProtoReflect.Descriptor instead.
+func (*ServiceEndpoint) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ServiceEndpoint) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServiceEndpoint) GetPortName() string {
+	if x != nil {
+		return x.PortName
+	}
+	return ""
+}
+
+func (x *ServiceEndpoint) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type ServiceView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Fqdn          string                 `protobuf:"bytes,3,opt,name=fqdn,proto3" json:"fqdn,omitempty"` // <name>.<namespace>.svc.<domain>
+	Selector      map[string]string      `protobuf:"bytes,4,rep,name=selector,proto3" json:"selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Ports         []*ServicePort         `protobuf:"bytes,5,rep,name=ports,proto3" json:"ports,omitempty"`
+	Endpoints     []*ServiceEndpoint     `protobuf:"bytes,6,rep,name=endpoints,proto3" json:"endpoints,omitempty"` // ready workloads currently published
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceView) Reset() {
+	*x = ServiceView{}
+	mi := &file_control_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceView) ProtoMessage() {}
+
+func (x *ServiceView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceView.ProtoReflect.Descriptor instead.
+func (*ServiceView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ServiceView) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ServiceView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServiceView) GetFqdn() string {
+	if x != nil {
+		return x.Fqdn
+	}
+	return ""
+}
+
+func (x *ServiceView) GetSelector() map[string]string {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ServiceView) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ServiceView) GetEndpoints() []*ServiceEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+func (x *ServiceView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ServiceView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A stack member starts once every member in depends_on is ready. Its
+// workload is named <stack>-<member>.
+type StackMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DependsOn     []string               `protobuf:"bytes,2,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Template      *WorkloadSpec          `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StackMember) Reset() {
+	*x = StackMember{}
+	mi := &file_control_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StackMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackMember) ProtoMessage() {}
+
+func (x *StackMember) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackMember.ProtoReflect.Descriptor instead.
+func (*StackMember) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *StackMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StackMember) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *StackMember) GetTemplate() *WorkloadSpec {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ApplyStackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty means "default"
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members       []*StackMember         `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,4,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"` // Running (default) or Stopped
+	Colocate      bool                   `protobuf:"varint,5,opt,name=colocate,proto3" json:"colocate,omitempty"`                            // place every member on the same node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStackRequest) Reset() {
+	*x = ApplyStackRequest{}
+	mi := &file_control_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStackRequest) ProtoMessage() {}
+
+func (x *ApplyStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStackRequest.ProtoReflect.Descriptor instead.
+func (*ApplyStackRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ApplyStackRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ApplyStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApplyStackRequest) GetMembers() []*StackMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ApplyStackRequest) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *ApplyStackRequest) GetColocate() bool {
+	if x != nil {
+		return x.Colocate
+	}
+	return false
+}
+
+type ApplyStackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Stack         *StackView             `protobuf:"bytes,3,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyStackResponse) Reset() {
+	*x = ApplyStackResponse{}
+	mi := &file_control_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyStackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyStackResponse) ProtoMessage() {}
+
+func (x *ApplyStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyStackResponse.ProtoReflect.Descriptor instead.
+func (*ApplyStackResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ApplyStackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyStackResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyStackResponse) GetStack() *StackView {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+type DeleteStackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStackRequest) Reset() {
+	*x = DeleteStackRequest{}
+	mi := &file_control_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStackRequest) ProtoMessage() {}
+
+func (x *DeleteStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStackRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStackRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeleteStackRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteStackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteStackResponse) Reset() {
+	*x = DeleteStackResponse{}
+	mi := &file_control_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteStackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStackResponse) ProtoMessage() {}
+
+func (x *DeleteStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStackResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStackResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *DeleteStackResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteStackResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetStackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStackRequest) Reset() {
+	*x = GetStackRequest{}
+	mi := &file_control_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStackRequest) ProtoMessage() {}
+
+func (x *GetStackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStackRequest.ProtoReflect.Descriptor instead.
+func (*GetStackRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetStackRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetStackRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetStackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stack         *StackView             `protobuf:"bytes,1,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStackResponse) Reset() {
+	*x = GetStackResponse{}
+	mi := &file_control_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStackResponse) ProtoMessage() {}
+
+func (x *GetStackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStackResponse.ProtoReflect.Descriptor instead.
+func (*GetStackResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *GetStackResponse) GetStack() *StackView {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+type ListStacksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // empty lists all namespaces
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStacksRequest) Reset() {
+	*x = ListStacksRequest{}
+	mi := &file_control_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStacksRequest) ProtoMessage() {}
+
+func (x *ListStacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStacksRequest.ProtoReflect.Descriptor instead.
+func (*ListStacksRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListStacksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListStacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stacks        []*StackView           `protobuf:"bytes,1,rep,name=stacks,proto3" json:"stacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStacksResponse) Reset() {
+	*x = ListStacksResponse{}
+	mi := &file_control_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStacksResponse) ProtoMessage() {}
+
+func (x *ListStacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStacksResponse.ProtoReflect.Descriptor instead.
+func (*ListStacksResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListStacksResponse) GetStacks() []*StackView {
+	if x != nil {
+		return x.Stacks
+	}
+	return nil
+}
+
+type SetStackStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"` // Running or Stopped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStackStateRequest) Reset() {
+	*x = SetStackStateRequest{}
+	mi := &file_control_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStackStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStackStateRequest) ProtoMessage() {}
+
+func (x *SetStackStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStackStateRequest.ProtoReflect.Descriptor instead.
+func (*SetStackStateRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SetStackStateRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetStackStateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetStackStateRequest) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+type SetStackStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Stack         *StackView             `protobuf:"bytes,3,opt,name=stack,proto3" json:"stack,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStackStateResponse) Reset() {
+	*x = SetStackStateResponse{}
+	mi := &file_control_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStackStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStackStateResponse) ProtoMessage() {}
+
+func (x *SetStackStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStackStateResponse.ProtoReflect.Descriptor instead.
+func (*SetStackStateResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SetStackStateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetStackStateResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SetStackStateResponse) GetStack() *StackView {
+	if x != nil {
+		return x.Stack
+	}
+	return nil
+}
+
+type StackMemberView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DependsOn     []string               `protobuf:"bytes,2,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	WorkloadId    string                 `protobuf:"bytes,3,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Ready         bool                   `protobuf:"varint,6,opt,name=ready,proto3" json:"ready,omitempty"`
+	WaitingFor    []string               `protobuf:"bytes,7,rep,name=waiting_for,json=waitingFor,proto3" json:"waiting_for,omitempty"` // members this one waits on
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StackMemberView) Reset() {
+	*x = StackMemberView{}
+	mi := &file_control_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StackMemberView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackMemberView) ProtoMessage() {}
+
+func (x *StackMemberView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackMemberView.ProtoReflect.Descriptor instead.
+func (*StackMemberView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *StackMemberView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StackMemberView) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *StackMemberView) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *StackMemberView) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *StackMemberView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StackMemberView) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *StackMemberView) GetWaitingFor() []string {
+	if x != nil {
+		return x.WaitingFor
+	}
+	return nil
+}
+
+type StackView struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Namespace        string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DesiredState     string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	Phase            string                 `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"` // Progressing, Running, Degraded, Stopped or Deleting
+	Colocate         bool                   `protobuf:"varint,5,opt,name=colocate,proto3" json:"colocate,omitempty"`
+	Members          []*StackMemberView     `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	ReadyMembers     int32                  `protobuf:"varint,7,opt,name=ready_members,json=readyMembers,proto3" json:"ready_members,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LastReconciledAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_reconciled_at,json=lastReconciledAt,proto3" json:"last_reconciled_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StackView) Reset() {
+	*x = StackView{}
+	mi := &file_control_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StackView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StackView) ProtoMessage() {}
+
+func (x *StackView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StackView.ProtoReflect.Descriptor instead.
+func (*StackView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *StackView) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StackView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StackView) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *StackView) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *StackView) GetColocate() bool {
+	if x != nil {
+		return x.Colocate
+	}
+	return false
+}
+
+func (x *StackView) GetMembers() []*StackMemberView {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *StackView) GetReadyMembers() int32 {
+	if x != nil {
+		return x.ReadyMembers
+	}
+	return 0
+}
+
+func (x *StackView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StackView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StackView) GetLastReconciledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReconciledAt
+	}
+	return nil
+}
+
+// Jobs run template until `completions` runs have exited with code 0.
+// Unset backoff_limit means 6.
+type JobSpec struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Template                *WorkloadSpec          `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	Completions             int32                  `protobuf:"varint,2,opt,name=completions,proto3" json:"completions,omitempty"`
+	Parallelism             int32                  `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	BackoffLimit            *int32                 `protobuf:"varint,4,opt,name=backoff_limit,json=backoffLimit,proto3,oneof" json:"backoff_limit,omitempty"`
+	ActiveDeadlineSeconds   int32                  `protobuf:"varint,5,opt,name=active_deadline_seconds,json=activeDeadlineSeconds,proto3" json:"active_deadline_seconds,omitempty"`
+	TtlSecondsAfterFinished int32                  `protobuf:"varint,6,opt,name=ttl_seconds_after_finished,json=ttlSecondsAfterFinished,proto3" json:"ttl_seconds_after_finished,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *JobSpec) Reset() {
+	*x = JobSpec{}
+	mi := &file_control_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobSpec) ProtoMessage() {}
+
+func (x *JobSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobSpec.ProtoReflect.Descriptor instead.
+func (*JobSpec) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *JobSpec) GetTemplate() *WorkloadSpec {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *JobSpec) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *JobSpec) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *JobSpec) GetBackoffLimit() int32 {
+	if x != nil && x.BackoffLimit != nil {
+		return *x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *JobSpec) GetActiveDeadlineSeconds() int32 {
+	if x != nil {
+		return x.ActiveDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *JobSpec) GetTtlSecondsAfterFinished() int32 {
+	if x != nil {
+		return x.TtlSecondsAfterFinished
+	}
+	return 0
+}
+
+type ApplyJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Spec          *JobSpec               `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyJobRequest) Reset() {
+	*x = ApplyJobRequest{}
+	mi := &file_control_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJobRequest) ProtoMessage() {}
+
+func (x *ApplyJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJobRequest.ProtoReflect.Descriptor instead.
+func (*ApplyJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ApplyJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ApplyJobRequest) GetSpec() *JobSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ApplyJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Job           *JobView               `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyJobResponse) Reset() {
+	*x = ApplyJobResponse{}
+	mi := &file_control_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyJobResponse) ProtoMessage() {}
+
+func (x *ApplyJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyJobResponse.ProtoReflect.Descriptor instead.
+func (*ApplyJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ApplyJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyJobResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyJobResponse) GetJob() *JobView {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type DeleteJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobRequest) Reset() {
+	*x = DeleteJobRequest{}
+	mi := &file_control_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobRequest) ProtoMessage() {}
+
+func (x *DeleteJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *DeleteJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type DeleteJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobResponse) Reset() {
+	*x = DeleteJobResponse{}
+	mi := &file_control_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobResponse) ProtoMessage() {}
+
+func (x *DeleteJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *DeleteJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteJobResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_control_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *JobView               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobResponse) Reset() {
+	*x = GetJobResponse{}
+	mi := &file_control_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobResponse) ProtoMessage() {}
+
+func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
+func (*GetJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetJobResponse) GetJob() *JobView {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId     string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"` // optional filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_control_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListJobsRequest) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+type ListJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*JobView             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsResponse) Reset() {
+	*x = ListJobsResponse{}
+	mi := &file_control_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsResponse) ProtoMessage() {}
+
+func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListJobsResponse) GetJobs() []*JobView {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type JobView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Phase         string                 `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"` // Pending | Running | Succeeded | Failed
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Completions   int32                  `protobuf:"varint,5,opt,name=completions,proto3" json:"completions,omitempty"`
+	Parallelism   int32                  `protobuf:"varint,6,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	BackoffLimit  int32                  `protobuf:"varint,7,opt,name=backoff_limit,json=backoffLimit,proto3" json:"backoff_limit,omitempty"`
+	Active        int32                  `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,9,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,10,opt,name=failed,proto3" json:"failed,omitempty"`
+	WorkloadIds   []string               `protobuf:"bytes,11,rep,name=workload_ids,json=workloadIds,proto3" json:"workload_ids,omitempty"`
+	CronJobId     string                 `protobuf:"bytes,12,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	DesiredState  string                 `protobuf:"bytes,13,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobView) Reset() {
+	*x = JobView{}
+	mi := &file_control_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobView) ProtoMessage() {}
+
+func (x *JobView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobView.ProtoReflect.Descriptor instead.
+func (*JobView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *JobView) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobView) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *JobView) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *JobView) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *JobView) GetCompletions() int32 {
+	if x != nil {
+		return x.Completions
+	}
+	return 0
+}
+
+func (x *JobView) GetParallelism() int32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+func (x *JobView) GetBackoffLimit() int32 {
+	if x != nil {
+		return x.BackoffLimit
+	}
+	return 0
+}
+
+func (x *JobView) GetActive() int32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *JobView) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *JobView) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *JobView) GetWorkloadIds() []string {
+	if x != nil {
+		return x.WorkloadIds
+	}
+	return nil
+}
+
+func (x *JobView) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+func (x *JobView) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *JobView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JobView) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *JobView) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+// Unset history limits mean 3 successful and 1 failed job.
+type ApplyCronJobRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId                  string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	Schedule                   string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`                                            // five-field cron; may start with CRON_TZ=<zone>
+	ConcurrencyPolicy          string                 `protobuf:"bytes,3,opt,name=concurrency_policy,json=concurrencyPolicy,proto3" json:"concurrency_policy,omitempty"` // Allow | Forbid | Replace
+	Suspend                    bool                   `protobuf:"varint,4,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	StartingDeadlineSeconds    int32                  `protobuf:"varint,5,opt,name=starting_deadline_seconds,json=startingDeadlineSeconds,proto3" json:"starting_deadline_seconds,omitempty"`
+	SuccessfulJobsHistoryLimit *int32                 `protobuf:"varint,6,opt,name=successful_jobs_history_limit,json=successfulJobsHistoryLimit,proto3,oneof" json:"successful_jobs_history_limit,omitempty"`
+	FailedJobsHistoryLimit     *int32                 `protobuf:"varint,7,opt,name=failed_jobs_history_limit,json=failedJobsHistoryLimit,proto3,oneof" json:"failed_jobs_history_limit,omitempty"`
+	JobTemplate                *JobSpec               `protobuf:"bytes,8,opt,name=job_template,json=jobTemplate,proto3" json:"job_template,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ApplyCronJobRequest) Reset() {
+	*x = ApplyCronJobRequest{}
+	mi := &file_control_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCronJobRequest) ProtoMessage() {}
+
+func (x *ApplyCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCronJobRequest.ProtoReflect.Descriptor instead.
+func (*ApplyCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ApplyCronJobRequest) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+func (x *ApplyCronJobRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *ApplyCronJobRequest) GetConcurrencyPolicy() string {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (x *ApplyCronJobRequest) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *ApplyCronJobRequest) GetStartingDeadlineSeconds() int32 {
+	if x != nil {
+		return x.StartingDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *ApplyCronJobRequest) GetSuccessfulJobsHistoryLimit() int32 {
+	if x != nil && x.SuccessfulJobsHistoryLimit != nil {
+		return *x.SuccessfulJobsHistoryLimit
+	}
+	return 0
+}
+
+func (x *ApplyCronJobRequest) GetFailedJobsHistoryLimit() int32 {
+	if x != nil && x.FailedJobsHistoryLimit != nil {
+		return *x.FailedJobsHistoryLimit
+	}
+	return 0
+}
+
+func (x *ApplyCronJobRequest) GetJobTemplate() *JobSpec {
+	if x != nil {
+		return x.JobTemplate
+	}
+	return nil
+}
+
+type ApplyCronJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	CronJob       *CronJobView           `protobuf:"bytes,3,opt,name=cron_job,json=cronJob,proto3" json:"cron_job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyCronJobResponse) Reset() {
+	*x = ApplyCronJobResponse{}
+	mi := &file_control_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyCronJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyCronJobResponse) ProtoMessage() {}
+
+func (x *ApplyCronJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyCronJobResponse.ProtoReflect.Descriptor instead.
+func (*ApplyCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ApplyCronJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ApplyCronJobResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ApplyCronJobResponse) GetCronJob() *CronJobView {
+	if x != nil {
+		return x.CronJob
+	}
+	return nil
+}
+
+type DeleteCronJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId     string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCronJobRequest) Reset() {
+	*x = DeleteCronJobRequest{}
+	mi := &file_control_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCronJobRequest) ProtoMessage() {}
+
+func (x *DeleteCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCronJobRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteCronJobRequest) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+type DeleteCronJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCronJobResponse) Reset() {
+	*x = DeleteCronJobResponse{}
+	mi := &file_control_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCronJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCronJobResponse) ProtoMessage() {}
+
+func (x *DeleteCronJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCronJobResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeleteCronJobResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteCronJobResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type GetCronJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId     string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCronJobRequest) Reset() {
+	*x = GetCronJobRequest{}
+	mi := &file_control_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCronJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCronJobRequest) ProtoMessage() {}
+
+func (x *GetCronJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCronJobRequest.ProtoReflect.Descriptor instead.
+func (*GetCronJobRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *GetCronJobRequest) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+type GetCronJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJob       *CronJobView           `protobuf:"bytes,1,opt,name=cron_job,json=cronJob,proto3" json:"cron_job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCronJobResponse) Reset() {
+	*x = GetCronJobResponse{}
+	mi := &file_control_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCronJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCronJobResponse) ProtoMessage() {}
+
+func (x *GetCronJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCronJobResponse.ProtoReflect.Descriptor instead.
+func (*GetCronJobResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetCronJobResponse) GetCronJob() *CronJobView {
+	if x != nil {
+		return x.CronJob
+	}
+	return nil
+}
+
+type ListCronJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCronJobsRequest) Reset() {
+	*x = ListCronJobsRequest{}
+	mi := &file_control_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCronJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCronJobsRequest) ProtoMessage() {}
+
+func (x *ListCronJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCronJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListCronJobsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{141}
+}
+
+type ListCronJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CronJobs      []*CronJobView         `protobuf:"bytes,1,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCronJobsResponse) Reset() {
+	*x = ListCronJobsResponse{}
+	mi := &file_control_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCronJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCronJobsResponse) ProtoMessage() {}
+
+func (x *ListCronJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCronJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListCronJobsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListCronJobsResponse) GetCronJobs() []*CronJobView {
+	if x != nil {
+		return x.CronJobs
+	}
+	return nil
+}
+
+type CronJobView struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	CronJobId                  string                 `protobuf:"bytes,1,opt,name=cron_job_id,json=cronJobId,proto3" json:"cron_job_id,omitempty"`
+	Schedule                   string                 `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	ConcurrencyPolicy          string                 `protobuf:"bytes,3,opt,name=concurrency_policy,json=concurrencyPolicy,proto3" json:"concurrency_policy,omitempty"`
+	Suspend                    bool                   `protobuf:"varint,4,opt,name=suspend,proto3" json:"suspend,omitempty"`
+	StartingDeadlineSeconds    int32                  `protobuf:"varint,5,opt,name=starting_deadline_seconds,json=startingDeadlineSeconds,proto3" json:"starting_deadline_seconds,omitempty"`
+	SuccessfulJobsHistoryLimit int32                  `protobuf:"varint,6,opt,name=successful_jobs_history_limit,json=successfulJobsHistoryLimit,proto3" json:"successful_jobs_history_limit,omitempty"`
+	FailedJobsHistoryLimit     int32                  `protobuf:"varint,7,opt,name=failed_jobs_history_limit,json=failedJobsHistoryLimit,proto3" json:"failed_jobs_history_limit,omitempty"`
+	DesiredState               string                 `protobuf:"bytes,8,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
+	ActiveJobIds               []string               `protobuf:"bytes,9,rep,name=active_job_ids,json=activeJobIds,proto3" json:"active_job_ids,omitempty"`
+	LastScheduleAt             *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_schedule_at,json=lastScheduleAt,proto3" json:"last_schedule_at,omitempty"`
+	LastSuccessfulAt           *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_successful_at,json=lastSuccessfulAt,proto3" json:"last_successful_at,omitempty"`
+	NextScheduleAt             *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=next_schedule_at,json=nextScheduleAt,proto3" json:"next_schedule_at,omitempty"`
+	CreatedAt                  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CronJobView) Reset() {
+	*x = CronJobView{}
+	mi := &file_control_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CronJobView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJobView) ProtoMessage() {}
+
+func (x *CronJobView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CronJobView.ProtoReflect.Descriptor instead.
+func (*CronJobView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CronJobView) GetCronJobId() string {
+	if x != nil {
+		return x.CronJobId
+	}
+	return ""
+}
+
+func (x *CronJobView) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CronJobView) GetConcurrencyPolicy() string {
+	if x != nil {
+		return x.ConcurrencyPolicy
+	}
+	return ""
+}
+
+func (x *CronJobView) GetSuspend() bool {
+	if x != nil {
+		return x.Suspend
+	}
+	return false
+}
+
+func (x *CronJobView) GetStartingDeadlineSeconds() int32 {
+	if x != nil {
+		return x.StartingDeadlineSeconds
+	}
+	return 0
+}
+
+func (x *CronJobView) GetSuccessfulJobsHistoryLimit() int32 {
+	if x != nil {
+		return x.SuccessfulJobsHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronJobView) GetFailedJobsHistoryLimit() int32 {
+	if x != nil {
+		return x.FailedJobsHistoryLimit
+	}
+	return 0
+}
+
+func (x *CronJobView) GetDesiredState() string {
+	if x != nil {
+		return x.DesiredState
+	}
+	return ""
+}
+
+func (x *CronJobView) GetActiveJobIds() []string {
+	if x != nil {
+		return x.ActiveJobIds
+	}
+	return nil
+}
+
+func (x *CronJobView) GetLastScheduleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastScheduleAt
+	}
+	return nil
+}
+
+func (x *CronJobView) GetLastSuccessfulAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSuccessfulAt
+	}
+	return nil
+}
+
+func (x *CronJobView) GetNextScheduleAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextScheduleAt
+	}
+	return nil
+}
+
+func (x *CronJobView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ControlMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Message:
+	//
+	//	*ControlMessage_Register
+	//	*ControlMessage_Heartbeat
+	//	*ControlMessage_Apply
+	//	*ControlMessage_Delete
+	//	*ControlMessage_RegisterAck
+	//	*ControlMessage_HeartbeatAck
+	//	*ControlMessage_ApplyResult
+	//	*ControlMessage_DeleteResult
+	//	*ControlMessage_WorkloadStatus
+	Message isControlMessage_Message `protobuf_oneof:"message"`
+	// Stream envelope.
+	SessionId     string `protobuf:"bytes,20,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // assigned by scheduler on register; echoed by agent to resume
+	NodeId        string `protobuf:"bytes,21,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Sequence      uint64 `protobuf:"varint,22,opt,name=sequence,proto3" json:"sequence,omitempty"`                               // per-sender monotonically increasing, 0 = unsequenced
+	AckSequence   uint64 `protobuf:"varint,23,opt,name=ack_sequence,json=ackSequence,proto3" json:"ack_sequence,omitempty"`      // highest peer sequence processed by the sender
+	CorrelationId string `protobuf:"bytes,24,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"` // ties apply_result/delete_result to the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ControlMessage) Reset() {
+	*x = ControlMessage{}
+	mi := &file_control_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ControlMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlMessage) ProtoMessage() {}
+
+func (x *ControlMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlMessage.ProtoReflect.Descriptor instead.
+func (*ControlMessage) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ControlMessage) GetMessage() isControlMessage_Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetRegister() *RegisterNodeRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_Register); ok {
+			return x.Register
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetHeartbeat() *HeartbeatRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetApply() *ApplyWorkloadRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_Apply); ok {
+			return x.Apply
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetDelete() *DeleteWorkloadRequest {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_Delete); ok {
+			return x.Delete
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetRegisterAck() *RegisterNodeResponse {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_RegisterAck); ok {
+			return x.RegisterAck
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetHeartbeatAck() *HeartbeatResponse {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_HeartbeatAck); ok {
+			return x.HeartbeatAck
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetApplyResult() *ApplyWorkloadResponse {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_ApplyResult); ok {
+			return x.ApplyResult
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetDeleteResult() *DeleteWorkloadResponse {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_DeleteResult); ok {
+			return x.DeleteResult
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetWorkloadStatus() *WorkloadStatus {
+	if x != nil {
+		if x, ok := x.Message.(*ControlMessage_WorkloadStatus); ok {
+			return x.WorkloadStatus
+		}
+	}
+	return nil
+}
+
+func (x *ControlMessage) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ControlMessage) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ControlMessage) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ControlMessage) GetAckSequence() uint64 {
+	if x != nil {
+		return x.AckSequence
+	}
+	return 0
+}
+
+func (x *ControlMessage) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+type isControlMessage_Message interface {
+	isControlMessage_Message()
+}
+
+type ControlMessage_Register struct {
+	Register *RegisterNodeRequest `protobuf:"bytes,1,opt,name=register,proto3,oneof"`
+}
+
+type ControlMessage_Heartbeat struct {
+	Heartbeat *HeartbeatRequest `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+}
+
+type ControlMessage_Apply struct {
+	Apply *ApplyWorkloadRequest `protobuf:"bytes,3,opt,name=apply,proto3,oneof"`
+}
+
+type ControlMessage_Delete struct {
+	Delete *DeleteWorkloadRequest `protobuf:"bytes,4,opt,name=delete,proto3,oneof"`
+}
+
+type ControlMessage_RegisterAck struct {
+	RegisterAck *RegisterNodeResponse `protobuf:"bytes,5,opt,name=register_ack,json=registerAck,proto3,oneof"`
+}
+
+type ControlMessage_HeartbeatAck struct {
+	HeartbeatAck *HeartbeatResponse `protobuf:"bytes,6,opt,name=heartbeat_ack,json=heartbeatAck,proto3,oneof"`
+}
+
+type ControlMessage_ApplyResult struct {
+	ApplyResult *ApplyWorkloadResponse `protobuf:"bytes,7,opt,name=apply_result,json=applyResult,proto3,oneof"`
+}
+
+type ControlMessage_DeleteResult struct {
+	DeleteResult *DeleteWorkloadResponse `protobuf:"bytes,8,opt,name=delete_result,json=deleteResult,proto3,oneof"`
+}
+
+type ControlMessage_WorkloadStatus struct {
+	WorkloadStatus *WorkloadStatus `protobuf:"bytes,9,opt,name=workload_status,json=workloadStatus,proto3,oneof"`
+}
+
+func (*ControlMessage_Register) isControlMessage_Message() {}
+
+func (*ControlMessage_Heartbeat) isControlMessage_Message() {}
+
+func (*ControlMessage_Apply) isControlMessage_Message() {}
+
+func (*ControlMessage_Delete) isControlMessage_Message() {}
+
+func (*ControlMessage_RegisterAck) isControlMessage_Message() {}
+
+func (*ControlMessage_HeartbeatAck) isControlMessage_Message() {}
+
+func (*ControlMessage_ApplyResult) isControlMessage_Message() {}
+
+func (*ControlMessage_DeleteResult) isControlMessage_Message() {}
+
+func (*ControlMessage_WorkloadStatus) isControlMessage_Message() {}
+
+type CordonNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CordonNodeRequest) Reset() {
+	*x = CordonNodeRequest{}
+	mi := &file_control_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CordonNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CordonNodeRequest) ProtoMessage() {}
+
+func (x *CordonNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CordonNodeRequest.ProtoReflect.Descriptor instead.
+func (*CordonNodeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CordonNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CordonNodeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CordonNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Node          *NodeView              `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CordonNodeResponse) Reset() {
+	*x = CordonNodeResponse{}
+	mi := &file_control_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CordonNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CordonNodeResponse) ProtoMessage() {}
+
+func (x *CordonNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CordonNodeResponse.ProtoReflect.Descriptor instead.
+func (*CordonNodeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CordonNodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CordonNodeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CordonNodeResponse) GetNode() *NodeView {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type UncordonNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UncordonNodeRequest) Reset() {
+	*x = UncordonNodeRequest{}
+	mi := &file_control_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UncordonNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncordonNodeRequest) ProtoMessage() {}
+
+func (x *UncordonNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncordonNodeRequest.ProtoReflect.Descriptor instead.
+func (*UncordonNodeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *UncordonNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type UncordonNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Node          *NodeView              `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UncordonNodeResponse) Reset() {
+	*x = UncordonNodeResponse{}
+	mi := &file_control_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UncordonNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UncordonNodeResponse) ProtoMessage() {}
+
+func (x *UncordonNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UncordonNodeResponse.ProtoReflect.Descriptor instead.
+func (*UncordonNodeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *UncordonNodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UncordonNodeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *UncordonNodeResponse) GetNode() *NodeView {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// SetNodeTaintsRequest replaces the operator taints of a node. They are kept
+// apart from the taints the agent registers with, so re-registration leaves
+// them in place.
+type SetNodeTaintsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Taints        []*Taint               `protobuf:"bytes,2,rep,name=taints,proto3" json:"taints,omitempty"` // empty clears the operator taints
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeTaintsRequest) Reset() {
+	*x = SetNodeTaintsRequest{}
+	mi := &file_control_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeTaintsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeTaintsRequest) ProtoMessage() {}
+
+func (x *SetNodeTaintsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeTaintsRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeTaintsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *SetNodeTaintsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SetNodeTaintsRequest) GetTaints() []*Taint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+type SetNodeTaintsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Node          *NodeView              `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNodeTaintsResponse) Reset() {
+	*x = SetNodeTaintsResponse{}
+	mi := &file_control_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNodeTaintsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeTaintsResponse) ProtoMessage() {}
+
+func (x *SetNodeTaintsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeTaintsResponse.ProtoReflect.Descriptor instead.
+func (*SetNodeTaintsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *SetNodeTaintsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetNodeTaintsResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SetNodeTaintsResponse) GetNode() *NodeView {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type DrainNodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NodeId         string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxUnavailable int32                  `protobuf:"varint,3,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"` // workloads moved at once; 0 uses SCHEDULER_DRAIN_MAX_UNAVAILABLE
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DrainNodeRequest) Reset() {
+	*x = DrainNodeRequest{}
+	mi := &file_control_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainNodeRequest) ProtoMessage() {}
+
+func (x *DrainNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainNodeRequest.ProtoReflect.Descriptor instead.
+func (*DrainNodeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *DrainNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *DrainNodeRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DrainNodeRequest) GetMaxUnavailable() int32 {
+	if x != nil {
+		return x.MaxUnavailable
+	}
+	return 0
+}
+
+type DrainNodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Node          *NodeView              `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrainNodeResponse) Reset() {
+	*x = DrainNodeResponse{}
+	mi := &file_control_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrainNodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainNodeResponse) ProtoMessage() {}
+
+func (x *DrainNodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainNodeResponse.ProtoReflect.Descriptor instead.
+func (*DrainNodeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *DrainNodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DrainNodeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *DrainNodeResponse) GetNode() *NodeView {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+// Runs one descheduler pass now. A dry run only reports the moves.
+type RunDeschedulerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Strategies    []string               `protobuf:"bytes,2,rep,name=strategies,proto3" json:"strategies,omitempty"`              // lowNodeUtilization | removeDuplicates | violatedAffinity; empty uses SCHEDULER_DESCHEDULER_STRATEGIES
+	MaxMoves      int32                  `protobuf:"varint,3,opt,name=max_moves,json=maxMoves,proto3" json:"max_moves,omitempty"` // 0 uses SCHEDULER_DESCHEDULER_MAX_MOVES
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDeschedulerRequest) Reset() {
+	*x = RunDeschedulerRequest{}
+	mi := &file_control_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDeschedulerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDeschedulerRequest) ProtoMessage() {}
+
+func (x *RunDeschedulerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDeschedulerRequest.ProtoReflect.Descriptor instead.
+func (*RunDeschedulerRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *RunDeschedulerRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunDeschedulerRequest) GetStrategies() []string {
+	if x != nil {
+		return x.Strategies
+	}
+	return nil
+}
+
+func (x *RunDeschedulerRequest) GetMaxMoves() int32 {
+	if x != nil {
+		return x.MaxMoves
+	}
+	return 0
+}
+
+type DeschedulerMove struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Strategy      string                 `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	FromNodeId    string                 `protobuf:"bytes,3,opt,name=from_node_id,json=fromNodeId,proto3" json:"from_node_id,omitempty"`
+	ToNodeId      string                 `protobuf:"bytes,4,opt,name=to_node_id,json=toNodeId,proto3" json:"to_node_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Executed      bool                   `protobuf:"varint,6,opt,name=executed,proto3" json:"executed,omitempty"` // false in a dry run or when the move failed
+	Error         string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeschedulerMove) Reset() {
+	*x = DeschedulerMove{}
+	mi := &file_control_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeschedulerMove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeschedulerMove) ProtoMessage() {}
+
+func (x *DeschedulerMove) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeschedulerMove.ProtoReflect.Descriptor instead.
+func (*DeschedulerMove) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *DeschedulerMove) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *DeschedulerMove) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *DeschedulerMove) GetFromNodeId() string {
+	if x != nil {
+		return x.FromNodeId
+	}
+	return ""
+}
+
+func (x *DeschedulerMove) GetToNodeId() string {
+	if x != nil {
+		return x.ToNodeId
+	}
+	return ""
+}
+
+func (x *DeschedulerMove) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeschedulerMove) GetExecuted() bool {
+	if x != nil {
+		return x.Executed
+	}
+	return false
+}
+
+func (x *DeschedulerMove) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RunDeschedulerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Moves         []*DeschedulerMove     `protobuf:"bytes,4,rep,name=moves,proto3" json:"moves,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunDeschedulerResponse) Reset() {
+	*x = RunDeschedulerResponse{}
+	mi := &file_control_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunDeschedulerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunDeschedulerResponse) ProtoMessage() {}
+
+func (x *RunDeschedulerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunDeschedulerResponse.ProtoReflect.Descriptor instead.
+func (*RunDeschedulerResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *RunDeschedulerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RunDeschedulerResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *RunDeschedulerResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RunDeschedulerResponse) GetMoves() []*DeschedulerMove {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+type VolumeSnapshotView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeGb        int64                  `protobuf:"varint,2,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolumeSnapshotView) Reset() {
+	*x = VolumeSnapshotView{}
+	mi := &file_control_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeSnapshotView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeSnapshotView) ProtoMessage() {}
+
+func (x *VolumeSnapshotView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeSnapshotView.ProtoReflect.Descriptor instead.
+func (*VolumeSnapshotView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *VolumeSnapshotView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeSnapshotView) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *VolumeSnapshotView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type VolumeView struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // driver:name
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Driver          string                 `protobuf:"bytes,3,opt,name=driver,proto3" json:"driver,omitempty"`
+	SizeGb          int64                  `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	AccessMode      string                 `protobuf:"bytes,5,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	FsType          string                 `protobuf:"bytes,6,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	RetainPolicy    string                 `protobuf:"bytes,7,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"`
+	Phase           string                 `protobuf:"bytes,8,opt,name=phase,proto3" json:"phase,omitempty"` // Provisioning|Provisioned|Attached|Released|Retained|Deleting|Deleted|Error
+	LastError       string                 `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	WorkloadIds     []string               `protobuf:"bytes,10,rep,name=workload_ids,json=workloadIds,proto3" json:"workload_ids,omitempty"`
+	AttachedNodeIds []string               `protobuf:"bytes,11,rep,name=attached_node_ids,json=attachedNodeIds,proto3" json:"attached_node_ids,omitempty"`
+	NodeId          string                 `protobuf:"bytes,12,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                           // node holding a local volume
+	SourceVolumeId  string                 `protobuf:"bytes,13,opt,name=source_volume_id,json=sourceVolumeId,proto3" json:"source_volume_id,omitempty"` // set on clones
+	SourceSnapshot  string                 `protobuf:"bytes,14,opt,name=source_snapshot,json=sourceSnapshot,proto3" json:"source_snapshot,omitempty"`
+	Snapshots       []*VolumeSnapshotView  `protobuf:"bytes,15,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReleasedAt      *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=released_at,json=releasedAt,proto3" json:"released_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *VolumeView) Reset() {
+	*x = VolumeView{}
+	mi := &file_control_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeView) ProtoMessage() {}
+
+func (x *VolumeView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeView.ProtoReflect.Descriptor instead.
+func (*VolumeView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *VolumeView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VolumeView) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VolumeView) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *VolumeView) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *VolumeView) GetAccessMode() string {
+	if x != nil {
+		return x.AccessMode
+	}
+	return ""
+}
+
+func (x *VolumeView) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *VolumeView) GetRetainPolicy() string {
+	if x != nil {
+		return x.RetainPolicy
+	}
+	return ""
+}
+
+func (x *VolumeView) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *VolumeView) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *VolumeView) GetWorkloadIds() []string {
+	if x != nil {
+		return x.WorkloadIds
+	}
+	return nil
+}
+
+func (x *VolumeView) GetAttachedNodeIds() []string {
+	if x != nil {
+		return x.AttachedNodeIds
+	}
+	return nil
+}
+
+func (x *VolumeView) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *VolumeView) GetSourceVolumeId() string {
+	if x != nil {
+		return x.SourceVolumeId
+	}
+	return ""
+}
+
+func (x *VolumeView) GetSourceSnapshot() string {
+	if x != nil {
+		return x.SourceSnapshot
+	}
+	return ""
+}
+
+func (x *VolumeView) GetSnapshots() []*VolumeSnapshotView {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *VolumeView) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *VolumeView) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *VolumeView) GetReleasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleasedAt
+	}
+	return nil
+}
+
+type CreateVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"` // local|nfs|ceph-rbd; empty is local
+	SizeGb        int64                  `protobuf:"varint,3,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`
+	AccessMode    string                 `protobuf:"bytes,4,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	FsType        string                 `protobuf:"bytes,5,opt,name=fs_type,json=fsType,proto3" json:"fs_type,omitempty"`
+	RetainPolicy  string                 `protobuf:"bytes,6,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain; empty is Retain
+	NodeId        string                 `protobuf:"bytes,7,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                   // required for local volumes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeRequest) Reset() {
+	*x = CreateVolumeRequest{}
+	mi := &file_control_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeRequest) ProtoMessage() {}
+
+func (x *CreateVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *CreateVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *CreateVolumeRequest) GetAccessMode() string {
+	if x != nil {
+		return x.AccessMode
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetFsType() string {
+	if x != nil {
+		return x.FsType
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetRetainPolicy() string {
+	if x != nil {
+		return x.RetainPolicy
+	}
+	return ""
+}
+
+func (x *CreateVolumeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type CreateVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Volume        *VolumeView            `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolumeResponse) Reset() {
+	*x = CreateVolumeResponse{}
+	mi := &file_control_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolumeResponse) ProtoMessage() {}
+
+func (x *CreateVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CreateVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateVolumeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CreateVolumeResponse) GetVolume() *VolumeView {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type GetVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeRequest) Reset() {
+	*x = GetVolumeRequest{}
+	mi := &file_control_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeRequest) ProtoMessage() {}
+
+func (x *GetVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volume        *VolumeView            `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolumeResponse) Reset() {
+	*x = GetVolumeResponse{}
+	mi := &file_control_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeResponse) ProtoMessage() {}
+
+func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetVolumeResponse) GetVolume() *VolumeView {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phase         string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	NodeId        string                 `protobuf:"bytes,3,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	WorkloadId    string                 `protobuf:"bytes,4,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
+	mi := &file_control_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesRequest) ProtoMessage() {}
+
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListVolumesRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ListVolumesRequest) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *ListVolumesRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ListVolumesRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Volumes       []*VolumeView          `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
+	mi := &file_control_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVolumesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVolumesResponse) ProtoMessage() {}
+
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListVolumesResponse) GetVolumes() []*VolumeView {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type DeleteVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"` // drop the record even if the agent cannot delete the data
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeRequest) Reset() {
+	*x = DeleteVolumeRequest{}
+	mi := &file_control_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeRequest) ProtoMessage() {}
+
+func (x *DeleteVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *DeleteVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *DeleteVolumeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVolumeResponse) Reset() {
+	*x = DeleteVolumeResponse{}
+	mi := &file_control_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVolumeResponse) ProtoMessage() {}
+
+func (x *DeleteVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVolumeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *DeleteVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteVolumeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ResizeVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SizeGb        int64                  `protobuf:"varint,2,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"` // must be larger than the current size
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeVolumeRequest) Reset() {
+	*x = ResizeVolumeRequest{}
+	mi := &file_control_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeRequest) ProtoMessage() {}
+
+func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *ResizeVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ResizeVolumeRequest) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+type ResizeVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Volume        *VolumeView            `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResizeVolumeResponse) Reset() {
+	*x = ResizeVolumeResponse{}
+	mi := &file_control_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResizeVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeResponse) ProtoMessage() {}
+
+func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ResizeVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResizeVolumeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ResizeVolumeResponse) GetVolume() *VolumeView {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type SnapshotVolumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VolumeId      string                 `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	SnapshotName  string                 `protobuf:"bytes,2,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"` // empty generates one
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotVolumeRequest) Reset() {
+	*x = SnapshotVolumeRequest{}
+	mi := &file_control_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotVolumeRequest) ProtoMessage() {}
+
+func (x *SnapshotVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *SnapshotVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *SnapshotVolumeRequest) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+type SnapshotVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SnapshotName  string                 `protobuf:"bytes,3,opt,name=snapshot_name,json=snapshotName,proto3" json:"snapshot_name,omitempty"`
+	Volume        *VolumeView            `protobuf:"bytes,4,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotVolumeResponse) Reset() {
+	*x = SnapshotVolumeResponse{}
+	mi := &file_control_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotVolumeResponse) ProtoMessage() {}
+
+func (x *SnapshotVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotVolumeResponse.ProtoReflect.Descriptor instead.
+func (*SnapshotVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *SnapshotVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SnapshotVolumeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SnapshotVolumeResponse) GetSnapshotName() string {
+	if x != nil {
+		return x.SnapshotName
+	}
+	return ""
+}
+
+func (x *SnapshotVolumeResponse) GetVolume() *VolumeView {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+// Copies a volume, or one of its snapshots, into a new volume next to it.
+type CloneVolumeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SourceVolumeId string                 `protobuf:"bytes,1,opt,name=source_volume_id,json=sourceVolumeId,proto3" json:"source_volume_id,omitempty"`
+	SourceSnapshot string                 `protobuf:"bytes,2,opt,name=source_snapshot,json=sourceSnapshot,proto3" json:"source_snapshot,omitempty"` // empty clones the live volume
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SizeGb         int64                  `protobuf:"varint,4,opt,name=size_gb,json=sizeGb,proto3" json:"size_gb,omitempty"`                  // 0 keeps the source size
+	RetainPolicy   string                 `protobuf:"bytes,5,opt,name=retain_policy,json=retainPolicy,proto3" json:"retain_policy,omitempty"` // Delete|Retain; empty is Retain
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CloneVolumeRequest) Reset() {
+	*x = CloneVolumeRequest{}
+	mi := &file_control_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneVolumeRequest) ProtoMessage() {}
+
+func (x *CloneVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CloneVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *CloneVolumeRequest) GetSourceVolumeId() string {
+	if x != nil {
+		return x.SourceVolumeId
+	}
+	return ""
+}
+
+func (x *CloneVolumeRequest) GetSourceSnapshot() string {
+	if x != nil {
+		return x.SourceSnapshot
+	}
+	return ""
+}
+
+func (x *CloneVolumeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneVolumeRequest) GetSizeGb() int64 {
+	if x != nil {
+		return x.SizeGb
+	}
+	return 0
+}
+
+func (x *CloneVolumeRequest) GetRetainPolicy() string {
+	if x != nil {
+		return x.RetainPolicy
+	}
+	return ""
+}
+
+type CloneVolumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Volume        *VolumeView            `protobuf:"bytes,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneVolumeResponse) Reset() {
+	*x = CloneVolumeResponse{}
+	mi := &file_control_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneVolumeResponse) ProtoMessage() {}
+
+func (x *CloneVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CloneVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *CloneVolumeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CloneVolumeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *CloneVolumeResponse) GetVolume() *VolumeView {
+	if x != nil {
+		return x.Volume
+	}
+	return nil
+}
+
+type ExportStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStateRequest) Reset() {
+	*x = ExportStateRequest{}
+	mi := &file_control_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateRequest) ProtoMessage() {}
+
+func (x *ExportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateRequest.ProtoReflect.Descriptor instead.
+func (*ExportStateRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{172}
+}
+
+type ExportStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"` // JSON state archive
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"` // etcd | frozen
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Nodes         int32                  `protobuf:"varint,5,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Workloads     int32                  `protobuf:"varint,6,opt,name=workloads,proto3" json:"workloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStateResponse) Reset() {
+	*x = ExportStateResponse{}
+	mi := &file_control_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStateResponse) ProtoMessage() {}
+
+func (x *ExportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStateResponse.ProtoReflect.Descriptor instead.
+func (*ExportStateResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ExportStateResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportStateResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ExportStateResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExportStateResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ExportStateResponse) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *ExportStateResponse) GetWorkloads() int32 {
+	if x != nil {
+		return x.Workloads
+	}
+	return 0
+}
+
+type ImportStateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Archive       []byte                 `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`              // as returned by ExportState
+	Force         bool                   `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`                 // overwrite existing state
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // validate only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStateRequest) Reset() {
+	*x = ImportStateRequest{}
+	mi := &file_control_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateRequest) ProtoMessage() {}
+
+func (x *ImportStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateRequest.ProtoReflect.Descriptor instead.
+func (*ImportStateRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ImportStateRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ImportStateRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *ImportStateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportStateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Nodes         int32                  `protobuf:"varint,3,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Workloads     int32                  `protobuf:"varint,4,opt,name=workloads,proto3" json:"workloads,omitempty"`
+	Assignments   int32                  `protobuf:"varint,5,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	ReplicaSets   int32                  `protobuf:"varint,6,opt,name=replica_sets,json=replicaSets,proto3" json:"replica_sets,omitempty"`
+	Revisions     int32                  `protobuf:"varint,7,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	Volumes       int32                  `protobuf:"varint,8,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	Attachments   int32                  `protobuf:"varint,9,opt,name=attachments,proto3" json:"attachments,omitempty"`
+	Mode          string                 `protobuf:"bytes,10,opt,name=mode,proto3" json:"mode,omitempty"`
+	Jobs          int32                  `protobuf:"varint,11,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	CronJobs      int32                  `protobuf:"varint,12,opt,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
+	Namespaces    int32                  `protobuf:"varint,13,opt,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Secrets       int32                  `protobuf:"varint,14,opt,name=secrets,proto3" json:"secrets,omitempty"`
+	Configs       int32                  `protobuf:"varint,15,opt,name=configs,proto3" json:"configs,omitempty"`
+	Services      int32                  `protobuf:"varint,16,opt,name=services,proto3" json:"services,omitempty"`
+	Stacks        int32                  `protobuf:"varint,17,opt,name=stacks,proto3" json:"stacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStateResponse) Reset() {
+	*x = ImportStateResponse{}
+	mi := &file_control_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStateResponse) ProtoMessage() {}
+
+func (x *ImportStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStateResponse.ProtoReflect.Descriptor instead.
+func (*ImportStateResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ImportStateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportStateResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ImportStateResponse) GetNodes() int32 {
+	if x != nil {
+		return x.Nodes
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetWorkloads() int32 {
+	if x != nil {
+		return x.Workloads
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetAssignments() int32 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetReplicaSets() int32 {
+	if x != nil {
+		return x.ReplicaSets
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetRevisions() int32 {
+	if x != nil {
+		return x.Revisions
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetVolumes() int32 {
+	if x != nil {
+		return x.Volumes
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetAttachments() int32 {
+	if x != nil {
+		return x.Attachments
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportStateResponse) GetJobs() int32 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetCronJobs() int32 {
+	if x != nil {
+		return x.CronJobs
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetNamespaces() int32 {
+	if x != nil {
+		return x.Namespaces
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetSecrets() int32 {
+	if x != nil {
+		return x.Secrets
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetConfigs() int32 {
+	if x != nil {
+		return x.Configs
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetServices() int32 {
+	if x != nil {
+		return x.Services
+	}
+	return 0
+}
+
+func (x *ImportStateResponse) GetStacks() int32 {
+	if x != nil {
+		return x.Stacks
+	}
+	return 0
+}
+
+// All filters are optional and combine with AND; types match any listed.
+type WatchEventsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	NodeId     string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Types      []string               `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// Replay events from this revision on (inclusive). To resume, pass the
+	// revision of the last event received plus one. Zero streams new events.
+	FromRevision  int64 `protobuf:"varint,4,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchEventsRequest) Reset() {
+	*x = WatchEventsRequest{}
+	mi := &file_control_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEventsRequest) ProtoMessage() {}
+
+func (x *WatchEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *WatchEventsRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *WatchEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchEventsRequest) GetFromRevision() int64 {
+	if x != nil {
+		return x.FromRevision
+	}
+	return 0
+}
+
+type SchedulerEventView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	WorkloadId    string                 `protobuf:"bytes,3,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Details       map[string]string      `protobuf:"bytes,7,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Revision      int64                  `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulerEventView) Reset() {
+	*x = SchedulerEventView{}
+	mi := &file_control_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerEventView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerEventView) ProtoMessage() {}
+
+func (x *SchedulerEventView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerEventView.ProtoReflect.Descriptor instead.
+func (*SchedulerEventView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *SchedulerEventView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SchedulerEventView) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SchedulerEventView) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *SchedulerEventView) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *SchedulerEventView) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SchedulerEventView) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *SchedulerEventView) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *SchedulerEventView) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListPendingWorkloadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWorkloadsRequest) Reset() {
+	*x = ListPendingWorkloadsRequest{}
+	mi := &file_control_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWorkloadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWorkloadsRequest) ProtoMessage() {}
+
+func (x *ListPendingWorkloadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWorkloadsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingWorkloadsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{178}
+}
+
+// Highest priority first, then oldest first.
+type ListPendingWorkloadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workloads     []*PendingWorkloadView `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingWorkloadsResponse) Reset() {
+	*x = ListPendingWorkloadsResponse{}
+	mi := &file_control_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingWorkloadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingWorkloadsResponse) ProtoMessage() {}
+
+func (x *ListPendingWorkloadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingWorkloadsResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingWorkloadsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListPendingWorkloadsResponse) GetWorkloads() []*PendingWorkloadView {
+	if x != nil {
+		return x.Workloads
+	}
+	return nil
+}
+
+type PendingWorkloadView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+	PriorityClass string                 `protobuf:"bytes,3,opt,name=priority_class,json=priorityClass,proto3" json:"priority_class,omitempty"`
+	QueuedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	LastAttemptAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	Attempts      int32                  `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	Rejections    []*NodeRejection       `protobuf:"bytes,9,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingWorkloadView) Reset() {
+	*x = PendingWorkloadView{}
+	mi := &file_control_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingWorkloadView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingWorkloadView) ProtoMessage() {}
+
+func (x *PendingWorkloadView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingWorkloadView.ProtoReflect.Descriptor instead.
+func (*PendingWorkloadView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *PendingWorkloadView) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *PendingWorkloadView) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PendingWorkloadView) GetPriorityClass() string {
+	if x != nil {
+		return x.PriorityClass
+	}
+	return ""
+}
+
+func (x *PendingWorkloadView) GetQueuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return nil
+}
+
+func (x *PendingWorkloadView) GetLastAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAttemptAt
+	}
+	return nil
+}
+
+func (x *PendingWorkloadView) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *PendingWorkloadView) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PendingWorkloadView) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PendingWorkloadView) GetRejections() []*NodeRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+type NodeRejection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"` // placement filter that rejected the node
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeRejection) Reset() {
+	*x = NodeRejection{}
+	mi := &file_control_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeRejection) ProtoMessage() {}
+
+func (x *NodeRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,107 +12700,191 @@ func (x *WorkloadUsageSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadUsageSnapshot.ProtoReflect.Descriptor instead.
-func (*WorkloadUsageSnapshot) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use NodeRejection.ProtoReflect.Descriptor instead.
+func (*NodeRejection) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *WorkloadUsageSnapshot) GetWorkloadId() string {
+func (x *NodeRejection) GetNodeId() string {
 	if x != nil {
-		return x.WorkloadId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetType() string {
+func (x *NodeRejection) GetFilter() string {
 	if x != nil {
-		return x.Type
+		return x.Filter
 	}
 	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetCpuPercent() float64 {
+func (x *NodeRejection) GetReason() string {
 	if x != nil {
-		return x.CpuPercent
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetMemoryBytes() int64 {
+// Simulates placing spec, or the stored workload when only workload_id is
+// set. With both, spec is placed as the new version of that workload, so its
+// current reservations do not count against it.
+type SimulatePlacementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Spec          *WorkloadSpec          `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	WorkloadId    string                 `protobuf:"bytes,2,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulatePlacementRequest) Reset() {
+	*x = SimulatePlacementRequest{}
+	mi := &file_control_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulatePlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulatePlacementRequest) ProtoMessage() {}
+
+func (x *SimulatePlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[182]
 	if x != nil {
-		return x.MemoryBytes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WorkloadUsageSnapshot) GetDiskReadBytes() int64 {
+// Deprecated: Use SimulatePlacementRequest.ProtoReflect.Descriptor instead.
+func (*SimulatePlacementRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SimulatePlacementRequest) GetSpec() *WorkloadSpec {
 	if x != nil {
-		return x.DiskReadBytes
+		return x.Spec
 	}
-	return 0
+	return nil
 }
 
-func (x *WorkloadUsageSnapshot) GetDiskWriteBytes() int64 {
+func (x *SimulatePlacementRequest) GetWorkloadId() string {
 	if x != nil {
-		return x.DiskWriteBytes
+		return x.WorkloadId
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetNetRxBytes() int64 {
+type PlacementPluginResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Passed        bool                   `protobuf:"varint,2,opt,name=passed,proto3" json:"passed,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // why a filter rejected the node
+	Score         float64                `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // 0-100, scorers only
+	Weight        float64                `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementPluginResult) Reset() {
+	*x = PlacementPluginResult{}
+	mi := &file_control_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementPluginResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementPluginResult) ProtoMessage() {}
+
+func (x *PlacementPluginResult) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[183]
 	if x != nil {
-		return x.NetRxBytes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WorkloadUsageSnapshot) GetNetTxBytes() int64 {
+// Deprecated: Use PlacementPluginResult.ProtoReflect.Descriptor instead.
+func (*PlacementPluginResult) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *PlacementPluginResult) GetPlugin() string {
 	if x != nil {
-		return x.NetTxBytes
+		return x.Plugin
 	}
-	return 0
+	return ""
 }
 
-func (x *WorkloadUsageSnapshot) GetCollectedAt() *timestamppb.Timestamp {
+func (x *PlacementPluginResult) GetPassed() bool {
 	if x != nil {
-		return x.CollectedAt
+		return x.Passed
 	}
-	return nil
+	return false
 }
 
-func (x *WorkloadUsageSnapshot) GetSource() string {
+func (x *PlacementPluginResult) GetReason() string {
 	if x != nil {
-		return x.Source
+		return x.Reason
 	}
 	return ""
 }
 
-type ReasonDetail struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	LastTransition *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
-	NextRetryAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`
-	Retryable      bool                   `protobuf:"varint,5,opt,name=retryable,proto3" json:"retryable,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PlacementPluginResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
 }
 
-func (x *ReasonDetail) Reset() {
-	*x = ReasonDetail{}
-	mi := &file_control_proto_msgTypes[26]
+func (x *PlacementPluginResult) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type PlacementCandidate struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	NodeId        string                   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Feasible      bool                     `protobuf:"varint,2,opt,name=feasible,proto3" json:"feasible,omitempty"`
+	Rank          int32                    `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                // 1 is the node placement would pick; 0 when infeasible
+	TotalScore    float64                  `protobuf:"fixed64,4,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"` // weighted sum of scores
+	Filters       []*PlacementPluginResult `protobuf:"bytes,5,rep,name=filters,proto3" json:"filters,omitempty"`                           // in order, up to the first rejection
+	Scores        []*PlacementPluginResult `protobuf:"bytes,6,rep,name=scores,proto3" json:"scores,omitempty"`
+	RejectedBy    string                   `protobuf:"bytes,7,opt,name=rejected_by,json=rejectedBy,proto3" json:"rejected_by,omitempty"` // filter that rejected the node
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementCandidate) Reset() {
+	*x = PlacementCandidate{}
+	mi := &file_control_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReasonDetail) String() string {
+func (x *PlacementCandidate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReasonDetail) ProtoMessage() {}
+func (*PlacementCandidate) ProtoMessage() {}
 
-func (x *ReasonDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[26]
+func (x *PlacementCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2131,74 +12895,90 @@ func (x *ReasonDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReasonDetail.ProtoReflect.Descriptor instead.
-func (*ReasonDetail) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PlacementCandidate.ProtoReflect.Descriptor instead.
+func (*PlacementCandidate) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{184}
 }
 
-func (x *ReasonDetail) GetCode() string {
+func (x *PlacementCandidate) GetNodeId() string {
 	if x != nil {
-		return x.Code
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *ReasonDetail) GetMessage() string {
+func (x *PlacementCandidate) GetFeasible() bool {
 	if x != nil {
-		return x.Message
+		return x.Feasible
 	}
-	return ""
+	return false
 }
 
-func (x *ReasonDetail) GetLastTransition() *timestamppb.Timestamp {
+func (x *PlacementCandidate) GetRank() int32 {
 	if x != nil {
-		return x.LastTransition
+		return x.Rank
 	}
-	return nil
+	return 0
 }
 
-func (x *ReasonDetail) GetNextRetryAt() *timestamppb.Timestamp {
+func (x *PlacementCandidate) GetTotalScore() float64 {
 	if x != nil {
-		return x.NextRetryAt
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *PlacementCandidate) GetFilters() []*PlacementPluginResult {
+	if x != nil {
+		return x.Filters
 	}
 	return nil
 }
 
-func (x *ReasonDetail) GetRetryable() bool {
+func (x *PlacementCandidate) GetScores() []*PlacementPluginResult {
 	if x != nil {
-		return x.Retryable
+		return x.Scores
 	}
-	return false
+	return nil
 }
 
-type WorkloadStatus struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	WorkloadId     string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
-	State          string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // Running, Stopped, Failed
-	FailureReason  FailureReason          `protobuf:"varint,3,opt,name=failure_reason,json=failureReason,proto3,enum=persys.control.v1.FailureReason" json:"failure_reason,omitempty"`
-	Message        string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	LastTransition *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_transition,json=lastTransition,proto3" json:"last_transition,omitempty"`
-	Reason         *ReasonDetail          `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
-	Usage          *WorkloadUsageSnapshot `protobuf:"bytes,7,opt,name=usage,proto3" json:"usage,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PlacementCandidate) GetRejectedBy() string {
+	if x != nil {
+		return x.RejectedBy
+	}
+	return ""
 }
 
-func (x *WorkloadStatus) Reset() {
-	*x = WorkloadStatus{}
-	mi := &file_control_proto_msgTypes[27]
+// Candidates are ranked: feasible nodes best first, then rejected nodes.
+type SimulatePlacementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Schedulable    bool                   `protobuf:"varint,1,opt,name=schedulable,proto3" json:"schedulable,omitempty"`
+	SelectedNodeId string                 `protobuf:"bytes,2,opt,name=selected_node_id,json=selectedNodeId,proto3" json:"selected_node_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // assignment_reason the workload would get
+	Strategy       string                 `protobuf:"bytes,4,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Candidates     []*PlacementCandidate  `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Set when no node fits but preempting lower-priority workloads would.
+	PreemptionNodeId  string   `protobuf:"bytes,6,opt,name=preemption_node_id,json=preemptionNodeId,proto3" json:"preemption_node_id,omitempty"`
+	PreemptionVictims []string `protobuf:"bytes,7,rep,name=preemption_victims,json=preemptionVictims,proto3" json:"preemption_victims,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SimulatePlacementResponse) Reset() {
+	*x = SimulatePlacementResponse{}
+	mi := &file_control_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadStatus) String() string {
+func (x *SimulatePlacementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadStatus) ProtoMessage() {}
+func (*SimulatePlacementResponse) ProtoMessage() {}
 
-func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[27]
+func (x *SimulatePlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2209,82 +12989,168 @@ func (x *WorkloadStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadStatus.ProtoReflect.Descriptor instead.
-func (*WorkloadStatus) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SimulatePlacementResponse.ProtoReflect.Descriptor instead.
+func (*SimulatePlacementResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{185}
 }
 
-func (x *WorkloadStatus) GetWorkloadId() string {
+func (x *SimulatePlacementResponse) GetSchedulable() bool {
 	if x != nil {
-		return x.WorkloadId
+		return x.Schedulable
 	}
-	return ""
+	return false
 }
 
-func (x *WorkloadStatus) GetState() string {
+func (x *SimulatePlacementResponse) GetSelectedNodeId() string {
 	if x != nil {
-		return x.State
+		return x.SelectedNodeId
 	}
 	return ""
 }
 
-func (x *WorkloadStatus) GetFailureReason() FailureReason {
+func (x *SimulatePlacementResponse) GetReason() string {
 	if x != nil {
-		return x.FailureReason
+		return x.Reason
 	}
-	return FailureReason_FAILURE_REASON_UNSPECIFIED
+	return ""
 }
 
-func (x *WorkloadStatus) GetMessage() string {
+func (x *SimulatePlacementResponse) GetStrategy() string {
 	if x != nil {
-		return x.Message
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *WorkloadStatus) GetLastTransition() *timestamppb.Timestamp {
+func (x *SimulatePlacementResponse) GetCandidates() []*PlacementCandidate {
 	if x != nil {
-		return x.LastTransition
+		return x.Candidates
 	}
 	return nil
 }
 
-func (x *WorkloadStatus) GetReason() *ReasonDetail {
+func (x *SimulatePlacementResponse) GetPreemptionNodeId() string {
 	if x != nil {
-		return x.Reason
+		return x.PreemptionNodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkloadStatus) GetUsage() *WorkloadUsageSnapshot {
+func (x *SimulatePlacementResponse) GetPreemptionVictims() []string {
 	if x != nil {
-		return x.Usage
+		return x.PreemptionVictims
 	}
 	return nil
 }
 
-type RetryWorkloadRequest struct {
+type GetWorkloadUsageHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`                                   // default: one hour before to
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`                                       // default: now
+	StepSeconds   int64                  `protobuf:"varint,5,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"` // default: the step of the rollup read
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RetryWorkloadRequest) Reset() {
-	*x = RetryWorkloadRequest{}
-	mi := &file_control_proto_msgTypes[28]
+func (x *GetWorkloadUsageHistoryRequest) Reset() {
+	*x = GetWorkloadUsageHistoryRequest{}
+	mi := &file_control_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadUsageHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadUsageHistoryRequest) ProtoMessage() {}
+
+func (x *GetWorkloadUsageHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadUsageHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadUsageHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *GetWorkloadUsageHistoryRequest) GetWorkloadId() string {
+	if x != nil {
+		return x.WorkloadId
+	}
+	return ""
+}
+
+func (x *GetWorkloadUsageHistoryRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetWorkloadUsageHistoryRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetWorkloadUsageHistoryRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetWorkloadUsageHistoryRequest) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
+}
+
+type WorkloadUsagePoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // start of the step
+	Samples        int32                  `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	CpuAvgPercent  float64                `protobuf:"fixed64,3,opt,name=cpu_avg_percent,json=cpuAvgPercent,proto3" json:"cpu_avg_percent,omitempty"`
+	CpuMaxPercent  float64                `protobuf:"fixed64,4,opt,name=cpu_max_percent,json=cpuMaxPercent,proto3" json:"cpu_max_percent,omitempty"`
+	MemoryAvgBytes int64                  `protobuf:"varint,5,opt,name=memory_avg_bytes,json=memoryAvgBytes,proto3" json:"memory_avg_bytes,omitempty"`
+	MemoryMaxBytes int64                  `protobuf:"varint,6,opt,name=memory_max_bytes,json=memoryMaxBytes,proto3" json:"memory_max_bytes,omitempty"`
+	// Counters as of the last sample in the step.
+	DiskReadBytes  int64 `protobuf:"varint,7,opt,name=disk_read_bytes,json=diskReadBytes,proto3" json:"disk_read_bytes,omitempty"`
+	DiskWriteBytes int64 `protobuf:"varint,8,opt,name=disk_write_bytes,json=diskWriteBytes,proto3" json:"disk_write_bytes,omitempty"`
+	NetRxBytes     int64 `protobuf:"varint,9,opt,name=net_rx_bytes,json=netRxBytes,proto3" json:"net_rx_bytes,omitempty"`
+	NetTxBytes     int64 `protobuf:"varint,10,opt,name=net_tx_bytes,json=netTxBytes,proto3" json:"net_tx_bytes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WorkloadUsagePoint) Reset() {
+	*x = WorkloadUsagePoint{}
+	mi := &file_control_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RetryWorkloadRequest) String() string {
+func (x *WorkloadUsagePoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetryWorkloadRequest) ProtoMessage() {}
+func (*WorkloadUsagePoint) ProtoMessage() {}
 
-func (x *RetryWorkloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[28]
+func (x *WorkloadUsagePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,84 +13161,109 @@ func (x *RetryWorkloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetryWorkloadRequest.ProtoReflect.Descriptor instead.
-func (*RetryWorkloadRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use WorkloadUsagePoint.ProtoReflect.Descriptor instead.
+func (*WorkloadUsagePoint) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{187}
 }
 
-func (x *RetryWorkloadRequest) GetWorkloadId() string {
+func (x *WorkloadUsagePoint) GetTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.WorkloadId
+		return x.Timestamp
 	}
-	return ""
+	return nil
 }
 
-type RetryWorkloadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Accepted      bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *WorkloadUsagePoint) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
 }
 
-func (x *RetryWorkloadResponse) Reset() {
-	*x = RetryWorkloadResponse{}
-	mi := &file_control_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *WorkloadUsagePoint) GetCpuAvgPercent() float64 {
+	if x != nil {
+		return x.CpuAvgPercent
+	}
+	return 0
 }
 
-func (x *RetryWorkloadResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WorkloadUsagePoint) GetCpuMaxPercent() float64 {
+	if x != nil {
+		return x.CpuMaxPercent
+	}
+	return 0
 }
 
-func (*RetryWorkloadResponse) ProtoMessage() {}
+func (x *WorkloadUsagePoint) GetMemoryAvgBytes() int64 {
+	if x != nil {
+		return x.MemoryAvgBytes
+	}
+	return 0
+}
 
-func (x *RetryWorkloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[29]
+func (x *WorkloadUsagePoint) GetMemoryMaxBytes() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MemoryMaxBytes
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use RetryWorkloadResponse.ProtoReflect.Descriptor instead.
-func (*RetryWorkloadResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{29}
+func (x *WorkloadUsagePoint) GetDiskReadBytes() int64 {
+	if x != nil {
+		return x.DiskReadBytes
+	}
+	return 0
 }
 
-func (x *RetryWorkloadResponse) GetAccepted() bool {
+func (x *WorkloadUsagePoint) GetDiskWriteBytes() int64 {
 	if x != nil {
-		return x.Accepted
+		return x.DiskWriteBytes
 	}
-	return false
+	return 0
 }
 
-type ListNodesRequest struct {
+func (x *WorkloadUsagePoint) GetNetRxBytes() int64 {
+	if x != nil {
+		return x.NetRxBytes
+	}
+	return 0
+}
+
+func (x *WorkloadUsagePoint) GetNetTxBytes() int64 {
+	if x != nil {
+		return x.NetTxBytes
+	}
+	return 0
+}
+
+type GetWorkloadUsageHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // optional filter: Ready | NotReady | Draining
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Resolution    string                 `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"` // rollup the points were read from: 1m, 5m or 1h
+	StepSeconds   int64                  `protobuf:"varint,3,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Points        []*WorkloadUsagePoint  `protobuf:"bytes,6,rep,name=points,proto3" json:"points,omitempty"`
+	CpuTrend      string                 `protobuf:"bytes,7,opt,name=cpu_trend,json=cpuTrend,proto3" json:"cpu_trend,omitempty"` // increasing, decreasing or stable; empty with fewer than two points
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesRequest) Reset() {
-	*x = ListNodesRequest{}
-	mi := &file_control_proto_msgTypes[30]
+func (x *GetWorkloadUsageHistoryResponse) Reset() {
+	*x = GetWorkloadUsageHistoryResponse{}
+	mi := &file_control_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesRequest) String() string {
+func (x *GetWorkloadUsageHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesRequest) ProtoMessage() {}
+func (*GetWorkloadUsageHistoryResponse) ProtoMessage() {}
 
-func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[30]
+func (x *GetWorkloadUsageHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2383,84 +13274,82 @@ func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
-func (*ListNodesRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetWorkloadUsageHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkloadUsageHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *ListNodesRequest) GetStatus() string {
+func (x *GetWorkloadUsageHistoryResponse) GetWorkloadId() string {
 	if x != nil {
-		return x.Status
+		return x.WorkloadId
 	}
 	return ""
 }
 
-type GetNodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetWorkloadUsageHistoryResponse) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
 }
 
-func (x *GetNodeRequest) Reset() {
-	*x = GetNodeRequest{}
-	mi := &file_control_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetWorkloadUsageHistoryResponse) GetStepSeconds() int64 {
+	if x != nil {
+		return x.StepSeconds
+	}
+	return 0
 }
 
-func (x *GetNodeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetWorkloadUsageHistoryResponse) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
 }
 
-func (*GetNodeRequest) ProtoMessage() {}
-
-func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[31]
+func (x *GetWorkloadUsageHistoryResponse) GetTo() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.To
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
-func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{31}
+func (x *GetWorkloadUsageHistoryResponse) GetPoints() []*WorkloadUsagePoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
 }
 
-func (x *GetNodeRequest) GetNodeId() string {
+func (x *GetWorkloadUsageHistoryResponse) GetCpuTrend() string {
 	if x != nil {
-		return x.NodeId
+		return x.CpuTrend
 	}
 	return ""
 }
 
-type ListNodesResponse struct {
+type ListWorkloadRevisionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Nodes         []*NodeView            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListNodesResponse) Reset() {
-	*x = ListNodesResponse{}
-	mi := &file_control_proto_msgTypes[32]
+func (x *ListWorkloadRevisionsRequest) Reset() {
+	*x = ListWorkloadRevisionsRequest{}
+	mi := &file_control_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListNodesResponse) String() string {
+func (x *ListWorkloadRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNodesResponse) ProtoMessage() {}
+func (*ListWorkloadRevisionsRequest) ProtoMessage() {}
 
-func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[32]
+func (x *ListWorkloadRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,40 +13360,41 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
-func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ListWorkloadRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkloadRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *ListNodesResponse) GetNodes() []*NodeView {
+func (x *ListWorkloadRevisionsRequest) GetWorkloadId() string {
 	if x != nil {
-		return x.Nodes
+		return x.WorkloadId
 	}
-	return nil
+	return ""
 }
 
-type GetNodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Node          *NodeView              `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+// Newest first.
+type ListWorkloadRevisionsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Revisions     []*WorkloadRevisionView `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNodeResponse) Reset() {
-	*x = GetNodeResponse{}
-	mi := &file_control_proto_msgTypes[33]
+func (x *ListWorkloadRevisionsResponse) Reset() {
+	*x = ListWorkloadRevisionsResponse{}
+	mi := &file_control_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNodeResponse) String() string {
+func (x *ListWorkloadRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeResponse) ProtoMessage() {}
+func (*ListWorkloadRevisionsResponse) ProtoMessage() {}
 
-func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[33]
+func (x *ListWorkloadRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,52 +13405,46 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ListWorkloadRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkloadRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{190}
 }
 
-func (x *GetNodeResponse) GetNode() *NodeView {
+func (x *ListWorkloadRevisionsResponse) GetRevisions() []*WorkloadRevisionView {
 	if x != nil {
-		return x.Node
+		return x.Revisions
 	}
 	return nil
 }
 
-type NodeView struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	NodeId                 string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Status                 string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	StatusReason           string                 `protobuf:"bytes,3,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`
-	StatusUpdatedBy        string                 `protobuf:"bytes,4,opt,name=status_updated_by,json=statusUpdatedBy,proto3" json:"status_updated_by,omitempty"`
-	StatusUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=status_updated_at,json=statusUpdatedAt,proto3" json:"status_updated_at,omitempty"`
-	LastHeartbeat          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
-	GrpcEndpoint           string                 `protobuf:"bytes,7,opt,name=grpc_endpoint,json=grpcEndpoint,proto3" json:"grpc_endpoint,omitempty"`
-	TotalCpuCores          float64                `protobuf:"fixed64,8,opt,name=total_cpu_cores,json=totalCpuCores,proto3" json:"total_cpu_cores,omitempty"`
-	AvailableCpuCores      float64                `protobuf:"fixed64,9,opt,name=available_cpu_cores,json=availableCpuCores,proto3" json:"available_cpu_cores,omitempty"`
-	TotalMemoryMb          int64                  `protobuf:"varint,10,opt,name=total_memory_mb,json=totalMemoryMb,proto3" json:"total_memory_mb,omitempty"`
-	AvailableMemoryMb      int64                  `protobuf:"varint,11,opt,name=available_memory_mb,json=availableMemoryMb,proto3" json:"available_memory_mb,omitempty"`
-	SupportedWorkloadTypes []string               `protobuf:"bytes,12,rep,name=supported_workload_types,json=supportedWorkloadTypes,proto3" json:"supported_workload_types,omitempty"`
-	Labels                 map[string]string      `protobuf:"bytes,13,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type WorkloadRevisionView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RevisionId    string                 `protobuf:"bytes,1,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	Revision      int32                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	ChangeCause   string                 `protobuf:"bytes,3,opt,name=change_cause,json=changeCause,proto3" json:"change_cause,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current       bool                   `protobuf:"varint,5,opt,name=current,proto3" json:"current,omitempty"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Image         string                 `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeView) Reset() {
-	*x = NodeView{}
-	mi := &file_control_proto_msgTypes[34]
+func (x *WorkloadRevisionView) Reset() {
+	*x = WorkloadRevisionView{}
+	mi := &file_control_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeView) String() string {
+func (x *WorkloadRevisionView) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeView) ProtoMessage() {}
+func (*WorkloadRevisionView) ProtoMessage() {}
 
-func (x *NodeView) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[34]
+func (x *WorkloadRevisionView) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2571,125 +13455,137 @@ func (x *NodeView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeView.ProtoReflect.Descriptor instead.
-func (*NodeView) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use WorkloadRevisionView.ProtoReflect.Descriptor instead.
+func (*WorkloadRevisionView) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *NodeView) GetNodeId() string {
+func (x *WorkloadRevisionView) GetRevisionId() string {
 	if x != nil {
-		return x.NodeId
+		return x.RevisionId
 	}
 	return ""
 }
 
-func (x *NodeView) GetStatus() string {
+func (x *WorkloadRevisionView) GetRevision() int32 {
 	if x != nil {
-		return x.Status
+		return x.Revision
 	}
-	return ""
+	return 0
 }
 
-func (x *NodeView) GetStatusReason() string {
+func (x *WorkloadRevisionView) GetChangeCause() string {
 	if x != nil {
-		return x.StatusReason
+		return x.ChangeCause
 	}
 	return ""
 }
 
-func (x *NodeView) GetStatusUpdatedBy() string {
+func (x *WorkloadRevisionView) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StatusUpdatedBy
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeView) GetStatusUpdatedAt() *timestamppb.Timestamp {
+func (x *WorkloadRevisionView) GetCurrent() bool {
 	if x != nil {
-		return x.StatusUpdatedAt
+		return x.Current
 	}
-	return nil
+	return false
 }
 
-func (x *NodeView) GetLastHeartbeat() *timestamppb.Timestamp {
+func (x *WorkloadRevisionView) GetType() string {
 	if x != nil {
-		return x.LastHeartbeat
+		return x.Type
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeView) GetGrpcEndpoint() string {
+func (x *WorkloadRevisionView) GetImage() string {
 	if x != nil {
-		return x.GrpcEndpoint
+		return x.Image
 	}
 	return ""
 }
 
-func (x *NodeView) GetTotalCpuCores() float64 {
-	if x != nil {
-		return x.TotalCpuCores
-	}
-	return 0
+// An empty revision_id rolls back to the newest revision before the current one.
+type RollbackWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	RevisionId    string                 `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NodeView) GetAvailableCpuCores() float64 {
-	if x != nil {
-		return x.AvailableCpuCores
-	}
-	return 0
+func (x *RollbackWorkloadRequest) Reset() {
+	*x = RollbackWorkloadRequest{}
+	mi := &file_control_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *NodeView) GetTotalMemoryMb() int64 {
-	if x != nil {
-		return x.TotalMemoryMb
-	}
-	return 0
+func (x *RollbackWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *NodeView) GetAvailableMemoryMb() int64 {
+func (*RollbackWorkloadRequest) ProtoMessage() {}
+
+func (x *RollbackWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[192]
 	if x != nil {
-		return x.AvailableMemoryMb
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *NodeView) GetSupportedWorkloadTypes() []string {
+// Deprecated: Use RollbackWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*RollbackWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *RollbackWorkloadRequest) GetWorkloadId() string {
 	if x != nil {
-		return x.SupportedWorkloadTypes
+		return x.WorkloadId
 	}
-	return nil
+	return ""
 }
 
-func (x *NodeView) GetLabels() map[string]string {
+func (x *RollbackWorkloadRequest) GetRevisionId() string {
 	if x != nil {
-		return x.Labels
+		return x.RevisionId
 	}
-	return nil
+	return ""
 }
 
-type ListWorkloadsRequest struct {
+type RollbackWorkloadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // optional filter
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // optional filter
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Workload      *WorkloadView          `protobuf:"bytes,3,opt,name=workload,proto3" json:"workload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkloadsRequest) Reset() {
-	*x = ListWorkloadsRequest{}
-	mi := &file_control_proto_msgTypes[35]
+func (x *RollbackWorkloadResponse) Reset() {
+	*x = RollbackWorkloadResponse{}
+	mi := &file_control_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkloadsRequest) String() string {
+func (x *RollbackWorkloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkloadsRequest) ProtoMessage() {}
+func (*RollbackWorkloadResponse) ProtoMessage() {}
 
-func (x *ListWorkloadsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[35]
+func (x *RollbackWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2700,47 +13596,59 @@ func (x *ListWorkloadsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkloadsRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkloadsRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use RollbackWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*RollbackWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{193}
 }
 
-func (x *ListWorkloadsRequest) GetNodeId() string {
+func (x *RollbackWorkloadResponse) GetSuccess() bool {
 	if x != nil {
-		return x.NodeId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *ListWorkloadsRequest) GetStatus() string {
+func (x *RollbackWorkloadResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.Status
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-type GetWorkloadRequest struct {
+func (x *RollbackWorkloadResponse) GetWorkload() *WorkloadView {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+type StreamWorkloadLogsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // when set, the workload must be in it
+	Follow        bool                   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+	TailLines     int64                  `protobuf:"varint,4,opt,name=tail_lines,json=tailLines,proto3" json:"tail_lines,omitempty"` // 0 = whole log
+	Since         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	Container     string                 `protobuf:"bytes,6,opt,name=container,proto3" json:"container,omitempty"` // compose service; empty = all
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkloadRequest) Reset() {
-	*x = GetWorkloadRequest{}
-	mi := &file_control_proto_msgTypes[36]
+func (x *StreamWorkloadLogsRequest) Reset() {
+	*x = StreamWorkloadLogsRequest{}
+	mi := &file_control_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkloadRequest) String() string {
+func (x *StreamWorkloadLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkloadRequest) ProtoMessage() {}
+func (*StreamWorkloadLogsRequest) ProtoMessage() {}
 
-func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[36]
+func (x *StreamWorkloadLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,40 +13659,78 @@ func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use StreamWorkloadLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamWorkloadLogsRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *GetWorkloadRequest) GetWorkloadId() string {
+func (x *StreamWorkloadLogsRequest) GetWorkloadId() string {
 	if x != nil {
 		return x.WorkloadId
 	}
 	return ""
 }
 
-type ListWorkloadsResponse struct {
+func (x *StreamWorkloadLogsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StreamWorkloadLogsRequest) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+func (x *StreamWorkloadLogsRequest) GetTailLines() int64 {
+	if x != nil {
+		return x.TailLines
+	}
+	return 0
+}
+
+func (x *StreamWorkloadLogsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *StreamWorkloadLogsRequest) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type WorkloadLogChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workloads     []*WorkloadView        `protobuf:"bytes,1,rep,name=workloads,proto3" json:"workloads,omitempty"`
+	Stream        LogStream              `protobuf:"varint,1,opt,name=stream,proto3,enum=persys.control.v1.LogStream" json:"stream,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Container     string                 `protobuf:"bytes,4,opt,name=container,proto3" json:"container,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWorkloadsResponse) Reset() {
-	*x = ListWorkloadsResponse{}
-	mi := &file_control_proto_msgTypes[37]
+func (x *WorkloadLogChunk) Reset() {
+	*x = WorkloadLogChunk{}
+	mi := &file_control_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWorkloadsResponse) String() string {
+func (x *WorkloadLogChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkloadsResponse) ProtoMessage() {}
+func (*WorkloadLogChunk) ProtoMessage() {}
 
-func (x *ListWorkloadsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[37]
+func (x *WorkloadLogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,40 +13741,62 @@ func (x *ListWorkloadsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkloadsResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkloadsResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use WorkloadLogChunk.ProtoReflect.Descriptor instead.
+func (*WorkloadLogChunk) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{195}
 }
 
-func (x *ListWorkloadsResponse) GetWorkloads() []*WorkloadView {
+func (x *WorkloadLogChunk) GetStream() LogStream {
 	if x != nil {
-		return x.Workloads
+		return x.Stream
+	}
+	return LogStream_LOG_STREAM_UNSPECIFIED
+}
+
+func (x *WorkloadLogChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type GetWorkloadResponse struct {
+func (x *WorkloadLogChunk) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *WorkloadLogChunk) GetContainer() string {
+	if x != nil {
+		return x.Container
+	}
+	return ""
+}
+
+type TerminalSize struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Workload      *WorkloadView          `protobuf:"bytes,1,opt,name=workload,proto3" json:"workload,omitempty"`
+	Cols          uint32                 `protobuf:"varint,1,opt,name=cols,proto3" json:"cols,omitempty"`
+	Rows          uint32                 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWorkloadResponse) Reset() {
-	*x = GetWorkloadResponse{}
-	mi := &file_control_proto_msgTypes[38]
+func (x *TerminalSize) Reset() {
+	*x = TerminalSize{}
+	mi := &file_control_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWorkloadResponse) String() string {
+func (x *TerminalSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWorkloadResponse) ProtoMessage() {}
+func (*TerminalSize) ProtoMessage() {}
 
-func (x *GetWorkloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[38]
+func (x *TerminalSize) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2839,52 +13807,54 @@ func (x *GetWorkloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWorkloadResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkloadResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use TerminalSize.ProtoReflect.Descriptor instead.
+func (*TerminalSize) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *GetWorkloadResponse) GetWorkload() *WorkloadView {
+func (x *TerminalSize) GetCols() uint32 {
 	if x != nil {
-		return x.Workload
+		return x.Cols
 	}
-	return nil
+	return 0
 }
 
-type WorkloadView struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	WorkloadId       string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
-	Type             string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	DesiredState     string                 `protobuf:"bytes,3,opt,name=desired_state,json=desiredState,proto3" json:"desired_state,omitempty"`
-	Status           string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	AssignedNodeId   string                 `protobuf:"bytes,5,opt,name=assigned_node_id,json=assignedNodeId,proto3" json:"assigned_node_id,omitempty"`
-	RevisionId       string                 `protobuf:"bytes,6,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
-	RetryAttempts    int32                  `protobuf:"varint,7,opt,name=retry_attempts,json=retryAttempts,proto3" json:"retry_attempts,omitempty"`
-	RetryMaxAttempts int32                  `protobuf:"varint,8,opt,name=retry_max_attempts,json=retryMaxAttempts,proto3" json:"retry_max_attempts,omitempty"`
-	RetryNextAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=retry_next_at,json=retryNextAt,proto3" json:"retry_next_at,omitempty"`
-	FailureReason    string                 `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
-	LastUpdated      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	Reason           *ReasonDetail          `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Usage            *WorkloadUsageSnapshot `protobuf:"bytes,13,opt,name=usage,proto3" json:"usage,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *TerminalSize) GetRows() uint32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
 }
 
-func (x *WorkloadView) Reset() {
-	*x = WorkloadView{}
-	mi := &file_control_proto_msgTypes[39]
+type ExecStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WorkloadId    string                 `protobuf:"bytes,1,opt,name=workload_id,json=workloadId,proto3" json:"workload_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"` // when set, the workload must be in it
+	Command       []string               `protobuf:"bytes,3,rep,name=command,proto3" json:"command,omitempty"`
+	Tty           bool                   `protobuf:"varint,4,opt,name=tty,proto3" json:"tty,omitempty"`
+	Stdin         bool                   `protobuf:"varint,5,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Size          *TerminalSize          `protobuf:"bytes,6,opt,name=size,proto3" json:"size,omitempty"`
+	Container     string                 `protobuf:"bytes,7,opt,name=container,proto3" json:"container,omitempty"`                        // compose service; required for compose workloads
+	RequestedBy   string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // caller identity recorded in the audit event
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecStart) Reset() {
+	*x = ExecStart{}
+	mi := &file_control_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WorkloadView) String() string {
+func (x *ExecStart) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkloadView) ProtoMessage() {}
+func (*ExecStart) ProtoMessage() {}
 
-func (x *WorkloadView) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[39]
+func (x *ExecStart) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2895,168 +13865,96 @@ func (x *WorkloadView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkloadView.ProtoReflect.Descriptor instead.
-func (*WorkloadView) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ExecStart.ProtoReflect.Descriptor instead.
+func (*ExecStart) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{197}
 }
 
-func (x *WorkloadView) GetWorkloadId() string {
+func (x *ExecStart) GetWorkloadId() string {
 	if x != nil {
 		return x.WorkloadId
 	}
 	return ""
 }
 
-func (x *WorkloadView) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
-
-func (x *WorkloadView) GetDesiredState() string {
-	if x != nil {
-		return x.DesiredState
-	}
-	return ""
-}
-
-func (x *WorkloadView) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *WorkloadView) GetAssignedNodeId() string {
+func (x *ExecStart) GetNamespace() string {
 	if x != nil {
-		return x.AssignedNodeId
+		return x.Namespace
 	}
 	return ""
 }
 
-func (x *WorkloadView) GetRevisionId() string {
+func (x *ExecStart) GetCommand() []string {
 	if x != nil {
-		return x.RevisionId
+		return x.Command
 	}
-	return ""
+	return nil
 }
 
-func (x *WorkloadView) GetRetryAttempts() int32 {
+func (x *ExecStart) GetTty() bool {
 	if x != nil {
-		return x.RetryAttempts
+		return x.Tty
 	}
-	return 0
+	return false
 }
 
-func (x *WorkloadView) GetRetryMaxAttempts() int32 {
+func (x *ExecStart) GetStdin() bool {
 	if x != nil {
-		return x.RetryMaxAttempts
+		return x.Stdin
 	}
-	return 0
+	return false
 }
 
-func (x *WorkloadView) GetRetryNextAt() *timestamppb.Timestamp {
+func (x *ExecStart) GetSize() *TerminalSize {
 	if x != nil {
-		return x.RetryNextAt
+		return x.Size
 	}
 	return nil
 }
 
-func (x *WorkloadView) GetFailureReason() string {
+func (x *ExecStart) GetContainer() string {
 	if x != nil {
-		return x.FailureReason
+		return x.Container
 	}
 	return ""
 }
 
-func (x *WorkloadView) GetLastUpdated() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdated
-	}
-	return nil
-}
-
-func (x *WorkloadView) GetReason() *ReasonDetail {
-	if x != nil {
-		return x.Reason
-	}
-	return nil
-}
-
-func (x *WorkloadView) GetUsage() *WorkloadUsageSnapshot {
+func (x *ExecStart) GetRequestedBy() string {
 	if x != nil {
-		return x.Usage
+		return x.RequestedBy
 	}
-	return nil
+	return ""
 }
 
-type GetClusterSummaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// The first request of an exec stream must carry start.
+type ExecWorkloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*ExecWorkloadRequest_Start
+	//	*ExecWorkloadRequest_Stdin
+	//	*ExecWorkloadRequest_Resize
+	//	*ExecWorkloadRequest_CloseStdin
+	Payload       isExecWorkloadRequest_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterSummaryRequest) Reset() {
-	*x = GetClusterSummaryRequest{}
-	mi := &file_control_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetClusterSummaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetClusterSummaryRequest) ProtoMessage() {}
-
-func (x *GetClusterSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[40]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetClusterSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{40}
-}
-
-type GetClusterSummaryResponse struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	TotalNodes       int32                  `protobuf:"varint,1,opt,name=total_nodes,json=totalNodes,proto3" json:"total_nodes,omitempty"`
-	ReadyNodes       int32                  `protobuf:"varint,2,opt,name=ready_nodes,json=readyNodes,proto3" json:"ready_nodes,omitempty"`
-	NotReadyNodes    int32                  `protobuf:"varint,3,opt,name=not_ready_nodes,json=notReadyNodes,proto3" json:"not_ready_nodes,omitempty"`
-	TotalWorkloads   int32                  `protobuf:"varint,4,opt,name=total_workloads,json=totalWorkloads,proto3" json:"total_workloads,omitempty"`
-	RunningWorkloads int32                  `protobuf:"varint,5,opt,name=running_workloads,json=runningWorkloads,proto3" json:"running_workloads,omitempty"`
-	PendingWorkloads int32                  `protobuf:"varint,6,opt,name=pending_workloads,json=pendingWorkloads,proto3" json:"pending_workloads,omitempty"`
-	FailedWorkloads  int32                  `protobuf:"varint,7,opt,name=failed_workloads,json=failedWorkloads,proto3" json:"failed_workloads,omitempty"`
-	DeletedWorkloads int32                  `protobuf:"varint,8,opt,name=deleted_workloads,json=deletedWorkloads,proto3" json:"deleted_workloads,omitempty"`
-	GeneratedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GetClusterSummaryResponse) Reset() {
-	*x = GetClusterSummaryResponse{}
-	mi := &file_control_proto_msgTypes[41]
+func (x *ExecWorkloadRequest) Reset() {
+	*x = ExecWorkloadRequest{}
+	mi := &file_control_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterSummaryResponse) String() string {
+func (x *ExecWorkloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterSummaryResponse) ProtoMessage() {}
+func (*ExecWorkloadRequest) ProtoMessage() {}
 
-func (x *GetClusterSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[41]
+func (x *ExecWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3067,102 +13965,161 @@ func (x *GetClusterSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ExecWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*ExecWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *GetClusterSummaryResponse) GetTotalNodes() int32 {
+func (x *ExecWorkloadRequest) GetPayload() isExecWorkloadRequest_Payload {
 	if x != nil {
-		return x.TotalNodes
+		return x.Payload
 	}
-	return 0
+	return nil
 }
 
-func (x *GetClusterSummaryResponse) GetReadyNodes() int32 {
+func (x *ExecWorkloadRequest) GetStart() *ExecStart {
 	if x != nil {
-		return x.ReadyNodes
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Start); ok {
+			return x.Start
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GetClusterSummaryResponse) GetNotReadyNodes() int32 {
+func (x *ExecWorkloadRequest) GetStdin() []byte {
 	if x != nil {
-		return x.NotReadyNodes
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Stdin); ok {
+			return x.Stdin
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GetClusterSummaryResponse) GetTotalWorkloads() int32 {
+func (x *ExecWorkloadRequest) GetResize() *TerminalSize {
 	if x != nil {
-		return x.TotalWorkloads
+		if x, ok := x.Payload.(*ExecWorkloadRequest_Resize); ok {
+			return x.Resize
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *GetClusterSummaryResponse) GetRunningWorkloads() int32 {
+func (x *ExecWorkloadRequest) GetCloseStdin() bool {
 	if x != nil {
-		return x.RunningWorkloads
+		if x, ok := x.Payload.(*ExecWorkloadRequest_CloseStdin); ok {
+			return x.CloseStdin
+		}
 	}
-	return 0
+	return false
 }
 
-func (x *GetClusterSummaryResponse) GetPendingWorkloads() int32 {
-	if x != nil {
-		return x.PendingWorkloads
-	}
-	return 0
+type isExecWorkloadRequest_Payload interface {
+	isExecWorkloadRequest_Payload()
 }
 
-func (x *GetClusterSummaryResponse) GetFailedWorkloads() int32 {
+type ExecWorkloadRequest_Start struct {
+	Start *ExecStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_Stdin struct {
+	Stdin []byte `protobuf:"bytes,2,opt,name=stdin,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_Resize struct {
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize,proto3,oneof"`
+}
+
+type ExecWorkloadRequest_CloseStdin struct {
+	CloseStdin bool `protobuf:"varint,4,opt,name=close_stdin,json=closeStdin,proto3,oneof"`
+}
+
+func (*ExecWorkloadRequest_Start) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_Stdin) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_Resize) isExecWorkloadRequest_Payload() {}
+
+func (*ExecWorkloadRequest_CloseStdin) isExecWorkloadRequest_Payload() {}
+
+type ExecExit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExitCode      int32                  `protobuf:"varint,1,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecExit) Reset() {
+	*x = ExecExit{}
+	mi := &file_control_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecExit) ProtoMessage() {}
+
+func (x *ExecExit) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[199]
 	if x != nil {
-		return x.FailedWorkloads
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetClusterSummaryResponse) GetDeletedWorkloads() int32 {
+// Deprecated: Use ExecExit.ProtoReflect.Descriptor instead.
+func (*ExecExit) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ExecExit) GetExitCode() int32 {
 	if x != nil {
-		return x.DeletedWorkloads
+		return x.ExitCode
 	}
 	return 0
 }
 
-func (x *GetClusterSummaryResponse) GetGeneratedAt() *timestamppb.Timestamp {
+func (x *ExecExit) GetError() string {
 	if x != nil {
-		return x.GeneratedAt
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type ControlMessage struct {
+type ExecWorkloadResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Message:
+	// Types that are valid to be assigned to Payload:
 	//
-	//	*ControlMessage_Register
-	//	*ControlMessage_Heartbeat
-	//	*ControlMessage_Apply
-	//	*ControlMessage_Delete
-	Message       isControlMessage_Message `protobuf_oneof:"message"`
+	//	*ExecWorkloadResponse_Stdout
+	//	*ExecWorkloadResponse_Stderr
+	//	*ExecWorkloadResponse_Exit
+	Payload       isExecWorkloadResponse_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ControlMessage) Reset() {
-	*x = ControlMessage{}
-	mi := &file_control_proto_msgTypes[42]
+func (x *ExecWorkloadResponse) Reset() {
+	*x = ExecWorkloadResponse{}
+	mi := &file_control_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ControlMessage) String() string {
+func (x *ExecWorkloadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ControlMessage) ProtoMessage() {}
+func (*ExecWorkloadResponse) ProtoMessage() {}
 
-func (x *ControlMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_control_proto_msgTypes[42]
+func (x *ExecWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_control_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3173,81 +14130,66 @@ func (x *ControlMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ControlMessage.ProtoReflect.Descriptor instead.
-func (*ControlMessage) Descriptor() ([]byte, []int) {
-	return file_control_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *ControlMessage) GetMessage() isControlMessage_Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
+// Deprecated: Use ExecWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*ExecWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_control_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *ControlMessage) GetRegister() *RegisterNodeRequest {
+func (x *ExecWorkloadResponse) GetPayload() isExecWorkloadResponse_Payload {
 	if x != nil {
-		if x, ok := x.Message.(*ControlMessage_Register); ok {
-			return x.Register
-		}
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *ControlMessage) GetHeartbeat() *HeartbeatRequest {
+func (x *ExecWorkloadResponse) GetStdout() []byte {
 	if x != nil {
-		if x, ok := x.Message.(*ControlMessage_Heartbeat); ok {
-			return x.Heartbeat
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Stdout); ok {
+			return x.Stdout
 		}
 	}
 	return nil
 }
 
-func (x *ControlMessage) GetApply() *ApplyWorkloadRequest {
+func (x *ExecWorkloadResponse) GetStderr() []byte {
 	if x != nil {
-		if x, ok := x.Message.(*ControlMessage_Apply); ok {
-			return x.Apply
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Stderr); ok {
+			return x.Stderr
 		}
 	}
 	return nil
 }
 
-func (x *ControlMessage) GetDelete() *DeleteWorkloadRequest {
+func (x *ExecWorkloadResponse) GetExit() *ExecExit {
 	if x != nil {
-		if x, ok := x.Message.(*ControlMessage_Delete); ok {
-			return x.Delete
+		if x, ok := x.Payload.(*ExecWorkloadResponse_Exit); ok {
+			return x.Exit
 		}
 	}
 	return nil
 }
 
-type isControlMessage_Message interface {
-	isControlMessage_Message()
-}
-
-type ControlMessage_Register struct {
-	Register *RegisterNodeRequest `protobuf:"bytes,1,opt,name=register,proto3,oneof"`
+type isExecWorkloadResponse_Payload interface {
+	isExecWorkloadResponse_Payload()
 }
 
-type ControlMessage_Heartbeat struct {
-	Heartbeat *HeartbeatRequest `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+type ExecWorkloadResponse_Stdout struct {
+	Stdout []byte `protobuf:"bytes,1,opt,name=stdout,proto3,oneof"`
 }
 
-type ControlMessage_Apply struct {
-	Apply *ApplyWorkloadRequest `protobuf:"bytes,3,opt,name=apply,proto3,oneof"`
+type ExecWorkloadResponse_Stderr struct {
+	Stderr []byte `protobuf:"bytes,2,opt,name=stderr,proto3,oneof"`
 }
 
-type ControlMessage_Delete struct {
-	Delete *DeleteWorkloadRequest `protobuf:"bytes,4,opt,name=delete,proto3,oneof"`
+type ExecWorkloadResponse_Exit struct {
+	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit,proto3,oneof"` // last message of the stream
 }
 
-func (*ControlMessage_Register) isControlMessage_Message() {}
-
-func (*ControlMessage_Heartbeat) isControlMessage_Message() {}
+func (*ExecWorkloadResponse_Stdout) isExecWorkloadResponse_Payload() {}
 
-func (*ControlMessage_Apply) isControlMessage_Message() {}
+func (*ExecWorkloadResponse_Stderr) isExecWorkloadResponse_Payload() {}
 
-func (*ControlMessage_Delete) isControlMessage_Message() {}
+func (*ExecWorkloadResponse_Exit) isExecWorkloadResponse_Payload() {}
 
 var File_control_proto protoreflect.FileDescriptor
 
@@ -3278,7 +14220,7 @@ const file_control_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\x12%\n" +
 	"\x0eapplied_action\x18\x04 \x01(\tR\rappliedAction\x129\n" +
 	"\n" +
-	"decided_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"\xa1\x03\n" +
+	"decided_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"\xd3\x03\n" +
 	"\x13RegisterNodeRequest\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12G\n" +
 	"\fcapabilities\x18\x02 \x01(\v2#.persys.control.v1.NodeCapabilitiesR\fcapabilities\x12J\n" +
@@ -3287,10 +14229,21 @@ const file_control_proto_rawDesc = "" +
 	"\rgrpc_endpoint\x18\x05 \x01(\tR\fgrpcEndpoint\x12\x1d\n" +
 	"\n" +
 	"cluster_id\x18\x06 \x01(\tR\tclusterId\x128\n" +
-	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x1a9\n" +
+	"\ttimestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x120\n" +
+	"\x06taints\x18\b \x03(\v2\x18.persys.control.v1.TaintR\x06taints\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa7\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
+	"\x05Taint\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\"h\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\"\xa7\x02\n" +
 	"\x10NodeCapabilities\x120\n" +
 	"\x14cpu_total_millicores\x18\x01 \x01(\x03R\x12cpuTotalMillicores\x12&\n" +
 	"\x0fmemory_total_mb\x18\x02 \x01(\x03R\rmemoryTotalMb\x12C\n" +
@@ -3311,7 +14264,7 @@ const file_control_proto_rawDesc = "" +
 	"\x05usage\x18\x02 \x01(\v2\x1c.persys.control.v1.NodeUsageR\x05usage\x12N\n" +
 	"\x11workload_statuses\x18\x03 \x03(\v2!.persys.control.v1.WorkloadStatusR\x10workloadStatuses\x128\n" +
 	"\ttimestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12O\n" +
-	"\x0eworkload_usage\x18\x05 \x03(\v2(.persys.control.v1.WorkloadUsageSnapshotR\rworkloadUsage\"\x99\x02\n" +
+	"\x0eworkload_usage\x18\x05 \x03(\v2(.persys.control.v1.WorkloadUsageSnapshotR\rworkloadUsage\"\xe3\x02\n" +
 	"\tNodeUsage\x128\n" +
 	"\x18cpu_allocated_millicores\x18\x01 \x01(\x03R\x16cpuAllocatedMillicores\x12.\n" +
 	"\x13cpu_used_millicores\x18\x02 \x01(\x03R\x11cpuUsedMillicores\x12.\n" +
@@ -3319,7 +14272,12 @@ const file_control_proto_rawDesc = "" +
 	"\x0ememory_used_mb\x18\x04 \x01(\x03R\fmemoryUsedMb\x12*\n" +
 	"\x11disk_allocated_gb\x18\x05 \x01(\x03R\x0fdiskAllocatedGb\x12 \n" +
 	"\fdisk_used_gb\x18\x06 \x01(\x03R\n" +
-	"diskUsedGb\"\x9c\x01\n" +
+	"diskUsedGb\x12H\n" +
+	"\rstorage_pools\x18\a \x03(\v2#.persys.control.v1.StoragePoolUsageR\fstoragePools\"b\n" +
+	"\x10StoragePoolUsage\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fallocated_gb\x18\x02 \x01(\x03R\vallocatedGb\x12\x17\n" +
+	"\aused_gb\x18\x03 \x01(\x03R\x06usedGb\"\x9c\x01\n" +
 	"\x11HeartbeatResponse\x12\"\n" +
 	"\facknowledged\x18\x01 \x01(\bR\facknowledged\x12\x1d\n" +
 	"\n" +
@@ -3336,13 +14294,14 @@ const file_control_proto_rawDesc = "" +
 	"\x15ApplyWorkloadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12G\n" +
 	"\x0efailure_reason\x18\x02 \x01(\x0e2 .persys.control.v1.FailureReasonR\rfailureReason\x12#\n" +
-	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"8\n" +
+	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"V\n" +
 	"\x15DeleteWorkloadRequest\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
-	"workloadId\"W\n" +
+	"workloadId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"W\n" +
 	"\x16DeleteWorkloadResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xa8\x03\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xca\x05\n" +
 	"\fWorkloadSpec\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12E\n" +
 	"\tresources\x18\x02 \x01(\v2'.persys.control.v1.ResourceRequirementsR\tresources\x12@\n" +
@@ -3350,16 +14309,41 @@ const file_control_proto_rawDesc = "" +
 	" \x01(\v2 .persys.control.v1.ContainerSpecH\x00R\tcontainer\x12:\n" +
 	"\acompose\x18\v \x01(\v2\x1e.persys.control.v1.ComposeSpecH\x00R\acompose\x12+\n" +
 	"\x02vm\x18\f \x01(\v2\x19.persys.control.v1.VMSpecH\x00R\x02vm\x12I\n" +
-	"\bmetadata\x18\x14 \x03(\v2-.persys.control.v1.WorkloadSpec.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x14 \x03(\v2-.persys.control.v1.WorkloadSpec.MetadataEntryR\bmetadata\x12@\n" +
+	"\tplacement\x18\x15 \x01(\v2\".persys.control.v1.PlacementPolicyR\tplacement\x12?\n" +
+	"\vtolerations\x18\x16 \x03(\v2\x1d.persys.control.v1.TolerationR\vtolerations\x12\x1a\n" +
+	"\bpriority\x18\x17 \x01(\x05R\bpriority\x12%\n" +
+	"\x0epriority_class\x18\x18 \x01(\tR\rpriorityClass\x12<\n" +
+	"\arollout\x18\x19 \x01(\v2\".persys.control.v1.RolloutStrategyR\arollout\x12\x1c\n" +
+	"\tnamespace\x18\x1a \x01(\tR\tnamespace\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
 	"\n" +
-	"\bworkload\"s\n" +
+	"\bworkload\"k\n" +
+	"\x0fRolloutStrategy\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12'\n" +
+	"\x0fmax_unavailable\x18\x02 \x01(\x05R\x0emaxUnavailable\x12\x1b\n" +
+	"\tmax_surge\x18\x03 \x01(\x05R\bmaxSurge\"\xae\x03\n" +
+	"\x0fPlacementPolicy\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12`\n" +
+	"\x16required_node_affinity\x18\x02 \x03(\v2*.persys.control.v1.NodeSelectorRequirementR\x14requiredNodeAffinity\x12`\n" +
+	"\x17preferred_node_affinity\x18\x03 \x03(\v2(.persys.control.v1.PreferredNodeAffinityR\x15preferredNodeAffinity\x12.\n" +
+	"\x13anti_affinity_group\x18\x04 \x01(\tR\x11antiAffinityGroup\x124\n" +
+	"\x16anti_affinity_required\x18\x05 \x01(\bR\x14antiAffinityRequired\x12.\n" +
+	"\x13spread_topology_key\x18\x06 \x01(\tR\x11spreadTopologyKey\x12%\n" +
+	"\x0eaffinity_group\x18\a \x01(\tR\raffinityGroup\"_\n" +
+	"\x17NodeSelectorRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"}\n" +
+	"\x15PreferredNodeAffinity\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x05R\x06weight\x12L\n" +
+	"\vrequirement\x18\x02 \x01(\v2*.persys.control.v1.NodeSelectorRequirementR\vrequirement\"s\n" +
 	"\x14ResourceRequirements\x12%\n" +
 	"\x0ecpu_millicores\x18\x01 \x01(\x03R\rcpuMillicores\x12\x1b\n" +
 	"\tmemory_mb\x18\x02 \x01(\x03R\bmemoryMb\x12\x17\n" +
-	"\adisk_gb\x18\x03 \x01(\x03R\x06diskGb\"\xb3\x03\n" +
+	"\adisk_gb\x18\x03 \x01(\x03R\x06diskGb\"\xbc\x05\n" +
 	"\rContainerSpec\x12\x14\n" +
 	"\x05image\x18\x01 \x01(\tR\x05image\x12\x18\n" +
 	"\acommand\x18\x02 \x03(\tR\acommand\x12;\n" +
@@ -3370,10 +14354,62 @@ const file_control_proto_rawDesc = "" +
 	"\n" +
 	"privileged\x18\a \x01(\bR\n" +
 	"privileged\x12M\n" +
-	"\x0fmanaged_volumes\x18\b \x03(\v2$.persys.control.v1.ManagedVolumeSpecR\x0emanagedVolumes\x1a6\n" +
+	"\x0fmanaged_volumes\x18\b \x03(\v2$.persys.control.v1.ManagedVolumeSpecR\x0emanagedVolumes\x12?\n" +
+	"\x0eliveness_probe\x18\t \x01(\v2\x18.persys.control.v1.ProbeR\rlivenessProbe\x12A\n" +
+	"\x0freadiness_probe\x18\n" +
+	" \x01(\v2\x18.persys.control.v1.ProbeR\x0ereadinessProbe\x12;\n" +
+	"\benv_from\x18\v \x03(\v2 .persys.control.v1.EnvFromSourceR\aenvFrom\x122\n" +
+	"\x05files\x18\f \x03(\v2\x1c.persys.control.v1.FileMountR\x05files\x12\x12\n" +
+	"\x04args\x18\r \x03(\tR\x04args\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"n\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"W\n" +
+	"\rEnvFromSource\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\tR\x06config\x12\x16\n" +
+	"\x06prefix\x18\x03 \x01(\tR\x06prefix\"\x8f\x01\n" +
+	"\tFileMount\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06config\x18\x02 \x01(\tR\x06config\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\x05R\x04mode\x12\x18\n" +
+	"\acontent\x18\x06 \x01(\fR\acontent\"c\n" +
+	"\vTemplateVar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\x12\x16\n" +
+	"\x06config\x18\x03 \x01(\tR\x06config\x12\x10\n" +
+	"\x03key\x18\x04 \x01(\tR\x03key\"\xa9\x03\n" +
+	"\x05Probe\x12=\n" +
+	"\bhttp_get\x18\x01 \x01(\v2 .persys.control.v1.HTTPGetActionH\x00R\ahttpGet\x12C\n" +
+	"\n" +
+	"tcp_socket\x18\x02 \x01(\v2\".persys.control.v1.TCPSocketActionH\x00R\ttcpSocket\x123\n" +
+	"\x04exec\x18\x03 \x01(\v2\x1d.persys.control.v1.ExecActionH\x00R\x04exec\x122\n" +
+	"\x15initial_delay_seconds\x18\x04 \x01(\x05R\x13initialDelaySeconds\x12%\n" +
+	"\x0eperiod_seconds\x18\x05 \x01(\x05R\rperiodSeconds\x12'\n" +
+	"\x0ftimeout_seconds\x18\x06 \x01(\x05R\x0etimeoutSeconds\x12+\n" +
+	"\x11success_threshold\x18\a \x01(\x05R\x10successThreshold\x12+\n" +
+	"\x11failure_threshold\x18\b \x01(\x05R\x10failureThresholdB\t\n" +
+	"\ahandler\"\xd4\x01\n" +
+	"\rHTTPGetAction\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x16\n" +
+	"\x06scheme\x18\x03 \x01(\tR\x06scheme\x12G\n" +
+	"\aheaders\x18\x04 \x03(\v2-.persys.control.v1.HTTPGetAction.HeadersEntryR\aheaders\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"%\n" +
+	"\x0fTCPSocketAction\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\"&\n" +
+	"\n" +
+	"ExecAction\x12\x18\n" +
+	"\acommand\x18\x01 \x03(\tR\acommand\"\x92\x01\n" +
+	"\vProbeResult\x12\x14\n" +
+	"\x05probe\x18\x01 \x01(\tR\x05probe\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"checked_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\"n\n" +
 	"\vVolumeMount\x12\x1b\n" +
 	"\thost_path\x18\x01 \x01(\tR\bhostPath\x12%\n" +
 	"\x0econtainer_path\x18\x02 \x01(\tR\rcontainerPath\x12\x1b\n" +
@@ -3381,7 +14417,7 @@ const file_control_proto_rawDesc = "" +
 	"\x04Port\x12\x1b\n" +
 	"\thost_port\x18\x01 \x01(\x05R\bhostPort\x12%\n" +
 	"\x0econtainer_port\x18\x02 \x01(\x05R\rcontainerPort\x12\x1a\n" +
-	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"\xf6\x01\n" +
+	"\bprotocol\x18\x03 \x01(\tR\bprotocol\"\xd2\x03\n" +
 	"\vComposeSpec\x12\x1f\n" +
 	"\vsource_type\x18\x01 \x01(\tR\n" +
 	"sourceType\x12\x19\n" +
@@ -3389,10 +14425,16 @@ const file_control_proto_rawDesc = "" +
 	"\agit_ref\x18\x03 \x01(\tR\x06gitRef\x12\x1f\n" +
 	"\vinline_yaml\x18\x04 \x01(\tR\n" +
 	"inlineYaml\x129\n" +
-	"\x03env\x18\x05 \x03(\v2'.persys.control.v1.ComposeSpec.EnvEntryR\x03env\x1a6\n" +
+	"\x03env\x18\x05 \x03(\v2'.persys.control.v1.ComposeSpec.EnvEntryR\x03env\x12;\n" +
+	"\benv_from\x18\x06 \x03(\v2 .persys.control.v1.EnvFromSourceR\aenvFrom\x122\n" +
+	"\x05files\x18\a \x03(\v2\x1c.persys.control.v1.FileMountR\x05files\x12(\n" +
+	"\x10git_token_secret\x18\b \x01(\tR\x0egitTokenSecret\x12\"\n" +
+	"\rgit_token_key\x18\t \x01(\tR\vgitTokenKey\x12\x1b\n" +
+	"\tgit_token\x18\n" +
+	" \x01(\tR\bgitToken\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xdb\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa4\x04\n" +
 	"\x06VMSpec\x12\x14\n" +
 	"\x05vcpus\x18\x01 \x01(\x05R\x05vcpus\x12\x1b\n" +
 	"\tmemory_mb\x18\x02 \x01(\x03R\bmemoryMb\x123\n" +
@@ -3401,7 +14443,11 @@ const file_control_proto_rawDesc = "" +
 	"\n" +
 	"cloud_init\x18\x05 \x01(\v2\".persys.control.v1.CloudInitConfigR\tcloudInit\x12\x19\n" +
 	"\bos_image\x18\x06 \x01(\tR\aosImage\x12M\n" +
-	"\x0fmanaged_volumes\x18\a \x03(\v2$.persys.control.v1.ManagedVolumeSpecR\x0emanagedVolumes\"c\n" +
+	"\x0fmanaged_volumes\x18\a \x03(\v2$.persys.control.v1.ManagedVolumeSpecR\x0emanagedVolumes\x12?\n" +
+	"\x0eliveness_probe\x18\b \x01(\v2\x18.persys.control.v1.ProbeR\rlivenessProbe\x12A\n" +
+	"\x0freadiness_probe\x18\t \x01(\v2\x18.persys.control.v1.ProbeR\x0ereadinessProbe\x12C\n" +
+	"\rtemplate_vars\x18\n" +
+	" \x03(\v2\x1e.persys.control.v1.TemplateVarR\ftemplateVars\"c\n" +
 	"\n" +
 	"DiskConfig\x12\x1b\n" +
 	"\tpool_name\x18\x01 \x01(\tR\bpoolName\x12\x17\n" +
@@ -3417,7 +14463,7 @@ const file_control_proto_rawDesc = "" +
 	"\tmeta_data\x18\x02 \x01(\tR\bmetaData\x12%\n" +
 	"\x0enetwork_config\x18\x03 \x01(\tR\rnetworkConfig\x12\x1f\n" +
 	"\vvendor_data\x18\x04 \x01(\tR\n" +
-	"vendorData\"\xf3\x01\n" +
+	"vendorData\"\x90\x02\n" +
 	"\x11ManagedVolumeSpec\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x17\n" +
@@ -3428,7 +14474,8 @@ const file_control_proto_rawDesc = "" +
 	"\n" +
 	"mount_path\x18\x06 \x01(\tR\tmountPath\x12\x1b\n" +
 	"\tread_only\x18\a \x01(\bR\breadOnly\x12#\n" +
-	"\rretain_policy\x18\b \x01(\tR\fretainPolicy\"\xfd\x02\n" +
+	"\rretain_policy\x18\b \x01(\tR\fretainPolicy\x12\x1b\n" +
+	"\tvolume_id\x18\t \x01(\tR\bvolumeId\"\xfd\x02\n" +
 	"\x15WorkloadUsageSnapshot\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
 	"workloadId\x12\x12\n" +
@@ -3450,7 +14497,7 @@ const file_control_proto_rawDesc = "" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12C\n" +
 	"\x0flast_transition\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastTransition\x12>\n" +
 	"\rnext_retry_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vnextRetryAt\x12\x1c\n" +
-	"\tretryable\x18\x05 \x01(\bR\tretryable\"\xe8\x02\n" +
+	"\tretryable\x18\x05 \x01(\bR\tretryable\"\x83\x04\n" +
 	"\x0eWorkloadStatus\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
 	"workloadId\x12\x14\n" +
@@ -3459,7 +14506,13 @@ const file_control_proto_rawDesc = "" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12C\n" +
 	"\x0flast_transition\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastTransition\x127\n" +
 	"\x06reason\x18\x06 \x01(\v2\x1f.persys.control.v1.ReasonDetailR\x06reason\x12>\n" +
-	"\x05usage\x18\a \x01(\v2(.persys.control.v1.WorkloadUsageSnapshotR\x05usage\"7\n" +
+	"\x05usage\x18\a \x01(\v2(.persys.control.v1.WorkloadUsageSnapshotR\x05usage\x12C\n" +
+	"\rprobe_results\x18\b \x03(\v2\x1e.persys.control.v1.ProbeResultR\fprobeResults\x12\x16\n" +
+	"\x06exited\x18\t \x01(\bR\x06exited\x12\x1b\n" +
+	"\texit_code\x18\n" +
+	" \x01(\x05R\bexitCode\x12\x1f\n" +
+	"\vrevision_id\x18\v \x01(\tR\n" +
+	"revisionId\"7\n" +
 	"\x14RetryWorkloadRequest\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
 	"workloadId\"3\n" +
@@ -3472,7 +14525,7 @@ const file_control_proto_rawDesc = "" +
 	"\x11ListNodesResponse\x121\n" +
 	"\x05nodes\x18\x01 \x03(\v2\x1b.persys.control.v1.NodeViewR\x05nodes\"B\n" +
 	"\x0fGetNodeResponse\x12/\n" +
-	"\x04node\x18\x01 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\"\xa2\x05\n" +
+	"\x04node\x18\x01 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\"\xed\t\n" +
 	"\bNodeView\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
@@ -3487,20 +14540,56 @@ const file_control_proto_rawDesc = "" +
 	" \x01(\x03R\rtotalMemoryMb\x12.\n" +
 	"\x13available_memory_mb\x18\v \x01(\x03R\x11availableMemoryMb\x128\n" +
 	"\x18supported_workload_types\x18\f \x03(\tR\x16supportedWorkloadTypes\x12?\n" +
-	"\x06labels\x18\r \x03(\v2'.persys.control.v1.NodeView.LabelsEntryR\x06labels\x1a9\n" +
+	"\x06labels\x18\r \x03(\v2'.persys.control.v1.NodeView.LabelsEntryR\x06labels\x12I\n" +
+	"\rstorage_pools\x18\x0e \x03(\v2$.persys.control.v1.StoragePoolStatusR\fstoragePools\x12$\n" +
+	"\runschedulable\x18\x0f \x01(\bR\runschedulable\x120\n" +
+	"\x06taints\x18\x10 \x03(\v2\x18.persys.control.v1.TaintR\x06taints\x128\n" +
+	"\x05drain\x18\x11 \x01(\v2\".persys.control.v1.NodeDrainStatusR\x05drain\x12.\n" +
+	"\x13allocated_cpu_cores\x18\x12 \x01(\x01R\x11allocatedCpuCores\x12.\n" +
+	"\x13allocated_memory_mb\x18\x13 \x01(\x03R\x11allocatedMemoryMb\x12*\n" +
+	"\x11allocated_disk_gb\x18\x14 \x01(\x03R\x0fallocatedDiskGb\x12/\n" +
+	"\x13allocated_workloads\x18\x15 \x01(\x05R\x12allocatedWorkloads\x12$\n" +
+	"\x0eused_cpu_cores\x18\x16 \x01(\x01R\fusedCpuCores\x12$\n" +
+	"\x0eused_memory_mb\x18\x17 \x01(\x03R\fusedMemoryMb\x12 \n" +
+	"\fused_disk_gb\x18\x18 \x01(\x03R\n" +
+	"usedDiskGb\x12A\n" +
+	"\x0foperator_taints\x18\x19 \x03(\v2\x18.persys.control.v1.TaintR\x0eoperatorTaints\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"G\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9e\x03\n" +
+	"\x0fNodeDrainStatus\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0fmax_unavailable\x18\x03 \x01(\x05R\x0emaxUnavailable\x129\n" +
+	"\n" +
+	"started_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x12\x1a\n" +
+	"\bmigrated\x18\a \x01(\x05R\bmigrated\x12\x16\n" +
+	"\x06failed\x18\b \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tremaining\x18\t \x01(\x05R\tremaining\x123\n" +
+	"\x16in_flight_workload_ids\x18\n" +
+	" \x03(\tR\x13inFlightWorkloadIds\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\v \x01(\tR\tlastError\"\x92\x01\n" +
+	"\x11StoragePoolStatus\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x19\n" +
+	"\btotal_gb\x18\x03 \x01(\x03R\atotalGb\x12!\n" +
+	"\fallocated_gb\x18\x04 \x01(\x03R\vallocatedGb\x12\x17\n" +
+	"\aused_gb\x18\x05 \x01(\x03R\x06usedGb\"e\n" +
 	"\x14ListWorkloadsRequest\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"5\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\"S\n" +
 	"\x12GetWorkloadRequest\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
-	"workloadId\"V\n" +
+	"workloadId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\"V\n" +
 	"\x15ListWorkloadsResponse\x12=\n" +
 	"\tworkloads\x18\x01 \x03(\v2\x1f.persys.control.v1.WorkloadViewR\tworkloads\"R\n" +
 	"\x13GetWorkloadResponse\x12;\n" +
-	"\bworkload\x18\x01 \x01(\v2\x1f.persys.control.v1.WorkloadViewR\bworkload\"\xbf\x04\n" +
+	"\bworkload\x18\x01 \x01(\v2\x1f.persys.control.v1.WorkloadViewR\bworkload\"\xe4\x06\n" +
 	"\fWorkloadView\x12\x1f\n" +
 	"\vworkload_id\x18\x01 \x01(\tR\n" +
 	"workloadId\x12\x12\n" +
@@ -3517,8 +14606,39 @@ const file_control_proto_rawDesc = "" +
 	" \x01(\tR\rfailureReason\x12=\n" +
 	"\flast_updated\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vlastUpdated\x127\n" +
 	"\x06reason\x18\f \x01(\v2\x1f.persys.control.v1.ReasonDetailR\x06reason\x12>\n" +
-	"\x05usage\x18\r \x01(\v2(.persys.control.v1.WorkloadUsageSnapshotR\x05usage\"\x1a\n" +
-	"\x18GetClusterSummaryRequest\"\x9f\x03\n" +
+	"\x05usage\x18\r \x01(\v2(.persys.control.v1.WorkloadUsageSnapshotR\x05usage\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\x05R\bpriority\x12%\n" +
+	"\x0epriority_class\x18\x0f \x01(\tR\rpriorityClass\x12>\n" +
+	"\arollout\x18\x10 \x01(\v2$.persys.control.v1.RolloutStatusViewR\arollout\x12\x14\n" +
+	"\x05ready\x18\x11 \x01(\bR\x05ready\x12=\n" +
+	"\x06health\x18\x12 \x01(\v2%.persys.control.v1.WorkloadHealthViewR\x06health\x12\x1c\n" +
+	"\tnamespace\x18\x13 \x01(\tR\tnamespace\x12-\n" +
+	"\x05ports\x18\x14 \x03(\v2\x17.persys.control.v1.PortR\x05ports\"\xa4\x02\n" +
+	"\x12WorkloadHealthView\x12=\n" +
+	"\bliveness\x18\x01 \x01(\v2!.persys.control.v1.ProbeStateViewR\bliveness\x12?\n" +
+	"\treadiness\x18\x02 \x01(\v2!.persys.control.v1.ProbeStateViewR\treadiness\x12\x1a\n" +
+	"\brestarts\x18\x03 \x01(\x05R\brestarts\x12B\n" +
+	"\x0flast_restart_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastRestartAt\x12.\n" +
+	"\x13last_restart_reason\x18\x05 \x01(\tR\x11lastRestartReason\"\xc1\x02\n" +
+	"\x0eProbeStateView\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x123\n" +
+	"\x15consecutive_successes\x18\x02 \x01(\x05R\x14consecutiveSuccesses\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\x05R\x13consecutiveFailures\x12!\n" +
+	"\flast_message\x18\x04 \x01(\tR\vlastMessage\x12B\n" +
+	"\x0flast_checked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCheckedAt\x12H\n" +
+	"\x12last_transition_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastTransitionAt\"\xc8\x02\n" +
+	"\x11RolloutStatusView\x12\x1a\n" +
+	"\bstrategy\x18\x01 \x01(\tR\bstrategy\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12\x1f\n" +
+	"\vrevision_id\x18\x03 \x01(\tR\n" +
+	"revisionId\x120\n" +
+	"\x14previous_revision_id\x18\x04 \x01(\tR\x12previousRevisionId\x12\x14\n" +
+	"\x05phase\x18\x05 \x01(\tR\x05phase\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x1a\n" +
+	"\x18GetClusterSummaryRequest\"\xf9\x03\n" +
 	"\x19GetClusterSummaryResponse\x12\x1f\n" +
 	"\vtotal_nodes\x18\x01 \x01(\x05R\n" +
 	"totalNodes\x12\x1f\n" +
@@ -3530,19 +14650,760 @@ const file_control_proto_rawDesc = "" +
 	"\x11pending_workloads\x18\x06 \x01(\x05R\x10pendingWorkloads\x12)\n" +
 	"\x10failed_workloads\x18\a \x01(\x05R\x0ffailedWorkloads\x12+\n" +
 	"\x11deleted_workloads\x18\b \x01(\x05R\x10deletedWorkloads\x12=\n" +
-	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\xab\x02\n" +
+	"\fgenerated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12'\n" +
+	"\x0fready_workloads\x18\n" +
+	" \x01(\x05R\x0ereadyWorkloads\x12/\n" +
+	"\x13unhealthy_workloads\x18\v \x01(\x05R\x12unhealthyWorkloads\"\xbc\x01\n" +
+	"\x16ApplyReplicaSetRequest\x12$\n" +
+	"\x0ereplica_set_id\x18\x01 \x01(\tR\freplicaSetId\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\x12;\n" +
+	"\btemplate\x18\x03 \x01(\v2\x1f.persys.control.v1.WorkloadSpecR\btemplate\x12#\n" +
+	"\rdesired_state\x18\x04 \x01(\tR\fdesiredState\"\x9c\x01\n" +
+	"\x17ApplyReplicaSetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12B\n" +
+	"\vreplica_set\x18\x03 \x01(\v2!.persys.control.v1.ReplicaSetViewR\n" +
+	"replicaSet\"Z\n" +
+	"\x16ScaleReplicaSetRequest\x12$\n" +
+	"\x0ereplica_set_id\x18\x01 \x01(\tR\freplicaSetId\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\"\x9c\x01\n" +
+	"\x17ScaleReplicaSetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12B\n" +
+	"\vreplica_set\x18\x03 \x01(\v2!.persys.control.v1.ReplicaSetViewR\n" +
+	"replicaSet\"?\n" +
+	"\x17DeleteReplicaSetRequest\x12$\n" +
+	"\x0ereplica_set_id\x18\x01 \x01(\tR\freplicaSetId\"Y\n" +
+	"\x18DeleteReplicaSetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"<\n" +
+	"\x14GetReplicaSetRequest\x12$\n" +
+	"\x0ereplica_set_id\x18\x01 \x01(\tR\freplicaSetId\"[\n" +
+	"\x15GetReplicaSetResponse\x12B\n" +
+	"\vreplica_set\x18\x01 \x01(\v2!.persys.control.v1.ReplicaSetViewR\n" +
+	"replicaSet\"\x18\n" +
+	"\x16ListReplicaSetsRequest\"_\n" +
+	"\x17ListReplicaSetsResponse\x12D\n" +
+	"\freplica_sets\x18\x01 \x03(\v2!.persys.control.v1.ReplicaSetViewR\vreplicaSets\"\x90\x04\n" +
+	"\x0eReplicaSetView\x12$\n" +
+	"\x0ereplica_set_id\x18\x01 \x01(\tR\freplicaSetId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12#\n" +
+	"\rdesired_state\x18\x03 \x01(\tR\fdesiredState\x12\x1a\n" +
+	"\breplicas\x18\x04 \x01(\x05R\breplicas\x12)\n" +
+	"\x10current_replicas\x18\x05 \x01(\x05R\x0fcurrentReplicas\x12%\n" +
+	"\x0eready_replicas\x18\x06 \x01(\x05R\rreadyReplicas\x12+\n" +
+	"\x11template_revision\x18\a \x01(\tR\x10templateRevision\x12!\n" +
+	"\fworkload_ids\x18\b \x03(\tR\vworkloadIds\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x0elast_scaled_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\flastScaledAt\x12)\n" +
+	"\x10updated_replicas\x18\f \x01(\x05R\x0fupdatedReplicas\"\x92\x01\n" +
+	"\rResourceQuota\x12\x1b\n" +
+	"\tcpu_cores\x18\x01 \x01(\x01R\bcpuCores\x12\x1b\n" +
+	"\tmemory_mb\x18\x02 \x01(\x03R\bmemoryMb\x12\x17\n" +
+	"\adisk_gb\x18\x03 \x01(\x03R\x06diskGb\x12\x1c\n" +
+	"\tworkloads\x18\x04 \x01(\x05R\tworkloads\x12\x10\n" +
+	"\x03vms\x18\x05 \x01(\x05R\x03vms\"\xec\x01\n" +
+	"\x15ApplyNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12L\n" +
+	"\x06labels\x18\x02 \x03(\v24.persys.control.v1.ApplyNamespaceRequest.LabelsEntryR\x06labels\x126\n" +
+	"\x05quota\x18\x03 \x01(\v2 .persys.control.v1.ResourceQuotaR\x05quota\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x01\n" +
+	"\x16ApplyNamespaceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12>\n" +
+	"\tnamespace\x18\x03 \x01(\v2 .persys.control.v1.NamespaceViewR\tnamespace\",\n" +
+	"\x16DeleteNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"X\n" +
+	"\x17DeleteNamespaceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\")\n" +
+	"\x13GetNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"V\n" +
+	"\x14GetNamespaceResponse\x12>\n" +
+	"\tnamespace\x18\x01 \x01(\v2 .persys.control.v1.NamespaceViewR\tnamespace\"\x17\n" +
+	"\x15ListNamespacesRequest\"Z\n" +
+	"\x16ListNamespacesResponse\x12@\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\v2 .persys.control.v1.NamespaceViewR\n" +
+	"namespaces\"\x88\x03\n" +
+	"\rNamespaceView\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12D\n" +
+	"\x06labels\x18\x02 \x03(\v2,.persys.control.v1.NamespaceView.LabelsEntryR\x06labels\x126\n" +
+	"\x05quota\x18\x03 \x01(\v2 .persys.control.v1.ResourceQuotaR\x05quota\x124\n" +
+	"\x04used\x18\x04 \x01(\v2 .persys.control.v1.ResourceQuotaR\x04used\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xca\x02\n" +
+	"\x12ApplySecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12I\n" +
+	"\x06labels\x18\x03 \x03(\v21.persys.control.v1.ApplySecretRequest.LabelsEntryR\x06labels\x12C\n" +
+	"\x04data\x18\x04 \x03(\v2/.persys.control.v1.ApplySecretRequest.DataEntryR\x04data\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x13ApplySecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x125\n" +
+	"\x06secret\x18\x03 \x01(\v2\x1d.persys.control.v1.SecretViewR\x06secret\"G\n" +
+	"\x13DeleteSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"U\n" +
+	"\x14DeleteSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"D\n" +
+	"\x10GetSecretRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"J\n" +
+	"\x11GetSecretResponse\x125\n" +
+	"\x06secret\x18\x01 \x01(\v2\x1d.persys.control.v1.SecretViewR\x06secret\"2\n" +
+	"\x12ListSecretsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"N\n" +
+	"\x13ListSecretsResponse\x127\n" +
+	"\asecrets\x18\x01 \x03(\v2\x1d.persys.control.v1.SecretViewR\asecrets\"\xe0\x02\n" +
+	"\n" +
+	"SecretView\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
+	"\x06labels\x18\x03 \x03(\v2).persys.control.v1.SecretView.LabelsEntryR\x06labels\x12\x12\n" +
+	"\x04keys\x18\x04 \x03(\tR\x04keys\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xca\x02\n" +
+	"\x12ApplyConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12I\n" +
+	"\x06labels\x18\x03 \x03(\v21.persys.control.v1.ApplyConfigRequest.LabelsEntryR\x06labels\x12C\n" +
+	"\x04data\x18\x04 \x03(\v2/.persys.control.v1.ApplyConfigRequest.DataEntryR\x04data\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x13ApplyConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x125\n" +
+	"\x06config\x18\x03 \x01(\v2\x1d.persys.control.v1.ConfigViewR\x06config\"G\n" +
+	"\x13DeleteConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"U\n" +
+	"\x14DeleteConfigResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"D\n" +
+	"\x10GetConfigRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"J\n" +
+	"\x11GetConfigResponse\x125\n" +
+	"\x06config\x18\x01 \x01(\v2\x1d.persys.control.v1.ConfigViewR\x06config\"2\n" +
+	"\x12ListConfigsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"N\n" +
+	"\x13ListConfigsResponse\x127\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1d.persys.control.v1.ConfigViewR\aconfigs\"\xc2\x03\n" +
+	"\n" +
+	"ConfigView\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12A\n" +
+	"\x06labels\x18\x03 \x03(\v2).persys.control.v1.ConfigView.LabelsEntryR\x06labels\x12;\n" +
+	"\x04data\x18\x04 \x03(\v2'.persys.control.v1.ConfigView.DataEntryR\x04data\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"n\n" +
+	"\vServicePort\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\x05R\x04port\x12\x1b\n" +
+	"\thost_port\x18\x04 \x01(\x05R\bhostPort\"\x8c\x02\n" +
+	"\x13ApplyServiceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12P\n" +
+	"\bselector\x18\x03 \x03(\v24.persys.control.v1.ApplyServiceRequest.SelectorEntryR\bselector\x124\n" +
+	"\x05ports\x18\x04 \x03(\v2\x1e.persys.control.v1.ServicePortR\x05ports\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8f\x01\n" +
+	"\x14ApplyServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x128\n" +
+	"\aservice\x18\x03 \x01(\v2\x1e.persys.control.v1.ServiceViewR\aservice\"H\n" +
+	"\x14DeleteServiceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"V\n" +
+	"\x15DeleteServiceResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"E\n" +
+	"\x11GetServiceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"N\n" +
+	"\x12GetServiceResponse\x128\n" +
+	"\aservice\x18\x01 \x01(\v2\x1e.persys.control.v1.ServiceViewR\aservice\"3\n" +
+	"\x13ListServicesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"R\n" +
+	"\x14ListServicesResponse\x12:\n" +
+	"\bservices\x18\x01 \x03(\v2\x1e.persys.control.v1.ServiceViewR\bservices\"\xac\x01\n" +
+	"\x0fServiceEndpoint\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x1b\n" +
+	"\tport_name\x18\x05 \x01(\tR\bportName\x12\x1a\n" +
+	"\bprotocol\x18\x06 \x01(\tR\bprotocol\"\xc8\x03\n" +
+	"\vServiceView\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04fqdn\x18\x03 \x01(\tR\x04fqdn\x12H\n" +
+	"\bselector\x18\x04 \x03(\v2,.persys.control.v1.ServiceView.SelectorEntryR\bselector\x124\n" +
+	"\x05ports\x18\x05 \x03(\v2\x1e.persys.control.v1.ServicePortR\x05ports\x12@\n" +
+	"\tendpoints\x18\x06 \x03(\v2\".persys.control.v1.ServiceEndpointR\tendpoints\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a;\n" +
+	"\rSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"}\n" +
+	"\vStackMember\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x02 \x03(\tR\tdependsOn\x12;\n" +
+	"\btemplate\x18\x03 \x01(\v2\x1f.persys.control.v1.WorkloadSpecR\btemplate\"\xc0\x01\n" +
+	"\x11ApplyStackRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x128\n" +
+	"\amembers\x18\x03 \x03(\v2\x1e.persys.control.v1.StackMemberR\amembers\x12#\n" +
+	"\rdesired_state\x18\x04 \x01(\tR\fdesiredState\x12\x1a\n" +
+	"\bcolocate\x18\x05 \x01(\bR\bcolocate\"\x87\x01\n" +
+	"\x12ApplyStackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x122\n" +
+	"\x05stack\x18\x03 \x01(\v2\x1c.persys.control.v1.StackViewR\x05stack\"F\n" +
+	"\x12DeleteStackRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"T\n" +
+	"\x13DeleteStackResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"C\n" +
+	"\x0fGetStackRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"F\n" +
+	"\x10GetStackResponse\x122\n" +
+	"\x05stack\x18\x01 \x01(\v2\x1c.persys.control.v1.StackViewR\x05stack\"1\n" +
+	"\x11ListStacksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"J\n" +
+	"\x12ListStacksResponse\x124\n" +
+	"\x06stacks\x18\x01 \x03(\v2\x1c.persys.control.v1.StackViewR\x06stacks\"m\n" +
+	"\x14SetStackStateRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rdesired_state\x18\x03 \x01(\tR\fdesiredState\"\x8a\x01\n" +
+	"\x15SetStackStateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x122\n" +
+	"\x05stack\x18\x03 \x01(\v2\x1c.persys.control.v1.StackViewR\x05stack\"\xcd\x01\n" +
+	"\x0fStackMemberView\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"depends_on\x18\x02 \x03(\tR\tdependsOn\x12\x1f\n" +
+	"\vworkload_id\x18\x03 \x01(\tR\n" +
+	"workloadId\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05ready\x18\x06 \x01(\bR\x05ready\x12\x1f\n" +
+	"\vwaiting_for\x18\a \x03(\tR\n" +
+	"waitingFor\"\xb7\x03\n" +
+	"\tStackView\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rdesired_state\x18\x03 \x01(\tR\fdesiredState\x12\x14\n" +
+	"\x05phase\x18\x04 \x01(\tR\x05phase\x12\x1a\n" +
+	"\bcolocate\x18\x05 \x01(\bR\bcolocate\x12<\n" +
+	"\amembers\x18\x06 \x03(\v2\".persys.control.v1.StackMemberViewR\amembers\x12#\n" +
+	"\rready_members\x18\a \x01(\x05R\freadyMembers\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
+	"\x12last_reconciled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x10lastReconciledAt\"\xbb\x02\n" +
+	"\aJobSpec\x12;\n" +
+	"\btemplate\x18\x01 \x01(\v2\x1f.persys.control.v1.WorkloadSpecR\btemplate\x12 \n" +
+	"\vcompletions\x18\x02 \x01(\x05R\vcompletions\x12 \n" +
+	"\vparallelism\x18\x03 \x01(\x05R\vparallelism\x12(\n" +
+	"\rbackoff_limit\x18\x04 \x01(\x05H\x00R\fbackoffLimit\x88\x01\x01\x126\n" +
+	"\x17active_deadline_seconds\x18\x05 \x01(\x05R\x15activeDeadlineSeconds\x12;\n" +
+	"\x1attl_seconds_after_finished\x18\x06 \x01(\x05R\x17ttlSecondsAfterFinishedB\x10\n" +
+	"\x0e_backoff_limit\"X\n" +
+	"\x0fApplyJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12.\n" +
+	"\x04spec\x18\x02 \x01(\v2\x1a.persys.control.v1.JobSpecR\x04spec\"\x7f\n" +
+	"\x10ApplyJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12,\n" +
+	"\x03job\x18\x03 \x01(\v2\x1a.persys.control.v1.JobViewR\x03job\")\n" +
+	"\x10DeleteJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"R\n" +
+	"\x11DeleteJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"&\n" +
+	"\rGetJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\">\n" +
+	"\x0eGetJobResponse\x12,\n" +
+	"\x03job\x18\x01 \x01(\v2\x1a.persys.control.v1.JobViewR\x03job\"1\n" +
+	"\x0fListJobsRequest\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\"B\n" +
+	"\x10ListJobsResponse\x12.\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x1a.persys.control.v1.JobViewR\x04jobs\"\xb6\x04\n" +
+	"\aJobView\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05phase\x18\x03 \x01(\tR\x05phase\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12 \n" +
+	"\vcompletions\x18\x05 \x01(\x05R\vcompletions\x12 \n" +
+	"\vparallelism\x18\x06 \x01(\x05R\vparallelism\x12#\n" +
+	"\rbackoff_limit\x18\a \x01(\x05R\fbackoffLimit\x12\x16\n" +
+	"\x06active\x18\b \x01(\x05R\x06active\x12\x1c\n" +
+	"\tsucceeded\x18\t \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\n" +
+	" \x01(\x05R\x06failed\x12!\n" +
+	"\fworkload_ids\x18\v \x03(\tR\vworkloadIds\x12\x1e\n" +
+	"\vcron_job_id\x18\f \x01(\tR\tcronJobId\x12#\n" +
+	"\rdesired_state\x18\r \x01(\tR\fdesiredState\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12=\n" +
+	"\fcompleted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\xdd\x03\n" +
+	"\x13ApplyCronJobRequest\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12-\n" +
+	"\x12concurrency_policy\x18\x03 \x01(\tR\x11concurrencyPolicy\x12\x18\n" +
+	"\asuspend\x18\x04 \x01(\bR\asuspend\x12:\n" +
+	"\x19starting_deadline_seconds\x18\x05 \x01(\x05R\x17startingDeadlineSeconds\x12F\n" +
+	"\x1dsuccessful_jobs_history_limit\x18\x06 \x01(\x05H\x00R\x1asuccessfulJobsHistoryLimit\x88\x01\x01\x12>\n" +
+	"\x19failed_jobs_history_limit\x18\a \x01(\x05H\x01R\x16failedJobsHistoryLimit\x88\x01\x01\x12=\n" +
+	"\fjob_template\x18\b \x01(\v2\x1a.persys.control.v1.JobSpecR\vjobTemplateB \n" +
+	"\x1e_successful_jobs_history_limitB\x1c\n" +
+	"\x1a_failed_jobs_history_limit\"\x90\x01\n" +
+	"\x14ApplyCronJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x129\n" +
+	"\bcron_job\x18\x03 \x01(\v2\x1e.persys.control.v1.CronJobViewR\acronJob\"6\n" +
+	"\x14DeleteCronJobRequest\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\"V\n" +
+	"\x15DeleteCronJobResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"3\n" +
+	"\x11GetCronJobRequest\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\"O\n" +
+	"\x12GetCronJobResponse\x129\n" +
+	"\bcron_job\x18\x01 \x01(\v2\x1e.persys.control.v1.CronJobViewR\acronJob\"\x15\n" +
+	"\x13ListCronJobsRequest\"S\n" +
+	"\x14ListCronJobsResponse\x12;\n" +
+	"\tcron_jobs\x18\x01 \x03(\v2\x1e.persys.control.v1.CronJobViewR\bcronJobs\"\xa8\x05\n" +
+	"\vCronJobView\x12\x1e\n" +
+	"\vcron_job_id\x18\x01 \x01(\tR\tcronJobId\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12-\n" +
+	"\x12concurrency_policy\x18\x03 \x01(\tR\x11concurrencyPolicy\x12\x18\n" +
+	"\asuspend\x18\x04 \x01(\bR\asuspend\x12:\n" +
+	"\x19starting_deadline_seconds\x18\x05 \x01(\x05R\x17startingDeadlineSeconds\x12A\n" +
+	"\x1dsuccessful_jobs_history_limit\x18\x06 \x01(\x05R\x1asuccessfulJobsHistoryLimit\x129\n" +
+	"\x19failed_jobs_history_limit\x18\a \x01(\x05R\x16failedJobsHistoryLimit\x12#\n" +
+	"\rdesired_state\x18\b \x01(\tR\fdesiredState\x12$\n" +
+	"\x0eactive_job_ids\x18\t \x03(\tR\factiveJobIds\x12D\n" +
+	"\x10last_schedule_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0elastScheduleAt\x12H\n" +
+	"\x12last_successful_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x10lastSuccessfulAt\x12D\n" +
+	"\x10next_schedule_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0enextScheduleAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x06\n" +
 	"\x0eControlMessage\x12D\n" +
 	"\bregister\x18\x01 \x01(\v2&.persys.control.v1.RegisterNodeRequestH\x00R\bregister\x12C\n" +
 	"\theartbeat\x18\x02 \x01(\v2#.persys.control.v1.HeartbeatRequestH\x00R\theartbeat\x12?\n" +
 	"\x05apply\x18\x03 \x01(\v2'.persys.control.v1.ApplyWorkloadRequestH\x00R\x05apply\x12B\n" +
-	"\x06delete\x18\x04 \x01(\v2(.persys.control.v1.DeleteWorkloadRequestH\x00R\x06deleteB\t\n" +
-	"\amessage*\xda\x01\n" +
+	"\x06delete\x18\x04 \x01(\v2(.persys.control.v1.DeleteWorkloadRequestH\x00R\x06delete\x12L\n" +
+	"\fregister_ack\x18\x05 \x01(\v2'.persys.control.v1.RegisterNodeResponseH\x00R\vregisterAck\x12K\n" +
+	"\rheartbeat_ack\x18\x06 \x01(\v2$.persys.control.v1.HeartbeatResponseH\x00R\fheartbeatAck\x12M\n" +
+	"\fapply_result\x18\a \x01(\v2(.persys.control.v1.ApplyWorkloadResponseH\x00R\vapplyResult\x12P\n" +
+	"\rdelete_result\x18\b \x01(\v2).persys.control.v1.DeleteWorkloadResponseH\x00R\fdeleteResult\x12L\n" +
+	"\x0fworkload_status\x18\t \x01(\v2!.persys.control.v1.WorkloadStatusH\x00R\x0eworkloadStatus\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x14 \x01(\tR\tsessionId\x12\x17\n" +
+	"\anode_id\x18\x15 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bsequence\x18\x16 \x01(\x04R\bsequence\x12!\n" +
+	"\fack_sequence\x18\x17 \x01(\x04R\vackSequence\x12%\n" +
+	"\x0ecorrelation_id\x18\x18 \x01(\tR\rcorrelationIdB\t\n" +
+	"\amessage\"D\n" +
+	"\x11CordonNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x84\x01\n" +
+	"\x12CordonNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12/\n" +
+	"\x04node\x18\x03 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\".\n" +
+	"\x13UncordonNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\x86\x01\n" +
+	"\x14UncordonNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12/\n" +
+	"\x04node\x18\x03 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\"a\n" +
+	"\x14SetNodeTaintsRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x120\n" +
+	"\x06taints\x18\x02 \x03(\v2\x18.persys.control.v1.TaintR\x06taints\"\x87\x01\n" +
+	"\x15SetNodeTaintsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12/\n" +
+	"\x04node\x18\x03 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\"l\n" +
+	"\x10DrainNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12'\n" +
+	"\x0fmax_unavailable\x18\x03 \x01(\x05R\x0emaxUnavailable\"\x83\x01\n" +
+	"\x11DrainNodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12/\n" +
+	"\x04node\x18\x03 \x01(\v2\x1b.persys.control.v1.NodeViewR\x04node\"m\n" +
+	"\x15RunDeschedulerRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1e\n" +
+	"\n" +
+	"strategies\x18\x02 \x03(\tR\n" +
+	"strategies\x12\x1b\n" +
+	"\tmax_moves\x18\x03 \x01(\x05R\bmaxMoves\"\xd8\x01\n" +
+	"\x0fDeschedulerMove\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x12 \n" +
+	"\ffrom_node_id\x18\x03 \x01(\tR\n" +
+	"fromNodeId\x12\x1c\n" +
+	"\n" +
+	"to_node_id\x18\x04 \x01(\tR\btoNodeId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bexecuted\x18\x06 \x01(\bR\bexecuted\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xaa\x01\n" +
+	"\x16RunDeschedulerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x128\n" +
+	"\x05moves\x18\x04 \x03(\v2\".persys.control.v1.DeschedulerMoveR\x05moves\"|\n" +
+	"\x12VolumeSnapshotView\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\asize_gb\x18\x02 \x01(\x03R\x06sizeGb\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa8\x05\n" +
+	"\n" +
+	"VolumeView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x03 \x01(\tR\x06driver\x12\x17\n" +
+	"\asize_gb\x18\x04 \x01(\x03R\x06sizeGb\x12\x1f\n" +
+	"\vaccess_mode\x18\x05 \x01(\tR\n" +
+	"accessMode\x12\x17\n" +
+	"\afs_type\x18\x06 \x01(\tR\x06fsType\x12#\n" +
+	"\rretain_policy\x18\a \x01(\tR\fretainPolicy\x12\x14\n" +
+	"\x05phase\x18\b \x01(\tR\x05phase\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\t \x01(\tR\tlastError\x12!\n" +
+	"\fworkload_ids\x18\n" +
+	" \x03(\tR\vworkloadIds\x12*\n" +
+	"\x11attached_node_ids\x18\v \x03(\tR\x0fattachedNodeIds\x12\x17\n" +
+	"\anode_id\x18\f \x01(\tR\x06nodeId\x12(\n" +
+	"\x10source_volume_id\x18\r \x01(\tR\x0esourceVolumeId\x12'\n" +
+	"\x0fsource_snapshot\x18\x0e \x01(\tR\x0esourceSnapshot\x12C\n" +
+	"\tsnapshots\x18\x0f \x03(\v2%.persys.control.v1.VolumeSnapshotViewR\tsnapshots\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12;\n" +
+	"\vreleased_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"releasedAt\"\xd2\x01\n" +
+	"\x13CreateVolumeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x17\n" +
+	"\asize_gb\x18\x03 \x01(\x03R\x06sizeGb\x12\x1f\n" +
+	"\vaccess_mode\x18\x04 \x01(\tR\n" +
+	"accessMode\x12\x17\n" +
+	"\afs_type\x18\x05 \x01(\tR\x06fsType\x12#\n" +
+	"\rretain_policy\x18\x06 \x01(\tR\fretainPolicy\x12\x17\n" +
+	"\anode_id\x18\a \x01(\tR\x06nodeId\"\x8c\x01\n" +
+	"\x14CreateVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x125\n" +
+	"\x06volume\x18\x03 \x01(\v2\x1d.persys.control.v1.VolumeViewR\x06volume\"/\n" +
+	"\x10GetVolumeRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\"J\n" +
+	"\x11GetVolumeResponse\x125\n" +
+	"\x06volume\x18\x01 \x01(\v2\x1d.persys.control.v1.VolumeViewR\x06volume\"|\n" +
+	"\x12ListVolumesRequest\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x17\n" +
+	"\anode_id\x18\x03 \x01(\tR\x06nodeId\x12\x1f\n" +
+	"\vworkload_id\x18\x04 \x01(\tR\n" +
+	"workloadId\"N\n" +
+	"\x13ListVolumesResponse\x127\n" +
+	"\avolumes\x18\x01 \x03(\v2\x1d.persys.control.v1.VolumeViewR\avolumes\"H\n" +
+	"\x13DeleteVolumeRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"U\n" +
+	"\x14DeleteVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"K\n" +
+	"\x13ResizeVolumeRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12\x17\n" +
+	"\asize_gb\x18\x02 \x01(\x03R\x06sizeGb\"\x8c\x01\n" +
+	"\x14ResizeVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x125\n" +
+	"\x06volume\x18\x03 \x01(\v2\x1d.persys.control.v1.VolumeViewR\x06volume\"Y\n" +
+	"\x15SnapshotVolumeRequest\x12\x1b\n" +
+	"\tvolume_id\x18\x01 \x01(\tR\bvolumeId\x12#\n" +
+	"\rsnapshot_name\x18\x02 \x01(\tR\fsnapshotName\"\xb3\x01\n" +
+	"\x16SnapshotVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12#\n" +
+	"\rsnapshot_name\x18\x03 \x01(\tR\fsnapshotName\x125\n" +
+	"\x06volume\x18\x04 \x01(\v2\x1d.persys.control.v1.VolumeViewR\x06volume\"\xb9\x01\n" +
+	"\x12CloneVolumeRequest\x12(\n" +
+	"\x10source_volume_id\x18\x01 \x01(\tR\x0esourceVolumeId\x12'\n" +
+	"\x0fsource_snapshot\x18\x02 \x01(\tR\x0esourceSnapshot\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\asize_gb\x18\x04 \x01(\x03R\x06sizeGb\x12#\n" +
+	"\rretain_policy\x18\x05 \x01(\tR\fretainPolicy\"\x8b\x01\n" +
+	"\x13CloneVolumeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x125\n" +
+	"\x06volume\x18\x03 \x01(\v2\x1d.persys.control.v1.VolumeViewR\x06volume\"\x14\n" +
+	"\x12ExportStateRequest\"\xd0\x01\n" +
+	"\x13ExportStateResponse\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05nodes\x18\x05 \x01(\x05R\x05nodes\x12\x1c\n" +
+	"\tworkloads\x18\x06 \x01(\x05R\tworkloads\"]\n" +
+	"\x12ImportStateRequest\x12\x18\n" +
+	"\aarchive\x18\x01 \x01(\fR\aarchive\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xf4\x03\n" +
+	"\x13ImportStateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x14\n" +
+	"\x05nodes\x18\x03 \x01(\x05R\x05nodes\x12\x1c\n" +
+	"\tworkloads\x18\x04 \x01(\x05R\tworkloads\x12 \n" +
+	"\vassignments\x18\x05 \x01(\x05R\vassignments\x12!\n" +
+	"\freplica_sets\x18\x06 \x01(\x05R\vreplicaSets\x12\x1c\n" +
+	"\trevisions\x18\a \x01(\x05R\trevisions\x12\x18\n" +
+	"\avolumes\x18\b \x01(\x05R\avolumes\x12 \n" +
+	"\vattachments\x18\t \x01(\x05R\vattachments\x12\x12\n" +
+	"\x04mode\x18\n" +
+	" \x01(\tR\x04mode\x12\x12\n" +
+	"\x04jobs\x18\v \x01(\x05R\x04jobs\x12\x1b\n" +
+	"\tcron_jobs\x18\f \x01(\x05R\bcronJobs\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\r \x01(\x05R\n" +
+	"namespaces\x12\x18\n" +
+	"\asecrets\x18\x0e \x01(\x05R\asecrets\x12\x18\n" +
+	"\aconfigs\x18\x0f \x01(\x05R\aconfigs\x12\x1a\n" +
+	"\bservices\x18\x10 \x01(\x05R\bservices\x12\x16\n" +
+	"\x06stacks\x18\x11 \x01(\x05R\x06stacks\"\x89\x01\n" +
+	"\x12WatchEventsRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12#\n" +
+	"\rfrom_revision\x18\x04 \x01(\x03R\ffromRevision\"\xea\x02\n" +
+	"\x12SchedulerEventView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1f\n" +
+	"\vworkload_id\x18\x03 \x01(\tR\n" +
+	"workloadId\x12\x17\n" +
+	"\anode_id\x18\x04 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x128\n" +
+	"\ttimestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12L\n" +
+	"\adetails\x18\a \x03(\v22.persys.control.v1.SchedulerEventView.DetailsEntryR\adetails\x12\x1a\n" +
+	"\brevision\x18\b \x01(\x03R\brevision\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1d\n" +
+	"\x1bListPendingWorkloadsRequest\"d\n" +
+	"\x1cListPendingWorkloadsResponse\x12D\n" +
+	"\tworkloads\x18\x01 \x03(\v2&.persys.control.v1.PendingWorkloadViewR\tworkloads\"\xb2\x03\n" +
+	"\x13PendingWorkloadView\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1a\n" +
+	"\bpriority\x18\x02 \x01(\x05R\bpriority\x12%\n" +
+	"\x0epriority_class\x18\x03 \x01(\tR\rpriorityClass\x127\n" +
+	"\tqueued_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bqueuedAt\x12B\n" +
+	"\x0flast_attempt_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastAttemptAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12\x1a\n" +
+	"\battempts\x18\a \x01(\x05R\battempts\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12@\n" +
+	"\n" +
+	"rejections\x18\t \x03(\v2 .persys.control.v1.NodeRejectionR\n" +
+	"rejections\"X\n" +
+	"\rNodeRejection\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x16\n" +
+	"\x06filter\x18\x02 \x01(\tR\x06filter\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"p\n" +
+	"\x18SimulatePlacementRequest\x123\n" +
+	"\x04spec\x18\x01 \x01(\v2\x1f.persys.control.v1.WorkloadSpecR\x04spec\x12\x1f\n" +
+	"\vworkload_id\x18\x02 \x01(\tR\n" +
+	"workloadId\"\x8d\x01\n" +
+	"\x15PlacementPluginResult\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x16\n" +
+	"\x06passed\x18\x02 \x01(\bR\x06passed\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05score\x12\x16\n" +
+	"\x06weight\x18\x05 \x01(\x01R\x06weight\"\xa5\x02\n" +
+	"\x12PlacementCandidate\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1a\n" +
+	"\bfeasible\x18\x02 \x01(\bR\bfeasible\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x05R\x04rank\x12\x1f\n" +
+	"\vtotal_score\x18\x04 \x01(\x01R\n" +
+	"totalScore\x12B\n" +
+	"\afilters\x18\x05 \x03(\v2(.persys.control.v1.PlacementPluginResultR\afilters\x12@\n" +
+	"\x06scores\x18\x06 \x03(\v2(.persys.control.v1.PlacementPluginResultR\x06scores\x12\x1f\n" +
+	"\vrejected_by\x18\a \x01(\tR\n" +
+	"rejectedBy\"\xbf\x02\n" +
+	"\x19SimulatePlacementResponse\x12 \n" +
+	"\vschedulable\x18\x01 \x01(\bR\vschedulable\x12(\n" +
+	"\x10selected_node_id\x18\x02 \x01(\tR\x0eselectedNodeId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1a\n" +
+	"\bstrategy\x18\x04 \x01(\tR\bstrategy\x12E\n" +
+	"\n" +
+	"candidates\x18\x05 \x03(\v2%.persys.control.v1.PlacementCandidateR\n" +
+	"candidates\x12,\n" +
+	"\x12preemption_node_id\x18\x06 \x01(\tR\x10preemptionNodeId\x12-\n" +
+	"\x12preemption_victims\x18\a \x03(\tR\x11preemptionVictims\"\xde\x01\n" +
+	"\x1eGetWorkloadUsageHistoryRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12!\n" +
+	"\fstep_seconds\x18\x05 \x01(\x03R\vstepSeconds\"\xa2\x03\n" +
+	"\x12WorkloadUsagePoint\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\asamples\x18\x02 \x01(\x05R\asamples\x12&\n" +
+	"\x0fcpu_avg_percent\x18\x03 \x01(\x01R\rcpuAvgPercent\x12&\n" +
+	"\x0fcpu_max_percent\x18\x04 \x01(\x01R\rcpuMaxPercent\x12(\n" +
+	"\x10memory_avg_bytes\x18\x05 \x01(\x03R\x0ememoryAvgBytes\x12(\n" +
+	"\x10memory_max_bytes\x18\x06 \x01(\x03R\x0ememoryMaxBytes\x12&\n" +
+	"\x0fdisk_read_bytes\x18\a \x01(\x03R\rdiskReadBytes\x12(\n" +
+	"\x10disk_write_bytes\x18\b \x01(\x03R\x0ediskWriteBytes\x12 \n" +
+	"\fnet_rx_bytes\x18\t \x01(\x03R\n" +
+	"netRxBytes\x12 \n" +
+	"\fnet_tx_bytes\x18\n" +
+	" \x01(\x03R\n" +
+	"netTxBytes\"\xbd\x02\n" +
+	"\x1fGetWorkloadUsageHistoryResponse\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x02 \x01(\tR\n" +
+	"resolution\x12!\n" +
+	"\fstep_seconds\x18\x03 \x01(\x03R\vstepSeconds\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12=\n" +
+	"\x06points\x18\x06 \x03(\v2%.persys.control.v1.WorkloadUsagePointR\x06points\x12\x1b\n" +
+	"\tcpu_trend\x18\a \x01(\tR\bcpuTrend\"?\n" +
+	"\x1cListWorkloadRevisionsRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\"f\n" +
+	"\x1dListWorkloadRevisionsResponse\x12E\n" +
+	"\trevisions\x18\x01 \x03(\v2'.persys.control.v1.WorkloadRevisionViewR\trevisions\"\xf5\x01\n" +
+	"\x14WorkloadRevisionView\x12\x1f\n" +
+	"\vrevision_id\x18\x01 \x01(\tR\n" +
+	"revisionId\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x05R\brevision\x12!\n" +
+	"\fchange_cause\x18\x03 \x01(\tR\vchangeCause\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\acurrent\x18\x05 \x01(\bR\acurrent\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x14\n" +
+	"\x05image\x18\a \x01(\tR\x05image\"[\n" +
+	"\x17RollbackWorkloadRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1f\n" +
+	"\vrevision_id\x18\x02 \x01(\tR\n" +
+	"revisionId\"\x96\x01\n" +
+	"\x18RollbackWorkloadResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12;\n" +
+	"\bworkload\x18\x03 \x01(\v2\x1f.persys.control.v1.WorkloadViewR\bworkload\"\xe1\x01\n" +
+	"\x19StreamWorkloadLogsRequest\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x16\n" +
+	"\x06follow\x18\x03 \x01(\bR\x06follow\x12\x1d\n" +
+	"\n" +
+	"tail_lines\x18\x04 \x01(\x03R\ttailLines\x120\n" +
+	"\x05since\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12\x1c\n" +
+	"\tcontainer\x18\x06 \x01(\tR\tcontainer\"\xb4\x01\n" +
+	"\x10WorkloadLogChunk\x124\n" +
+	"\x06stream\x18\x01 \x01(\x0e2\x1c.persys.control.v1.LogStreamR\x06stream\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1c\n" +
+	"\tcontainer\x18\x04 \x01(\tR\tcontainer\"6\n" +
+	"\fTerminalSize\x12\x12\n" +
+	"\x04cols\x18\x01 \x01(\rR\x04cols\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\rR\x04rows\"\x82\x02\n" +
+	"\tExecStart\x12\x1f\n" +
+	"\vworkload_id\x18\x01 \x01(\tR\n" +
+	"workloadId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x18\n" +
+	"\acommand\x18\x03 \x03(\tR\acommand\x12\x10\n" +
+	"\x03tty\x18\x04 \x01(\bR\x03tty\x12\x14\n" +
+	"\x05stdin\x18\x05 \x01(\bR\x05stdin\x123\n" +
+	"\x04size\x18\x06 \x01(\v2\x1f.persys.control.v1.TerminalSizeR\x04size\x12\x1c\n" +
+	"\tcontainer\x18\a \x01(\tR\tcontainer\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\"\xcc\x01\n" +
+	"\x13ExecWorkloadRequest\x124\n" +
+	"\x05start\x18\x01 \x01(\v2\x1c.persys.control.v1.ExecStartH\x00R\x05start\x12\x16\n" +
+	"\x05stdin\x18\x02 \x01(\fH\x00R\x05stdin\x129\n" +
+	"\x06resize\x18\x03 \x01(\v2\x1f.persys.control.v1.TerminalSizeH\x00R\x06resize\x12!\n" +
+	"\vclose_stdin\x18\x04 \x01(\bH\x00R\n" +
+	"closeStdinB\t\n" +
+	"\apayload\"=\n" +
+	"\bExecExit\x12\x1b\n" +
+	"\texit_code\x18\x01 \x01(\x05R\bexitCode\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x88\x01\n" +
+	"\x14ExecWorkloadResponse\x12\x18\n" +
+	"\x06stdout\x18\x01 \x01(\fH\x00R\x06stdout\x12\x18\n" +
+	"\x06stderr\x18\x02 \x01(\fH\x00R\x06stderr\x121\n" +
+	"\x04exit\x18\x03 \x01(\v2\x1b.persys.control.v1.ExecExitH\x00R\x04exitB\t\n" +
+	"\apayload*\xda\x01\n" +
 	"\x14AutomationActionType\x12&\n" +
 	"\"AUTOMATION_ACTION_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
 	"#AUTOMATION_ACTION_SET_DESIRED_STATE\x10\x01\x12$\n" +
 	" AUTOMATION_ACTION_RETRY_WORKLOAD\x10\x02\x12%\n" +
 	"!AUTOMATION_ACTION_DELETE_WORKLOAD\x10\x03\x12$\n" +
-	" AUTOMATION_ACTION_SCALE_REPLICAS\x10\x04*\xd6\x01\n" +
+	" AUTOMATION_ACTION_SCALE_REPLICAS\x10\x04*\xea\x01\n" +
 	"\rFailureReason\x12\x1e\n" +
 	"\x1aFAILURE_REASON_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11IMAGE_PULL_FAILED\x10\x01\x12\x13\n" +
@@ -3552,19 +15413,85 @@ const file_control_proto_rawDesc = "" +
 	"\rRUNTIME_ERROR\x10\x05\x12\x11\n" +
 	"\rNETWORK_ERROR\x10\x06\x12\x11\n" +
 	"\rSTORAGE_ERROR\x10\a\x12\x12\n" +
-	"\x0eVM_BOOT_FAILED\x10\b2\xb9\t\n" +
+	"\x0eVM_BOOT_FAILED\x10\b\x12\x12\n" +
+	"\x0eQUOTA_EXCEEDED\x10\t*U\n" +
+	"\tLogStream\x12\x1a\n" +
+	"\x16LOG_STREAM_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11LOG_STREAM_STDOUT\x10\x01\x12\x15\n" +
+	"\x11LOG_STREAM_STDERR\x10\x022\xa84\n" +
 	"\fAgentControl\x12_\n" +
 	"\fRegisterNode\x12&.persys.control.v1.RegisterNodeRequest\x1a'.persys.control.v1.RegisterNodeResponse\x12V\n" +
 	"\tHeartbeat\x12#.persys.control.v1.HeartbeatRequest\x1a$.persys.control.v1.HeartbeatResponse\x12b\n" +
 	"\rApplyWorkload\x12'.persys.control.v1.ApplyWorkloadRequest\x1a(.persys.control.v1.ApplyWorkloadResponse\x12e\n" +
-	"\x0eDeleteWorkload\x12(.persys.control.v1.DeleteWorkloadRequest\x1a).persys.control.v1.DeleteWorkloadResponse\x12b\n" +
+	"\x0eDeleteWorkload\x12(.persys.control.v1.DeleteWorkloadRequest\x1a).persys.control.v1.DeleteWorkloadResponse\x12z\n" +
+	"\x15ListWorkloadRevisions\x12/.persys.control.v1.ListWorkloadRevisionsRequest\x1a0.persys.control.v1.ListWorkloadRevisionsResponse\x12k\n" +
+	"\x10RollbackWorkload\x12*.persys.control.v1.RollbackWorkloadRequest\x1a+.persys.control.v1.RollbackWorkloadResponse\x12b\n" +
 	"\rRetryWorkload\x12'.persys.control.v1.RetryWorkloadRequest\x1a(.persys.control.v1.RetryWorkloadResponse\x12\x89\x01\n" +
 	"\x1aSubmitAutomationSuggestion\x124.persys.control.v1.SubmitAutomationSuggestionRequest\x1a5.persys.control.v1.SubmitAutomationSuggestionResponse\x12V\n" +
 	"\tListNodes\x12#.persys.control.v1.ListNodesRequest\x1a$.persys.control.v1.ListNodesResponse\x12P\n" +
 	"\aGetNode\x12!.persys.control.v1.GetNodeRequest\x1a\".persys.control.v1.GetNodeResponse\x12b\n" +
 	"\rListWorkloads\x12'.persys.control.v1.ListWorkloadsRequest\x1a(.persys.control.v1.ListWorkloadsResponse\x12\\\n" +
 	"\vGetWorkload\x12%.persys.control.v1.GetWorkloadRequest\x1a&.persys.control.v1.GetWorkloadResponse\x12n\n" +
-	"\x11GetClusterSummary\x12+.persys.control.v1.GetClusterSummaryRequest\x1a,.persys.control.v1.GetClusterSummaryResponse\x12Y\n" +
+	"\x11GetClusterSummary\x12+.persys.control.v1.GetClusterSummaryRequest\x1a,.persys.control.v1.GetClusterSummaryResponse\x12w\n" +
+	"\x14ListPendingWorkloads\x12..persys.control.v1.ListPendingWorkloadsRequest\x1a/.persys.control.v1.ListPendingWorkloadsResponse\x12n\n" +
+	"\x11SimulatePlacement\x12+.persys.control.v1.SimulatePlacementRequest\x1a,.persys.control.v1.SimulatePlacementResponse\x12\x80\x01\n" +
+	"\x17GetWorkloadUsageHistory\x121.persys.control.v1.GetWorkloadUsageHistoryRequest\x1a2.persys.control.v1.GetWorkloadUsageHistoryResponse\x12h\n" +
+	"\x0fApplyReplicaSet\x12).persys.control.v1.ApplyReplicaSetRequest\x1a*.persys.control.v1.ApplyReplicaSetResponse\x12h\n" +
+	"\x0fScaleReplicaSet\x12).persys.control.v1.ScaleReplicaSetRequest\x1a*.persys.control.v1.ScaleReplicaSetResponse\x12k\n" +
+	"\x10DeleteReplicaSet\x12*.persys.control.v1.DeleteReplicaSetRequest\x1a+.persys.control.v1.DeleteReplicaSetResponse\x12b\n" +
+	"\rGetReplicaSet\x12'.persys.control.v1.GetReplicaSetRequest\x1a(.persys.control.v1.GetReplicaSetResponse\x12h\n" +
+	"\x0fListReplicaSets\x12).persys.control.v1.ListReplicaSetsRequest\x1a*.persys.control.v1.ListReplicaSetsResponse\x12e\n" +
+	"\x0eApplyNamespace\x12(.persys.control.v1.ApplyNamespaceRequest\x1a).persys.control.v1.ApplyNamespaceResponse\x12h\n" +
+	"\x0fDeleteNamespace\x12).persys.control.v1.DeleteNamespaceRequest\x1a*.persys.control.v1.DeleteNamespaceResponse\x12_\n" +
+	"\fGetNamespace\x12&.persys.control.v1.GetNamespaceRequest\x1a'.persys.control.v1.GetNamespaceResponse\x12e\n" +
+	"\x0eListNamespaces\x12(.persys.control.v1.ListNamespacesRequest\x1a).persys.control.v1.ListNamespacesResponse\x12\\\n" +
+	"\vApplySecret\x12%.persys.control.v1.ApplySecretRequest\x1a&.persys.control.v1.ApplySecretResponse\x12_\n" +
+	"\fDeleteSecret\x12&.persys.control.v1.DeleteSecretRequest\x1a'.persys.control.v1.DeleteSecretResponse\x12V\n" +
+	"\tGetSecret\x12#.persys.control.v1.GetSecretRequest\x1a$.persys.control.v1.GetSecretResponse\x12\\\n" +
+	"\vListSecrets\x12%.persys.control.v1.ListSecretsRequest\x1a&.persys.control.v1.ListSecretsResponse\x12\\\n" +
+	"\vApplyConfig\x12%.persys.control.v1.ApplyConfigRequest\x1a&.persys.control.v1.ApplyConfigResponse\x12_\n" +
+	"\fDeleteConfig\x12&.persys.control.v1.DeleteConfigRequest\x1a'.persys.control.v1.DeleteConfigResponse\x12V\n" +
+	"\tGetConfig\x12#.persys.control.v1.GetConfigRequest\x1a$.persys.control.v1.GetConfigResponse\x12\\\n" +
+	"\vListConfigs\x12%.persys.control.v1.ListConfigsRequest\x1a&.persys.control.v1.ListConfigsResponse\x12_\n" +
+	"\fApplyService\x12&.persys.control.v1.ApplyServiceRequest\x1a'.persys.control.v1.ApplyServiceResponse\x12b\n" +
+	"\rDeleteService\x12'.persys.control.v1.DeleteServiceRequest\x1a(.persys.control.v1.DeleteServiceResponse\x12Y\n" +
+	"\n" +
+	"GetService\x12$.persys.control.v1.GetServiceRequest\x1a%.persys.control.v1.GetServiceResponse\x12_\n" +
+	"\fListServices\x12&.persys.control.v1.ListServicesRequest\x1a'.persys.control.v1.ListServicesResponse\x12Y\n" +
+	"\n" +
+	"ApplyStack\x12$.persys.control.v1.ApplyStackRequest\x1a%.persys.control.v1.ApplyStackResponse\x12\\\n" +
+	"\vDeleteStack\x12%.persys.control.v1.DeleteStackRequest\x1a&.persys.control.v1.DeleteStackResponse\x12S\n" +
+	"\bGetStack\x12\".persys.control.v1.GetStackRequest\x1a#.persys.control.v1.GetStackResponse\x12Y\n" +
+	"\n" +
+	"ListStacks\x12$.persys.control.v1.ListStacksRequest\x1a%.persys.control.v1.ListStacksResponse\x12b\n" +
+	"\rSetStackState\x12'.persys.control.v1.SetStackStateRequest\x1a(.persys.control.v1.SetStackStateResponse\x12S\n" +
+	"\bApplyJob\x12\".persys.control.v1.ApplyJobRequest\x1a#.persys.control.v1.ApplyJobResponse\x12V\n" +
+	"\tDeleteJob\x12#.persys.control.v1.DeleteJobRequest\x1a$.persys.control.v1.DeleteJobResponse\x12M\n" +
+	"\x06GetJob\x12 .persys.control.v1.GetJobRequest\x1a!.persys.control.v1.GetJobResponse\x12S\n" +
+	"\bListJobs\x12\".persys.control.v1.ListJobsRequest\x1a#.persys.control.v1.ListJobsResponse\x12_\n" +
+	"\fApplyCronJob\x12&.persys.control.v1.ApplyCronJobRequest\x1a'.persys.control.v1.ApplyCronJobResponse\x12b\n" +
+	"\rDeleteCronJob\x12'.persys.control.v1.DeleteCronJobRequest\x1a(.persys.control.v1.DeleteCronJobResponse\x12Y\n" +
+	"\n" +
+	"GetCronJob\x12$.persys.control.v1.GetCronJobRequest\x1a%.persys.control.v1.GetCronJobResponse\x12_\n" +
+	"\fListCronJobs\x12&.persys.control.v1.ListCronJobsRequest\x1a'.persys.control.v1.ListCronJobsResponse\x12Y\n" +
+	"\n" +
+	"CordonNode\x12$.persys.control.v1.CordonNodeRequest\x1a%.persys.control.v1.CordonNodeResponse\x12_\n" +
+	"\fUncordonNode\x12&.persys.control.v1.UncordonNodeRequest\x1a'.persys.control.v1.UncordonNodeResponse\x12b\n" +
+	"\rSetNodeTaints\x12'.persys.control.v1.SetNodeTaintsRequest\x1a(.persys.control.v1.SetNodeTaintsResponse\x12V\n" +
+	"\tDrainNode\x12#.persys.control.v1.DrainNodeRequest\x1a$.persys.control.v1.DrainNodeResponse\x12e\n" +
+	"\x0eRunDescheduler\x12(.persys.control.v1.RunDeschedulerRequest\x1a).persys.control.v1.RunDeschedulerResponse\x12_\n" +
+	"\fCreateVolume\x12&.persys.control.v1.CreateVolumeRequest\x1a'.persys.control.v1.CreateVolumeResponse\x12V\n" +
+	"\tGetVolume\x12#.persys.control.v1.GetVolumeRequest\x1a$.persys.control.v1.GetVolumeResponse\x12\\\n" +
+	"\vListVolumes\x12%.persys.control.v1.ListVolumesRequest\x1a&.persys.control.v1.ListVolumesResponse\x12_\n" +
+	"\fDeleteVolume\x12&.persys.control.v1.DeleteVolumeRequest\x1a'.persys.control.v1.DeleteVolumeResponse\x12_\n" +
+	"\fResizeVolume\x12&.persys.control.v1.ResizeVolumeRequest\x1a'.persys.control.v1.ResizeVolumeResponse\x12e\n" +
+	"\x0eSnapshotVolume\x12(.persys.control.v1.SnapshotVolumeRequest\x1a).persys.control.v1.SnapshotVolumeResponse\x12\\\n" +
+	"\vCloneVolume\x12%.persys.control.v1.CloneVolumeRequest\x1a&.persys.control.v1.CloneVolumeResponse\x12\\\n" +
+	"\vExportState\x12%.persys.control.v1.ExportStateRequest\x1a&.persys.control.v1.ExportStateResponse\x12\\\n" +
+	"\vImportState\x12%.persys.control.v1.ImportStateRequest\x1a&.persys.control.v1.ImportStateResponse\x12]\n" +
+	"\vWatchEvents\x12%.persys.control.v1.WatchEventsRequest\x1a%.persys.control.v1.SchedulerEventView0\x01\x12i\n" +
+	"\x12StreamWorkloadLogs\x12,.persys.control.v1.StreamWorkloadLogsRequest\x1a#.persys.control.v1.WorkloadLogChunk0\x01\x12c\n" +
+	"\fExecWorkload\x12&.persys.control.v1.ExecWorkloadRequest\x1a'.persys.control.v1.ExecWorkloadResponse(\x010\x01\x12Y\n" +
 	"\rControlStream\x12!.persys.control.v1.ControlMessage\x1a!.persys.control.v1.ControlMessage(\x010\x01B7Z5github.com/persys-dev/persys/api/control/v1;controlv1b\x06proto3"
 
 var (
@@ -3579,144 +15506,588 @@ func file_control_proto_rawDescGZIP() []byte {
 	return file_control_proto_rawDescData
 }
 
-var file_control_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_control_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_control_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_control_proto_msgTypes = make([]protoimpl.MessageInfo, 219)
 var file_control_proto_goTypes = []any{
 	(AutomationActionType)(0),                  // 0: persys.control.v1.AutomationActionType
 	(FailureReason)(0),                         // 1: persys.control.v1.FailureReason
-	(*AutomationSuggestion)(nil),               // 2: persys.control.v1.AutomationSuggestion
-	(*SubmitAutomationSuggestionRequest)(nil),  // 3: persys.control.v1.SubmitAutomationSuggestionRequest
-	(*SubmitAutomationSuggestionResponse)(nil), // 4: persys.control.v1.SubmitAutomationSuggestionResponse
-	(*RegisterNodeRequest)(nil),                // 5: persys.control.v1.RegisterNodeRequest
-	(*NodeCapabilities)(nil),                   // 6: persys.control.v1.NodeCapabilities
-	(*StoragePool)(nil),                        // 7: persys.control.v1.StoragePool
-	(*RegisterNodeResponse)(nil),               // 8: persys.control.v1.RegisterNodeResponse
-	(*HeartbeatRequest)(nil),                   // 9: persys.control.v1.HeartbeatRequest
-	(*NodeUsage)(nil),                          // 10: persys.control.v1.NodeUsage
-	(*HeartbeatResponse)(nil),                  // 11: persys.control.v1.HeartbeatResponse
-	(*ApplyWorkloadRequest)(nil),               // 12: persys.control.v1.ApplyWorkloadRequest
-	(*ApplyWorkloadResponse)(nil),              // 13: persys.control.v1.ApplyWorkloadResponse
-	(*DeleteWorkloadRequest)(nil),              // 14: persys.control.v1.DeleteWorkloadRequest
-	(*DeleteWorkloadResponse)(nil),             // 15: persys.control.v1.DeleteWorkloadResponse
-	(*WorkloadSpec)(nil),                       // 16: persys.control.v1.WorkloadSpec
-	(*ResourceRequirements)(nil),               // 17: persys.control.v1.ResourceRequirements
-	(*ContainerSpec)(nil),                      // 18: persys.control.v1.ContainerSpec
-	(*VolumeMount)(nil),                        // 19: persys.control.v1.VolumeMount
-	(*Port)(nil),                               // 20: persys.control.v1.Port
-	(*ComposeSpec)(nil),                        // 21: persys.control.v1.ComposeSpec
-	(*VMSpec)(nil),                             // 22: persys.control.v1.VMSpec
-	(*DiskConfig)(nil),                         // 23: persys.control.v1.DiskConfig
-	(*NetworkConfig)(nil),                      // 24: persys.control.v1.NetworkConfig
-	(*CloudInitConfig)(nil),                    // 25: persys.control.v1.CloudInitConfig
-	(*ManagedVolumeSpec)(nil),                  // 26: persys.control.v1.ManagedVolumeSpec
-	(*WorkloadUsageSnapshot)(nil),              // 27: persys.control.v1.WorkloadUsageSnapshot
-	(*ReasonDetail)(nil),                       // 28: persys.control.v1.ReasonDetail
-	(*WorkloadStatus)(nil),                     // 29: persys.control.v1.WorkloadStatus
-	(*RetryWorkloadRequest)(nil),               // 30: persys.control.v1.RetryWorkloadRequest
-	(*RetryWorkloadResponse)(nil),              // 31: persys.control.v1.RetryWorkloadResponse
-	(*ListNodesRequest)(nil),                   // 32: persys.control.v1.ListNodesRequest
-	(*GetNodeRequest)(nil),                     // 33: persys.control.v1.GetNodeRequest
-	(*ListNodesResponse)(nil),                  // 34: persys.control.v1.ListNodesResponse
-	(*GetNodeResponse)(nil),                    // 35: persys.control.v1.GetNodeResponse
-	(*NodeView)(nil),                           // 36: persys.control.v1.NodeView
-	(*ListWorkloadsRequest)(nil),               // 37: persys.control.v1.ListWorkloadsRequest
-	(*GetWorkloadRequest)(nil),                 // 38: persys.control.v1.GetWorkloadRequest
-	(*ListWorkloadsResponse)(nil),              // 39: persys.control.v1.ListWorkloadsResponse
-	(*GetWorkloadResponse)(nil),                // 40: persys.control.v1.GetWorkloadResponse
-	(*WorkloadView)(nil),                       // 41: persys.control.v1.WorkloadView
-	(*GetClusterSummaryRequest)(nil),           // 42: persys.control.v1.GetClusterSummaryRequest
-	(*GetClusterSummaryResponse)(nil),          // 43: persys.control.v1.GetClusterSummaryResponse
-	(*ControlMessage)(nil),                     // 44: persys.control.v1.ControlMessage
-	nil,                                        // 45: persys.control.v1.RegisterNodeRequest.LabelsEntry
-	nil,                                        // 46: persys.control.v1.WorkloadSpec.MetadataEntry
-	nil,                                        // 47: persys.control.v1.ContainerSpec.EnvEntry
-	nil,                                        // 48: persys.control.v1.ComposeSpec.EnvEntry
-	nil,                                        // 49: persys.control.v1.NodeView.LabelsEntry
-	(*timestamppb.Timestamp)(nil),              // 50: google.protobuf.Timestamp
+	(LogStream)(0),                             // 2: persys.control.v1.LogStream
+	(*AutomationSuggestion)(nil),               // 3: persys.control.v1.AutomationSuggestion
+	(*SubmitAutomationSuggestionRequest)(nil),  // 4: persys.control.v1.SubmitAutomationSuggestionRequest
+	(*SubmitAutomationSuggestionResponse)(nil), // 5: persys.control.v1.SubmitAutomationSuggestionResponse
+	(*RegisterNodeRequest)(nil),                // 6: persys.control.v1.RegisterNodeRequest
+	(*Taint)(nil),                              // 7: persys.control.v1.Taint
+	(*Toleration)(nil),                         // 8: persys.control.v1.Toleration
+	(*NodeCapabilities)(nil),                   // 9: persys.control.v1.NodeCapabilities
+	(*StoragePool)(nil),                        // 10: persys.control.v1.StoragePool
+	(*RegisterNodeResponse)(nil),               // 11: persys.control.v1.RegisterNodeResponse
+	(*HeartbeatRequest)(nil),                   // 12: persys.control.v1.HeartbeatRequest
+	(*NodeUsage)(nil),                          // 13: persys.control.v1.NodeUsage
+	(*StoragePoolUsage)(nil),                   // 14: persys.control.v1.StoragePoolUsage
+	(*HeartbeatResponse)(nil),                  // 15: persys.control.v1.HeartbeatResponse
+	(*ApplyWorkloadRequest)(nil),               // 16: persys.control.v1.ApplyWorkloadRequest
+	(*ApplyWorkloadResponse)(nil),              // 17: persys.control.v1.ApplyWorkloadResponse
+	(*DeleteWorkloadRequest)(nil),              // 18: persys.control.v1.DeleteWorkloadRequest
+	(*DeleteWorkloadResponse)(nil),             // 19: persys.control.v1.DeleteWorkloadResponse
+	(*WorkloadSpec)(nil),                       // 20: persys.control.v1.WorkloadSpec
+	(*RolloutStrategy)(nil),                    // 21: persys.control.v1.RolloutStrategy
+	(*PlacementPolicy)(nil),                    // 22: persys.control.v1.PlacementPolicy
+	(*NodeSelectorRequirement)(nil),            // 23: persys.control.v1.NodeSelectorRequirement
+	(*PreferredNodeAffinity)(nil),              // 24: persys.control.v1.PreferredNodeAffinity
+	(*ResourceRequirements)(nil),               // 25: persys.control.v1.ResourceRequirements
+	(*ContainerSpec)(nil),                      // 26: persys.control.v1.ContainerSpec
+	(*EnvFromSource)(nil),                      // 27: persys.control.v1.EnvFromSource
+	(*FileMount)(nil),                          // 28: persys.control.v1.FileMount
+	(*TemplateVar)(nil),                        // 29: persys.control.v1.TemplateVar
+	(*Probe)(nil),                              // 30: persys.control.v1.Probe
+	(*HTTPGetAction)(nil),                      // 31: persys.control.v1.HTTPGetAction
+	(*TCPSocketAction)(nil),                    // 32: persys.control.v1.TCPSocketAction
+	(*ExecAction)(nil),                         // 33: persys.control.v1.ExecAction
+	(*ProbeResult)(nil),                        // 34: persys.control.v1.ProbeResult
+	(*VolumeMount)(nil),                        // 35: persys.control.v1.VolumeMount
+	(*Port)(nil),                               // 36: persys.control.v1.Port
+	(*ComposeSpec)(nil),                        // 37: persys.control.v1.ComposeSpec
+	(*VMSpec)(nil),                             // 38: persys.control.v1.VMSpec
+	(*DiskConfig)(nil),                         // 39: persys.control.v1.DiskConfig
+	(*NetworkConfig)(nil),                      // 40: persys.control.v1.NetworkConfig
+	(*CloudInitConfig)(nil),                    // 41: persys.control.v1.CloudInitConfig
+	(*ManagedVolumeSpec)(nil),                  // 42: persys.control.v1.ManagedVolumeSpec
+	(*WorkloadUsageSnapshot)(nil),              // 43: persys.control.v1.WorkloadUsageSnapshot
+	(*ReasonDetail)(nil),                       // 44: persys.control.v1.ReasonDetail
+	(*WorkloadStatus)(nil),                     // 45: persys.control.v1.WorkloadStatus
+	(*RetryWorkloadRequest)(nil),               // 46: persys.control.v1.RetryWorkloadRequest
+	(*RetryWorkloadResponse)(nil),              // 47: persys.control.v1.RetryWorkloadResponse
+	(*ListNodesRequest)(nil),                   // 48: persys.control.v1.ListNodesRequest
+	(*GetNodeRequest)(nil),                     // 49: persys.control.v1.GetNodeRequest
+	(*ListNodesResponse)(nil),                  // 50: persys.control.v1.ListNodesResponse
+	(*GetNodeResponse)(nil),                    // 51: persys.control.v1.GetNodeResponse
+	(*NodeView)(nil),                           // 52: persys.control.v1.NodeView
+	(*NodeDrainStatus)(nil),                    // 53: persys.control.v1.NodeDrainStatus
+	(*StoragePoolStatus)(nil),                  // 54: persys.control.v1.StoragePoolStatus
+	(*ListWorkloadsRequest)(nil),               // 55: persys.control.v1.ListWorkloadsRequest
+	(*GetWorkloadRequest)(nil),                 // 56: persys.control.v1.GetWorkloadRequest
+	(*ListWorkloadsResponse)(nil),              // 57: persys.control.v1.ListWorkloadsResponse
+	(*GetWorkloadResponse)(nil),                // 58: persys.control.v1.GetWorkloadResponse
+	(*WorkloadView)(nil),                       // 59: persys.control.v1.WorkloadView
+	(*WorkloadHealthView)(nil),                 // 60: persys.control.v1.WorkloadHealthView
+	(*ProbeStateView)(nil),                     // 61: persys.control.v1.ProbeStateView
+	(*RolloutStatusView)(nil),                  // 62: persys.control.v1.RolloutStatusView
+	(*GetClusterSummaryRequest)(nil),           // 63: persys.control.v1.GetClusterSummaryRequest
+	(*GetClusterSummaryResponse)(nil),          // 64: persys.control.v1.GetClusterSummaryResponse
+	(*ApplyReplicaSetRequest)(nil),             // 65: persys.control.v1.ApplyReplicaSetRequest
+	(*ApplyReplicaSetResponse)(nil),            // 66: persys.control.v1.ApplyReplicaSetResponse
+	(*ScaleReplicaSetRequest)(nil),             // 67: persys.control.v1.ScaleReplicaSetRequest
+	(*ScaleReplicaSetResponse)(nil),            // 68: persys.control.v1.ScaleReplicaSetResponse
+	(*DeleteReplicaSetRequest)(nil),            // 69: persys.control.v1.DeleteReplicaSetRequest
+	(*DeleteReplicaSetResponse)(nil),           // 70: persys.control.v1.DeleteReplicaSetResponse
+	(*GetReplicaSetRequest)(nil),               // 71: persys.control.v1.GetReplicaSetRequest
+	(*GetReplicaSetResponse)(nil),              // 72: persys.control.v1.GetReplicaSetResponse
+	(*ListReplicaSetsRequest)(nil),             // 73: persys.control.v1.ListReplicaSetsRequest
+	(*ListReplicaSetsResponse)(nil),            // 74: persys.control.v1.ListReplicaSetsResponse
+	(*ReplicaSetView)(nil),                     // 75: persys.control.v1.ReplicaSetView
+	(*ResourceQuota)(nil),                      // 76: persys.control.v1.ResourceQuota
+	(*ApplyNamespaceRequest)(nil),              // 77: persys.control.v1.ApplyNamespaceRequest
+	(*ApplyNamespaceResponse)(nil),             // 78: persys.control.v1.ApplyNamespaceResponse
+	(*DeleteNamespaceRequest)(nil),             // 79: persys.control.v1.DeleteNamespaceRequest
+	(*DeleteNamespaceResponse)(nil),            // 80: persys.control.v1.DeleteNamespaceResponse
+	(*GetNamespaceRequest)(nil),                // 81: persys.control.v1.GetNamespaceRequest
+	(*GetNamespaceResponse)(nil),               // 82: persys.control.v1.GetNamespaceResponse
+	(*ListNamespacesRequest)(nil),              // 83: persys.control.v1.ListNamespacesRequest
+	(*ListNamespacesResponse)(nil),             // 84: persys.control.v1.ListNamespacesResponse
+	(*NamespaceView)(nil),                      // 85: persys.control.v1.NamespaceView
+	(*ApplySecretRequest)(nil),                 // 86: persys.control.v1.ApplySecretRequest
+	(*ApplySecretResponse)(nil),                // 87: persys.control.v1.ApplySecretResponse
+	(*DeleteSecretRequest)(nil),                // 88: persys.control.v1.DeleteSecretRequest
+	(*DeleteSecretResponse)(nil),               // 89: persys.control.v1.DeleteSecretResponse
+	(*GetSecretRequest)(nil),                   // 90: persys.control.v1.GetSecretRequest
+	(*GetSecretResponse)(nil),                  // 91: persys.control.v1.GetSecretResponse
+	(*ListSecretsRequest)(nil),                 // 92: persys.control.v1.ListSecretsRequest
+	(*ListSecretsResponse)(nil),                // 93: persys.control.v1.ListSecretsResponse
+	(*SecretView)(nil),                         // 94: persys.control.v1.SecretView
+	(*ApplyConfigRequest)(nil),                 // 95: persys.control.v1.ApplyConfigRequest
+	(*ApplyConfigResponse)(nil),                // 96: persys.control.v1.ApplyConfigResponse
+	(*DeleteConfigRequest)(nil),                // 97: persys.control.v1.DeleteConfigRequest
+	(*DeleteConfigResponse)(nil),               // 98: persys.control.v1.DeleteConfigResponse
+	(*GetConfigRequest)(nil),                   // 99: persys.control.v1.GetConfigRequest
+	(*GetConfigResponse)(nil),                  // 100: persys.control.v1.GetConfigResponse
+	(*ListConfigsRequest)(nil),                 // 101: persys.control.v1.ListConfigsRequest
+	(*ListConfigsResponse)(nil),                // 102: persys.control.v1.ListConfigsResponse
+	(*ConfigView)(nil),                         // 103: persys.control.v1.ConfigView
+	(*ServicePort)(nil),                        // 104: persys.control.v1.ServicePort
+	(*ApplyServiceRequest)(nil),                // 105: persys.control.v1.ApplyServiceRequest
+	(*ApplyServiceResponse)(nil),               // 106: persys.control.v1.ApplyServiceResponse
+	(*DeleteServiceRequest)(nil),               // 107: persys.control.v1.DeleteServiceRequest
+	(*DeleteServiceResponse)(nil),              // 108: persys.control.v1.DeleteServiceResponse
+	(*GetServiceRequest)(nil),                  // 109: persys.control.v1.GetServiceRequest
+	(*GetServiceResponse)(nil),                 // 110: persys.control.v1.GetServiceResponse
+	(*ListServicesRequest)(nil),                // 111: persys.control.v1.ListServicesRequest
+	(*ListServicesResponse)(nil),               // 112: persys.control.v1.ListServicesResponse
+	(*ServiceEndpoint)(nil),                    // 113: persys.control.v1.ServiceEndpoint
+	(*ServiceView)(nil),                        // 114: persys.control.v1.ServiceView
+	(*StackMember)(nil),                        // 115: persys.control.v1.StackMember
+	(*ApplyStackRequest)(nil),                  // 116: persys.control.v1.ApplyStackRequest
+	(*ApplyStackResponse)(nil),                 // 117: persys.control.v1.ApplyStackResponse
+	(*DeleteStackRequest)(nil),                 // 118: persys.control.v1.DeleteStackRequest
+	(*DeleteStackResponse)(nil),                // 119: persys.control.v1.DeleteStackResponse
+	(*GetStackRequest)(nil),                    // 120: persys.control.v1.GetStackRequest
+	(*GetStackResponse)(nil),                   // 121: persys.control.v1.GetStackResponse
+	(*ListStacksRequest)(nil),                  // 122: persys.control.v1.ListStacksRequest
+	(*ListStacksResponse)(nil),                 // 123: persys.control.v1.ListStacksResponse
+	(*SetStackStateRequest)(nil),               // 124: persys.control.v1.SetStackStateRequest
+	(*SetStackStateResponse)(nil),              // 125: persys.control.v1.SetStackStateResponse
+	(*StackMemberView)(nil),                    // 126: persys.control.v1.StackMemberView
+	(*StackView)(nil),                          // 127: persys.control.v1.StackView
+	(*JobSpec)(nil),                            // 128: persys.control.v1.JobSpec
+	(*ApplyJobRequest)(nil),                    // 129: persys.control.v1.ApplyJobRequest
+	(*ApplyJobResponse)(nil),                   // 130: persys.control.v1.ApplyJobResponse
+	(*DeleteJobRequest)(nil),                   // 131: persys.control.v1.DeleteJobRequest
+	(*DeleteJobResponse)(nil),                  // 132: persys.control.v1.DeleteJobResponse
+	(*GetJobRequest)(nil),                      // 133: persys.control.v1.GetJobRequest
+	(*GetJobResponse)(nil),                     // 134: persys.control.v1.GetJobResponse
+	(*ListJobsRequest)(nil),                    // 135: persys.control.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),                   // 136: persys.control.v1.ListJobsResponse
+	(*JobView)(nil),                            // 137: persys.control.v1.JobView
+	(*ApplyCronJobRequest)(nil),                // 138: persys.control.v1.ApplyCronJobRequest
+	(*ApplyCronJobResponse)(nil),               // 139: persys.control.v1.ApplyCronJobResponse
+	(*DeleteCronJobRequest)(nil),               // 140: persys.control.v1.DeleteCronJobRequest
+	(*DeleteCronJobResponse)(nil),              // 141: persys.control.v1.DeleteCronJobResponse
+	(*GetCronJobRequest)(nil),                  // 142: persys.control.v1.GetCronJobRequest
+	(*GetCronJobResponse)(nil),                 // 143: persys.control.v1.GetCronJobResponse
+	(*ListCronJobsRequest)(nil),                // 144: persys.control.v1.ListCronJobsRequest
+	(*ListCronJobsResponse)(nil),               // 145: persys.control.v1.ListCronJobsResponse
+	(*CronJobView)(nil),                        // 146: persys.control.v1.CronJobView
+	(*ControlMessage)(nil),                     // 147: persys.control.v1.ControlMessage
+	(*CordonNodeRequest)(nil),                  // 148: persys.control.v1.CordonNodeRequest
+	(*CordonNodeResponse)(nil),                 // 149: persys.control.v1.CordonNodeResponse
+	(*UncordonNodeRequest)(nil),                // 150: persys.control.v1.UncordonNodeRequest
+	(*UncordonNodeResponse)(nil),               // 151: persys.control.v1.UncordonNodeResponse
+	(*SetNodeTaintsRequest)(nil),               // 152: persys.control.v1.SetNodeTaintsRequest
+	(*SetNodeTaintsResponse)(nil),              // 153: persys.control.v1.SetNodeTaintsResponse
+	(*DrainNodeRequest)(nil),                   // 154: persys.control.v1.DrainNodeRequest
+	(*DrainNodeResponse)(nil),                  // 155: persys.control.v1.DrainNodeResponse
+	(*RunDeschedulerRequest)(nil),              // 156: persys.control.v1.RunDeschedulerRequest
+	(*DeschedulerMove)(nil),                    // 157: persys.control.v1.DeschedulerMove
+	(*RunDeschedulerResponse)(nil),             // 158: persys.control.v1.RunDeschedulerResponse
+	(*VolumeSnapshotView)(nil),                 // 159: persys.control.v1.VolumeSnapshotView
+	(*VolumeView)(nil),                         // 160: persys.control.v1.VolumeView
+	(*CreateVolumeRequest)(nil),                // 161: persys.control.v1.CreateVolumeRequest
+	(*CreateVolumeResponse)(nil),               // 162: persys.control.v1.CreateVolumeResponse
+	(*GetVolumeRequest)(nil),                   // 163: persys.control.v1.GetVolumeRequest
+	(*GetVolumeResponse)(nil),                  // 164: persys.control.v1.GetVolumeResponse
+	(*ListVolumesRequest)(nil),                 // 165: persys.control.v1.ListVolumesRequest
+	(*ListVolumesResponse)(nil),                // 166: persys.control.v1.ListVolumesResponse
+	(*DeleteVolumeRequest)(nil),                // 167: persys.control.v1.DeleteVolumeRequest
+	(*DeleteVolumeResponse)(nil),               // 168: persys.control.v1.DeleteVolumeResponse
+	(*ResizeVolumeRequest)(nil),                // 169: persys.control.v1.ResizeVolumeRequest
+	(*ResizeVolumeResponse)(nil),               // 170: persys.control.v1.ResizeVolumeResponse
+	(*SnapshotVolumeRequest)(nil),              // 171: persys.control.v1.SnapshotVolumeRequest
+	(*SnapshotVolumeResponse)(nil),             // 172: persys.control.v1.SnapshotVolumeResponse
+	(*CloneVolumeRequest)(nil),                 // 173: persys.control.v1.CloneVolumeRequest
+	(*CloneVolumeResponse)(nil),                // 174: persys.control.v1.CloneVolumeResponse
+	(*ExportStateRequest)(nil),                 // 175: persys.control.v1.ExportStateRequest
+	(*ExportStateResponse)(nil),                // 176: persys.control.v1.ExportStateResponse
+	(*ImportStateRequest)(nil),                 // 177: persys.control.v1.ImportStateRequest
+	(*ImportStateResponse)(nil),                // 178: persys.control.v1.ImportStateResponse
+	(*WatchEventsRequest)(nil),                 // 179: persys.control.v1.WatchEventsRequest
+	(*SchedulerEventView)(nil),                 // 180: persys.control.v1.SchedulerEventView
+	(*ListPendingWorkloadsRequest)(nil),        // 181: persys.control.v1.ListPendingWorkloadsRequest
+	(*ListPendingWorkloadsResponse)(nil),       // 182: persys.control.v1.ListPendingWorkloadsResponse
+	(*PendingWorkloadView)(nil),                // 183: persys.control.v1.PendingWorkloadView
+	(*NodeRejection)(nil),                      // 184: persys.control.v1.NodeRejection
+	(*SimulatePlacementRequest)(nil),           // 185: persys.control.v1.SimulatePlacementRequest
+	(*PlacementPluginResult)(nil),              // 186: persys.control.v1.PlacementPluginResult
+	(*PlacementCandidate)(nil),                 // 187: persys.control.v1.PlacementCandidate
+	(*SimulatePlacementResponse)(nil),          // 188: persys.control.v1.SimulatePlacementResponse
+	(*GetWorkloadUsageHistoryRequest)(nil),     // 189: persys.control.v1.GetWorkloadUsageHistoryRequest
+	(*WorkloadUsagePoint)(nil),                 // 190: persys.control.v1.WorkloadUsagePoint
+	(*GetWorkloadUsageHistoryResponse)(nil),    // 191: persys.control.v1.GetWorkloadUsageHistoryResponse
+	(*ListWorkloadRevisionsRequest)(nil),       // 192: persys.control.v1.ListWorkloadRevisionsRequest
+	(*ListWorkloadRevisionsResponse)(nil),      // 193: persys.control.v1.ListWorkloadRevisionsResponse
+	(*WorkloadRevisionView)(nil),               // 194: persys.control.v1.WorkloadRevisionView
+	(*RollbackWorkloadRequest)(nil),            // 195: persys.control.v1.RollbackWorkloadRequest
+	(*RollbackWorkloadResponse)(nil),           // 196: persys.control.v1.RollbackWorkloadResponse
+	(*StreamWorkloadLogsRequest)(nil),          // 197: persys.control.v1.StreamWorkloadLogsRequest
+	(*WorkloadLogChunk)(nil),                   // 198: persys.control.v1.WorkloadLogChunk
+	(*TerminalSize)(nil),                       // 199: persys.control.v1.TerminalSize
+	(*ExecStart)(nil),                          // 200: persys.control.v1.ExecStart
+	(*ExecWorkloadRequest)(nil),                // 201: persys.control.v1.ExecWorkloadRequest
+	(*ExecExit)(nil),                           // 202: persys.control.v1.ExecExit
+	(*ExecWorkloadResponse)(nil),               // 203: persys.control.v1.ExecWorkloadResponse
+	nil,                                        // 204: persys.control.v1.RegisterNodeRequest.LabelsEntry
+	nil,                                        // 205: persys.control.v1.WorkloadSpec.MetadataEntry
+	nil,                                        // 206: persys.control.v1.ContainerSpec.EnvEntry
+	nil,                                        // 207: persys.control.v1.HTTPGetAction.HeadersEntry
+	nil,                                        // 208: persys.control.v1.ComposeSpec.EnvEntry
+	nil,                                        // 209: persys.control.v1.NodeView.LabelsEntry
+	nil,                                        // 210: persys.control.v1.ApplyNamespaceRequest.LabelsEntry
+	nil,                                        // 211: persys.control.v1.NamespaceView.LabelsEntry
+	nil,                                        // 212: persys.control.v1.ApplySecretRequest.LabelsEntry
+	nil,                                        // 213: persys.control.v1.ApplySecretRequest.DataEntry
+	nil,                                        // 214: persys.control.v1.SecretView.LabelsEntry
+	nil,                                        // 215: persys.control.v1.ApplyConfigRequest.LabelsEntry
+	nil,                                        // 216: persys.control.v1.ApplyConfigRequest.DataEntry
+	nil,                                        // 217: persys.control.v1.ConfigView.LabelsEntry
+	nil,                                        // 218: persys.control.v1.ConfigView.DataEntry
+	nil,                                        // 219: persys.control.v1.ApplyServiceRequest.SelectorEntry
+	nil,                                        // 220: persys.control.v1.ServiceView.SelectorEntry
+	nil,                                        // 221: persys.control.v1.SchedulerEventView.DetailsEntry
+	(*timestamppb.Timestamp)(nil),              // 222: google.protobuf.Timestamp
 }
 var file_control_proto_depIdxs = []int32{
-	0,  // 0: persys.control.v1.AutomationSuggestion.action_type:type_name -> persys.control.v1.AutomationActionType
-	50, // 1: persys.control.v1.AutomationSuggestion.suggested_at:type_name -> google.protobuf.Timestamp
-	2,  // 2: persys.control.v1.SubmitAutomationSuggestionRequest.suggestion:type_name -> persys.control.v1.AutomationSuggestion
-	50, // 3: persys.control.v1.SubmitAutomationSuggestionResponse.decided_at:type_name -> google.protobuf.Timestamp
-	6,  // 4: persys.control.v1.RegisterNodeRequest.capabilities:type_name -> persys.control.v1.NodeCapabilities
-	45, // 5: persys.control.v1.RegisterNodeRequest.labels:type_name -> persys.control.v1.RegisterNodeRequest.LabelsEntry
-	50, // 6: persys.control.v1.RegisterNodeRequest.timestamp:type_name -> google.protobuf.Timestamp
-	7,  // 7: persys.control.v1.NodeCapabilities.storage_pools:type_name -> persys.control.v1.StoragePool
-	50, // 8: persys.control.v1.RegisterNodeResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
-	10, // 9: persys.control.v1.HeartbeatRequest.usage:type_name -> persys.control.v1.NodeUsage
-	29, // 10: persys.control.v1.HeartbeatRequest.workload_statuses:type_name -> persys.control.v1.WorkloadStatus
-	50, // 11: persys.control.v1.HeartbeatRequest.timestamp:type_name -> google.protobuf.Timestamp
-	27, // 12: persys.control.v1.HeartbeatRequest.workload_usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
-	50, // 13: persys.control.v1.HeartbeatResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
-	16, // 14: persys.control.v1.ApplyWorkloadRequest.spec:type_name -> persys.control.v1.WorkloadSpec
-	1,  // 15: persys.control.v1.ApplyWorkloadResponse.failure_reason:type_name -> persys.control.v1.FailureReason
-	17, // 16: persys.control.v1.WorkloadSpec.resources:type_name -> persys.control.v1.ResourceRequirements
-	18, // 17: persys.control.v1.WorkloadSpec.container:type_name -> persys.control.v1.ContainerSpec
-	21, // 18: persys.control.v1.WorkloadSpec.compose:type_name -> persys.control.v1.ComposeSpec
-	22, // 19: persys.control.v1.WorkloadSpec.vm:type_name -> persys.control.v1.VMSpec
-	46, // 20: persys.control.v1.WorkloadSpec.metadata:type_name -> persys.control.v1.WorkloadSpec.MetadataEntry
-	47, // 21: persys.control.v1.ContainerSpec.env:type_name -> persys.control.v1.ContainerSpec.EnvEntry
-	19, // 22: persys.control.v1.ContainerSpec.volumes:type_name -> persys.control.v1.VolumeMount
-	20, // 23: persys.control.v1.ContainerSpec.ports:type_name -> persys.control.v1.Port
-	26, // 24: persys.control.v1.ContainerSpec.managed_volumes:type_name -> persys.control.v1.ManagedVolumeSpec
-	48, // 25: persys.control.v1.ComposeSpec.env:type_name -> persys.control.v1.ComposeSpec.EnvEntry
-	23, // 26: persys.control.v1.VMSpec.disks:type_name -> persys.control.v1.DiskConfig
-	24, // 27: persys.control.v1.VMSpec.networks:type_name -> persys.control.v1.NetworkConfig
-	25, // 28: persys.control.v1.VMSpec.cloud_init:type_name -> persys.control.v1.CloudInitConfig
-	26, // 29: persys.control.v1.VMSpec.managed_volumes:type_name -> persys.control.v1.ManagedVolumeSpec
-	50, // 30: persys.control.v1.WorkloadUsageSnapshot.collected_at:type_name -> google.protobuf.Timestamp
-	50, // 31: persys.control.v1.ReasonDetail.last_transition:type_name -> google.protobuf.Timestamp
-	50, // 32: persys.control.v1.ReasonDetail.next_retry_at:type_name -> google.protobuf.Timestamp
-	1,  // 33: persys.control.v1.WorkloadStatus.failure_reason:type_name -> persys.control.v1.FailureReason
-	50, // 34: persys.control.v1.WorkloadStatus.last_transition:type_name -> google.protobuf.Timestamp
-	28, // 35: persys.control.v1.WorkloadStatus.reason:type_name -> persys.control.v1.ReasonDetail
-	27, // 36: persys.control.v1.WorkloadStatus.usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
-	36, // 37: persys.control.v1.ListNodesResponse.nodes:type_name -> persys.control.v1.NodeView
-	36, // 38: persys.control.v1.GetNodeResponse.node:type_name -> persys.control.v1.NodeView
-	50, // 39: persys.control.v1.NodeView.status_updated_at:type_name -> google.protobuf.Timestamp
-	50, // 40: persys.control.v1.NodeView.last_heartbeat:type_name -> google.protobuf.Timestamp
-	49, // 41: persys.control.v1.NodeView.labels:type_name -> persys.control.v1.NodeView.LabelsEntry
-	41, // 42: persys.control.v1.ListWorkloadsResponse.workloads:type_name -> persys.control.v1.WorkloadView
-	41, // 43: persys.control.v1.GetWorkloadResponse.workload:type_name -> persys.control.v1.WorkloadView
-	50, // 44: persys.control.v1.WorkloadView.retry_next_at:type_name -> google.protobuf.Timestamp
-	50, // 45: persys.control.v1.WorkloadView.last_updated:type_name -> google.protobuf.Timestamp
-	28, // 46: persys.control.v1.WorkloadView.reason:type_name -> persys.control.v1.ReasonDetail
-	27, // 47: persys.control.v1.WorkloadView.usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
-	50, // 48: persys.control.v1.GetClusterSummaryResponse.generated_at:type_name -> google.protobuf.Timestamp
-	5,  // 49: persys.control.v1.ControlMessage.register:type_name -> persys.control.v1.RegisterNodeRequest
-	9,  // 50: persys.control.v1.ControlMessage.heartbeat:type_name -> persys.control.v1.HeartbeatRequest
-	12, // 51: persys.control.v1.ControlMessage.apply:type_name -> persys.control.v1.ApplyWorkloadRequest
-	14, // 52: persys.control.v1.ControlMessage.delete:type_name -> persys.control.v1.DeleteWorkloadRequest
-	5,  // 53: persys.control.v1.AgentControl.RegisterNode:input_type -> persys.control.v1.RegisterNodeRequest
-	9,  // 54: persys.control.v1.AgentControl.Heartbeat:input_type -> persys.control.v1.HeartbeatRequest
-	12, // 55: persys.control.v1.AgentControl.ApplyWorkload:input_type -> persys.control.v1.ApplyWorkloadRequest
-	14, // 56: persys.control.v1.AgentControl.DeleteWorkload:input_type -> persys.control.v1.DeleteWorkloadRequest
-	30, // 57: persys.control.v1.AgentControl.RetryWorkload:input_type -> persys.control.v1.RetryWorkloadRequest
-	3,  // 58: persys.control.v1.AgentControl.SubmitAutomationSuggestion:input_type -> persys.control.v1.SubmitAutomationSuggestionRequest
-	32, // 59: persys.control.v1.AgentControl.ListNodes:input_type -> persys.control.v1.ListNodesRequest
-	33, // 60: persys.control.v1.AgentControl.GetNode:input_type -> persys.control.v1.GetNodeRequest
-	37, // 61: persys.control.v1.AgentControl.ListWorkloads:input_type -> persys.control.v1.ListWorkloadsRequest
-	38, // 62: persys.control.v1.AgentControl.GetWorkload:input_type -> persys.control.v1.GetWorkloadRequest
-	42, // 63: persys.control.v1.AgentControl.GetClusterSummary:input_type -> persys.control.v1.GetClusterSummaryRequest
-	44, // 64: persys.control.v1.AgentControl.ControlStream:input_type -> persys.control.v1.ControlMessage
-	8,  // 65: persys.control.v1.AgentControl.RegisterNode:output_type -> persys.control.v1.RegisterNodeResponse
-	11, // 66: persys.control.v1.AgentControl.Heartbeat:output_type -> persys.control.v1.HeartbeatResponse
-	13, // 67: persys.control.v1.AgentControl.ApplyWorkload:output_type -> persys.control.v1.ApplyWorkloadResponse
-	15, // 68: persys.control.v1.AgentControl.DeleteWorkload:output_type -> persys.control.v1.DeleteWorkloadResponse
-	31, // 69: persys.control.v1.AgentControl.RetryWorkload:output_type -> persys.control.v1.RetryWorkloadResponse
-	4,  // 70: persys.control.v1.AgentControl.SubmitAutomationSuggestion:output_type -> persys.control.v1.SubmitAutomationSuggestionResponse
-	34, // 71: persys.control.v1.AgentControl.ListNodes:output_type -> persys.control.v1.ListNodesResponse
-	35, // 72: persys.control.v1.AgentControl.GetNode:output_type -> persys.control.v1.GetNodeResponse
-	39, // 73: persys.control.v1.AgentControl.ListWorkloads:output_type -> persys.control.v1.ListWorkloadsResponse
-	40, // 74: persys.control.v1.AgentControl.GetWorkload:output_type -> persys.control.v1.GetWorkloadResponse
-	43, // 75: persys.control.v1.AgentControl.GetClusterSummary:output_type -> persys.control.v1.GetClusterSummaryResponse
-	44, // 76: persys.control.v1.AgentControl.ControlStream:output_type -> persys.control.v1.ControlMessage
-	65, // [65:77] is the sub-list for method output_type
-	53, // [53:65] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	0,   // 0: persys.control.v1.AutomationSuggestion.action_type:type_name -> persys.control.v1.AutomationActionType
+	222, // 1: persys.control.v1.AutomationSuggestion.suggested_at:type_name -> google.protobuf.Timestamp
+	3,   // 2: persys.control.v1.SubmitAutomationSuggestionRequest.suggestion:type_name -> persys.control.v1.AutomationSuggestion
+	222, // 3: persys.control.v1.SubmitAutomationSuggestionResponse.decided_at:type_name -> google.protobuf.Timestamp
+	9,   // 4: persys.control.v1.RegisterNodeRequest.capabilities:type_name -> persys.control.v1.NodeCapabilities
+	204, // 5: persys.control.v1.RegisterNodeRequest.labels:type_name -> persys.control.v1.RegisterNodeRequest.LabelsEntry
+	222, // 6: persys.control.v1.RegisterNodeRequest.timestamp:type_name -> google.protobuf.Timestamp
+	7,   // 7: persys.control.v1.RegisterNodeRequest.taints:type_name -> persys.control.v1.Taint
+	10,  // 8: persys.control.v1.NodeCapabilities.storage_pools:type_name -> persys.control.v1.StoragePool
+	222, // 9: persys.control.v1.RegisterNodeResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	13,  // 10: persys.control.v1.HeartbeatRequest.usage:type_name -> persys.control.v1.NodeUsage
+	45,  // 11: persys.control.v1.HeartbeatRequest.workload_statuses:type_name -> persys.control.v1.WorkloadStatus
+	222, // 12: persys.control.v1.HeartbeatRequest.timestamp:type_name -> google.protobuf.Timestamp
+	43,  // 13: persys.control.v1.HeartbeatRequest.workload_usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
+	14,  // 14: persys.control.v1.NodeUsage.storage_pools:type_name -> persys.control.v1.StoragePoolUsage
+	222, // 15: persys.control.v1.HeartbeatResponse.lease_expires_at:type_name -> google.protobuf.Timestamp
+	20,  // 16: persys.control.v1.ApplyWorkloadRequest.spec:type_name -> persys.control.v1.WorkloadSpec
+	1,   // 17: persys.control.v1.ApplyWorkloadResponse.failure_reason:type_name -> persys.control.v1.FailureReason
+	25,  // 18: persys.control.v1.WorkloadSpec.resources:type_name -> persys.control.v1.ResourceRequirements
+	26,  // 19: persys.control.v1.WorkloadSpec.container:type_name -> persys.control.v1.ContainerSpec
+	37,  // 20: persys.control.v1.WorkloadSpec.compose:type_name -> persys.control.v1.ComposeSpec
+	38,  // 21: persys.control.v1.WorkloadSpec.vm:type_name -> persys.control.v1.VMSpec
+	205, // 22: persys.control.v1.WorkloadSpec.metadata:type_name -> persys.control.v1.WorkloadSpec.MetadataEntry
+	22,  // 23: persys.control.v1.WorkloadSpec.placement:type_name -> persys.control.v1.PlacementPolicy
+	8,   // 24: persys.control.v1.WorkloadSpec.tolerations:type_name -> persys.control.v1.Toleration
+	21,  // 25: persys.control.v1.WorkloadSpec.rollout:type_name -> persys.control.v1.RolloutStrategy
+	23,  // 26: persys.control.v1.PlacementPolicy.required_node_affinity:type_name -> persys.control.v1.NodeSelectorRequirement
+	24,  // 27: persys.control.v1.PlacementPolicy.preferred_node_affinity:type_name -> persys.control.v1.PreferredNodeAffinity
+	23,  // 28: persys.control.v1.PreferredNodeAffinity.requirement:type_name -> persys.control.v1.NodeSelectorRequirement
+	206, // 29: persys.control.v1.ContainerSpec.env:type_name -> persys.control.v1.ContainerSpec.EnvEntry
+	35,  // 30: persys.control.v1.ContainerSpec.volumes:type_name -> persys.control.v1.VolumeMount
+	36,  // 31: persys.control.v1.ContainerSpec.ports:type_name -> persys.control.v1.Port
+	42,  // 32: persys.control.v1.ContainerSpec.managed_volumes:type_name -> persys.control.v1.ManagedVolumeSpec
+	30,  // 33: persys.control.v1.ContainerSpec.liveness_probe:type_name -> persys.control.v1.Probe
+	30,  // 34: persys.control.v1.ContainerSpec.readiness_probe:type_name -> persys.control.v1.Probe
+	27,  // 35: persys.control.v1.ContainerSpec.env_from:type_name -> persys.control.v1.EnvFromSource
+	28,  // 36: persys.control.v1.ContainerSpec.files:type_name -> persys.control.v1.FileMount
+	31,  // 37: persys.control.v1.Probe.http_get:type_name -> persys.control.v1.HTTPGetAction
+	32,  // 38: persys.control.v1.Probe.tcp_socket:type_name -> persys.control.v1.TCPSocketAction
+	33,  // 39: persys.control.v1.Probe.exec:type_name -> persys.control.v1.ExecAction
+	207, // 40: persys.control.v1.HTTPGetAction.headers:type_name -> persys.control.v1.HTTPGetAction.HeadersEntry
+	222, // 41: persys.control.v1.ProbeResult.checked_at:type_name -> google.protobuf.Timestamp
+	208, // 42: persys.control.v1.ComposeSpec.env:type_name -> persys.control.v1.ComposeSpec.EnvEntry
+	27,  // 43: persys.control.v1.ComposeSpec.env_from:type_name -> persys.control.v1.EnvFromSource
+	28,  // 44: persys.control.v1.ComposeSpec.files:type_name -> persys.control.v1.FileMount
+	39,  // 45: persys.control.v1.VMSpec.disks:type_name -> persys.control.v1.DiskConfig
+	40,  // 46: persys.control.v1.VMSpec.networks:type_name -> persys.control.v1.NetworkConfig
+	41,  // 47: persys.control.v1.VMSpec.cloud_init:type_name -> persys.control.v1.CloudInitConfig
+	42,  // 48: persys.control.v1.VMSpec.managed_volumes:type_name -> persys.control.v1.ManagedVolumeSpec
+	30,  // 49: persys.control.v1.VMSpec.liveness_probe:type_name -> persys.control.v1.Probe
+	30,  // 50: persys.control.v1.VMSpec.readiness_probe:type_name -> persys.control.v1.Probe
+	29,  // 51: persys.control.v1.VMSpec.template_vars:type_name -> persys.control.v1.TemplateVar
+	222, // 52: persys.control.v1.WorkloadUsageSnapshot.collected_at:type_name -> google.protobuf.Timestamp
+	222, // 53: persys.control.v1.ReasonDetail.last_transition:type_name -> google.protobuf.Timestamp
+	222, // 54: persys.control.v1.ReasonDetail.next_retry_at:type_name -> google.protobuf.Timestamp
+	1,   // 55: persys.control.v1.WorkloadStatus.failure_reason:type_name -> persys.control.v1.FailureReason
+	222, // 56: persys.control.v1.WorkloadStatus.last_transition:type_name -> google.protobuf.Timestamp
+	44,  // 57: persys.control.v1.WorkloadStatus.reason:type_name -> persys.control.v1.ReasonDetail
+	43,  // 58: persys.control.v1.WorkloadStatus.usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
+	34,  // 59: persys.control.v1.WorkloadStatus.probe_results:type_name -> persys.control.v1.ProbeResult
+	52,  // 60: persys.control.v1.ListNodesResponse.nodes:type_name -> persys.control.v1.NodeView
+	52,  // 61: persys.control.v1.GetNodeResponse.node:type_name -> persys.control.v1.NodeView
+	222, // 62: persys.control.v1.NodeView.status_updated_at:type_name -> google.protobuf.Timestamp
+	222, // 63: persys.control.v1.NodeView.last_heartbeat:type_name -> google.protobuf.Timestamp
+	209, // 64: persys.control.v1.NodeView.labels:type_name -> persys.control.v1.NodeView.LabelsEntry
+	54,  // 65: persys.control.v1.NodeView.storage_pools:type_name -> persys.control.v1.StoragePoolStatus
+	7,   // 66: persys.control.v1.NodeView.taints:type_name -> persys.control.v1.Taint
+	53,  // 67: persys.control.v1.NodeView.drain:type_name -> persys.control.v1.NodeDrainStatus
+	7,   // 68: persys.control.v1.NodeView.operator_taints:type_name -> persys.control.v1.Taint
+	222, // 69: persys.control.v1.NodeDrainStatus.started_at:type_name -> google.protobuf.Timestamp
+	222, // 70: persys.control.v1.NodeDrainStatus.completed_at:type_name -> google.protobuf.Timestamp
+	59,  // 71: persys.control.v1.ListWorkloadsResponse.workloads:type_name -> persys.control.v1.WorkloadView
+	59,  // 72: persys.control.v1.GetWorkloadResponse.workload:type_name -> persys.control.v1.WorkloadView
+	222, // 73: persys.control.v1.WorkloadView.retry_next_at:type_name -> google.protobuf.Timestamp
+	222, // 74: persys.control.v1.WorkloadView.last_updated:type_name -> google.protobuf.Timestamp
+	44,  // 75: persys.control.v1.WorkloadView.reason:type_name -> persys.control.v1.ReasonDetail
+	43,  // 76: persys.control.v1.WorkloadView.usage:type_name -> persys.control.v1.WorkloadUsageSnapshot
+	62,  // 77: persys.control.v1.WorkloadView.rollout:type_name -> persys.control.v1.RolloutStatusView
+	60,  // 78: persys.control.v1.WorkloadView.health:type_name -> persys.control.v1.WorkloadHealthView
+	36,  // 79: persys.control.v1.WorkloadView.ports:type_name -> persys.control.v1.Port
+	61,  // 80: persys.control.v1.WorkloadHealthView.liveness:type_name -> persys.control.v1.ProbeStateView
+	61,  // 81: persys.control.v1.WorkloadHealthView.readiness:type_name -> persys.control.v1.ProbeStateView
+	222, // 82: persys.control.v1.WorkloadHealthView.last_restart_at:type_name -> google.protobuf.Timestamp
+	222, // 83: persys.control.v1.ProbeStateView.last_checked_at:type_name -> google.protobuf.Timestamp
+	222, // 84: persys.control.v1.ProbeStateView.last_transition_at:type_name -> google.protobuf.Timestamp
+	222, // 85: persys.control.v1.RolloutStatusView.started_at:type_name -> google.protobuf.Timestamp
+	222, // 86: persys.control.v1.RolloutStatusView.completed_at:type_name -> google.protobuf.Timestamp
+	222, // 87: persys.control.v1.GetClusterSummaryResponse.generated_at:type_name -> google.protobuf.Timestamp
+	20,  // 88: persys.control.v1.ApplyReplicaSetRequest.template:type_name -> persys.control.v1.WorkloadSpec
+	75,  // 89: persys.control.v1.ApplyReplicaSetResponse.replica_set:type_name -> persys.control.v1.ReplicaSetView
+	75,  // 90: persys.control.v1.ScaleReplicaSetResponse.replica_set:type_name -> persys.control.v1.ReplicaSetView
+	75,  // 91: persys.control.v1.GetReplicaSetResponse.replica_set:type_name -> persys.control.v1.ReplicaSetView
+	75,  // 92: persys.control.v1.ListReplicaSetsResponse.replica_sets:type_name -> persys.control.v1.ReplicaSetView
+	222, // 93: persys.control.v1.ReplicaSetView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 94: persys.control.v1.ReplicaSetView.updated_at:type_name -> google.protobuf.Timestamp
+	222, // 95: persys.control.v1.ReplicaSetView.last_scaled_at:type_name -> google.protobuf.Timestamp
+	210, // 96: persys.control.v1.ApplyNamespaceRequest.labels:type_name -> persys.control.v1.ApplyNamespaceRequest.LabelsEntry
+	76,  // 97: persys.control.v1.ApplyNamespaceRequest.quota:type_name -> persys.control.v1.ResourceQuota
+	85,  // 98: persys.control.v1.ApplyNamespaceResponse.namespace:type_name -> persys.control.v1.NamespaceView
+	85,  // 99: persys.control.v1.GetNamespaceResponse.namespace:type_name -> persys.control.v1.NamespaceView
+	85,  // 100: persys.control.v1.ListNamespacesResponse.namespaces:type_name -> persys.control.v1.NamespaceView
+	211, // 101: persys.control.v1.NamespaceView.labels:type_name -> persys.control.v1.NamespaceView.LabelsEntry
+	76,  // 102: persys.control.v1.NamespaceView.quota:type_name -> persys.control.v1.ResourceQuota
+	76,  // 103: persys.control.v1.NamespaceView.used:type_name -> persys.control.v1.ResourceQuota
+	222, // 104: persys.control.v1.NamespaceView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 105: persys.control.v1.NamespaceView.updated_at:type_name -> google.protobuf.Timestamp
+	212, // 106: persys.control.v1.ApplySecretRequest.labels:type_name -> persys.control.v1.ApplySecretRequest.LabelsEntry
+	213, // 107: persys.control.v1.ApplySecretRequest.data:type_name -> persys.control.v1.ApplySecretRequest.DataEntry
+	94,  // 108: persys.control.v1.ApplySecretResponse.secret:type_name -> persys.control.v1.SecretView
+	94,  // 109: persys.control.v1.GetSecretResponse.secret:type_name -> persys.control.v1.SecretView
+	94,  // 110: persys.control.v1.ListSecretsResponse.secrets:type_name -> persys.control.v1.SecretView
+	214, // 111: persys.control.v1.SecretView.labels:type_name -> persys.control.v1.SecretView.LabelsEntry
+	222, // 112: persys.control.v1.SecretView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 113: persys.control.v1.SecretView.updated_at:type_name -> google.protobuf.Timestamp
+	215, // 114: persys.control.v1.ApplyConfigRequest.labels:type_name -> persys.control.v1.ApplyConfigRequest.LabelsEntry
+	216, // 115: persys.control.v1.ApplyConfigRequest.data:type_name -> persys.control.v1.ApplyConfigRequest.DataEntry
+	103, // 116: persys.control.v1.ApplyConfigResponse.config:type_name -> persys.control.v1.ConfigView
+	103, // 117: persys.control.v1.GetConfigResponse.config:type_name -> persys.control.v1.ConfigView
+	103, // 118: persys.control.v1.ListConfigsResponse.configs:type_name -> persys.control.v1.ConfigView
+	217, // 119: persys.control.v1.ConfigView.labels:type_name -> persys.control.v1.ConfigView.LabelsEntry
+	218, // 120: persys.control.v1.ConfigView.data:type_name -> persys.control.v1.ConfigView.DataEntry
+	222, // 121: persys.control.v1.ConfigView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 122: persys.control.v1.ConfigView.updated_at:type_name -> google.protobuf.Timestamp
+	219, // 123: persys.control.v1.ApplyServiceRequest.selector:type_name -> persys.control.v1.ApplyServiceRequest.SelectorEntry
+	104, // 124: persys.control.v1.ApplyServiceRequest.ports:type_name -> persys.control.v1.ServicePort
+	114, // 125: persys.control.v1.ApplyServiceResponse.service:type_name -> persys.control.v1.ServiceView
+	114, // 126: persys.control.v1.GetServiceResponse.service:type_name -> persys.control.v1.ServiceView
+	114, // 127: persys.control.v1.ListServicesResponse.services:type_name -> persys.control.v1.ServiceView
+	220, // 128: persys.control.v1.ServiceView.selector:type_name -> persys.control.v1.ServiceView.SelectorEntry
+	104, // 129: persys.control.v1.ServiceView.ports:type_name -> persys.control.v1.ServicePort
+	113, // 130: persys.control.v1.ServiceView.endpoints:type_name -> persys.control.v1.ServiceEndpoint
+	222, // 131: persys.control.v1.ServiceView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 132: persys.control.v1.ServiceView.updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 133: persys.control.v1.StackMember.template:type_name -> persys.control.v1.WorkloadSpec
+	115, // 134: persys.control.v1.ApplyStackRequest.members:type_name -> persys.control.v1.StackMember
+	127, // 135: persys.control.v1.ApplyStackResponse.stack:type_name -> persys.control.v1.StackView
+	127, // 136: persys.control.v1.GetStackResponse.stack:type_name -> persys.control.v1.StackView
+	127, // 137: persys.control.v1.ListStacksResponse.stacks:type_name -> persys.control.v1.StackView
+	127, // 138: persys.control.v1.SetStackStateResponse.stack:type_name -> persys.control.v1.StackView
+	126, // 139: persys.control.v1.StackView.members:type_name -> persys.control.v1.StackMemberView
+	222, // 140: persys.control.v1.StackView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 141: persys.control.v1.StackView.updated_at:type_name -> google.protobuf.Timestamp
+	222, // 142: persys.control.v1.StackView.last_reconciled_at:type_name -> google.protobuf.Timestamp
+	20,  // 143: persys.control.v1.JobSpec.template:type_name -> persys.control.v1.WorkloadSpec
+	128, // 144: persys.control.v1.ApplyJobRequest.spec:type_name -> persys.control.v1.JobSpec
+	137, // 145: persys.control.v1.ApplyJobResponse.job:type_name -> persys.control.v1.JobView
+	137, // 146: persys.control.v1.GetJobResponse.job:type_name -> persys.control.v1.JobView
+	137, // 147: persys.control.v1.ListJobsResponse.jobs:type_name -> persys.control.v1.JobView
+	222, // 148: persys.control.v1.JobView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 149: persys.control.v1.JobView.started_at:type_name -> google.protobuf.Timestamp
+	222, // 150: persys.control.v1.JobView.completed_at:type_name -> google.protobuf.Timestamp
+	128, // 151: persys.control.v1.ApplyCronJobRequest.job_template:type_name -> persys.control.v1.JobSpec
+	146, // 152: persys.control.v1.ApplyCronJobResponse.cron_job:type_name -> persys.control.v1.CronJobView
+	146, // 153: persys.control.v1.GetCronJobResponse.cron_job:type_name -> persys.control.v1.CronJobView
+	146, // 154: persys.control.v1.ListCronJobsResponse.cron_jobs:type_name -> persys.control.v1.CronJobView
+	222, // 155: persys.control.v1.CronJobView.last_schedule_at:type_name -> google.protobuf.Timestamp
+	222, // 156: persys.control.v1.CronJobView.last_successful_at:type_name -> google.protobuf.Timestamp
+	222, // 157: persys.control.v1.CronJobView.next_schedule_at:type_name -> google.protobuf.Timestamp
+	222, // 158: persys.control.v1.CronJobView.created_at:type_name -> google.protobuf.Timestamp
+	6,   // 159: persys.control.v1.ControlMessage.register:type_name -> persys.control.v1.RegisterNodeRequest
+	12,  // 160: persys.control.v1.ControlMessage.heartbeat:type_name -> persys.control.v1.HeartbeatRequest
+	16,  // 161: persys.control.v1.ControlMessage.apply:type_name -> persys.control.v1.ApplyWorkloadRequest
+	18,  // 162: persys.control.v1.ControlMessage.delete:type_name -> persys.control.v1.DeleteWorkloadRequest
+	11,  // 163: persys.control.v1.ControlMessage.register_ack:type_name -> persys.control.v1.RegisterNodeResponse
+	15,  // 164: persys.control.v1.ControlMessage.heartbeat_ack:type_name -> persys.control.v1.HeartbeatResponse
+	17,  // 165: persys.control.v1.ControlMessage.apply_result:type_name -> persys.control.v1.ApplyWorkloadResponse
+	19,  // 166: persys.control.v1.ControlMessage.delete_result:type_name -> persys.control.v1.DeleteWorkloadResponse
+	45,  // 167: persys.control.v1.ControlMessage.workload_status:type_name -> persys.control.v1.WorkloadStatus
+	52,  // 168: persys.control.v1.CordonNodeResponse.node:type_name -> persys.control.v1.NodeView
+	52,  // 169: persys.control.v1.UncordonNodeResponse.node:type_name -> persys.control.v1.NodeView
+	7,   // 170: persys.control.v1.SetNodeTaintsRequest.taints:type_name -> persys.control.v1.Taint
+	52,  // 171: persys.control.v1.SetNodeTaintsResponse.node:type_name -> persys.control.v1.NodeView
+	52,  // 172: persys.control.v1.DrainNodeResponse.node:type_name -> persys.control.v1.NodeView
+	157, // 173: persys.control.v1.RunDeschedulerResponse.moves:type_name -> persys.control.v1.DeschedulerMove
+	222, // 174: persys.control.v1.VolumeSnapshotView.created_at:type_name -> google.protobuf.Timestamp
+	159, // 175: persys.control.v1.VolumeView.snapshots:type_name -> persys.control.v1.VolumeSnapshotView
+	222, // 176: persys.control.v1.VolumeView.created_at:type_name -> google.protobuf.Timestamp
+	222, // 177: persys.control.v1.VolumeView.updated_at:type_name -> google.protobuf.Timestamp
+	222, // 178: persys.control.v1.VolumeView.released_at:type_name -> google.protobuf.Timestamp
+	160, // 179: persys.control.v1.CreateVolumeResponse.volume:type_name -> persys.control.v1.VolumeView
+	160, // 180: persys.control.v1.GetVolumeResponse.volume:type_name -> persys.control.v1.VolumeView
+	160, // 181: persys.control.v1.ListVolumesResponse.volumes:type_name -> persys.control.v1.VolumeView
+	160, // 182: persys.control.v1.ResizeVolumeResponse.volume:type_name -> persys.control.v1.VolumeView
+	160, // 183: persys.control.v1.SnapshotVolumeResponse.volume:type_name -> persys.control.v1.VolumeView
+	160, // 184: persys.control.v1.CloneVolumeResponse.volume:type_name -> persys.control.v1.VolumeView
+	222, // 185: persys.control.v1.ExportStateResponse.created_at:type_name -> google.protobuf.Timestamp
+	222, // 186: persys.control.v1.SchedulerEventView.timestamp:type_name -> google.protobuf.Timestamp
+	221, // 187: persys.control.v1.SchedulerEventView.details:type_name -> persys.control.v1.SchedulerEventView.DetailsEntry
+	183, // 188: persys.control.v1.ListPendingWorkloadsResponse.workloads:type_name -> persys.control.v1.PendingWorkloadView
+	222, // 189: persys.control.v1.PendingWorkloadView.queued_at:type_name -> google.protobuf.Timestamp
+	222, // 190: persys.control.v1.PendingWorkloadView.last_attempt_at:type_name -> google.protobuf.Timestamp
+	222, // 191: persys.control.v1.PendingWorkloadView.next_attempt_at:type_name -> google.protobuf.Timestamp
+	184, // 192: persys.control.v1.PendingWorkloadView.rejections:type_name -> persys.control.v1.NodeRejection
+	20,  // 193: persys.control.v1.SimulatePlacementRequest.spec:type_name -> persys.control.v1.WorkloadSpec
+	186, // 194: persys.control.v1.PlacementCandidate.filters:type_name -> persys.control.v1.PlacementPluginResult
+	186, // 195: persys.control.v1.PlacementCandidate.scores:type_name -> persys.control.v1.PlacementPluginResult
+	187, // 196: persys.control.v1.SimulatePlacementResponse.candidates:type_name -> persys.control.v1.PlacementCandidate
+	222, // 197: persys.control.v1.GetWorkloadUsageHistoryRequest.from:type_name -> google.protobuf.Timestamp
+	222, // 198: persys.control.v1.GetWorkloadUsageHistoryRequest.to:type_name -> google.protobuf.Timestamp
+	222, // 199: persys.control.v1.WorkloadUsagePoint.timestamp:type_name -> google.protobuf.Timestamp
+	222, // 200: persys.control.v1.GetWorkloadUsageHistoryResponse.from:type_name -> google.protobuf.Timestamp
+	222, // 201: persys.control.v1.GetWorkloadUsageHistoryResponse.to:type_name -> google.protobuf.Timestamp
+	190, // 202: persys.control.v1.GetWorkloadUsageHistoryResponse.points:type_name -> persys.control.v1.WorkloadUsagePoint
+	194, // 203: persys.control.v1.ListWorkloadRevisionsResponse.revisions:type_name -> persys.control.v1.WorkloadRevisionView
+	222, // 204: persys.control.v1.WorkloadRevisionView.created_at:type_name -> google.protobuf.Timestamp
+	59,  // 205: persys.control.v1.RollbackWorkloadResponse.workload:type_name -> persys.control.v1.WorkloadView
+	222, // 206: persys.control.v1.StreamWorkloadLogsRequest.since:type_name -> google.protobuf.Timestamp
+	2,   // 207: persys.control.v1.WorkloadLogChunk.stream:type_name -> persys.control.v1.LogStream
+	222, // 208: persys.control.v1.WorkloadLogChunk.timestamp:type_name -> google.protobuf.Timestamp
+	199, // 209: persys.control.v1.ExecStart.size:type_name -> persys.control.v1.TerminalSize
+	200, // 210: persys.control.v1.ExecWorkloadRequest.start:type_name -> persys.control.v1.ExecStart
+	199, // 211: persys.control.v1.ExecWorkloadRequest.resize:type_name -> persys.control.v1.TerminalSize
+	202, // 212: persys.control.v1.ExecWorkloadResponse.exit:type_name -> persys.control.v1.ExecExit
+	6,   // 213: persys.control.v1.AgentControl.RegisterNode:input_type -> persys.control.v1.RegisterNodeRequest
+	12,  // 214: persys.control.v1.AgentControl.Heartbeat:input_type -> persys.control.v1.HeartbeatRequest
+	16,  // 215: persys.control.v1.AgentControl.ApplyWorkload:input_type -> persys.control.v1.ApplyWorkloadRequest
+	18,  // 216: persys.control.v1.AgentControl.DeleteWorkload:input_type -> persys.control.v1.DeleteWorkloadRequest
+	192, // 217: persys.control.v1.AgentControl.ListWorkloadRevisions:input_type -> persys.control.v1.ListWorkloadRevisionsRequest
+	195, // 218: persys.control.v1.AgentControl.RollbackWorkload:input_type -> persys.control.v1.RollbackWorkloadRequest
+	46,  // 219: persys.control.v1.AgentControl.RetryWorkload:input_type -> persys.control.v1.RetryWorkloadRequest
+	4,   // 220: persys.control.v1.AgentControl.SubmitAutomationSuggestion:input_type -> persys.control.v1.SubmitAutomationSuggestionRequest
+	48,  // 221: persys.control.v1.AgentControl.ListNodes:input_type -> persys.control.v1.ListNodesRequest
+	49,  // 222: persys.control.v1.AgentControl.GetNode:input_type -> persys.control.v1.GetNodeRequest
+	55,  // 223: persys.control.v1.AgentControl.ListWorkloads:input_type -> persys.control.v1.ListWorkloadsRequest
+	56,  // 224: persys.control.v1.AgentControl.GetWorkload:input_type -> persys.control.v1.GetWorkloadRequest
+	63,  // 225: persys.control.v1.AgentControl.GetClusterSummary:input_type -> persys.control.v1.GetClusterSummaryRequest
+	181, // 226: persys.control.v1.AgentControl.ListPendingWorkloads:input_type -> persys.control.v1.ListPendingWorkloadsRequest
+	185, // 227: persys.control.v1.AgentControl.SimulatePlacement:input_type -> persys.control.v1.SimulatePlacementRequest
+	189, // 228: persys.control.v1.AgentControl.GetWorkloadUsageHistory:input_type -> persys.control.v1.GetWorkloadUsageHistoryRequest
+	65,  // 229: persys.control.v1.AgentControl.ApplyReplicaSet:input_type -> persys.control.v1.ApplyReplicaSetRequest
+	67,  // 230: persys.control.v1.AgentControl.ScaleReplicaSet:input_type -> persys.control.v1.ScaleReplicaSetRequest
+	69,  // 231: persys.control.v1.AgentControl.DeleteReplicaSet:input_type -> persys.control.v1.DeleteReplicaSetRequest
+	71,  // 232: persys.control.v1.AgentControl.GetReplicaSet:input_type -> persys.control.v1.GetReplicaSetRequest
+	73,  // 233: persys.control.v1.AgentControl.ListReplicaSets:input_type -> persys.control.v1.ListReplicaSetsRequest
+	77,  // 234: persys.control.v1.AgentControl.ApplyNamespace:input_type -> persys.control.v1.ApplyNamespaceRequest
+	79,  // 235: persys.control.v1.AgentControl.DeleteNamespace:input_type -> persys.control.v1.DeleteNamespaceRequest
+	81,  // 236: persys.control.v1.AgentControl.GetNamespace:input_type -> persys.control.v1.GetNamespaceRequest
+	83,  // 237: persys.control.v1.AgentControl.ListNamespaces:input_type -> persys.control.v1.ListNamespacesRequest
+	86,  // 238: persys.control.v1.AgentControl.ApplySecret:input_type -> persys.control.v1.ApplySecretRequest
+	88,  // 239: persys.control.v1.AgentControl.DeleteSecret:input_type -> persys.control.v1.DeleteSecretRequest
+	90,  // 240: persys.control.v1.AgentControl.GetSecret:input_type -> persys.control.v1.GetSecretRequest
+	92,  // 241: persys.control.v1.AgentControl.ListSecrets:input_type -> persys.control.v1.ListSecretsRequest
+	95,  // 242: persys.control.v1.AgentControl.ApplyConfig:input_type -> persys.control.v1.ApplyConfigRequest
+	97,  // 243: persys.control.v1.AgentControl.DeleteConfig:input_type -> persys.control.v1.DeleteConfigRequest
+	99,  // 244: persys.control.v1.AgentControl.GetConfig:input_type -> persys.control.v1.GetConfigRequest
+	101, // 245: persys.control.v1.AgentControl.ListConfigs:input_type -> persys.control.v1.ListConfigsRequest
+	105, // 246: persys.control.v1.AgentControl.ApplyService:input_type -> persys.control.v1.ApplyServiceRequest
+	107, // 247: persys.control.v1.AgentControl.DeleteService:input_type -> persys.control.v1.DeleteServiceRequest
+	109, // 248: persys.control.v1.AgentControl.GetService:input_type -> persys.control.v1.GetServiceRequest
+	111, // 249: persys.control.v1.AgentControl.ListServices:input_type -> persys.control.v1.ListServicesRequest
+	116, // 250: persys.control.v1.AgentControl.ApplyStack:input_type -> persys.control.v1.ApplyStackRequest
+	118, // 251: persys.control.v1.AgentControl.DeleteStack:input_type -> persys.control.v1.DeleteStackRequest
+	120, // 252: persys.control.v1.AgentControl.GetStack:input_type -> persys.control.v1.GetStackRequest
+	122, // 253: persys.control.v1.AgentControl.ListStacks:input_type -> persys.control.v1.ListStacksRequest
+	124, // 254: persys.control.v1.AgentControl.SetStackState:input_type -> persys.control.v1.SetStackStateRequest
+	129, // 255: persys.control.v1.AgentControl.ApplyJob:input_type -> persys.control.v1.ApplyJobRequest
+	131, // 256: persys.control.v1.AgentControl.DeleteJob:input_type -> persys.control.v1.DeleteJobRequest
+	133, // 257: persys.control.v1.AgentControl.GetJob:input_type -> persys.control.v1.GetJobRequest
+	135, // 258: persys.control.v1.AgentControl.ListJobs:input_type -> persys.control.v1.ListJobsRequest
+	138, // 259: persys.control.v1.AgentControl.ApplyCronJob:input_type -> persys.control.v1.ApplyCronJobRequest
+	140, // 260: persys.control.v1.AgentControl.DeleteCronJob:input_type -> persys.control.v1.DeleteCronJobRequest
+	142, // 261: persys.control.v1.AgentControl.GetCronJob:input_type -> persys.control.v1.GetCronJobRequest
+	144, // 262: persys.control.v1.AgentControl.ListCronJobs:input_type -> persys.control.v1.ListCronJobsRequest
+	148, // 263: persys.control.v1.AgentControl.CordonNode:input_type -> persys.control.v1.CordonNodeRequest
+	150, // 264: persys.control.v1.AgentControl.UncordonNode:input_type -> persys.control.v1.UncordonNodeRequest
+	152, // 265: persys.control.v1.AgentControl.SetNodeTaints:input_type -> persys.control.v1.SetNodeTaintsRequest
+	154, // 266: persys.control.v1.AgentControl.DrainNode:input_type -> persys.control.v1.DrainNodeRequest
+	156, // 267: persys.control.v1.AgentControl.RunDescheduler:input_type -> persys.control.v1.RunDeschedulerRequest
+	161, // 268: persys.control.v1.AgentControl.CreateVolume:input_type -> persys.control.v1.CreateVolumeRequest
+	163, // 269: persys.control.v1.AgentControl.GetVolume:input_type -> persys.control.v1.GetVolumeRequest
+	165, // 270: persys.control.v1.AgentControl.ListVolumes:input_type -> persys.control.v1.ListVolumesRequest
+	167, // 271: persys.control.v1.AgentControl.DeleteVolume:input_type -> persys.control.v1.DeleteVolumeRequest
+	169, // 272: persys.control.v1.AgentControl.ResizeVolume:input_type -> persys.control.v1.ResizeVolumeRequest
+	171, // 273: persys.control.v1.AgentControl.SnapshotVolume:input_type -> persys.control.v1.SnapshotVolumeRequest
+	173, // 274: persys.control.v1.AgentControl.CloneVolume:input_type -> persys.control.v1.CloneVolumeRequest
+	175, // 275: persys.control.v1.AgentControl.ExportState:input_type -> persys.control.v1.ExportStateRequest
+	177, // 276: persys.control.v1.AgentControl.ImportState:input_type -> persys.control.v1.ImportStateRequest
+	179, // 277: persys.control.v1.AgentControl.WatchEvents:input_type -> persys.control.v1.WatchEventsRequest
+	197, // 278: persys.control.v1.AgentControl.StreamWorkloadLogs:input_type -> persys.control.v1.StreamWorkloadLogsRequest
+	201, // 279: persys.control.v1.AgentControl.ExecWorkload:input_type -> persys.control.v1.ExecWorkloadRequest
+	147, // 280: persys.control.v1.AgentControl.ControlStream:input_type -> persys.control.v1.ControlMessage
+	11,  // 281: persys.control.v1.AgentControl.RegisterNode:output_type -> persys.control.v1.RegisterNodeResponse
+	15,  // 282: persys.control.v1.AgentControl.Heartbeat:output_type -> persys.control.v1.HeartbeatResponse
+	17,  // 283: persys.control.v1.AgentControl.ApplyWorkload:output_type -> persys.control.v1.ApplyWorkloadResponse
+	19,  // 284: persys.control.v1.AgentControl.DeleteWorkload:output_type -> persys.control.v1.DeleteWorkloadResponse
+	193, // 285: persys.control.v1.AgentControl.ListWorkloadRevisions:output_type -> persys.control.v1.ListWorkloadRevisionsResponse
+	196, // 286: persys.control.v1.AgentControl.RollbackWorkload:output_type -> persys.control.v1.RollbackWorkloadResponse
+	47,  // 287: persys.control.v1.AgentControl.RetryWorkload:output_type -> persys.control.v1.RetryWorkloadResponse
+	5,   // 288: persys.control.v1.AgentControl.SubmitAutomationSuggestion:output_type -> persys.control.v1.SubmitAutomationSuggestionResponse
+	50,  // 289: persys.control.v1.AgentControl.ListNodes:output_type -> persys.control.v1.ListNodesResponse
+	51,  // 290: persys.control.v1.AgentControl.GetNode:output_type -> persys.control.v1.GetNodeResponse
+	57,  // 291: persys.control.v1.AgentControl.ListWorkloads:output_type -> persys.control.v1.ListWorkloadsResponse
+	58,  // 292: persys.control.v1.AgentControl.GetWorkload:output_type -> persys.control.v1.GetWorkloadResponse
+	64,  // 293: persys.control.v1.AgentControl.GetClusterSummary:output_type -> persys.control.v1.GetClusterSummaryResponse
+	182, // 294: persys.control.v1.AgentControl.ListPendingWorkloads:output_type -> persys.control.v1.ListPendingWorkloadsResponse
+	188, // 295: persys.control.v1.AgentControl.SimulatePlacement:output_type -> persys.control.v1.SimulatePlacementResponse
+	191, // 296: persys.control.v1.AgentControl.GetWorkloadUsageHistory:output_type -> persys.control.v1.GetWorkloadUsageHistoryResponse
+	66,  // 297: persys.control.v1.AgentControl.ApplyReplicaSet:output_type -> persys.control.v1.ApplyReplicaSetResponse
+	68,  // 298: persys.control.v1.AgentControl.ScaleReplicaSet:output_type -> persys.control.v1.ScaleReplicaSetResponse
+	70,  // 299: persys.control.v1.AgentControl.DeleteReplicaSet:output_type -> persys.control.v1.DeleteReplicaSetResponse
+	72,  // 300: persys.control.v1.AgentControl.GetReplicaSet:output_type -> persys.control.v1.GetReplicaSetResponse
+	74,  // 301: persys.control.v1.AgentControl.ListReplicaSets:output_type -> persys.control.v1.ListReplicaSetsResponse
+	78,  // 302: persys.control.v1.AgentControl.ApplyNamespace:output_type -> persys.control.v1.ApplyNamespaceResponse
+	80,  // 303: persys.control.v1.AgentControl.DeleteNamespace:output_type -> persys.control.v1.DeleteNamespaceResponse
+	82,  // 304: persys.control.v1.AgentControl.GetNamespace:output_type -> persys.control.v1.GetNamespaceResponse
+	84,  // 305: persys.control.v1.AgentControl.ListNamespaces:output_type -> persys.control.v1.ListNamespacesResponse
+	87,  // 306: persys.control.v1.AgentControl.ApplySecret:output_type -> persys.control.v1.ApplySecretResponse
+	89,  // 307: persys.control.v1.AgentControl.DeleteSecret:output_type -> persys.control.v1.DeleteSecretResponse
+	91,  // 308: persys.control.v1.AgentControl.GetSecret:output_type -> persys.control.v1.GetSecretResponse
+	93,  // 309: persys.control.v1.AgentControl.ListSecrets:output_type -> persys.control.v1.ListSecretsResponse
+	96,  // 310: persys.control.v1.AgentControl.ApplyConfig:output_type -> persys.control.v1.ApplyConfigResponse
+	98,  // 311: persys.control.v1.AgentControl.DeleteConfig:output_type -> persys.control.v1.DeleteConfigResponse
+	100, // 312: persys.control.v1.AgentControl.GetConfig:output_type -> persys.control.v1.GetConfigResponse
+	102, // 313: persys.control.v1.AgentControl.ListConfigs:output_type -> persys.control.v1.ListConfigsResponse
+	106, // 314: persys.control.v1.AgentControl.ApplyService:output_type -> persys.control.v1.ApplyServiceResponse
+	108, // 315: persys.control.v1.AgentControl.DeleteService:output_type -> persys.control.v1.DeleteServiceResponse
+	110, // 316: persys.control.v1.AgentControl.GetService:output_type -> persys.control.v1.GetServiceResponse
+	112, // 317: persys.control.v1.AgentControl.ListServices:output_type -> persys.control.v1.ListServicesResponse
+	117, // 318: persys.control.v1.AgentControl.ApplyStack:output_type -> persys.control.v1.ApplyStackResponse
+	119, // 319: persys.control.v1.AgentControl.DeleteStack:output_type -> persys.control.v1.DeleteStackResponse
+	121, // 320: persys.control.v1.AgentControl.GetStack:output_type -> persys.control.v1.GetStackResponse
+	123, // 321: persys.control.v1.AgentControl.ListStacks:output_type -> persys.control.v1.ListStacksResponse
+	125, // 322: persys.control.v1.AgentControl.SetStackState:output_type -> persys.control.v1.SetStackStateResponse
+	130, // 323: persys.control.v1.AgentControl.ApplyJob:output_type -> persys.control.v1.ApplyJobResponse
+	132, // 324: persys.control.v1.AgentControl.DeleteJob:output_type -> persys.control.v1.DeleteJobResponse
+	134, // 325: persys.control.v1.AgentControl.GetJob:output_type -> persys.control.v1.GetJobResponse
+	136, // 326: persys.control.v1.AgentControl.ListJobs:output_type -> persys.control.v1.ListJobsResponse
+	139, // 327: persys.control.v1.AgentControl.ApplyCronJob:output_type -> persys.control.v1.ApplyCronJobResponse
+	141, // 328: persys.control.v1.AgentControl.DeleteCronJob:output_type -> persys.control.v1.DeleteCronJobResponse
+	143, // 329: persys.control.v1.AgentControl.GetCronJob:output_type -> persys.control.v1.GetCronJobResponse
+	145, // 330: persys.control.v1.AgentControl.ListCronJobs:output_type -> persys.control.v1.ListCronJobsResponse
+	149, // 331: persys.control.v1.AgentControl.CordonNode:output_type -> persys.control.v1.CordonNodeResponse
+	151, // 332: persys.control.v1.AgentControl.UncordonNode:output_type -> persys.control.v1.UncordonNodeResponse
+	153, // 333: persys.control.v1.AgentControl.SetNodeTaints:output_type -> persys.control.v1.SetNodeTaintsResponse
+	155, // 334: persys.control.v1.AgentControl.DrainNode:output_type -> persys.control.v1.DrainNodeResponse
+	158, // 335: persys.control.v1.AgentControl.RunDescheduler:output_type -> persys.control.v1.RunDeschedulerResponse
+	162, // 336: persys.control.v1.AgentControl.CreateVolume:output_type -> persys.control.v1.CreateVolumeResponse
+	164, // 337: persys.control.v1.AgentControl.GetVolume:output_type -> persys.control.v1.GetVolumeResponse
+	166, // 338: persys.control.v1.AgentControl.ListVolumes:output_type -> persys.control.v1.ListVolumesResponse
+	168, // 339: persys.control.v1.AgentControl.DeleteVolume:output_type -> persys.control.v1.DeleteVolumeResponse
+	170, // 340: persys.control.v1.AgentControl.ResizeVolume:output_type -> persys.control.v1.ResizeVolumeResponse
+	172, // 341: persys.control.v1.AgentControl.SnapshotVolume:output_type -> persys.control.v1.SnapshotVolumeResponse
+	174, // 342: persys.control.v1.AgentControl.CloneVolume:output_type -> persys.control.v1.CloneVolumeResponse
+	176, // 343: persys.control.v1.AgentControl.ExportState:output_type -> persys.control.v1.ExportStateResponse
+	178, // 344: persys.control.v1.AgentControl.ImportState:output_type -> persys.control.v1.ImportStateResponse
+	180, // 345: persys.control.v1.AgentControl.WatchEvents:output_type -> persys.control.v1.SchedulerEventView
+	198, // 346: persys.control.v1.AgentControl.StreamWorkloadLogs:output_type -> persys.control.v1.WorkloadLogChunk
+	203, // 347: persys.control.v1.AgentControl.ExecWorkload:output_type -> persys.control.v1.ExecWorkloadResponse
+	147, // 348: persys.control.v1.AgentControl.ControlStream:output_type -> persys.control.v1.ControlMessage
+	281, // [281:349] is the sub-list for method output_type
+	213, // [213:281] is the sub-list for method input_type
+	213, // [213:213] is the sub-list for extension type_name
+	213, // [213:213] is the sub-list for extension extendee
+	0,   // [0:213] is the sub-list for field type_name
 }
 
 func init() { file_control_proto_init() }
@@ -3724,24 +16095,47 @@ func file_control_proto_init() {
 	if File_control_proto != nil {
 		return
 	}
-	file_control_proto_msgTypes[14].OneofWrappers = []any{
+	file_control_proto_msgTypes[17].OneofWrappers = []any{
 		(*WorkloadSpec_Container)(nil),
 		(*WorkloadSpec_Compose)(nil),
 		(*WorkloadSpec_Vm)(nil),
 	}
-	file_control_proto_msgTypes[42].OneofWrappers = []any{
+	file_control_proto_msgTypes[27].OneofWrappers = []any{
+		(*Probe_HttpGet)(nil),
+		(*Probe_TcpSocket)(nil),
+		(*Probe_Exec)(nil),
+	}
+	file_control_proto_msgTypes[125].OneofWrappers = []any{}
+	file_control_proto_msgTypes[135].OneofWrappers = []any{}
+	file_control_proto_msgTypes[144].OneofWrappers = []any{
 		(*ControlMessage_Register)(nil),
 		(*ControlMessage_Heartbeat)(nil),
 		(*ControlMessage_Apply)(nil),
 		(*ControlMessage_Delete)(nil),
+		(*ControlMessage_RegisterAck)(nil),
+		(*ControlMessage_HeartbeatAck)(nil),
+		(*ControlMessage_ApplyResult)(nil),
+		(*ControlMessage_DeleteResult)(nil),
+		(*ControlMessage_WorkloadStatus)(nil),
+	}
+	file_control_proto_msgTypes[198].OneofWrappers = []any{
+		(*ExecWorkloadRequest_Start)(nil),
+		(*ExecWorkloadRequest_Stdin)(nil),
+		(*ExecWorkloadRequest_Resize)(nil),
+		(*ExecWorkloadRequest_CloseStdin)(nil),
+	}
+	file_control_proto_msgTypes[200].OneofWrappers = []any{
+		(*ExecWorkloadResponse_Stdout)(nil),
+		(*ExecWorkloadResponse_Stderr)(nil),
+		(*ExecWorkloadResponse_Exit)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_control_proto_rawDesc), len(file_control_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   48,
+			NumEnums:      3,
+			NumMessages:   219,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
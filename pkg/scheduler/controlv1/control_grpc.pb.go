@@ -23,6 +23,8 @@ const (
 	AgentControl_Heartbeat_FullMethodName                  = "/persys.control.v1.AgentControl/Heartbeat"
 	AgentControl_ApplyWorkload_FullMethodName              = "/persys.control.v1.AgentControl/ApplyWorkload"
 	AgentControl_DeleteWorkload_FullMethodName             = "/persys.control.v1.AgentControl/DeleteWorkload"
+	AgentControl_ListWorkloadRevisions_FullMethodName      = "/persys.control.v1.AgentControl/ListWorkloadRevisions"
+	AgentControl_RollbackWorkload_FullMethodName           = "/persys.control.v1.AgentControl/RollbackWorkload"
 	AgentControl_RetryWorkload_FullMethodName              = "/persys.control.v1.AgentControl/RetryWorkload"
 	AgentControl_SubmitAutomationSuggestion_FullMethodName = "/persys.control.v1.AgentControl/SubmitAutomationSuggestion"
 	AgentControl_ListNodes_FullMethodName                  = "/persys.control.v1.AgentControl/ListNodes"
@@ -30,6 +32,60 @@ const (
 	AgentControl_ListWorkloads_FullMethodName              = "/persys.control.v1.AgentControl/ListWorkloads"
 	AgentControl_GetWorkload_FullMethodName                = "/persys.control.v1.AgentControl/GetWorkload"
 	AgentControl_GetClusterSummary_FullMethodName          = "/persys.control.v1.AgentControl/GetClusterSummary"
+	AgentControl_ListPendingWorkloads_FullMethodName       = "/persys.control.v1.AgentControl/ListPendingWorkloads"
+	AgentControl_SimulatePlacement_FullMethodName          = "/persys.control.v1.AgentControl/SimulatePlacement"
+	AgentControl_GetWorkloadUsageHistory_FullMethodName    = "/persys.control.v1.AgentControl/GetWorkloadUsageHistory"
+	AgentControl_ApplyReplicaSet_FullMethodName            = "/persys.control.v1.AgentControl/ApplyReplicaSet"
+	AgentControl_ScaleReplicaSet_FullMethodName            = "/persys.control.v1.AgentControl/ScaleReplicaSet"
+	AgentControl_DeleteReplicaSet_FullMethodName           = "/persys.control.v1.AgentControl/DeleteReplicaSet"
+	AgentControl_GetReplicaSet_FullMethodName              = "/persys.control.v1.AgentControl/GetReplicaSet"
+	AgentControl_ListReplicaSets_FullMethodName            = "/persys.control.v1.AgentControl/ListReplicaSets"
+	AgentControl_ApplyNamespace_FullMethodName             = "/persys.control.v1.AgentControl/ApplyNamespace"
+	AgentControl_DeleteNamespace_FullMethodName            = "/persys.control.v1.AgentControl/DeleteNamespace"
+	AgentControl_GetNamespace_FullMethodName               = "/persys.control.v1.AgentControl/GetNamespace"
+	AgentControl_ListNamespaces_FullMethodName             = "/persys.control.v1.AgentControl/ListNamespaces"
+	AgentControl_ApplySecret_FullMethodName                = "/persys.control.v1.AgentControl/ApplySecret"
+	AgentControl_DeleteSecret_FullMethodName               = "/persys.control.v1.AgentControl/DeleteSecret"
+	AgentControl_GetSecret_FullMethodName                  = "/persys.control.v1.AgentControl/GetSecret"
+	AgentControl_ListSecrets_FullMethodName                = "/persys.control.v1.AgentControl/ListSecrets"
+	AgentControl_ApplyConfig_FullMethodName                = "/persys.control.v1.AgentControl/ApplyConfig"
+	AgentControl_DeleteConfig_FullMethodName               = "/persys.control.v1.AgentControl/DeleteConfig"
+	AgentControl_GetConfig_FullMethodName                  = "/persys.control.v1.AgentControl/GetConfig"
+	AgentControl_ListConfigs_FullMethodName                = "/persys.control.v1.AgentControl/ListConfigs"
+	AgentControl_ApplyService_FullMethodName               = "/persys.control.v1.AgentControl/ApplyService"
+	AgentControl_DeleteService_FullMethodName              = "/persys.control.v1.AgentControl/DeleteService"
+	AgentControl_GetService_FullMethodName                 = "/persys.control.v1.AgentControl/GetService"
+	AgentControl_ListServices_FullMethodName               = "/persys.control.v1.AgentControl/ListServices"
+	AgentControl_ApplyStack_FullMethodName                 = "/persys.control.v1.AgentControl/ApplyStack"
+	AgentControl_DeleteStack_FullMethodName                = "/persys.control.v1.AgentControl/DeleteStack"
+	AgentControl_GetStack_FullMethodName                   = "/persys.control.v1.AgentControl/GetStack"
+	AgentControl_ListStacks_FullMethodName                 = "/persys.control.v1.AgentControl/ListStacks"
+	AgentControl_SetStackState_FullMethodName              = "/persys.control.v1.AgentControl/SetStackState"
+	AgentControl_ApplyJob_FullMethodName                   = "/persys.control.v1.AgentControl/ApplyJob"
+	AgentControl_DeleteJob_FullMethodName                  = "/persys.control.v1.AgentControl/DeleteJob"
+	AgentControl_GetJob_FullMethodName                     = "/persys.control.v1.AgentControl/GetJob"
+	AgentControl_ListJobs_FullMethodName                   = "/persys.control.v1.AgentControl/ListJobs"
+	AgentControl_ApplyCronJob_FullMethodName               = "/persys.control.v1.AgentControl/ApplyCronJob"
+	AgentControl_DeleteCronJob_FullMethodName              = "/persys.control.v1.AgentControl/DeleteCronJob"
+	AgentControl_GetCronJob_FullMethodName                 = "/persys.control.v1.AgentControl/GetCronJob"
+	AgentControl_ListCronJobs_FullMethodName               = "/persys.control.v1.AgentControl/ListCronJobs"
+	AgentControl_CordonNode_FullMethodName                 = "/persys.control.v1.AgentControl/CordonNode"
+	AgentControl_UncordonNode_FullMethodName               = "/persys.control.v1.AgentControl/UncordonNode"
+	AgentControl_SetNodeTaints_FullMethodName              = "/persys.control.v1.AgentControl/SetNodeTaints"
+	AgentControl_DrainNode_FullMethodName                  = "/persys.control.v1.AgentControl/DrainNode"
+	AgentControl_RunDescheduler_FullMethodName             = "/persys.control.v1.AgentControl/RunDescheduler"
+	AgentControl_CreateVolume_FullMethodName               = "/persys.control.v1.AgentControl/CreateVolume"
+	AgentControl_GetVolume_FullMethodName                  = "/persys.control.v1.AgentControl/GetVolume"
+	AgentControl_ListVolumes_FullMethodName                = "/persys.control.v1.AgentControl/ListVolumes"
+	AgentControl_DeleteVolume_FullMethodName               = "/persys.control.v1.AgentControl/DeleteVolume"
+	AgentControl_ResizeVolume_FullMethodName               = "/persys.control.v1.AgentControl/ResizeVolume"
+	AgentControl_SnapshotVolume_FullMethodName             = "/persys.control.v1.AgentControl/SnapshotVolume"
+	AgentControl_CloneVolume_FullMethodName                = "/persys.control.v1.AgentControl/CloneVolume"
+	AgentControl_ExportState_FullMethodName                = "/persys.control.v1.AgentControl/ExportState"
+	AgentControl_ImportState_FullMethodName                = "/persys.control.v1.AgentControl/ImportState"
+	AgentControl_WatchEvents_FullMethodName                = "/persys.control.v1.AgentControl/WatchEvents"
+	AgentControl_StreamWorkloadLogs_FullMethodName         = "/persys.control.v1.AgentControl/StreamWorkloadLogs"
+	AgentControl_ExecWorkload_FullMethodName               = "/persys.control.v1.AgentControl/ExecWorkload"
 	AgentControl_ControlStream_FullMethodName              = "/persys.control.v1.AgentControl/ControlStream"
 )
 
@@ -44,6 +100,9 @@ type AgentControlClient interface {
 	// Workload lifecycle
 	ApplyWorkload(ctx context.Context, in *ApplyWorkloadRequest, opts ...grpc.CallOption) (*ApplyWorkloadResponse, error)
 	DeleteWorkload(ctx context.Context, in *DeleteWorkloadRequest, opts ...grpc.CallOption) (*DeleteWorkloadResponse, error)
+	// Revision history
+	ListWorkloadRevisions(ctx context.Context, in *ListWorkloadRevisionsRequest, opts ...grpc.CallOption) (*ListWorkloadRevisionsResponse, error)
+	RollbackWorkload(ctx context.Context, in *RollbackWorkloadRequest, opts ...grpc.CallOption) (*RollbackWorkloadResponse, error)
 	// Retry trigger
 	RetryWorkload(ctx context.Context, in *RetryWorkloadRequest, opts ...grpc.CallOption) (*RetryWorkloadResponse, error)
 	SubmitAutomationSuggestion(ctx context.Context, in *SubmitAutomationSuggestionRequest, opts ...grpc.CallOption) (*SubmitAutomationSuggestionResponse, error)
@@ -53,7 +112,75 @@ type AgentControlClient interface {
 	ListWorkloads(ctx context.Context, in *ListWorkloadsRequest, opts ...grpc.CallOption) (*ListWorkloadsResponse, error)
 	GetWorkload(ctx context.Context, in *GetWorkloadRequest, opts ...grpc.CallOption) (*GetWorkloadResponse, error)
 	GetClusterSummary(ctx context.Context, in *GetClusterSummaryRequest, opts ...grpc.CallOption) (*GetClusterSummaryResponse, error)
-	// Optional future streaming channel
+	ListPendingWorkloads(ctx context.Context, in *ListPendingWorkloadsRequest, opts ...grpc.CallOption) (*ListPendingWorkloadsResponse, error)
+	// Dry run of placement: nothing is reserved or persisted
+	SimulatePlacement(ctx context.Context, in *SimulatePlacementRequest, opts ...grpc.CallOption) (*SimulatePlacementResponse, error)
+	// Usage history rolled up from the samples agents report
+	GetWorkloadUsageHistory(ctx context.Context, in *GetWorkloadUsageHistoryRequest, opts ...grpc.CallOption) (*GetWorkloadUsageHistoryResponse, error)
+	// Replica sets
+	ApplyReplicaSet(ctx context.Context, in *ApplyReplicaSetRequest, opts ...grpc.CallOption) (*ApplyReplicaSetResponse, error)
+	ScaleReplicaSet(ctx context.Context, in *ScaleReplicaSetRequest, opts ...grpc.CallOption) (*ScaleReplicaSetResponse, error)
+	DeleteReplicaSet(ctx context.Context, in *DeleteReplicaSetRequest, opts ...grpc.CallOption) (*DeleteReplicaSetResponse, error)
+	GetReplicaSet(ctx context.Context, in *GetReplicaSetRequest, opts ...grpc.CallOption) (*GetReplicaSetResponse, error)
+	ListReplicaSets(ctx context.Context, in *ListReplicaSetsRequest, opts ...grpc.CallOption) (*ListReplicaSetsResponse, error)
+	// Namespaces and quotas
+	ApplyNamespace(ctx context.Context, in *ApplyNamespaceRequest, opts ...grpc.CallOption) (*ApplyNamespaceResponse, error)
+	DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error)
+	ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	// Secrets and configs. Secret values are write-only.
+	ApplySecret(ctx context.Context, in *ApplySecretRequest, opts ...grpc.CallOption) (*ApplySecretResponse, error)
+	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error)
+	DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (*ListConfigsResponse, error)
+	// Services publish DNS records for the workloads they select
+	ApplyService(ctx context.Context, in *ApplyServiceRequest, opts ...grpc.CallOption) (*ApplyServiceResponse, error)
+	DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error)
+	GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceResponse, error)
+	ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error)
+	// Stacks deploy a group of dependent workloads in order
+	ApplyStack(ctx context.Context, in *ApplyStackRequest, opts ...grpc.CallOption) (*ApplyStackResponse, error)
+	DeleteStack(ctx context.Context, in *DeleteStackRequest, opts ...grpc.CallOption) (*DeleteStackResponse, error)
+	GetStack(ctx context.Context, in *GetStackRequest, opts ...grpc.CallOption) (*GetStackResponse, error)
+	ListStacks(ctx context.Context, in *ListStacksRequest, opts ...grpc.CallOption) (*ListStacksResponse, error)
+	SetStackState(ctx context.Context, in *SetStackStateRequest, opts ...grpc.CallOption) (*SetStackStateResponse, error)
+	// Run-to-completion jobs
+	ApplyJob(ctx context.Context, in *ApplyJobRequest, opts ...grpc.CallOption) (*ApplyJobResponse, error)
+	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	ApplyCronJob(ctx context.Context, in *ApplyCronJobRequest, opts ...grpc.CallOption) (*ApplyCronJobResponse, error)
+	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*DeleteCronJobResponse, error)
+	GetCronJob(ctx context.Context, in *GetCronJobRequest, opts ...grpc.CallOption) (*GetCronJobResponse, error)
+	ListCronJobs(ctx context.Context, in *ListCronJobsRequest, opts ...grpc.CallOption) (*ListCronJobsResponse, error)
+	// Node maintenance
+	CordonNode(ctx context.Context, in *CordonNodeRequest, opts ...grpc.CallOption) (*CordonNodeResponse, error)
+	UncordonNode(ctx context.Context, in *UncordonNodeRequest, opts ...grpc.CallOption) (*UncordonNodeResponse, error)
+	SetNodeTaints(ctx context.Context, in *SetNodeTaintsRequest, opts ...grpc.CallOption) (*SetNodeTaintsResponse, error)
+	DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (*DrainNodeResponse, error)
+	RunDescheduler(ctx context.Context, in *RunDeschedulerRequest, opts ...grpc.CallOption) (*RunDeschedulerResponse, error)
+	// Managed volumes
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error)
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
+	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
+	SnapshotVolume(ctx context.Context, in *SnapshotVolumeRequest, opts ...grpc.CallOption) (*SnapshotVolumeResponse, error)
+	CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*CloneVolumeResponse, error)
+	// Disaster recovery
+	ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error)
+	ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error)
+	// Event stream
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SchedulerEventView], error)
+	// Workload I/O, proxied to the agent running the workload
+	StreamWorkloadLogs(ctx context.Context, in *StreamWorkloadLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkloadLogChunk], error)
+	ExecWorkload(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse], error)
+	// Long-lived bidirectional agent channel. Agents register, heartbeat and
+	// report workload status over it; the scheduler pushes applies/deletes back.
 	ControlStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlMessage, ControlMessage], error)
 }
 
@@ -105,6 +232,26 @@ func (c *agentControlClient) DeleteWorkload(ctx context.Context, in *DeleteWorkl
 	return out, nil
 }
 
+func (c *agentControlClient) ListWorkloadRevisions(ctx context.Context, in *ListWorkloadRevisionsRequest, opts ...grpc.CallOption) (*ListWorkloadRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorkloadRevisionsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListWorkloadRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) RollbackWorkload(ctx context.Context, in *RollbackWorkloadRequest, opts ...grpc.CallOption) (*RollbackWorkloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackWorkloadResponse)
+	err := c.cc.Invoke(ctx, AgentControl_RollbackWorkload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentControlClient) RetryWorkload(ctx context.Context, in *RetryWorkloadRequest, opts ...grpc.CallOption) (*RetryWorkloadResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RetryWorkloadResponse)
@@ -175,306 +322,2089 @@ func (c *agentControlClient) GetClusterSummary(ctx context.Context, in *GetClust
 	return out, nil
 }
 
-func (c *agentControlClient) ControlStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlMessage, ControlMessage], error) {
+func (c *agentControlClient) ListPendingWorkloads(ctx context.Context, in *ListPendingWorkloadsRequest, opts ...grpc.CallOption) (*ListPendingWorkloadsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[0], AgentControl_ControlStream_FullMethodName, cOpts...)
+	out := new(ListPendingWorkloadsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListPendingWorkloads_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &grpc.GenericClientStream[ControlMessage, ControlMessage]{ClientStream: stream}
-	return x, nil
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type AgentControl_ControlStreamClient = grpc.BidiStreamingClient[ControlMessage, ControlMessage]
+func (c *agentControlClient) SimulatePlacement(ctx context.Context, in *SimulatePlacementRequest, opts ...grpc.CallOption) (*SimulatePlacementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SimulatePlacementResponse)
+	err := c.cc.Invoke(ctx, AgentControl_SimulatePlacement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// AgentControlServer is the server API for AgentControl service.
-// All implementations must embed UnimplementedAgentControlServer
-// for forward compatibility.
-type AgentControlServer interface {
-	// Registration
-	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
-	// Heartbeat
-	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
-	// Workload lifecycle
-	ApplyWorkload(context.Context, *ApplyWorkloadRequest) (*ApplyWorkloadResponse, error)
-	DeleteWorkload(context.Context, *DeleteWorkloadRequest) (*DeleteWorkloadResponse, error)
-	// Retry trigger
-	RetryWorkload(context.Context, *RetryWorkloadRequest) (*RetryWorkloadResponse, error)
-	SubmitAutomationSuggestion(context.Context, *SubmitAutomationSuggestionRequest) (*SubmitAutomationSuggestionResponse, error)
-	// Cluster and node management visibility
-	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
-	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
-	ListWorkloads(context.Context, *ListWorkloadsRequest) (*ListWorkloadsResponse, error)
-	GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error)
-	GetClusterSummary(context.Context, *GetClusterSummaryRequest) (*GetClusterSummaryResponse, error)
-	// Optional future streaming channel
-	ControlStream(grpc.BidiStreamingServer[ControlMessage, ControlMessage]) error
-	mustEmbedUnimplementedAgentControlServer()
+func (c *agentControlClient) GetWorkloadUsageHistory(ctx context.Context, in *GetWorkloadUsageHistoryRequest, opts ...grpc.CallOption) (*GetWorkloadUsageHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkloadUsageHistoryResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetWorkloadUsageHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedAgentControlServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedAgentControlServer struct{}
+func (c *agentControlClient) ApplyReplicaSet(ctx context.Context, in *ApplyReplicaSetRequest, opts ...grpc.CallOption) (*ApplyReplicaSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyReplicaSetResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyReplicaSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedAgentControlServer) RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RegisterNode not implemented")
+func (c *agentControlClient) ScaleReplicaSet(ctx context.Context, in *ScaleReplicaSetRequest, opts ...grpc.CallOption) (*ScaleReplicaSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScaleReplicaSetResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ScaleReplicaSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+
+func (c *agentControlClient) DeleteReplicaSet(ctx context.Context, in *DeleteReplicaSetRequest, opts ...grpc.CallOption) (*DeleteReplicaSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReplicaSetResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteReplicaSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) ApplyWorkload(context.Context, *ApplyWorkloadRequest) (*ApplyWorkloadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ApplyWorkload not implemented")
+
+func (c *agentControlClient) GetReplicaSet(ctx context.Context, in *GetReplicaSetRequest, opts ...grpc.CallOption) (*GetReplicaSetResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReplicaSetResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetReplicaSet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) DeleteWorkload(context.Context, *DeleteWorkloadRequest) (*DeleteWorkloadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method DeleteWorkload not implemented")
+
+func (c *agentControlClient) ListReplicaSets(ctx context.Context, in *ListReplicaSetsRequest, opts ...grpc.CallOption) (*ListReplicaSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReplicaSetsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListReplicaSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) RetryWorkload(context.Context, *RetryWorkloadRequest) (*RetryWorkloadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method RetryWorkload not implemented")
+
+func (c *agentControlClient) ApplyNamespace(ctx context.Context, in *ApplyNamespaceRequest, opts ...grpc.CallOption) (*ApplyNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyNamespaceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) SubmitAutomationSuggestion(context.Context, *SubmitAutomationSuggestionRequest) (*SubmitAutomationSuggestionResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method SubmitAutomationSuggestion not implemented")
+
+func (c *agentControlClient) DeleteNamespace(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
+
+func (c *agentControlClient) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*GetNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetNode not implemented")
+
+func (c *agentControlClient) ListNamespaces(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListNamespaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) ListWorkloads(context.Context, *ListWorkloadsRequest) (*ListWorkloadsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListWorkloads not implemented")
+
+func (c *agentControlClient) ApplySecret(ctx context.Context, in *ApplySecretRequest, opts ...grpc.CallOption) (*ApplySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplySecretResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplySecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedAgentControlServer) GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetWorkload not implemented")
+
+func (c *agentControlClient) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSecretResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSecretResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ApplyConfig(ctx context.Context, in *ApplyConfigRequest, opts ...grpc.CallOption) (*ApplyConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyConfigResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteConfig(ctx context.Context, in *DeleteConfigRequest, opts ...grpc.CallOption) (*DeleteConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteConfigResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListConfigs(ctx context.Context, in *ListConfigsRequest, opts ...grpc.CallOption) (*ListConfigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConfigsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListConfigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ApplyService(ctx context.Context, in *ApplyServiceRequest, opts ...grpc.CallOption) (*ApplyServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyServiceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteService(ctx context.Context, in *DeleteServiceRequest, opts ...grpc.CallOption) (*DeleteServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteServiceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetService(ctx context.Context, in *GetServiceRequest, opts ...grpc.CallOption) (*GetServiceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetService_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListServices(ctx context.Context, in *ListServicesRequest, opts ...grpc.CallOption) (*ListServicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServicesResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListServices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ApplyStack(ctx context.Context, in *ApplyStackRequest, opts ...grpc.CallOption) (*ApplyStackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyStackResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyStack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteStack(ctx context.Context, in *DeleteStackRequest, opts ...grpc.CallOption) (*DeleteStackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteStackResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteStack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetStack(ctx context.Context, in *GetStackRequest, opts ...grpc.CallOption) (*GetStackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStackResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetStack_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListStacks(ctx context.Context, in *ListStacksRequest, opts ...grpc.CallOption) (*ListStacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStacksResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListStacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) SetStackState(ctx context.Context, in *SetStackStateRequest, opts ...grpc.CallOption) (*SetStackStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStackStateResponse)
+	err := c.cc.Invoke(ctx, AgentControl_SetStackState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ApplyJob(ctx context.Context, in *ApplyJobRequest, opts ...grpc.CallOption) (*ApplyJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ApplyCronJob(ctx context.Context, in *ApplyCronJobRequest, opts ...grpc.CallOption) (*ApplyCronJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyCronJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ApplyCronJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*DeleteCronJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCronJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteCronJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetCronJob(ctx context.Context, in *GetCronJobRequest, opts ...grpc.CallOption) (*GetCronJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCronJobResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetCronJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListCronJobs(ctx context.Context, in *ListCronJobsRequest, opts ...grpc.CallOption) (*ListCronJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCronJobsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListCronJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) CordonNode(ctx context.Context, in *CordonNodeRequest, opts ...grpc.CallOption) (*CordonNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CordonNodeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_CordonNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) UncordonNode(ctx context.Context, in *UncordonNodeRequest, opts ...grpc.CallOption) (*UncordonNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UncordonNodeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_UncordonNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) SetNodeTaints(ctx context.Context, in *SetNodeTaintsRequest, opts ...grpc.CallOption) (*SetNodeTaintsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNodeTaintsResponse)
+	err := c.cc.Invoke(ctx, AgentControl_SetNodeTaints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DrainNode(ctx context.Context, in *DrainNodeRequest, opts ...grpc.CallOption) (*DrainNodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrainNodeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DrainNode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) RunDescheduler(ctx context.Context, in *RunDeschedulerRequest, opts ...grpc.CallOption) (*RunDeschedulerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunDeschedulerResponse)
+	err := c.cc.Invoke(ctx, AgentControl_RunDescheduler_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_CreateVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) GetVolume(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_GetVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ListVolumes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) DeleteVolume(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_DeleteVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResizeVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ResizeVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) SnapshotVolume(ctx context.Context, in *SnapshotVolumeRequest, opts ...grpc.CallOption) (*SnapshotVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapshotVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_SnapshotVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) CloneVolume(ctx context.Context, in *CloneVolumeRequest, opts ...grpc.CallOption) (*CloneVolumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneVolumeResponse)
+	err := c.cc.Invoke(ctx, AgentControl_CloneVolume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ExportState(ctx context.Context, in *ExportStateRequest, opts ...grpc.CallOption) (*ExportStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportStateResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ExportState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) ImportState(ctx context.Context, in *ImportStateRequest, opts ...grpc.CallOption) (*ImportStateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportStateResponse)
+	err := c.cc.Invoke(ctx, AgentControl_ImportState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentControlClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SchedulerEventView], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[0], AgentControl_WatchEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchEventsRequest, SchedulerEventView]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_WatchEventsClient = grpc.ServerStreamingClient[SchedulerEventView]
+
+func (c *agentControlClient) StreamWorkloadLogs(ctx context.Context, in *StreamWorkloadLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WorkloadLogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[1], AgentControl_StreamWorkloadLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamWorkloadLogsRequest, WorkloadLogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_StreamWorkloadLogsClient = grpc.ServerStreamingClient[WorkloadLogChunk]
+
+func (c *agentControlClient) ExecWorkload(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[2], AgentControl_ExecWorkload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExecWorkloadRequest, ExecWorkloadResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_ExecWorkloadClient = grpc.BidiStreamingClient[ExecWorkloadRequest, ExecWorkloadResponse]
+
+func (c *agentControlClient) ControlStream(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[ControlMessage, ControlMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[3], AgentControl_ControlStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ControlMessage, ControlMessage]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_ControlStreamClient = grpc.BidiStreamingClient[ControlMessage, ControlMessage]
+
+// AgentControlServer is the server API for AgentControl service.
+// All implementations must embed UnimplementedAgentControlServer
+// for forward compatibility.
+type AgentControlServer interface {
+	// Registration
+	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
+	// Heartbeat
+	Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error)
+	// Workload lifecycle
+	ApplyWorkload(context.Context, *ApplyWorkloadRequest) (*ApplyWorkloadResponse, error)
+	DeleteWorkload(context.Context, *DeleteWorkloadRequest) (*DeleteWorkloadResponse, error)
+	// Revision history
+	ListWorkloadRevisions(context.Context, *ListWorkloadRevisionsRequest) (*ListWorkloadRevisionsResponse, error)
+	RollbackWorkload(context.Context, *RollbackWorkloadRequest) (*RollbackWorkloadResponse, error)
+	// Retry trigger
+	RetryWorkload(context.Context, *RetryWorkloadRequest) (*RetryWorkloadResponse, error)
+	SubmitAutomationSuggestion(context.Context, *SubmitAutomationSuggestionRequest) (*SubmitAutomationSuggestionResponse, error)
+	// Cluster and node management visibility
+	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
+	GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error)
+	ListWorkloads(context.Context, *ListWorkloadsRequest) (*ListWorkloadsResponse, error)
+	GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error)
+	GetClusterSummary(context.Context, *GetClusterSummaryRequest) (*GetClusterSummaryResponse, error)
+	ListPendingWorkloads(context.Context, *ListPendingWorkloadsRequest) (*ListPendingWorkloadsResponse, error)
+	// Dry run of placement: nothing is reserved or persisted
+	SimulatePlacement(context.Context, *SimulatePlacementRequest) (*SimulatePlacementResponse, error)
+	// Usage history rolled up from the samples agents report
+	GetWorkloadUsageHistory(context.Context, *GetWorkloadUsageHistoryRequest) (*GetWorkloadUsageHistoryResponse, error)
+	// Replica sets
+	ApplyReplicaSet(context.Context, *ApplyReplicaSetRequest) (*ApplyReplicaSetResponse, error)
+	ScaleReplicaSet(context.Context, *ScaleReplicaSetRequest) (*ScaleReplicaSetResponse, error)
+	DeleteReplicaSet(context.Context, *DeleteReplicaSetRequest) (*DeleteReplicaSetResponse, error)
+	GetReplicaSet(context.Context, *GetReplicaSetRequest) (*GetReplicaSetResponse, error)
+	ListReplicaSets(context.Context, *ListReplicaSetsRequest) (*ListReplicaSetsResponse, error)
+	// Namespaces and quotas
+	ApplyNamespace(context.Context, *ApplyNamespaceRequest) (*ApplyNamespaceResponse, error)
+	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error)
+	ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	// Secrets and configs. Secret values are write-only.
+	ApplySecret(context.Context, *ApplySecretRequest) (*ApplySecretResponse, error)
+	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error)
+	DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error)
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	ListConfigs(context.Context, *ListConfigsRequest) (*ListConfigsResponse, error)
+	// Services publish DNS records for the workloads they select
+	ApplyService(context.Context, *ApplyServiceRequest) (*ApplyServiceResponse, error)
+	DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error)
+	GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error)
+	ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error)
+	// Stacks deploy a group of dependent workloads in order
+	ApplyStack(context.Context, *ApplyStackRequest) (*ApplyStackResponse, error)
+	DeleteStack(context.Context, *DeleteStackRequest) (*DeleteStackResponse, error)
+	GetStack(context.Context, *GetStackRequest) (*GetStackResponse, error)
+	ListStacks(context.Context, *ListStacksRequest) (*ListStacksResponse, error)
+	SetStackState(context.Context, *SetStackStateRequest) (*SetStackStateResponse, error)
+	// Run-to-completion jobs
+	ApplyJob(context.Context, *ApplyJobRequest) (*ApplyJobResponse, error)
+	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	ApplyCronJob(context.Context, *ApplyCronJobRequest) (*ApplyCronJobResponse, error)
+	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*DeleteCronJobResponse, error)
+	GetCronJob(context.Context, *GetCronJobRequest) (*GetCronJobResponse, error)
+	ListCronJobs(context.Context, *ListCronJobsRequest) (*ListCronJobsResponse, error)
+	// Node maintenance
+	CordonNode(context.Context, *CordonNodeRequest) (*CordonNodeResponse, error)
+	UncordonNode(context.Context, *UncordonNodeRequest) (*UncordonNodeResponse, error)
+	SetNodeTaints(context.Context, *SetNodeTaintsRequest) (*SetNodeTaintsResponse, error)
+	DrainNode(context.Context, *DrainNodeRequest) (*DrainNodeResponse, error)
+	RunDescheduler(context.Context, *RunDeschedulerRequest) (*RunDeschedulerResponse, error)
+	// Managed volumes
+	CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error)
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
+	SnapshotVolume(context.Context, *SnapshotVolumeRequest) (*SnapshotVolumeResponse, error)
+	CloneVolume(context.Context, *CloneVolumeRequest) (*CloneVolumeResponse, error)
+	// Disaster recovery
+	ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error)
+	ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error)
+	// Event stream
+	WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[SchedulerEventView]) error
+	// Workload I/O, proxied to the agent running the workload
+	StreamWorkloadLogs(*StreamWorkloadLogsRequest, grpc.ServerStreamingServer[WorkloadLogChunk]) error
+	ExecWorkload(grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]) error
+	// Long-lived bidirectional agent channel. Agents register, heartbeat and
+	// report workload status over it; the scheduler pushes applies/deletes back.
+	ControlStream(grpc.BidiStreamingServer[ControlMessage, ControlMessage]) error
+	mustEmbedUnimplementedAgentControlServer()
+}
+
+// UnimplementedAgentControlServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAgentControlServer struct{}
+
+func (UnimplementedAgentControlServer) RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterNode not implemented")
+}
+func (UnimplementedAgentControlServer) Heartbeat(context.Context, *HeartbeatRequest) (*HeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Heartbeat not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyWorkload(context.Context, *ApplyWorkloadRequest) (*ApplyWorkloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteWorkload(context.Context, *DeleteWorkloadRequest) (*DeleteWorkloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) ListWorkloadRevisions(context.Context, *ListWorkloadRevisionsRequest) (*ListWorkloadRevisionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkloadRevisions not implemented")
+}
+func (UnimplementedAgentControlServer) RollbackWorkload(context.Context, *RollbackWorkloadRequest) (*RollbackWorkloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RollbackWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) RetryWorkload(context.Context, *RetryWorkloadRequest) (*RetryWorkloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RetryWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) SubmitAutomationSuggestion(context.Context, *SubmitAutomationSuggestionRequest) (*SubmitAutomationSuggestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitAutomationSuggestion not implemented")
+}
+func (UnimplementedAgentControlServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
+}
+func (UnimplementedAgentControlServer) GetNode(context.Context, *GetNodeRequest) (*GetNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNode not implemented")
+}
+func (UnimplementedAgentControlServer) ListWorkloads(context.Context, *ListWorkloadsRequest) (*ListWorkloadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWorkloads not implemented")
+}
+func (UnimplementedAgentControlServer) GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) GetClusterSummary(context.Context, *GetClusterSummaryRequest) (*GetClusterSummaryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetClusterSummary not implemented")
+}
+func (UnimplementedAgentControlServer) ListPendingWorkloads(context.Context, *ListPendingWorkloadsRequest) (*ListPendingWorkloadsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPendingWorkloads not implemented")
+}
+func (UnimplementedAgentControlServer) SimulatePlacement(context.Context, *SimulatePlacementRequest) (*SimulatePlacementResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SimulatePlacement not implemented")
+}
+func (UnimplementedAgentControlServer) GetWorkloadUsageHistory(context.Context, *GetWorkloadUsageHistoryRequest) (*GetWorkloadUsageHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWorkloadUsageHistory not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyReplicaSet(context.Context, *ApplyReplicaSetRequest) (*ApplyReplicaSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyReplicaSet not implemented")
+}
+func (UnimplementedAgentControlServer) ScaleReplicaSet(context.Context, *ScaleReplicaSetRequest) (*ScaleReplicaSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScaleReplicaSet not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteReplicaSet(context.Context, *DeleteReplicaSetRequest) (*DeleteReplicaSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReplicaSet not implemented")
+}
+func (UnimplementedAgentControlServer) GetReplicaSet(context.Context, *GetReplicaSetRequest) (*GetReplicaSetResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReplicaSet not implemented")
+}
+func (UnimplementedAgentControlServer) ListReplicaSets(context.Context, *ListReplicaSetsRequest) (*ListReplicaSetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReplicaSets not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyNamespace(context.Context, *ApplyNamespaceRequest) (*ApplyNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyNamespace not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteNamespace not implemented")
+}
+func (UnimplementedAgentControlServer) GetNamespace(context.Context, *GetNamespaceRequest) (*GetNamespaceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNamespace not implemented")
+}
+func (UnimplementedAgentControlServer) ListNamespaces(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNamespaces not implemented")
+}
+func (UnimplementedAgentControlServer) ApplySecret(context.Context, *ApplySecretRequest) (*ApplySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplySecret not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSecret not implemented")
+}
+func (UnimplementedAgentControlServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSecret not implemented")
+}
+func (UnimplementedAgentControlServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyConfig(context.Context, *ApplyConfigRequest) (*ApplyConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyConfig not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteConfig(context.Context, *DeleteConfigRequest) (*DeleteConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteConfig not implemented")
+}
+func (UnimplementedAgentControlServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedAgentControlServer) ListConfigs(context.Context, *ListConfigsRequest) (*ListConfigsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListConfigs not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyService(context.Context, *ApplyServiceRequest) (*ApplyServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyService not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteService(context.Context, *DeleteServiceRequest) (*DeleteServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteService not implemented")
+}
+func (UnimplementedAgentControlServer) GetService(context.Context, *GetServiceRequest) (*GetServiceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetService not implemented")
+}
+func (UnimplementedAgentControlServer) ListServices(context.Context, *ListServicesRequest) (*ListServicesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListServices not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyStack(context.Context, *ApplyStackRequest) (*ApplyStackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyStack not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteStack(context.Context, *DeleteStackRequest) (*DeleteStackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteStack not implemented")
+}
+func (UnimplementedAgentControlServer) GetStack(context.Context, *GetStackRequest) (*GetStackResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetStack not implemented")
+}
+func (UnimplementedAgentControlServer) ListStacks(context.Context, *ListStacksRequest) (*ListStacksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStacks not implemented")
+}
+func (UnimplementedAgentControlServer) SetStackState(context.Context, *SetStackStateRequest) (*SetStackStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStackState not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyJob(context.Context, *ApplyJobRequest) (*ApplyJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyJob not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteJob not implemented")
+}
+func (UnimplementedAgentControlServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedAgentControlServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedAgentControlServer) ApplyCronJob(context.Context, *ApplyCronJobRequest) (*ApplyCronJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApplyCronJob not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteCronJob(context.Context, *DeleteCronJobRequest) (*DeleteCronJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCronJob not implemented")
+}
+func (UnimplementedAgentControlServer) GetCronJob(context.Context, *GetCronJobRequest) (*GetCronJobResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCronJob not implemented")
+}
+func (UnimplementedAgentControlServer) ListCronJobs(context.Context, *ListCronJobsRequest) (*ListCronJobsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCronJobs not implemented")
+}
+func (UnimplementedAgentControlServer) CordonNode(context.Context, *CordonNodeRequest) (*CordonNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CordonNode not implemented")
+}
+func (UnimplementedAgentControlServer) UncordonNode(context.Context, *UncordonNodeRequest) (*UncordonNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UncordonNode not implemented")
+}
+func (UnimplementedAgentControlServer) SetNodeTaints(context.Context, *SetNodeTaintsRequest) (*SetNodeTaintsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetNodeTaints not implemented")
+}
+func (UnimplementedAgentControlServer) DrainNode(context.Context, *DrainNodeRequest) (*DrainNodeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DrainNode not implemented")
+}
+func (UnimplementedAgentControlServer) RunDescheduler(context.Context, *RunDeschedulerRequest) (*RunDeschedulerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDescheduler not implemented")
+}
+func (UnimplementedAgentControlServer) CreateVolume(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVolume not implemented")
+}
+func (UnimplementedAgentControlServer) GetVolume(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedAgentControlServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (UnimplementedAgentControlServer) DeleteVolume(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVolume not implemented")
+}
+func (UnimplementedAgentControlServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResizeVolume not implemented")
+}
+func (UnimplementedAgentControlServer) SnapshotVolume(context.Context, *SnapshotVolumeRequest) (*SnapshotVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SnapshotVolume not implemented")
+}
+func (UnimplementedAgentControlServer) CloneVolume(context.Context, *CloneVolumeRequest) (*CloneVolumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CloneVolume not implemented")
+}
+func (UnimplementedAgentControlServer) ExportState(context.Context, *ExportStateRequest) (*ExportStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportState not implemented")
+}
+func (UnimplementedAgentControlServer) ImportState(context.Context, *ImportStateRequest) (*ImportStateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportState not implemented")
+}
+func (UnimplementedAgentControlServer) WatchEvents(*WatchEventsRequest, grpc.ServerStreamingServer[SchedulerEventView]) error {
+	return status.Error(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedAgentControlServer) StreamWorkloadLogs(*StreamWorkloadLogsRequest, grpc.ServerStreamingServer[WorkloadLogChunk]) error {
+	return status.Error(codes.Unimplemented, "method StreamWorkloadLogs not implemented")
+}
+func (UnimplementedAgentControlServer) ExecWorkload(grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]) error {
+	return status.Error(codes.Unimplemented, "method ExecWorkload not implemented")
+}
+func (UnimplementedAgentControlServer) ControlStream(grpc.BidiStreamingServer[ControlMessage, ControlMessage]) error {
+	return status.Error(codes.Unimplemented, "method ControlStream not implemented")
+}
+func (UnimplementedAgentControlServer) mustEmbedUnimplementedAgentControlServer() {}
+func (UnimplementedAgentControlServer) testEmbeddedByValue()                      {}
+
+// UnsafeAgentControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentControlServer will
+// result in compilation errors.
+type UnsafeAgentControlServer interface {
+	mustEmbedUnimplementedAgentControlServer()
+}
+
+func RegisterAgentControlServer(s grpc.ServiceRegistrar, srv AgentControlServer) {
+	// If the following call panics, it indicates UnimplementedAgentControlServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AgentControl_ServiceDesc, srv)
+}
+
+func _AgentControl_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).RegisterNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_RegisterNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).Heartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_Heartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyWorkload(ctx, req.(*ApplyWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteWorkload(ctx, req.(*DeleteWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListWorkloadRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkloadRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListWorkloadRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListWorkloadRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListWorkloadRevisions(ctx, req.(*ListWorkloadRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_RollbackWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).RollbackWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_RollbackWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).RollbackWorkload(ctx, req.(*RollbackWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_RetryWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).RetryWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_RetryWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).RetryWorkload(ctx, req.(*RetryWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_SubmitAutomationSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAutomationSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).SubmitAutomationSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_SubmitAutomationSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).SubmitAutomationSuggestion(ctx, req.(*SubmitAutomationSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListNodes(ctx, req.(*ListNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetNode(ctx, req.(*GetNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorkloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListWorkloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListWorkloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListWorkloads(ctx, req.(*ListWorkloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetWorkload(ctx, req.(*GetWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetClusterSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetClusterSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetClusterSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetClusterSummary(ctx, req.(*GetClusterSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListPendingWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingWorkloadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListPendingWorkloads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListPendingWorkloads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListPendingWorkloads(ctx, req.(*ListPendingWorkloadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_SimulatePlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulatePlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).SimulatePlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_SimulatePlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).SimulatePlacement(ctx, req.(*SimulatePlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetWorkloadUsageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkloadUsageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetWorkloadUsageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetWorkloadUsageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetWorkloadUsageHistory(ctx, req.(*GetWorkloadUsageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyReplicaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyReplicaSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyReplicaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyReplicaSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyReplicaSet(ctx, req.(*ApplyReplicaSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ScaleReplicaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScaleReplicaSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ScaleReplicaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ScaleReplicaSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ScaleReplicaSet(ctx, req.(*ScaleReplicaSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteReplicaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicaSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteReplicaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteReplicaSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteReplicaSet(ctx, req.(*DeleteReplicaSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetReplicaSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReplicaSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetReplicaSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetReplicaSet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetReplicaSet(ctx, req.(*GetReplicaSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListReplicaSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicaSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListReplicaSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListReplicaSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListReplicaSets(ctx, req.(*ListReplicaSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyNamespace(ctx, req.(*ApplyNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteNamespace(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetNamespace(ctx, req.(*GetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListNamespaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListNamespaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListNamespaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListNamespaces(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplySecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplySecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplySecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplySecret(ctx, req.(*ApplySecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteSecret(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetSecret(ctx, req.(*GetSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyConfig(ctx, req.(*ApplyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteConfig(ctx, req.(*DeleteConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListConfigs(ctx, req.(*ListConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyService(ctx, req.(*ApplyServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteService(ctx, req.(*DeleteServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetService(ctx, req.(*GetServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListServices(ctx, req.(*ListServicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyStack(ctx, req.(*ApplyStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteStack(ctx, req.(*DeleteStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetStack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetStack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetStack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetStack(ctx, req.(*GetStackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListStacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListStacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListStacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListStacks(ctx, req.(*ListStacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_SetStackState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStackStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).SetStackState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_SetStackState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).SetStackState(ctx, req.(*SetStackStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyJob(ctx, req.(*ApplyJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteJob(ctx, req.(*DeleteJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_ApplyCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ApplyCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ApplyCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ApplyCronJob(ctx, req.(*ApplyCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).DeleteCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_DeleteCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).DeleteCronJob(ctx, req.(*DeleteCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentControl_GetCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).GetCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_GetCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).GetCronJob(ctx, req.(*GetCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAgentControlServer) GetClusterSummary(context.Context, *GetClusterSummaryRequest) (*GetClusterSummaryResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetClusterSummary not implemented")
+
+func _AgentControl_ListCronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCronJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).ListCronJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_ListCronJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).ListCronJobs(ctx, req.(*ListCronJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAgentControlServer) ControlStream(grpc.BidiStreamingServer[ControlMessage, ControlMessage]) error {
-	return status.Error(codes.Unimplemented, "method ControlStream not implemented")
+
+func _AgentControl_CordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).CordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_CordonNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).CordonNode(ctx, req.(*CordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedAgentControlServer) mustEmbedUnimplementedAgentControlServer() {}
-func (UnimplementedAgentControlServer) testEmbeddedByValue()                      {}
 
-// UnsafeAgentControlServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AgentControlServer will
-// result in compilation errors.
-type UnsafeAgentControlServer interface {
-	mustEmbedUnimplementedAgentControlServer()
+func _AgentControl_UncordonNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentControlServer).UncordonNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_UncordonNode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).UncordonNode(ctx, req.(*UncordonNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAgentControlServer(s grpc.ServiceRegistrar, srv AgentControlServer) {
-	// If the following call panics, it indicates UnimplementedAgentControlServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _AgentControl_SetNodeTaints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeTaintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&AgentControl_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(AgentControlServer).SetNodeTaints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentControl_SetNodeTaints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentControlServer).SetNodeTaints(ctx, req.(*SetNodeTaintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_RegisterNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterNodeRequest)
+func _AgentControl_DrainNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).RegisterNode(ctx, in)
+		return srv.(AgentControlServer).DrainNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_RegisterNode_FullMethodName,
+		FullMethod: AgentControl_DrainNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).RegisterNode(ctx, req.(*RegisterNodeRequest))
+		return srv.(AgentControlServer).DrainNode(ctx, req.(*DrainNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_Heartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HeartbeatRequest)
+func _AgentControl_RunDescheduler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunDeschedulerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).Heartbeat(ctx, in)
+		return srv.(AgentControlServer).RunDescheduler(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_Heartbeat_FullMethodName,
+		FullMethod: AgentControl_RunDescheduler_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).Heartbeat(ctx, req.(*HeartbeatRequest))
+		return srv.(AgentControlServer).RunDescheduler(ctx, req.(*RunDeschedulerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_ApplyWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyWorkloadRequest)
+func _AgentControl_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).ApplyWorkload(ctx, in)
+		return srv.(AgentControlServer).CreateVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_ApplyWorkload_FullMethodName,
+		FullMethod: AgentControl_CreateVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).ApplyWorkload(ctx, req.(*ApplyWorkloadRequest))
+		return srv.(AgentControlServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_DeleteWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteWorkloadRequest)
+func _AgentControl_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).DeleteWorkload(ctx, in)
+		return srv.(AgentControlServer).GetVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_DeleteWorkload_FullMethodName,
+		FullMethod: AgentControl_GetVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).DeleteWorkload(ctx, req.(*DeleteWorkloadRequest))
+		return srv.(AgentControlServer).GetVolume(ctx, req.(*GetVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_RetryWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RetryWorkloadRequest)
+func _AgentControl_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).RetryWorkload(ctx, in)
+		return srv.(AgentControlServer).ListVolumes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_RetryWorkload_FullMethodName,
+		FullMethod: AgentControl_ListVolumes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).RetryWorkload(ctx, req.(*RetryWorkloadRequest))
+		return srv.(AgentControlServer).ListVolumes(ctx, req.(*ListVolumesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_SubmitAutomationSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitAutomationSuggestionRequest)
+func _AgentControl_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).SubmitAutomationSuggestion(ctx, in)
+		return srv.(AgentControlServer).DeleteVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_SubmitAutomationSuggestion_FullMethodName,
+		FullMethod: AgentControl_DeleteVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).SubmitAutomationSuggestion(ctx, req.(*SubmitAutomationSuggestionRequest))
+		return srv.(AgentControlServer).DeleteVolume(ctx, req.(*DeleteVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListNodesRequest)
+func _AgentControl_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).ListNodes(ctx, in)
+		return srv.(AgentControlServer).ResizeVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_ListNodes_FullMethodName,
+		FullMethod: AgentControl_ResizeVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).ListNodes(ctx, req.(*ListNodesRequest))
+		return srv.(AgentControlServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeRequest)
+func _AgentControl_SnapshotVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).GetNode(ctx, in)
+		return srv.(AgentControlServer).SnapshotVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_GetNode_FullMethodName,
+		FullMethod: AgentControl_SnapshotVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).GetNode(ctx, req.(*GetNodeRequest))
+		return srv.(AgentControlServer).SnapshotVolume(ctx, req.(*SnapshotVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_ListWorkloads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWorkloadsRequest)
+func _AgentControl_CloneVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneVolumeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).ListWorkloads(ctx, in)
+		return srv.(AgentControlServer).CloneVolume(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_ListWorkloads_FullMethodName,
+		FullMethod: AgentControl_CloneVolume_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).ListWorkloads(ctx, req.(*ListWorkloadsRequest))
+		return srv.(AgentControlServer).CloneVolume(ctx, req.(*CloneVolumeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_GetWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWorkloadRequest)
+func _AgentControl_ExportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).GetWorkload(ctx, in)
+		return srv.(AgentControlServer).ExportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_GetWorkload_FullMethodName,
+		FullMethod: AgentControl_ExportState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).GetWorkload(ctx, req.(*GetWorkloadRequest))
+		return srv.(AgentControlServer).ExportState(ctx, req.(*ExportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AgentControl_GetClusterSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetClusterSummaryRequest)
+func _AgentControl_ImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AgentControlServer).GetClusterSummary(ctx, in)
+		return srv.(AgentControlServer).ImportState(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AgentControl_GetClusterSummary_FullMethodName,
+		FullMethod: AgentControl_ImportState_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AgentControlServer).GetClusterSummary(ctx, req.(*GetClusterSummaryRequest))
+		return srv.(AgentControlServer).ImportState(ctx, req.(*ImportStateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentControl_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentControlServer).WatchEvents(m, &grpc.GenericServerStream[WatchEventsRequest, SchedulerEventView]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_WatchEventsServer = grpc.ServerStreamingServer[SchedulerEventView]
+
+func _AgentControl_StreamWorkloadLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamWorkloadLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AgentControlServer).StreamWorkloadLogs(m, &grpc.GenericServerStream[StreamWorkloadLogsRequest, WorkloadLogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_StreamWorkloadLogsServer = grpc.ServerStreamingServer[WorkloadLogChunk]
+
+func _AgentControl_ExecWorkload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentControlServer).ExecWorkload(&grpc.GenericServerStream[ExecWorkloadRequest, ExecWorkloadResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AgentControl_ExecWorkloadServer = grpc.BidiStreamingServer[ExecWorkloadRequest, ExecWorkloadResponse]
+
 func _AgentControl_ControlStream_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(AgentControlServer).ControlStream(&grpc.GenericServerStream[ControlMessage, ControlMessage]{ServerStream: stream})
 }
@@ -505,6 +2435,14 @@ var AgentControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorkload",
 			Handler:    _AgentControl_DeleteWorkload_Handler,
 		},
+		{
+			MethodName: "ListWorkloadRevisions",
+			Handler:    _AgentControl_ListWorkloadRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackWorkload",
+			Handler:    _AgentControl_RollbackWorkload_Handler,
+		},
 		{
 			MethodName: "RetryWorkload",
 			Handler:    _AgentControl_RetryWorkload_Handler,
@@ -533,8 +2471,228 @@ var AgentControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterSummary",
 			Handler:    _AgentControl_GetClusterSummary_Handler,
 		},
+		{
+			MethodName: "ListPendingWorkloads",
+			Handler:    _AgentControl_ListPendingWorkloads_Handler,
+		},
+		{
+			MethodName: "SimulatePlacement",
+			Handler:    _AgentControl_SimulatePlacement_Handler,
+		},
+		{
+			MethodName: "GetWorkloadUsageHistory",
+			Handler:    _AgentControl_GetWorkloadUsageHistory_Handler,
+		},
+		{
+			MethodName: "ApplyReplicaSet",
+			Handler:    _AgentControl_ApplyReplicaSet_Handler,
+		},
+		{
+			MethodName: "ScaleReplicaSet",
+			Handler:    _AgentControl_ScaleReplicaSet_Handler,
+		},
+		{
+			MethodName: "DeleteReplicaSet",
+			Handler:    _AgentControl_DeleteReplicaSet_Handler,
+		},
+		{
+			MethodName: "GetReplicaSet",
+			Handler:    _AgentControl_GetReplicaSet_Handler,
+		},
+		{
+			MethodName: "ListReplicaSets",
+			Handler:    _AgentControl_ListReplicaSets_Handler,
+		},
+		{
+			MethodName: "ApplyNamespace",
+			Handler:    _AgentControl_ApplyNamespace_Handler,
+		},
+		{
+			MethodName: "DeleteNamespace",
+			Handler:    _AgentControl_DeleteNamespace_Handler,
+		},
+		{
+			MethodName: "GetNamespace",
+			Handler:    _AgentControl_GetNamespace_Handler,
+		},
+		{
+			MethodName: "ListNamespaces",
+			Handler:    _AgentControl_ListNamespaces_Handler,
+		},
+		{
+			MethodName: "ApplySecret",
+			Handler:    _AgentControl_ApplySecret_Handler,
+		},
+		{
+			MethodName: "DeleteSecret",
+			Handler:    _AgentControl_DeleteSecret_Handler,
+		},
+		{
+			MethodName: "GetSecret",
+			Handler:    _AgentControl_GetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _AgentControl_ListSecrets_Handler,
+		},
+		{
+			MethodName: "ApplyConfig",
+			Handler:    _AgentControl_ApplyConfig_Handler,
+		},
+		{
+			MethodName: "DeleteConfig",
+			Handler:    _AgentControl_DeleteConfig_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _AgentControl_GetConfig_Handler,
+		},
+		{
+			MethodName: "ListConfigs",
+			Handler:    _AgentControl_ListConfigs_Handler,
+		},
+		{
+			MethodName: "ApplyService",
+			Handler:    _AgentControl_ApplyService_Handler,
+		},
+		{
+			MethodName: "DeleteService",
+			Handler:    _AgentControl_DeleteService_Handler,
+		},
+		{
+			MethodName: "GetService",
+			Handler:    _AgentControl_GetService_Handler,
+		},
+		{
+			MethodName: "ListServices",
+			Handler:    _AgentControl_ListServices_Handler,
+		},
+		{
+			MethodName: "ApplyStack",
+			Handler:    _AgentControl_ApplyStack_Handler,
+		},
+		{
+			MethodName: "DeleteStack",
+			Handler:    _AgentControl_DeleteStack_Handler,
+		},
+		{
+			MethodName: "GetStack",
+			Handler:    _AgentControl_GetStack_Handler,
+		},
+		{
+			MethodName: "ListStacks",
+			Handler:    _AgentControl_ListStacks_Handler,
+		},
+		{
+			MethodName: "SetStackState",
+			Handler:    _AgentControl_SetStackState_Handler,
+		},
+		{
+			MethodName: "ApplyJob",
+			Handler:    _AgentControl_ApplyJob_Handler,
+		},
+		{
+			MethodName: "DeleteJob",
+			Handler:    _AgentControl_DeleteJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _AgentControl_GetJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _AgentControl_ListJobs_Handler,
+		},
+		{
+			MethodName: "ApplyCronJob",
+			Handler:    _AgentControl_ApplyCronJob_Handler,
+		},
+		{
+			MethodName: "DeleteCronJob",
+			Handler:    _AgentControl_DeleteCronJob_Handler,
+		},
+		{
+			MethodName: "GetCronJob",
+			Handler:    _AgentControl_GetCronJob_Handler,
+		},
+		{
+			MethodName: "ListCronJobs",
+			Handler:    _AgentControl_ListCronJobs_Handler,
+		},
+		{
+			MethodName: "CordonNode",
+			Handler:    _AgentControl_CordonNode_Handler,
+		},
+		{
+			MethodName: "UncordonNode",
+			Handler:    _AgentControl_UncordonNode_Handler,
+		},
+		{
+			MethodName: "SetNodeTaints",
+			Handler:    _AgentControl_SetNodeTaints_Handler,
+		},
+		{
+			MethodName: "DrainNode",
+			Handler:    _AgentControl_DrainNode_Handler,
+		},
+		{
+			MethodName: "RunDescheduler",
+			Handler:    _AgentControl_RunDescheduler_Handler,
+		},
+		{
+			MethodName: "CreateVolume",
+			Handler:    _AgentControl_CreateVolume_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _AgentControl_GetVolume_Handler,
+		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _AgentControl_ListVolumes_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _AgentControl_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "ResizeVolume",
+			Handler:    _AgentControl_ResizeVolume_Handler,
+		},
+		{
+			MethodName: "SnapshotVolume",
+			Handler:    _AgentControl_SnapshotVolume_Handler,
+		},
+		{
+			MethodName: "CloneVolume",
+			Handler:    _AgentControl_CloneVolume_Handler,
+		},
+		{
+			MethodName: "ExportState",
+			Handler:    _AgentControl_ExportState_Handler,
+		},
+		{
+			MethodName: "ImportState",
+			Handler:    _AgentControl_ImportState_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _AgentControl_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamWorkloadLogs",
+			Handler:       _AgentControl_StreamWorkloadLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExecWorkload",
+			Handler:       _AgentControl_ExecWorkload_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ControlStream",
 			Handler:       _AgentControl_ControlStream_Handler,